@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"log"
 	"net/http"
@@ -19,6 +20,9 @@ import (
 )
 
 func main() {
+	migrateOnly := flag.Bool("migrate-only", false, "run database migrations and exit")
+	flag.Parse()
+
 	// Load configuration
 	cfg, err := config.Load()
 	if err != nil {
@@ -28,6 +32,18 @@ func main() {
 	// Initialize logger
 	logger := logger.New(cfg.Logger)
 
+	// Migrate-only mode applies schema migrations and exits, for deploy pipelines
+	if *migrateOnly {
+		db, err := factory.ConnectDatabase(cfg, logger)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("Failed to run migrations")
+		}
+		db.Close()
+
+		logger.Info().Msg("Migrations applied")
+		return
+	}
+
 	// Initialize dependencies
 	deps, err := factory.NewDependencies(cfg, logger)
 	if err != nil {
@@ -42,9 +58,15 @@ func main() {
 
 	// Create router
 	r := gin.New()
+	// Without this gin trusts X-Forwarded-For from any caller, which would let clients pick
+	// the IP that rate limits and webhook allowlists see
+	if err := r.SetTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+		logger.Fatal().Err(err).Msg("Invalid trusted proxies")
+	}
 
 	// Setup middleware
 	r.Use(middleware.Logger(logger))
+	r.Use(middleware.ResponseMeta())
 	r.Use(middleware.Recovery(logger))
 	r.Use(middleware.CORS())
 	r.Use(middleware.I18n(deps.I18n))
@@ -53,6 +75,12 @@ func main() {
 	// Setup routes
 	router.SetupRoutes(r, deps)
 
+	// Start background jobs
+	if cfg.Scheduler.Enabled {
+		deps.Scheduler.Start(context.Background())
+		defer deps.Scheduler.Stop()
+	}
+
 	// Create server
 	srv := &http.Server{
 		Addr:    fmt.Sprintf(":%d", cfg.Server.Port),
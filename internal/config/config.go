@@ -1,30 +1,52 @@
 package config
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"net/netip"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	Server    ServerConfig
-	Database  DatabaseConfig
-	Logger    LoggerConfig
-	JWT       JWTConfig
-	RateLimit RateLimitConfig
-	AWS       AWSConfig
-	Redis     RedisConfig
-	Twilio    TwilioConfig
-	Email     EmailConfig
-	Stripe    StripeConfig
+	Server     ServerConfig
+	Database   DatabaseConfig
+	Logger     LoggerConfig
+	JWT        JWTConfig
+	RateLimit  RateLimitConfig
+	AWS        AWSConfig
+	Media      MediaConfig
+	Redis      RedisConfig
+	Twilio     TwilioConfig
+	Maps       MapsConfig
+	Email      EmailConfig
+	Stripe     StripeConfig
+	Event      EventConfig
+	Scheduler  SchedulerConfig
+	Pagination PaginationConfig
+	Export     ExportConfig
+	Retention  RetentionConfig
 }
 
 type ServerConfig struct {
 	Port int
 	Mode string
+
+	// TrustedProxies are the IPs or CIDR ranges of the load balancers in front of the server.
+	// Only these may set the client IP through X-Forwarded-For; empty trusts no proxy, so the
+	// client IP is always the connection's address.
+	TrustedProxies []string
+}
+
+// IsDevelopment reports whether the server runs in development mode
+func (c ServerConfig) IsDevelopment() bool {
+	return c.Mode == "development"
 }
 
 type DatabaseConfig struct {
@@ -34,6 +56,10 @@ type DatabaseConfig struct {
 	Password string
 	DBName   string
 	SSLMode  string
+
+	// Startup retries while the database is not yet reachable
+	ConnectRetries int
+	RetryInterval  time.Duration
 }
 
 type LoggerConfig struct {
@@ -49,6 +75,14 @@ type JWTConfig struct {
 type RateLimitConfig struct {
 	RequestsPerMinute int
 	BurstSize         int
+
+	// Public search endpoints get their own, stricter per-IP bucket
+	SearchRequestsPerMinute int
+	SearchBurstSize         int
+
+	// Inbound payment webhooks get their own per-IP bucket
+	WebhookRequestsPerMinute int
+	WebhookBurstSize         int
 }
 
 type AWSConfig struct {
@@ -60,6 +94,20 @@ type AWSConfig struct {
 	UsePathStyleEndpoint bool
 }
 
+// MediaConfig controls the links media is served through. Uploaded files are private in the
+// bucket; media outside private events is reachable at <base>/files/<file name>, media of
+// private events only through expiring signed links.
+type MediaConfig struct {
+	// SigningSecret signs the links; it falls back to the JWT secret
+	SigningSecret string
+	// SignedURLTTL is how long a signed link keeps working
+	SignedURLTTL time.Duration
+	// SignedURLBase is the public address of the media routes, e.g.
+	// https://api.example.com/api/v1/media; signed links are <base>/<id>/signed?... and file
+	// links <base>/files/<file name>
+	SignedURLBase string
+}
+
 type RedisConfig struct {
 	Host     string
 	Port     string
@@ -76,6 +124,12 @@ type TwilioConfig struct {
 	MaxAttempts   int
 }
 
+// MapsConfig holds the Google Maps Platform settings used for venue timezone lookups
+type MapsConfig struct {
+	GoogleAPIKey    string // Empty disables lookups; venues then have no timezone
+	TimezoneTimeout time.Duration
+}
+
 type EmailConfig struct {
 	SMTPHost     string
 	SMTPPort     int
@@ -94,6 +148,130 @@ type StripeConfig struct {
 	SuccessURL     string
 	CancelURL      string
 	WebhookURL     string
+
+	// WebhookAllowedIPs restricts webhook deliveries to these IPs or CIDR ranges, e.g. the
+	// ranges Stripe publishes; empty accepts any source
+	WebhookAllowedIPs []string
+	// WebhookMaxBodyBytes rejects larger webhook bodies before their signature is verified
+	WebhookMaxBodyBytes int
+}
+
+// EventConfig narrows the event types and location types a deployment accepts.
+// Values outside the domain enums are never accepted, whatever is configured here.
+type EventConfig struct {
+	AllowedTypes         []string
+	AllowedLocationTypes []string
+
+	// ReportUnpublishThreshold stops a published event once it has this many pending abuse
+	// reports; zero disables it
+	ReportUnpublishThreshold int
+
+	// RequireEventImage blocks submitting or publishing events without an image. Drafts may
+	// still be saved without one.
+	RequireEventImage bool
+
+	// DescriptionFormat is how event descriptions are sanitized: "plain" strips all markup and
+	// stores the text HTML-escaped, "html" keeps basic formatting and links
+	DescriptionFormat string
+
+	// HidePrivateExistence reports private events a user may not see as not found instead of
+	// forbidden, so private event ids cannot be enumerated
+	HidePrivateExistence bool
+
+	// MaxInvitationsPerEvent caps the guest list of a single event; zero disables it. A lower
+	// subscription plan cap takes precedence.
+	MaxInvitationsPerEvent int
+	// InvitationLinkTTL is how long an RSVP link stays valid after the invitation is sent;
+	// zero keeps links valid until the invitation is answered
+	InvitationLinkTTL time.Duration
+	// InvitationLateResponseGrace is how long after an invitation expires at the event start a
+	// guest may still answer; late acceptances are stored as late_approved. Zero disables it.
+	InvitationLateResponseGrace time.Duration
+
+	// MaxDraftsPerCreator caps how many draft events a creator may keep at once; zero disables it
+	MaxDraftsPerCreator int
+
+	// MinTicketPrices is the lowest price a paid ticket may have, keyed by lower-case currency
+	// code. Free tickets are always allowed; currencies without an entry have no minimum.
+	MinTicketPrices map[string]float64
+
+	// CartHoldDuration is how long a cart reservation holds its tickets; buyers may ask for less
+	CartHoldDuration time.Duration
+
+	// CapacityWarningThresholds are the sold percentages at which organizers are warned that
+	// an event is filling up
+	CapacityWarningThresholds []int
+
+	// InterestMilestones are the interested-user counts at which organizers are told about
+	// demand for an event
+	InterestMilestones []int
+
+	// TrendingWindow is how far back views count towards trending; events created within it
+	// are listed even before they have views
+	TrendingWindow time.Duration
+	// TrendingHalfLife is the age at which a view counts half as much as a new one
+	TrendingHalfLife time.Duration
+
+	// AttentionWindow is how far ahead the creator's needs-attention list looks for events with
+	// low ticket sales; LowSalesPercent is the sold percentage below which sales count as low
+	AttentionWindow time.Duration
+	LowSalesPercent int
+
+	// SearchMinQueryLength rejects public search queries shorter than this many characters
+	SearchMinQueryLength int
+	// SearchCacheTTL is how long identical public search results are served from cache
+	SearchCacheTTL time.Duration
+
+	// ShareBaseURL is the public web address event pages live under; share previews link to
+	// <ShareBaseURL>/events/<id>
+	ShareBaseURL string
+	// ShareFallbackImageURL is the preview card used for events without an image
+	ShareFallbackImageURL string
+}
+
+// SchedulerConfig controls the background jobs run inside the API process
+type SchedulerConfig struct {
+	Enabled                    bool
+	SubscriptionExpiryInterval time.Duration
+	ExportInterval             time.Duration
+	PurgeInterval              time.Duration
+	NotificationInterval       time.Duration
+}
+
+// ExportConfig controls background CSV exports
+type ExportConfig struct {
+	// BatchSize is how many queued exports one worker run processes
+	BatchSize int
+	// DownloadURLTTL is how long a finished export's download link stays valid
+	DownloadURLTTL time.Duration
+	// ProcessingTimeout is how long an export may stay in processing before it is queued again
+	ProcessingTimeout time.Duration
+}
+
+// RetentionConfig sets how long operational records are kept before the purge job deletes
+// them; zero keeps a table forever. Financial records are never purged.
+type RetentionConfig struct {
+	EventHistory       time.Duration
+	ShareTokenAccesses time.Duration
+	ExportJobs         time.Duration
+	TicketReservations time.Duration
+	VerificationCodes  time.Duration
+	EventViews         time.Duration
+	// DeletedEvents is how long deleted events stay in the trash before they are purged
+	DeletedEvents time.Duration
+
+	// BatchSize is how many rows one delete statement removes, keeping locks short
+	BatchSize int
+}
+
+// cursorSecretLabel derives the cursor key from the JWT secret when no dedicated key is set
+const cursorSecretLabel = "louco-event/pagination-cursor/v1"
+
+// PaginationConfig holds the key used to sign keyset pagination cursors
+type PaginationConfig struct {
+	// CursorSecret is PAGINATION_CURSOR_SECRET, or a key derived from the JWT secret so a
+	// cursor signature can never be replayed as a token signature
+	CursorSecret string
 }
 
 func Load() (*Config, error) {
@@ -107,6 +285,8 @@ func Load() (*Config, error) {
 		Server: ServerConfig{
 			Port: getEnvAsInt("SERVER_PORT", 8080),
 			Mode: getEnv("SERVER_MODE", "development"),
+
+			TrustedProxies: getEnvAsSlice("SERVER_TRUSTED_PROXIES", nil),
 		},
 		Database: DatabaseConfig{
 			Host:     getEnv("DB_HOST", "localhost"),
@@ -115,6 +295,9 @@ func Load() (*Config, error) {
 			Password: getEnv("DB_PASSWORD", ""),
 			DBName:   getEnv("DB_NAME", "louco_event_db"),
 			SSLMode:  getEnv("DB_SSL_MODE", "disable"),
+
+			ConnectRetries: getEnvAsInt("DB_CONNECT_RETRIES", 5),
+			RetryInterval:  getEnvAsDuration("DB_RETRY_INTERVAL", 2*time.Second),
 		},
 		Logger: LoggerConfig{
 			Level:  getEnv("LOG_LEVEL", "info"),
@@ -127,6 +310,12 @@ func Load() (*Config, error) {
 		RateLimit: RateLimitConfig{
 			RequestsPerMinute: getEnvAsInt("RATE_LIMIT_RPM", 60),
 			BurstSize:         getEnvAsInt("RATE_LIMIT_BURST", 10),
+
+			SearchRequestsPerMinute: getEnvAsInt("SEARCH_RATE_LIMIT_RPM", 20),
+			SearchBurstSize:         getEnvAsInt("SEARCH_RATE_LIMIT_BURST", 5),
+
+			WebhookRequestsPerMinute: getEnvAsInt("WEBHOOK_RATE_LIMIT_RPM", 300),
+			WebhookBurstSize:         getEnvAsInt("WEBHOOK_RATE_LIMIT_BURST", 50),
 		},
 		AWS: AWSConfig{
 			Endpoint:             getEnv("AWS_ENDPOINT", "https://nbg1.your-objectstorage.com"),
@@ -150,6 +339,10 @@ func Load() (*Config, error) {
 			ReviewerOTP:   getEnv("TWILIO_REVIEWER_OTP", ""),
 			MaxAttempts:   getEnvAsInt("TWILIO_MAX_ATTEMPTS", 5),
 		},
+		Maps: MapsConfig{
+			GoogleAPIKey:    getEnv("GOOGLE_MAPS_API_KEY", ""),
+			TimezoneTimeout: getEnvAsDuration("TIMEZONE_LOOKUP_TIMEOUT", 5*time.Second),
+		},
 		Email: EmailConfig{
 			SMTPHost:     getEnv("SMTP_HOST", "smtp.gmail.com"),
 			SMTPPort:     getEnvAsInt("SMTP_PORT", 587),
@@ -167,7 +360,76 @@ func Load() (*Config, error) {
 			SuccessURL:     getEnv("STRIPE_SUCCESS_URL", "https://your-domain.com/payment/success"),
 			CancelURL:      getEnv("STRIPE_CANCEL_URL", "https://your-domain.com/payment/cancel"),
 			WebhookURL:     getEnv("STRIPE_WEBHOOK_URL", "https://your-domain.com/api/v1/webhooks/stripe"),
+
+			WebhookAllowedIPs:   getEnvAsSlice("STRIPE_WEBHOOK_ALLOWED_IPS", nil),
+			WebhookMaxBodyBytes: getEnvAsInt("STRIPE_WEBHOOK_MAX_BODY_BYTES", 256*1024),
+		},
+		Event: EventConfig{
+			AllowedTypes:         getEnvAsSlice("EVENT_ALLOWED_TYPES", []string{"public", "private"}),
+			AllowedLocationTypes: getEnvAsSlice("EVENT_ALLOWED_LOCATION_TYPES", []string{"location", "online", "announcement"}),
+
+			ReportUnpublishThreshold: getEnvAsInt("EVENT_REPORT_UNPUBLISH_THRESHOLD", 5),
+
+			RequireEventImage:           getEnvAsBool("EVENT_REQUIRE_IMAGE", false),
+			DescriptionFormat:           getEnv("EVENT_DESCRIPTION_FORMAT", "plain"),
+			HidePrivateExistence:        getEnvAsBool("EVENT_HIDE_PRIVATE_EXISTENCE", false),
+			MaxInvitationsPerEvent:      getEnvAsInt("EVENT_MAX_INVITATIONS_PER_EVENT", 500),
+			InvitationLinkTTL:           getEnvAsDuration("EVENT_INVITATION_LINK_TTL", 7*24*time.Hour),
+			InvitationLateResponseGrace: getEnvAsDuration("EVENT_INVITATION_LATE_RESPONSE_GRACE", 0),
+			MaxDraftsPerCreator:         getEnvAsInt("EVENT_MAX_DRAFTS_PER_CREATOR", 0),
+
+			MinTicketPrices:  getEnvAsFloatMap("EVENT_MIN_TICKET_PRICES", nil),
+			CartHoldDuration: getEnvAsDuration("EVENT_CART_HOLD_DURATION", 10*time.Minute),
+
+			CapacityWarningThresholds: getEnvAsIntSlice("EVENT_CAPACITY_WARNING_THRESHOLDS", []int{80, 95, 100}),
+			InterestMilestones:        getEnvAsIntSlice("EVENT_INTEREST_MILESTONES", []int{10, 50, 100}),
+
+			TrendingWindow:   getEnvAsDuration("EVENT_TRENDING_WINDOW", 7*24*time.Hour),
+			TrendingHalfLife: getEnvAsDuration("EVENT_TRENDING_HALF_LIFE", 24*time.Hour),
+
+			AttentionWindow: getEnvAsDuration("EVENT_ATTENTION_WINDOW", 72*time.Hour),
+			LowSalesPercent: getEnvAsInt("EVENT_LOW_SALES_PERCENT", 25),
+
+			SearchMinQueryLength: getEnvAsInt("EVENT_SEARCH_MIN_QUERY_LENGTH", 3),
+			SearchCacheTTL:       getEnvAsDuration("EVENT_SEARCH_CACHE_TTL", 30*time.Second),
+
+			ShareBaseURL:          getEnv("EVENT_SHARE_BASE_URL", "https://your-domain.com"),
+			ShareFallbackImageURL: getEnv("EVENT_SHARE_FALLBACK_IMAGE_URL", ""),
+		},
+		Scheduler: SchedulerConfig{
+			Enabled:                    getEnvAsBool("SCHEDULER_ENABLED", true),
+			SubscriptionExpiryInterval: getEnvAsDuration("SCHEDULER_SUBSCRIPTION_EXPIRY_INTERVAL", time.Hour),
+			ExportInterval:             getEnvAsDuration("SCHEDULER_EXPORT_INTERVAL", 30*time.Second),
+			PurgeInterval:              getEnvAsDuration("SCHEDULER_PURGE_INTERVAL", 24*time.Hour),
+			NotificationInterval:       getEnvAsDuration("SCHEDULER_NOTIFICATION_INTERVAL", time.Minute),
+		},
+		Media: MediaConfig{
+			SigningSecret: getEnv("MEDIA_URL_SIGNING_SECRET", getEnv("JWT_SECRET", "your-secret-key")),
+			SignedURLTTL:  getEnvAsDuration("MEDIA_SIGNED_URL_TTL", time.Hour),
+			SignedURLBase: getEnv("MEDIA_SIGNED_URL_BASE", "/api/v1/media"),
+		},
+		Pagination: PaginationConfig{
+			CursorSecret: getEnv("PAGINATION_CURSOR_SECRET", ""),
 		},
+		Export: ExportConfig{
+			BatchSize:         getEnvAsInt("EXPORT_BATCH_SIZE", 5),
+			DownloadURLTTL:    getEnvAsDuration("EXPORT_DOWNLOAD_URL_TTL", 24*time.Hour),
+			ProcessingTimeout: getEnvAsDuration("EXPORT_PROCESSING_TIMEOUT", 30*time.Minute),
+		},
+		Retention: RetentionConfig{
+			EventHistory:       getEnvAsDuration("RETENTION_EVENT_HISTORY", 365*24*time.Hour),
+			ShareTokenAccesses: getEnvAsDuration("RETENTION_SHARE_TOKEN_ACCESSES", 90*24*time.Hour),
+			ExportJobs:         getEnvAsDuration("RETENTION_EXPORT_JOBS", 30*24*time.Hour),
+			TicketReservations: getEnvAsDuration("RETENTION_TICKET_RESERVATIONS", 7*24*time.Hour),
+			VerificationCodes:  getEnvAsDuration("RETENTION_VERIFICATION_CODES", 7*24*time.Hour),
+			EventViews:         getEnvAsDuration("RETENTION_EVENT_VIEWS", 90*24*time.Hour),
+			DeletedEvents:      getEnvAsDuration("RETENTION_DELETED_EVENTS", 30*24*time.Hour),
+			BatchSize:          getEnvAsInt("RETENTION_BATCH_SIZE", 1000),
+		},
+	}
+
+	if cfg.Pagination.CursorSecret == "" {
+		cfg.Pagination.CursorSecret = deriveSecret(cfg.JWT.Secret, cursorSecretLabel)
 	}
 
 	if err := cfg.validate(); err != nil {
@@ -206,9 +468,43 @@ func (c *Config) validate() error {
 		return fmt.Errorf("Stripe webhook secret is required")
 	}
 
+	if entry, ok := firstInvalidIPRange(c.Stripe.WebhookAllowedIPs); !ok {
+		return fmt.Errorf("invalid Stripe webhook allowed IP or range: %s", entry)
+	}
+	if entry, ok := firstInvalidIPRange(c.Server.TrustedProxies); !ok {
+		return fmt.Errorf("invalid trusted proxy IP or range: %s", entry)
+	}
+
 	return nil
 }
 
+// deriveSecret returns a key for one purpose derived from a shared secret, so keys for
+// different purposes never match even though they come from the same setting
+func deriveSecret(secret, label string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(label))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// firstInvalidIPRange returns the first entry that is neither an IP nor a CIDR range, and
+// false when there is one
+func firstInvalidIPRange(entries []string) (string, bool) {
+	for _, entry := range entries {
+		if _, err := netip.ParsePrefix(entry); err == nil {
+			continue
+		}
+		if _, err := netip.ParseAddr(entry); err != nil {
+			return entry, false
+		}
+	}
+	return "", true
+}
+
+// MinTicketPrice returns the minimum paid ticket price for the currency, zero when none is set
+func (c EventConfig) MinTicketPrice(currency string) float64 {
+	return c.MinTicketPrices[strings.ToLower(currency)]
+}
+
 func (c *Config) GetDSN() string {
 	return fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
 		c.Database.Host,
@@ -253,3 +549,54 @@ func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
 	}
 	return defaultValue
 }
+
+func getEnvAsSlice(key string, defaultValue []string) []string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	var values []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			values = append(values, item)
+		}
+	}
+	if len(values) == 0 {
+		return defaultValue
+	}
+	return values
+}
+
+func getEnvAsIntSlice(key string, defaultValue []int) []int {
+	var values []int
+	for _, item := range getEnvAsSlice(key, nil) {
+		intValue, err := strconv.Atoi(item)
+		if err != nil {
+			return defaultValue
+		}
+		values = append(values, intValue)
+	}
+	if len(values) == 0 {
+		return defaultValue
+	}
+	return values
+}
+
+// getEnvAsFloatMap parses "key:value" pairs separated by commas, e.g. "eur:0.50,usd:1".
+// Keys are lower-cased; a malformed pair falls back to the default.
+func getEnvAsFloatMap(key string, defaultValue map[string]float64) map[string]float64 {
+	values := make(map[string]float64)
+	for _, item := range getEnvAsSlice(key, nil) {
+		name, raw, found := strings.Cut(item, ":")
+		floatValue, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+		if !found || err != nil {
+			return defaultValue
+		}
+		values[strings.ToLower(strings.TrimSpace(name))] = floatValue
+	}
+	if len(values) == 0 {
+		return defaultValue
+	}
+	return values
+}
@@ -15,6 +15,7 @@ type Address struct {
 	PostalCode  *string   `json:"postal_code" gorm:"type:varchar(20)"`
 	Latitude    float64   `json:"latitude" gorm:"type:decimal(10,8);not null"`
 	Longitude   float64   `json:"longitude" gorm:"type:decimal(11,8);not null"`
+	Timezone    string    `json:"timezone" gorm:"type:varchar(64);not null;default:''"` // IANA name looked up from the coordinates; empty when unknown
 	DoorNumber  *string   `json:"door_number" gorm:"type:varchar(50)"`
 	CreatedAt   time.Time `json:"created_at" gorm:"autoCreateTime"`
 	UpdatedAt   time.Time `json:"updated_at" gorm:"autoUpdateTime"`
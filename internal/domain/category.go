@@ -21,6 +21,19 @@ const (
 	CategoryTypeOther              CategoryType = "other"
 )
 
+// CategoryTypes lists every category type
+var CategoryTypes = []CategoryType{
+	CategoryTypeConcertsFestivals,
+	CategoryTypeParty,
+	CategoryTypeCulture,
+	CategoryTypeShows,
+	CategoryTypeSports,
+	CategoryTypeFreetimeActivities,
+	CategoryTypeBusiness,
+	CategoryTypeEthnic,
+	CategoryTypeOther,
+}
+
 type Category struct {
 	ID     int          `json:"id" gorm:"primaryKey;autoIncrement"`
 	Name   string       `json:"name" gorm:"type:varchar(200);not null"`
@@ -149,19 +162,7 @@ func (c *Category) ValidateRequiredFields() error {
 }
 
 func (c *Category) ValidateType() error {
-	validTypes := []CategoryType{
-		CategoryTypeConcertsFestivals,
-		CategoryTypeParty,
-		CategoryTypeCulture,
-		CategoryTypeShows,
-		CategoryTypeSports,
-		CategoryTypeFreetimeActivities,
-		CategoryTypeBusiness,
-		CategoryTypeEthnic,
-		CategoryTypeOther,
-	}
-
-	for _, validType := range validTypes {
+	for _, validType := range CategoryTypes {
 		if c.Type == validType {
 			return nil
 		}
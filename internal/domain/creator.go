@@ -12,6 +12,7 @@ type Creator struct {
 	Address          string    `json:"address" gorm:"type:varchar(500);not null"`
 	EstimatedTickets int       `json:"estimated_tickets" gorm:"not null"`
 	EstimatedEvents  int       `json:"estimated_events" gorm:"not null"`
+	Timezone         string    `json:"timezone" gorm:"type:varchar(64);not null;default:''"` // IANA name online events default to; empty means UTC
 	CreatedAt        time.Time `json:"created_at" gorm:"autoCreateTime"`
 	UpdatedAt        time.Time `json:"updated_at" gorm:"autoUpdateTime"`
 
@@ -107,4 +108,5 @@ var (
 	ErrCreatorEstimatedTicketsRequired = NewDomainError("estimated tickets is required for creator")
 	ErrCreatorEstimatedEventsRequired  = NewDomainError("estimated events is required for creator")
 	ErrCreatorIndustryRequired         = NewDomainError("at least one industry selection is required for creator")
+	ErrCreatorProfileNotFound          = NewLocalizedDomainError("creator.profile_not_found", "creator profile not found")
 )
@@ -1,7 +1,11 @@
 package domain
 
 import (
+	"fmt"
+	"strconv"
 	"time"
+
+	"gorm.io/gorm"
 )
 
 type EventType string
@@ -27,6 +31,29 @@ const (
 	EventStatusPublished EventStatus = "published"
 )
 
+// EventTypes, EventLocationTypes and EventStatuses list every value of their enum
+var (
+	EventTypes         = []EventType{EventTypePublic, EventTypePrivate}
+	EventLocationTypes = []EventLocationType{EventLocationTypeLocation, EventLocationTypeOnline, EventLocationTypeAnnouncement}
+	EventStatuses      = []EventStatus{
+		EventStatusDraft, EventStatusPending, EventStatusRejected,
+		EventStatusStopped, EventStatusCancelled, EventStatusPublished,
+	}
+)
+
+// IsValid reports whether the status is one of the known event statuses
+func (s EventStatus) IsValid() bool {
+	switch s {
+	case EventStatusDraft, EventStatusPending, EventStatusRejected,
+		EventStatusStopped, EventStatusCancelled, EventStatusPublished:
+		return true
+	}
+	return false
+}
+
+// DefaultEventDuration is assumed for events that have no end date or time
+const DefaultEventDuration = 3 * time.Hour
+
 type Event struct {
 	ID           int               `json:"id" gorm:"primaryKey;autoIncrement"`
 	CreatorID    int               `json:"creator_id" gorm:"not null;index"`
@@ -43,6 +70,8 @@ type Event struct {
 	StartTime *time.Time `json:"start_time" gorm:"type:time"`
 	EndDate   *time.Time `json:"end_date" gorm:"type:date"`
 	EndTime   *time.Time `json:"end_time" gorm:"type:time"`
+	// IANA name the dates and times are local to; empty means UTC
+	Timezone string `json:"timezone" gorm:"type:varchar(64);not null;default:''"`
 
 	// Location specific fields
 	AddressID *int `json:"address_id" gorm:"index"`
@@ -55,20 +84,53 @@ type Event struct {
 	TicketURL        *string `json:"ticket_url" gorm:"type:varchar(500)"`
 	HasSystemTickets bool    `json:"has_system_tickets" gorm:"default:false"`
 
+	// Sales cutoff, independent of each ticket's IsActive flag
+	SalesClosedAt     *time.Time `json:"sales_closed_at,omitempty" gorm:"default:null"`
+	CloseSalesAtStart bool       `json:"close_sales_at_start" gorm:"default:false"`
+
+	// Lets users without an invitation ask to join a private event
+	AllowJoinRequests bool `json:"allow_join_requests" gorm:"default:false"`
+
+	// Keeps the number of ticket holders off the public event stats
+	HideGoingCount bool `json:"hide_going_count" gorm:"default:false"`
+
+	// Seats shared by approved invitations and sold tickets; nil means unlimited
+	Capacity *int `json:"capacity" gorm:"default:null"`
+
+	// Curated by admins; featured lists show the most recently featured events first
+	Featured   bool       `json:"featured" gorm:"default:false;index"`
+	FeaturedAt *time.Time `json:"-" gorm:"default:null"`
+
+	// Highest capacity warning threshold (percent sold) already sent to the organizer
+	CapacityWarningLevel int `json:"-" gorm:"default:0"`
+	// Highest interest count milestone already sent to the organizer
+	InterestMilestoneLevel int `json:"-" gorm:"default:0"`
+
 	// Additional info
 	AdditionalInfo *string `json:"additional_info" gorm:"type:text"`
 
+	// Share link; the token is only ever shown to the owner
+	ShareToken          *string    `json:"-" gorm:"type:varchar(64);uniqueIndex"`
+	ShareTokenEnabled   bool       `json:"-" gorm:"default:false"`
+	ShareTokenCreatedAt *time.Time `json:"-" gorm:"default:null"`
+
+	// Distance in km from the searched point; only filled by geo queries, never stored
+	DistanceKm *float64 `json:"-" gorm:"->;-:migration"`
+
 	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
 	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+	// Deleted events stay in the trash, with their tickets and invitations, until they are purged
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
 
 	// Relations
-	Creator     Creator      `json:"creator" gorm:"foreignKey:CreatorID;references:ID"`
-	Image       *Media       `json:"image,omitempty" gorm:"foreignKey:ImageID;references:ID"`
-	Video       *Media       `json:"video,omitempty" gorm:"foreignKey:VideoID;references:ID"`
-	Address     *Address     `json:"address,omitempty" gorm:"foreignKey:AddressID;references:ID"`
-	Categories  []Category   `json:"categories" gorm:"many2many:event_categories;"`
-	Tickets     []Ticket     `json:"tickets" gorm:"foreignKey:EventID;references:ID"`
-	Invitations []Invitation `json:"invitations" gorm:"foreignKey:EventID;references:ID"`
+	Creator     Creator       `json:"creator" gorm:"foreignKey:CreatorID;references:ID"`
+	Image       *Media        `json:"image,omitempty" gorm:"foreignKey:ImageID;references:ID"`
+	Video       *Media        `json:"video,omitempty" gorm:"foreignKey:VideoID;references:ID"`
+	Address     *Address      `json:"address,omitempty" gorm:"foreignKey:AddressID;references:ID"`
+	Categories  []Category    `json:"categories" gorm:"many2many:event_categories;"`
+	Tickets     []Ticket      `json:"tickets" gorm:"foreignKey:EventID;references:ID"`
+	Invitations []Invitation  `json:"invitations" gorm:"foreignKey:EventID;references:ID"`
+	CoHosts     []EventCoHost `json:"co_hosts,omitempty" gorm:"foreignKey:EventID;references:ID"`
 }
 
 // EventCategory represents the many-to-many relationship between events and categories
@@ -115,11 +177,35 @@ func (e *Event) SetVideo(videoID int) {
 	e.UpdatedAt = time.Now()
 }
 
+// SetAddress points the event at another address. The loaded Address relation is dropped so
+// saving the event cannot restore the old foreign key from it.
 func (e *Event) SetAddress(addressID int) {
 	e.AddressID = &addressID
+	e.Address = nil
 	e.UpdatedAt = time.Now()
 }
 
+func (e *Event) RemoveAddress() {
+	e.AddressID = nil
+	e.Address = nil
+	e.UpdatedAt = time.Now()
+}
+
+// ChangeLocationType switches the location type and drops the location data only the previous
+// type may carry, so a later validation sees exactly what the new type allows
+func (e *Event) ChangeLocationType(locationType EventLocationType) {
+	if e.LocationType == locationType {
+		return
+	}
+	e.LocationType = locationType
+	switch locationType {
+	case EventLocationTypeLocation:
+		e.RemoveOnlineEventURL()
+	case EventLocationTypeOnline:
+		e.RemoveAddress()
+	}
+}
+
 func (e *Event) SetOnlineEventURL(url, eventType string) {
 	e.OnlineEventURL = &url
 	e.OnlineEventType = &eventType
@@ -331,6 +417,42 @@ func (e *Event) ValidateTicketRequirement() error {
 	return nil
 }
 
+// ValidateReadiness reports the first detail a draft still lacks before it can go live: start
+// date and time, an address or online URL for its location type, a category, and an active
+// ticket or a ticket URL. Announcements only need a category. Categories and Tickets must be
+// loaded.
+func (e *Event) ValidateReadiness() error {
+	if !e.IsAnnouncementEvent() {
+		if e.StartDate == nil || e.StartTime == nil {
+			return ErrEventDateTimeRequired
+		}
+		switch e.LocationType {
+		case EventLocationTypeLocation:
+			if e.AddressID == nil {
+				return ErrEventAddressRequired
+			}
+		case EventLocationTypeOnline:
+			if e.OnlineEventURL == nil || *e.OnlineEventURL == "" {
+				return ErrEventOnlineURLRequired
+			}
+		}
+	}
+	if len(e.Categories) == 0 {
+		return ErrEventCategoriesRequired
+	}
+	if e.IsAnnouncementEvent() || (e.TicketURL != nil && *e.TicketURL != "") {
+		return nil
+	}
+	if e.HasSystemTickets {
+		for _, ticket := range e.Tickets {
+			if ticket.IsActive {
+				return nil
+			}
+		}
+	}
+	return ErrEventTicketRequired
+}
+
 // Helper methods
 func (e *Event) IsPublic() bool {
 	return e.Type == EventTypePublic
@@ -380,6 +502,121 @@ func (e *Event) HasTickets() bool {
 	return e.HasSystemTickets || (e.TicketURL != nil && *e.TicketURL != "")
 }
 
+// IsPast reports whether the event's last day is before today; undated events are never past
+func (e *Event) IsPast() bool {
+	lastDay := e.EndDate
+	if lastDay == nil {
+		lastDay = e.StartDate
+	}
+	if lastDay == nil {
+		return false
+	}
+	return lastDay.Format("2006-01-02") < time.Now().Format("2006-01-02")
+}
+
+// SetShareToken replaces the share link token, which invalidates any previously shared link
+func (e *Event) SetShareToken(token string) {
+	now := time.Now()
+	e.ShareToken = &token
+	e.ShareTokenEnabled = true
+	e.ShareTokenCreatedAt = &now
+	e.UpdatedAt = now
+}
+
+// RevokeShareToken clears the share link so it stops working immediately
+func (e *Event) RevokeShareToken() {
+	e.ShareToken = nil
+	e.ShareTokenEnabled = false
+	e.ShareTokenCreatedAt = nil
+	e.UpdatedAt = time.Now()
+}
+
+// CapacityWarningThreshold returns the highest threshold reached by soldPercent that has not
+// been warned about yet. Only the highest one is returned so a large sale jumping past several
+// thresholds produces a single warning.
+func (e *Event) CapacityWarningThreshold(thresholds []int, soldPercent float64) (int, bool) {
+	reached := 0
+	for _, threshold := range thresholds {
+		if threshold > e.CapacityWarningLevel && soldPercent >= float64(threshold) && threshold > reached {
+			reached = threshold
+		}
+	}
+	return reached, reached > 0
+}
+
+// DetailChanges lists the details attendees rely on that differ between before and the event:
+// the start and end instants, the address and the online URL
+func (e *Event) DetailChanges(before *Event) []EventDetailChange {
+	var changes []EventDetailChange
+	add := func(field string, from, to *string) {
+		if (from == nil) != (to == nil) || (from != nil && *from != *to) {
+			changes = append(changes, EventDetailChange{Field: field, From: from, To: to})
+		}
+	}
+
+	add("start", formatEventMoment(before.StartDate, before.GetFullStartDateTime()), formatEventMoment(e.StartDate, e.GetFullStartDateTime()))
+	add("end", formatEventMoment(before.EndDate, before.GetFullEndDateTime()), formatEventMoment(e.EndDate, e.GetFullEndDateTime()))
+	add("address_id", formatEventID(before.AddressID), formatEventID(e.AddressID))
+	add("online_event_url", before.OnlineEventURL, e.OnlineEventURL)
+	return changes
+}
+
+// formatEventMoment formats the instant, or only the date for events without a time
+func formatEventMoment(date, instant *time.Time) *string {
+	var formatted string
+	switch {
+	case instant != nil:
+		formatted = instant.Format(time.RFC3339)
+	case date != nil:
+		formatted = date.Format("2006-01-02")
+	default:
+		return nil
+	}
+	return &formatted
+}
+
+func formatEventID(id *int) *string {
+	if id == nil {
+		return nil
+	}
+	formatted := strconv.Itoa(*id)
+	return &formatted
+}
+
+// RemainingCapacity returns the seats left when attendees are already taken, or nil when the
+// event has no capacity limit
+func (e *Event) RemainingCapacity(attendees int) *int {
+	if e.Capacity == nil {
+		return nil
+	}
+	remaining := max(*e.Capacity-attendees, 0)
+	return &remaining
+}
+
+// CheckCapacity reports whether seats more attendees still fit next to the ones already taken
+func (e *Event) CheckCapacity(attendees, seats int) error {
+	if remaining := e.RemainingCapacity(attendees); remaining != nil && seats > *remaining {
+		return ErrEventCapacityExceeded
+	}
+	return nil
+}
+
+// AreSalesClosed reports whether ticket sales were closed manually or the event has started
+// with automatic closing enabled
+func (e *Event) AreSalesClosed() bool {
+	now := time.Now()
+	if e.SalesClosedAt != nil && !now.Before(*e.SalesClosedAt) {
+		return true
+	}
+	if e.CloseSalesAtStart {
+		if start := e.GetFullStartDateTime(); start != nil && !now.Before(*start) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetFullStartDateTime combines the start date and time in the event's timezone
 func (e *Event) GetFullStartDateTime() *time.Time {
 	if e.StartDate == nil || e.StartTime == nil {
 		return nil
@@ -388,10 +625,11 @@ func (e *Event) GetFullStartDateTime() *time.Time {
 	// Combine date and time
 	year, month, day := e.StartDate.Date()
 	hour, min, sec := e.StartTime.Clock()
-	combined := time.Date(year, month, day, hour, min, sec, 0, e.StartDate.Location())
+	combined := time.Date(year, month, day, hour, min, sec, 0, e.TimeLocation())
 	return &combined
 }
 
+// GetFullEndDateTime combines the end date and time in the event's timezone
 func (e *Event) GetFullEndDateTime() *time.Time {
 	if e.EndDate == nil || e.EndTime == nil {
 		return nil
@@ -400,23 +638,96 @@ func (e *Event) GetFullEndDateTime() *time.Time {
 	// Combine date and time
 	year, month, day := e.EndDate.Date()
 	hour, min, sec := e.EndTime.Clock()
-	combined := time.Date(year, month, day, hour, min, sec, 0, e.EndDate.Location())
+	combined := time.Date(year, month, day, hour, min, sec, 0, e.TimeLocation())
 	return &combined
 }
 
+// TimeLocation returns the event's timezone, UTC when none or an unknown one is set
+func (e *Event) TimeLocation() *time.Location {
+	location, err := LoadEventTimezone(e.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+// LoadEventTimezone resolves an IANA timezone name; empty means UTC. The server's "Local" zone
+// is rejected since it differs between deployments.
+func LoadEventTimezone(name string) (*time.Location, error) {
+	if name == "Local" {
+		return nil, ErrEventInvalidTimezone
+	}
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, ErrEventInvalidTimezone
+	}
+	return location, nil
+}
+
 // Event domain errors
 var (
-	ErrEventNameRequired            = NewDomainError("event name is required")
-	ErrEventImageRequired           = NewDomainError("event image is required")
-	ErrEventVideoRequired           = NewDomainError("event video is required")
-	ErrEventAddressRequired         = NewDomainError("address is required for location events")
-	ErrEventTicketRequired          = NewDomainError("ticket information or ticket URL is required")
-	ErrEventDateTimeRequired        = NewDomainError("start date and time are required")
-	ErrEventCategoriesRequired      = NewDomainError("at least one category is required")
-	ErrEventInvalidLocationType     = NewDomainError("invalid location type")
-	ErrEventInvalidStatusTransition = NewDomainError("invalid status transition")
-	ErrEventAlreadyCancelled        = NewDomainError("event is already cancelled")
-	ErrEventNotFound                = NewDomainError("event not found")
-	ErrEventUnauthorized            = NewDomainError("unauthorized to access this event")
-	ErrEventCannotBeEdited          = NewDomainError("event cannot be edited in current status")
+	ErrEventNameRequired             = NewLocalizedDomainError("event.name_required", "event name is required")
+	ErrEventImageRequired            = NewLocalizedDomainError("event.image_required", "event image is required")
+	ErrEventVideoRequired            = NewLocalizedDomainError("event.video_required", "event video is required")
+	ErrEventAddressRequired          = NewLocalizedDomainError("event.address_required", "address is required for location events")
+	ErrEventTicketRequired           = NewLocalizedDomainError("event.ticket_required", "ticket information or ticket URL is required")
+	ErrEventDateTimeRequired         = NewLocalizedDomainError("event.datetime_required", "start date and time are required")
+	ErrEventCategoriesRequired       = NewLocalizedDomainError("event.categories_required", "at least one category is required")
+	ErrEventInvalidLocationType      = NewLocalizedDomainError("event.invalid_location_type", "invalid location type")
+	ErrEventInvalidStatusTransition  = NewLocalizedDomainError("event.invalid_status_transition", "invalid status transition")
+	ErrEventAlreadyCancelled         = NewLocalizedDomainError("event.already_cancelled", "event is already cancelled")
+	ErrEventNotFound                 = NewLocalizedDomainError("event.not_found", "event not found")
+	ErrEventUnauthorized             = NewLocalizedDomainError("event.access_denied", "unauthorized to access this event")
+	ErrEventCannotBeEdited           = NewLocalizedDomainError("event.cannot_be_edited", "event cannot be edited in current status")
+	ErrEventCannotBeDeleted          = NewLocalizedDomainError("event.cannot_be_deleted", "only draft events can be deleted")
+	ErrEventHasPurchases             = NewLocalizedDomainError("event.purge.has_purchases", "events with ticket purchases cannot be purged")
+	ErrEventOnlineURLRequired        = NewLocalizedDomainError("event.online_url_required", "online event URL is required for online events")
+	ErrEventOnlineURLNotAllowed      = NewLocalizedDomainError("event.online_url_not_allowed", "online event URL should not be provided for location-based events")
+	ErrEventAddressNotAllowed        = NewLocalizedDomainError("event.address_not_allowed", "address should not be provided for online events")
+	ErrEventEndBeforeStart           = NewLocalizedDomainError("event.end_before_start", "end date cannot be before start date")
+	ErrEventEndTimeBeforeStartTime   = NewLocalizedDomainError("event.end_time_before_start_time", "end time cannot be before start time on the same date")
+	ErrEventTicketSourceConflict     = NewLocalizedDomainError("event.ticket_source_conflict", "cannot have both system tickets and external ticket URL")
+	ErrEventSalesClosed              = NewLocalizedDomainError("event.sales_closed", "ticket sales for this event are closed")
+	ErrEventCapacityExceeded         = NewLocalizedDomainError("event.capacity_exceeded", "the event has no seats left")
+	ErrEventNotFeaturable            = NewLocalizedDomainError("event.feature.not_public", "only published public events can be featured")
+	ErrEventNotPrivate               = NewLocalizedDomainError("event.not_private", "only private events have invitees to notify")
+	ErrEventPublishingRights         = NewLocalizedDomainError("subscription.insufficient_publishing_rights", "insufficient publishing rights")
+	ErrEventDraftLimitReached        = NewLocalizedDomainError("event.draft_limit_reached", "draft event limit reached; publish or delete a draft first")
+	ErrEventInvalidDateRange         = NewLocalizedDomainError("event.invalid_date_range", "date range start cannot be after its end")
+	ErrEventInvalidTimezone          = NewLocalizedDomainError("event.invalid_timezone", "timezone must be a valid IANA name such as Europe/Istanbul")
+	ErrEventNotScheduled             = NewLocalizedDomainError("event.calendar.not_scheduled", "event has no date to add to a calendar")
+	ErrEventInvalidStatus            = NewLocalizedDomainError("event.invalid_status", "invalid event status")
+	ErrEventNoneToUpdate             = NewLocalizedDomainError("event.none_to_update", "no events to update")
+	ErrEventTooManyToUpdate          = NewLocalizedDomainError("event.bulk_status.too_many", "too many events in one bulk update")
+	ErrEventCategoryNotFound         = NewLocalizedDomainError("event.category_not_found", "category not found")
+	ErrEventSearchQueryTooShort      = NewLocalizedDomainError("event.search.query_too_short", "search query too short")
+	ErrEventCoordinatesRequired      = NewLocalizedDomainError("event.filter.coordinates_required", "latitude and longitude are required")
+	ErrEventAddressNotFound          = NewLocalizedDomainError("event.address_not_found", "address not found")
+	ErrEventImageNotFound            = NewLocalizedDomainError("event.image_not_found", "image not found")
+	ErrEventVideoNotFound            = NewLocalizedDomainError("event.video_not_found", "video not found")
+	ErrEventTypeNotAllowed           = NewLocalizedDomainError("event.type_not_allowed", "event type is not allowed")
+	ErrEventInvitationsPrivateOnly   = NewLocalizedDomainError("event.invitations_private_only", "only private events can have invitations")
+	ErrEventInvitationsLimitExceeded = NewLocalizedDomainError("event.invitations_limit_exceeded", "too many invitations in one request")
+	ErrEventInvitationInvalidEmail   = NewLocalizedDomainError("event.invitation_invalid_email", "invalid invitation email")
+	ErrEventInvitationDuplicateEmail = NewLocalizedDomainError("event.invitation_duplicate_email", "the same invitation email appears more than once")
 )
+
+// EventStatusTransitionError rejects a status change and lists the statuses the event may move
+// to instead. It matches ErrEventInvalidStatusTransition with errors.Is.
+type EventStatusTransitionError struct {
+	From    EventStatus
+	To      EventStatus
+	Allowed []EventStatus
+}
+
+func NewEventStatusTransitionError(from, to EventStatus, allowed []EventStatus) *EventStatusTransitionError {
+	return &EventStatusTransitionError{From: from, To: to, Allowed: allowed}
+}
+
+func (e *EventStatusTransitionError) Error() string {
+	return fmt.Sprintf("invalid status transition from %s to %s", e.From, e.To)
+}
+
+func (e *EventStatusTransitionError) Unwrap() error {
+	return ErrEventInvalidStatusTransition
+}
@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"time"
+)
+
+type EventCoHostRole string
+
+const (
+	// EventCoHostRoleHost is listed as an organizer of the event
+	EventCoHostRoleHost EventCoHostRole = "host"
+	// EventCoHostRoleEditor is listed and may also edit the event details
+	EventCoHostRoleEditor EventCoHostRole = "editor"
+)
+
+func (r EventCoHostRole) IsValid() bool {
+	return r == EventCoHostRoleHost || r == EventCoHostRoleEditor
+}
+
+// EventCoHost is another creator jointly organizing an event. The owner invites the creator;
+// the co-host only counts once AcceptedAt is set.
+type EventCoHost struct {
+	ID         int             `json:"id" gorm:"primaryKey;autoIncrement"`
+	EventID    int             `json:"event_id" gorm:"not null;uniqueIndex:idx_event_co_hosts_event_creator"`
+	CreatorID  int             `json:"creator_id" gorm:"not null;uniqueIndex:idx_event_co_hosts_event_creator;index"`
+	Role       EventCoHostRole `json:"role" gorm:"type:varchar(20);not null;default:'editor'"`
+	AcceptedAt *time.Time      `json:"accepted_at"`
+	CreatedAt  time.Time       `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt  time.Time       `json:"updated_at" gorm:"autoUpdateTime"`
+
+	// Relations
+	Creator *Creator `json:"creator,omitempty" gorm:"foreignKey:CreatorID;references:ID"`
+}
+
+func NewEventCoHost(eventID, creatorID int, role EventCoHostRole) *EventCoHost {
+	return &EventCoHost{
+		EventID:   eventID,
+		CreatorID: creatorID,
+		Role:      role,
+	}
+}
+
+func (h *EventCoHost) IsAccepted() bool {
+	return h.AcceptedAt != nil
+}
+
+// CanEdit reports whether the co-host may change the event details
+func (h *EventCoHost) CanEdit() bool {
+	return h.IsAccepted() && h.Role == EventCoHostRoleEditor
+}
+
+// Accept confirms the co-host invitation
+func (h *EventCoHost) Accept() error {
+	if h.IsAccepted() {
+		return ErrEventCoHostAlreadyAccepted
+	}
+	now := time.Now()
+	h.AcceptedAt = &now
+	h.UpdatedAt = now
+	return nil
+}
+
+// IsCoHostEditor reports whether the user is an accepted co-host allowed to edit the event.
+// Only the loaded CoHosts are checked.
+func (e *Event) IsCoHostEditor(userID int) bool {
+	for _, coHost := range e.CoHosts {
+		if coHost.CanEdit() && coHost.Creator != nil && coHost.Creator.UserID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// IsCoHostMember reports whether the user is an accepted co-host of any role. Only the loaded
+// CoHosts are checked.
+func (e *Event) IsCoHostMember(userID int) bool {
+	for _, coHost := range e.CoHosts {
+		if coHost.IsAccepted() && coHost.Creator != nil && coHost.Creator.UserID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// Event co-host domain errors
+var (
+	ErrEventCoHostNotFound        = NewLocalizedDomainError("event.cohost.not_found", "co-host invitation not found")
+	ErrEventCoHostAlreadyInvited  = NewLocalizedDomainError("event.cohost.already_invited", "creator is already a co-host of this event")
+	ErrEventCoHostAlreadyAccepted = NewLocalizedDomainError("event.cohost.already_accepted", "co-host invitation has already been accepted")
+	ErrEventCoHostIsOwner         = NewLocalizedDomainError("event.cohost.is_owner", "the event owner cannot be a co-host")
+	ErrEventCoHostInvalidRole     = NewLocalizedDomainError("event.cohost.invalid_role", "invalid co-host role")
+)
@@ -0,0 +1,171 @@
+package domain
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+type EventHistoryAction string
+
+const (
+	EventHistoryActionCreated       EventHistoryAction = "created"
+	EventHistoryActionUpdated       EventHistoryAction = "updated"
+	EventHistoryActionStatusChanged EventHistoryAction = "status_changed"
+
+	// EventHistoryActionCapacityWarning is the event.capacity_warning notification trigger
+	EventHistoryActionCapacityWarning EventHistoryAction = "capacity_warning"
+	// EventHistoryActionInterestMilestone is the event.interest_milestone notification trigger
+	EventHistoryActionInterestMilestone EventHistoryAction = "interest_milestone"
+	// EventHistoryActionDetailsChanged is the event.details_changed notification trigger; the
+	// attendees are emailed the Changes of a published event
+	EventHistoryActionDetailsChanged EventHistoryAction = "details_changed"
+
+	// EventHistoryActionTicketPricesChanged records a bulk ticket price update; the old and new
+	// price of each ticket are kept in Changes
+	EventHistoryActionTicketPricesChanged EventHistoryAction = "ticket_prices_changed"
+
+	// Moderation decisions on reports against the event; the moderator's note is kept in Note
+	EventHistoryActionReportResolved  EventHistoryAction = "report_resolved"
+	EventHistoryActionReportDismissed EventHistoryAction = "report_dismissed"
+)
+
+// EventDetailChange is one attendee-facing detail of a published event that changed. Nil
+// means the detail was not set.
+type EventDetailChange struct {
+	Field string  `json:"field"`
+	From  *string `json:"from"`
+	To    *string `json:"to"`
+}
+
+// TicketPriceChange is the old and new price of one ticket in a bulk price update
+type TicketPriceChange struct {
+	TicketID int     `json:"ticket_id"`
+	From     float64 `json:"from"`
+	To       float64 `json:"to"`
+}
+
+// EventHistory records a single change made to an event
+type EventHistory struct {
+	ID            int                `json:"id" gorm:"primaryKey;autoIncrement"`
+	EventID       int                `json:"event_id" gorm:"not null;index"`
+	ActorUserID   *int               `json:"actor_user_id" gorm:"index"` // nil for system changes
+	Action        EventHistoryAction `json:"action" gorm:"type:varchar(30);not null"`
+	FromStatus    *EventStatus       `json:"from_status,omitempty" gorm:"type:varchar(20)"`
+	ToStatus      *EventStatus       `json:"to_status,omitempty" gorm:"type:varchar(20)"`
+	ChangedFields *string            `json:"changed_fields,omitempty" gorm:"type:text"` // comma separated field names
+	Threshold     *int               `json:"threshold,omitempty"`                       // capacity warnings and interest milestones
+	Changes       json.RawMessage    `json:"changes,omitempty" gorm:"type:jsonb"`       // see EventDetailChange and TicketPriceChange
+	Note          *string            `json:"note,omitempty" gorm:"type:text"`           // moderation decisions
+	NotifiedAt    *time.Time         `json:"-" gorm:"default:null"`                     // set once attendees were told about the change
+	CreatedAt     time.Time          `json:"created_at" gorm:"autoCreateTime;index"`
+
+	// Relations
+	Actor *User `json:"actor,omitempty" gorm:"foreignKey:ActorUserID;references:ID"`
+}
+
+// TableName returns the table name for EventHistory entity
+func (EventHistory) TableName() string {
+	return "event_histories"
+}
+
+// NewEventStatusHistory creates a history entry for a status transition
+func NewEventStatusHistory(eventID int, actorUserID *int, from, to EventStatus) *EventHistory {
+	return &EventHistory{
+		EventID:     eventID,
+		ActorUserID: actorUserID,
+		Action:      EventHistoryActionStatusChanged,
+		FromStatus:  &from,
+		ToStatus:    &to,
+	}
+}
+
+// NewEventCapacityWarningHistory records that sales crossed the given capacity threshold
+func NewEventCapacityWarningHistory(eventID, threshold int) *EventHistory {
+	return &EventHistory{
+		EventID:   eventID,
+		Action:    EventHistoryActionCapacityWarning,
+		Threshold: &threshold,
+	}
+}
+
+// NewEventInterestMilestoneHistory records that the number of interested users reached threshold
+func NewEventInterestMilestoneHistory(eventID, threshold int) *EventHistory {
+	return &EventHistory{
+		EventID:   eventID,
+		Action:    EventHistoryActionInterestMilestone,
+		Threshold: &threshold,
+	}
+}
+
+// NewEventDetailsChangedHistory records the attendee-facing changes made to a published event
+func NewEventDetailsChangedHistory(eventID int, actorUserID *int, changes []EventDetailChange) *EventHistory {
+	// A slice of plain structs always marshals
+	encoded, _ := json.Marshal(changes)
+	return &EventHistory{
+		EventID:     eventID,
+		ActorUserID: actorUserID,
+		Action:      EventHistoryActionDetailsChanged,
+		Changes:     encoded,
+	}
+}
+
+// NewEventTicketPricesHistory records the old and new prices of a bulk ticket price update
+func NewEventTicketPricesHistory(eventID int, actorUserID *int, changes []TicketPriceChange) *EventHistory {
+	// A slice of plain structs always marshals
+	encoded, _ := json.Marshal(changes)
+	return &EventHistory{
+		EventID:     eventID,
+		ActorUserID: actorUserID,
+		Action:      EventHistoryActionTicketPricesChanged,
+		Changes:     encoded,
+	}
+}
+
+// NewEventModerationHistory records an admin's decision on a report against the event
+func NewEventModerationHistory(eventID, adminUserID int, decision EventReportStatus, note *string) *EventHistory {
+	action := EventHistoryActionReportResolved
+	if decision == EventReportStatusDismissed {
+		action = EventHistoryActionReportDismissed
+	}
+	return &EventHistory{
+		EventID:     eventID,
+		ActorUserID: &adminUserID,
+		Action:      action,
+		Note:        note,
+	}
+}
+
+// DetailChanges decodes the changes of a details_changed entry
+func (h *EventHistory) DetailChanges() ([]EventDetailChange, error) {
+	if len(h.Changes) == 0 {
+		return nil, nil
+	}
+	var changes []EventDetailChange
+	if err := json.Unmarshal(h.Changes, &changes); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// NewEventFieldsHistory creates a history entry for created or updated fields
+func NewEventFieldsHistory(eventID int, actorUserID *int, action EventHistoryAction, fields []string) *EventHistory {
+	history := &EventHistory{
+		EventID:     eventID,
+		ActorUserID: actorUserID,
+		Action:      action,
+	}
+	if len(fields) > 0 {
+		changed := strings.Join(fields, ",")
+		history.ChangedFields = &changed
+	}
+	return history
+}
+
+// Fields returns the changed field names
+func (h *EventHistory) Fields() []string {
+	if h.ChangedFields == nil || *h.ChangedFields == "" {
+		return nil
+	}
+	return strings.Split(*h.ChangedFields, ",")
+}
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"time"
+)
+
+// EventInterest records that a user would like to attend the event, or a repeat of it, but
+// cannot. Unlike tickets or invitations it is allowed on sold-out and finished events, so
+// organizers can gauge demand.
+type EventInterest struct {
+	ID        int       `json:"id" gorm:"primaryKey;autoIncrement"`
+	EventID   int       `json:"event_id" gorm:"not null;uniqueIndex:idx_event_interests_event_user"`
+	UserID    int       `json:"user_id" gorm:"not null;uniqueIndex:idx_event_interests_event_user;index"`
+	Email     string    `json:"email" gorm:"type:varchar(255);not null"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
+
+func NewEventInterest(eventID, userID int, email string) *EventInterest {
+	return &EventInterest{
+		EventID: eventID,
+		UserID:  userID,
+		Email:   email,
+	}
+}
+
+// InterestMilestone returns the highest threshold reached by count that the organizer has not
+// been told about yet
+func (e *Event) InterestMilestone(thresholds []int, count int64) (int, bool) {
+	reached := 0
+	for _, threshold := range thresholds {
+		if threshold > e.InterestMilestoneLevel && count >= int64(threshold) && threshold > reached {
+			reached = threshold
+		}
+	}
+	return reached, reached > 0
+}
+
+// Event interest domain errors
+var (
+	ErrEventInterestAlreadyRegistered = NewLocalizedDomainError("event.interest.already_registered", "interest already registered for this event")
+	ErrEventInterestOwnEvent          = NewLocalizedDomainError("event.interest.own_event", "cannot register interest in your own event")
+	ErrEventInterestNotAllowed        = NewLocalizedDomainError("event.interest.not_allowed", "interest can only be registered for published events")
+)
@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"time"
+)
+
+type EventJoinRequestStatus string
+
+const (
+	EventJoinRequestStatusPending  EventJoinRequestStatus = "pending"
+	EventJoinRequestStatusApproved EventJoinRequestStatus = "approved"
+	EventJoinRequestStatusRejected EventJoinRequestStatus = "rejected"
+)
+
+// EventJoinRequest is a user's request to be invited to a private event
+type EventJoinRequest struct {
+	ID          int                    `json:"id" gorm:"primaryKey;autoIncrement"`
+	EventID     int                    `json:"event_id" gorm:"not null;uniqueIndex:idx_event_join_requests_event_user"`
+	UserID      int                    `json:"user_id" gorm:"not null;uniqueIndex:idx_event_join_requests_event_user"`
+	Email       string                 `json:"email" gorm:"type:varchar(255);not null"`
+	Message     *string                `json:"message" gorm:"type:text"`
+	Status      EventJoinRequestStatus `json:"status" gorm:"type:varchar(20);not null;default:'pending';index"`
+	RespondedAt *time.Time             `json:"responded_at"`
+	CreatedAt   time.Time              `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time              `json:"updated_at" gorm:"autoUpdateTime"`
+
+	// Relations
+	User *User `json:"user,omitempty" gorm:"foreignKey:UserID;references:ID"`
+}
+
+func NewEventJoinRequest(eventID, userID int, email string, message *string) *EventJoinRequest {
+	return &EventJoinRequest{
+		EventID: eventID,
+		UserID:  userID,
+		Email:   email,
+		Message: message,
+		Status:  EventJoinRequestStatusPending,
+	}
+}
+
+func (r *EventJoinRequest) IsPending() bool {
+	return r.Status == EventJoinRequestStatusPending
+}
+
+// Approve accepts the request; the caller creates the matching invitation
+func (r *EventJoinRequest) Approve() error {
+	return r.respond(EventJoinRequestStatusApproved)
+}
+
+func (r *EventJoinRequest) Reject() error {
+	return r.respond(EventJoinRequestStatusRejected)
+}
+
+func (r *EventJoinRequest) respond(status EventJoinRequestStatus) error {
+	if !r.IsPending() {
+		return ErrEventJoinRequestAlreadyHandled
+	}
+	now := time.Now()
+	r.Status = status
+	r.RespondedAt = &now
+	r.UpdatedAt = now
+	return nil
+}
+
+// CanAcceptJoinRequests reports whether users may ask to join the event
+func (e *Event) CanAcceptJoinRequests() bool {
+	return e.IsPrivate() && e.IsPublished() && e.AllowJoinRequests
+}
+
+// Event join request domain errors
+var (
+	ErrEventJoinRequestsNotAllowed    = NewLocalizedDomainError("event.join_request.not_allowed", "event does not accept join requests")
+	ErrEventJoinRequestAlreadyHandled = NewLocalizedDomainError("event.join_request.already_handled", "join request has already been handled")
+	ErrEventJoinRequestDuplicate      = NewLocalizedDomainError("event.join_request.duplicate", "join request already exists")
+	ErrEventJoinRequestEmailRequired  = NewLocalizedDomainError("event.join_request.email_required", "an email address is required to request to join")
+	ErrEventJoinRequestNotFound       = NewLocalizedDomainError("event.join_request.not_found", "join request not found")
+)
@@ -0,0 +1,98 @@
+package domain
+
+// EventRole describes how a user relates to a single event
+type EventRole string
+
+const (
+	EventRoleAnonymous EventRole = "anonymous" // Not logged in
+	EventRoleUser      EventRole = "user"      // Logged in without any relation to the event
+	EventRoleInvitee   EventRole = "invitee"   // Invited to the event
+	EventRoleOwner     EventRole = "owner"     // Creator that owns the event
+	EventRoleCoHost    EventRole = "cohost"    // Accepted co-host allowed to edit the event
+	EventRoleMember    EventRole = "member"    // Accepted co-host listed as an organizer only
+	EventRoleAdmin     EventRole = "admin"     // Platform moderator, see UserRoleAdmin
+)
+
+// EventAction is an operation guarded by the event policy
+type EventAction string
+
+const (
+	EventActionView              EventAction = "view"
+	EventActionEdit              EventAction = "edit"
+	EventActionDelete            EventAction = "delete"
+	EventActionPublish           EventAction = "publish"
+	EventActionManageInvitations EventAction = "manage_invitations"
+	// EventActionManage covers the owner-only settings of an event: its status, cloning, share
+	// links, co-hosts, join requests, exports and interest list
+	EventActionManage EventAction = "manage"
+)
+
+// EventPolicy decides who may do what with an event. It only looks at the user's role and the
+// event's type and status; status transition rules stay on Event itself.
+type EventPolicy struct{}
+
+// NewEventPolicy creates an event policy
+func NewEventPolicy() EventPolicy {
+	return EventPolicy{}
+}
+
+// CanView reports whether the event is visible. Owners, co-hosts, members and admins see every
+// event; everyone else only sees published events, and private ones only when invited.
+func (EventPolicy) CanView(event *Event, role EventRole) bool {
+	if role == EventRoleOwner || role == EventRoleCoHost || role == EventRoleMember || role == EventRoleAdmin {
+		return true
+	}
+	if !event.IsPublished() {
+		return false
+	}
+	if event.IsPrivate() {
+		return role == EventRoleInvitee
+	}
+	return true
+}
+
+// CanEdit reports whether the event details may be changed by the owner or an accepted
+// editor co-host
+func (EventPolicy) CanEdit(event *Event, role EventRole) bool {
+	return role == EventRoleOwner || role == EventRoleCoHost
+}
+
+// CanDelete reports whether the event may be removed. Admins may remove events for moderation.
+func (EventPolicy) CanDelete(event *Event, role EventRole) bool {
+	return role == EventRoleOwner || role == EventRoleAdmin
+}
+
+// CanPublish reports whether the event may be submitted for review or published
+func (EventPolicy) CanPublish(event *Event, role EventRole) bool {
+	return role == EventRoleOwner && !event.IsCancelled()
+}
+
+// CanManageInvitations reports whether the guest list may be viewed and changed
+func (EventPolicy) CanManageInvitations(event *Event, role EventRole) bool {
+	return role == EventRoleOwner
+}
+
+// CanManage reports whether the owner-only settings of the event may be used
+func (EventPolicy) CanManage(event *Event, role EventRole) bool {
+	return role == EventRoleOwner
+}
+
+// Allows dispatches an action to the matching check
+func (p EventPolicy) Allows(action EventAction, event *Event, role EventRole) bool {
+	switch action {
+	case EventActionView:
+		return p.CanView(event, role)
+	case EventActionEdit:
+		return p.CanEdit(event, role)
+	case EventActionDelete:
+		return p.CanDelete(event, role)
+	case EventActionPublish:
+		return p.CanPublish(event, role)
+	case EventActionManageInvitations:
+		return p.CanManageInvitations(event, role)
+	case EventActionManage:
+		return p.CanManage(event, role)
+	default:
+		return false
+	}
+}
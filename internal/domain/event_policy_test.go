@@ -0,0 +1,67 @@
+package domain
+
+import "testing"
+
+func TestEventPolicyAllows(t *testing.T) {
+	events := map[string]*Event{
+		"public draft":      {Type: EventTypePublic, Status: EventStatusDraft},
+		"public published":  {Type: EventTypePublic, Status: EventStatusPublished},
+		"private published": {Type: EventTypePrivate, Status: EventStatusPublished},
+		"cancelled":         {Type: EventTypePublic, Status: EventStatusCancelled},
+	}
+
+	// want lists the allowed actions; every other action must be refused
+	tests := []struct {
+		event string
+		role  EventRole
+		want  []EventAction
+	}{
+		{"public draft", EventRoleAnonymous, nil},
+		{"public draft", EventRoleUser, nil},
+		{"public draft", EventRoleInvitee, nil},
+		{"public draft", EventRoleMember, []EventAction{EventActionView}},
+		{"public draft", EventRoleCoHost, []EventAction{EventActionView, EventActionEdit}},
+		{"public draft", EventRoleAdmin, []EventAction{EventActionView, EventActionDelete}},
+		{"public draft", EventRoleOwner, []EventAction{EventActionView, EventActionEdit, EventActionDelete, EventActionPublish, EventActionManageInvitations, EventActionManage}},
+
+		{"public published", EventRoleAnonymous, []EventAction{EventActionView}},
+		{"public published", EventRoleUser, []EventAction{EventActionView}},
+		{"public published", EventRoleInvitee, []EventAction{EventActionView}},
+		{"public published", EventRoleMember, []EventAction{EventActionView}},
+		{"public published", EventRoleAdmin, []EventAction{EventActionView, EventActionDelete}},
+		{"public published", EventRoleOwner, []EventAction{EventActionView, EventActionEdit, EventActionDelete, EventActionPublish, EventActionManageInvitations, EventActionManage}},
+
+		{"private published", EventRoleAnonymous, nil},
+		{"private published", EventRoleUser, nil},
+		{"private published", EventRoleInvitee, []EventAction{EventActionView}},
+		{"private published", EventRoleMember, []EventAction{EventActionView}},
+		{"private published", EventRoleCoHost, []EventAction{EventActionView, EventActionEdit}},
+		{"private published", EventRoleAdmin, []EventAction{EventActionView, EventActionDelete}},
+		{"private published", EventRoleOwner, []EventAction{EventActionView, EventActionEdit, EventActionDelete, EventActionPublish, EventActionManageInvitations, EventActionManage}},
+
+		{"cancelled", EventRoleUser, nil},
+		{"cancelled", EventRoleOwner, []EventAction{EventActionView, EventActionEdit, EventActionDelete, EventActionManageInvitations, EventActionManage}},
+	}
+
+	actions := []EventAction{EventActionView, EventActionEdit, EventActionDelete, EventActionPublish, EventActionManageInvitations, EventActionManage}
+	policy := NewEventPolicy()
+	for _, tt := range tests {
+		t.Run(tt.event+"/"+string(tt.role), func(t *testing.T) {
+			allowed := make(map[EventAction]bool, len(tt.want))
+			for _, action := range tt.want {
+				allowed[action] = true
+			}
+			for _, action := range actions {
+				if got := policy.Allows(action, events[tt.event], tt.role); got != allowed[action] {
+					t.Errorf("Allows(%s) = %v, want %v", action, got, allowed[action])
+				}
+			}
+		})
+	}
+
+	t.Run("unknown action", func(t *testing.T) {
+		if policy.Allows("archive", events["public published"], EventRoleOwner) {
+			t.Error("unknown action was allowed")
+		}
+	})
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"time"
+)
+
+// Star bounds of an event rating
+const (
+	MinEventRatingStars = 1
+	MaxEventRatingStars = 5
+)
+
+// EventRating is an attendee's review of an event after it took place. A user rates an event
+// once; rating again replaces the earlier stars and comment.
+type EventRating struct {
+	ID        int       `json:"id" gorm:"primaryKey;autoIncrement"`
+	EventID   int       `json:"event_id" gorm:"not null;uniqueIndex:idx_event_ratings_event_user"`
+	UserID    int       `json:"user_id" gorm:"not null;uniqueIndex:idx_event_ratings_event_user;index"`
+	Stars     int       `json:"stars" gorm:"not null"`
+	Comment   *string   `json:"comment" gorm:"type:text"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+func NewEventRating(eventID, userID, stars int, comment *string) (*EventRating, error) {
+	rating := &EventRating{
+		EventID: eventID,
+		UserID:  userID,
+		Stars:   stars,
+		Comment: comment,
+	}
+	if err := rating.Validate(); err != nil {
+		return nil, err
+	}
+	return rating, nil
+}
+
+func (r *EventRating) Validate() error {
+	if r.Stars < MinEventRatingStars || r.Stars > MaxEventRatingStars {
+		return ErrEventRatingInvalidStars
+	}
+	return nil
+}
+
+// RatingSummary is the average stars and number of a set of ratings
+type RatingSummary struct {
+	Average float64
+	Count   int64
+}
+
+// IsCompleted reports whether the event took place and can be rated: it was published, not
+// cancelled, and its last day is over
+func (e *Event) IsCompleted() bool {
+	return e.IsPublished() && e.IsPast()
+}
+
+// Event rating domain errors
+var (
+	ErrEventRatingInvalidStars = NewLocalizedDomainError("event.rating.invalid_stars", "rating must be between 1 and 5 stars")
+	ErrEventRatingNotCompleted = NewLocalizedDomainError("event.rating.not_completed", "only completed events can be rated")
+	ErrEventRatingNotAttended  = NewLocalizedDomainError("event.rating.not_attended", "only attendees can rate this event")
+	ErrEventRatingOwnEvent     = NewLocalizedDomainError("event.rating.own_event", "cannot rate your own event")
+)
@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"time"
+)
+
+type EventReportReason string
+type EventReportStatus string
+
+const (
+	// Event Report Reasons
+	EventReportReasonSpam          EventReportReason = "spam"
+	EventReportReasonInappropriate EventReportReason = "inappropriate"
+	EventReportReasonFraud         EventReportReason = "fraud"
+	EventReportReasonMisleading    EventReportReason = "misleading"
+	EventReportReasonOther         EventReportReason = "other"
+
+	// Event Report Status
+	EventReportStatusPending   EventReportStatus = "pending"
+	EventReportStatusResolved  EventReportStatus = "resolved"
+	EventReportStatusDismissed EventReportStatus = "dismissed"
+)
+
+// EventReport is an abuse report filed by a user against an event
+type EventReport struct {
+	ID               int               `json:"id" gorm:"primaryKey;autoIncrement"`
+	EventID          int               `json:"event_id" gorm:"not null;uniqueIndex:idx_event_reports_event_reporter"`
+	ReporterUserID   int               `json:"reporter_user_id" gorm:"not null;uniqueIndex:idx_event_reports_event_reporter"`
+	Reason           EventReportReason `json:"reason" gorm:"type:varchar(30);not null"`
+	Details          *string           `json:"details" gorm:"type:text"`
+	Status           EventReportStatus `json:"status" gorm:"type:varchar(20);not null;default:'pending';index"`
+	ResolvedByUserID *int              `json:"resolved_by_user_id"`
+	ResolutionNote   *string           `json:"resolution_note" gorm:"type:text"`
+	ResolvedAt       *time.Time        `json:"resolved_at"`
+	CreatedAt        time.Time         `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt        time.Time         `json:"updated_at" gorm:"autoUpdateTime"`
+
+	// Relations
+	Event    *Event `json:"event,omitempty" gorm:"foreignKey:EventID;references:ID"`
+	Reporter *User  `json:"reporter,omitempty" gorm:"foreignKey:ReporterUserID;references:ID"`
+}
+
+func NewEventReport(eventID, reporterUserID int, reason EventReportReason, details *string) *EventReport {
+	return &EventReport{
+		EventID:        eventID,
+		ReporterUserID: reporterUserID,
+		Reason:         reason,
+		Details:        details,
+		Status:         EventReportStatusPending,
+	}
+}
+
+// IsValid reports whether the reason is one of the known report reasons
+func (r EventReportReason) IsValid() bool {
+	switch r {
+	case EventReportReasonSpam, EventReportReasonInappropriate, EventReportReasonFraud,
+		EventReportReasonMisleading, EventReportReasonOther:
+		return true
+	}
+	return false
+}
+
+func (r *EventReport) IsPending() bool {
+	return r.Status == EventReportStatusPending
+}
+
+// Close marks the report as resolved or dismissed by an admin
+func (r *EventReport) Close(status EventReportStatus, adminUserID int, note *string) error {
+	if !r.IsPending() {
+		return ErrEventReportAlreadyClosed
+	}
+	if status != EventReportStatusResolved && status != EventReportStatusDismissed {
+		return ErrEventReportInvalidStatus
+	}
+
+	now := time.Now()
+	r.Status = status
+	r.ResolvedByUserID = &adminUserID
+	r.ResolutionNote = note
+	r.ResolvedAt = &now
+	r.UpdatedAt = now
+	return nil
+}
+
+// Event report domain errors
+var (
+	ErrEventReportAlreadyClosed = NewLocalizedDomainError("event.report.already_closed", "event report is already closed")
+	ErrEventReportInvalidStatus = NewLocalizedDomainError("event.report.invalid_status", "invalid event report status")
+	ErrEventReportInvalidReason = NewLocalizedDomainError("event.report.invalid_reason", "invalid report reason")
+	ErrEventReportDuplicate     = NewLocalizedDomainError("event.report.duplicate", "event already reported")
+	ErrEventReportNotFound      = NewLocalizedDomainError("event.report.not_found", "report not found")
+)
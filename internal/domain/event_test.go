@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestEventValidateReadiness(t *testing.T) {
+	start := time.Date(2030, time.June, 1, 19, 0, 0, 0, time.UTC)
+	addressID := 4
+	url := "https://meet.louco.test/jazz"
+	empty := ""
+
+	// ready is a complete online event; each case breaks one detail of it
+	ready := func(mutate func(event *Event)) *Event {
+		event := &Event{
+			LocationType:     EventLocationTypeOnline,
+			StartDate:        &start,
+			StartTime:        &start,
+			OnlineEventURL:   &url,
+			Categories:       []Category{{ID: 1}},
+			HasSystemTickets: true,
+			Tickets:          []Ticket{{IsActive: true}},
+		}
+		if mutate != nil {
+			mutate(event)
+		}
+		return event
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(event *Event)
+		want   error
+	}{
+		{"complete", nil, nil},
+		{"no start date", func(e *Event) { e.StartDate = nil }, ErrEventDateTimeRequired},
+		{"no start time", func(e *Event) { e.StartTime = nil }, ErrEventDateTimeRequired},
+		{"online without a URL", func(e *Event) { e.OnlineEventURL = &empty }, ErrEventOnlineURLRequired},
+		{"location without an address", func(e *Event) { e.LocationType, e.OnlineEventURL = EventLocationTypeLocation, nil }, ErrEventAddressRequired},
+		{"location with an address", func(e *Event) {
+			e.LocationType, e.OnlineEventURL, e.AddressID = EventLocationTypeLocation, nil, &addressID
+		}, nil},
+		{"no category", func(e *Event) { e.Categories = nil }, ErrEventCategoriesRequired},
+		{"no tickets", func(e *Event) { e.Tickets = nil }, ErrEventTicketRequired},
+		{"only inactive tickets", func(e *Event) { e.Tickets = []Ticket{{IsActive: false}} }, ErrEventTicketRequired},
+		{"tickets without system ticketing", func(e *Event) { e.HasSystemTickets = false }, ErrEventTicketRequired},
+		{"ticket URL instead", func(e *Event) { e.HasSystemTickets, e.Tickets, e.TicketURL = false, nil, &url }, nil},
+		{"announcement without dates or tickets", func(e *Event) {
+			e.LocationType, e.StartDate, e.StartTime, e.OnlineEventURL, e.Tickets = EventLocationTypeAnnouncement, nil, nil, nil, nil
+		}, nil},
+		{"announcement without a category", func(e *Event) { e.LocationType, e.Categories = EventLocationTypeAnnouncement, nil }, ErrEventCategoriesRequired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ready(tt.mutate).ValidateReadiness(); !errors.Is(err, tt.want) {
+				t.Errorf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"time"
+)
+
+// EventView records one visit to an event page; trending ranks events by recent views
+type EventView struct {
+	ID      int  `json:"id" gorm:"primaryKey;autoIncrement"`
+	EventID int  `json:"event_id" gorm:"not null;index:idx_event_views_event_viewed"`
+	UserID  *int `json:"user_id" gorm:"index"` // nil for anonymous visitors
+	// Client address; repeat anonymous views are told apart by it
+	IPAddress string    `json:"ip_address" gorm:"type:varchar(45)"`
+	ViewedAt  time.Time `json:"viewed_at" gorm:"not null;index:idx_event_views_event_viewed"`
+}
+
+func NewEventView(eventID int, userID *int, ipAddress string) *EventView {
+	return &EventView{
+		EventID:   eventID,
+		UserID:    userID,
+		IPAddress: ipAddress,
+		ViewedAt:  time.Now(),
+	}
+}
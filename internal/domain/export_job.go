@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type ExportJobType string
+type ExportJobStatus string
+
+const (
+	// Export Job Types
+	ExportJobTypeInvitations ExportJobType = "invitations"
+	ExportJobTypeAttendees   ExportJobType = "attendees"
+
+	// Export Job Status
+	ExportJobStatusQueued     ExportJobStatus = "queued"
+	ExportJobStatusProcessing ExportJobStatus = "processing"
+	ExportJobStatusDone       ExportJobStatus = "done"
+	ExportJobStatusFailed     ExportJobStatus = "failed"
+)
+
+// ExportJob is a CSV export requested by a user and built in the background
+type ExportJob struct {
+	ID          int             `json:"id" gorm:"primaryKey;autoIncrement"`
+	UserID      int             `json:"user_id" gorm:"not null;index"`
+	Type        ExportJobType   `json:"type" gorm:"type:varchar(30);not null"`
+	Params      json.RawMessage `json:"params" gorm:"type:jsonb;default:'{}'"`
+	Status      ExportJobStatus `json:"status" gorm:"type:varchar(20);not null;default:'queued';index"`
+	ResultKey   *string         `json:"-" gorm:"type:varchar(500)"`
+	Error       *string         `json:"error,omitempty" gorm:"type:text"`
+	StartedAt   *time.Time      `json:"started_at"`
+	CompletedAt *time.Time      `json:"completed_at"`
+	CreatedAt   time.Time       `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time       `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// ExportJobParams holds the filters of an export
+type ExportJobParams struct {
+	EventID int `json:"event_id"`
+}
+
+func NewExportJob(userID int, exportType ExportJobType, params ExportJobParams) (*ExportJob, error) {
+	encoded, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	return &ExportJob{
+		UserID: userID,
+		Type:   exportType,
+		Params: encoded,
+		Status: ExportJobStatusQueued,
+	}, nil
+}
+
+// IsValid reports whether the type is one of the known export types
+func (t ExportJobType) IsValid() bool {
+	switch t {
+	case ExportJobTypeInvitations, ExportJobTypeAttendees:
+		return true
+	}
+	return false
+}
+
+// DecodeParams returns the filters the export was requested with
+func (j *ExportJob) DecodeParams() (ExportJobParams, error) {
+	var params ExportJobParams
+	if len(j.Params) == 0 {
+		return params, nil
+	}
+	err := json.Unmarshal(j.Params, &params)
+	return params, err
+}
+
+func (j *ExportJob) IsDone() bool {
+	return j.Status == ExportJobStatusDone
+}
+
+// Start moves a queued job to processing
+func (j *ExportJob) Start() error {
+	if j.Status != ExportJobStatusQueued {
+		return ErrExportJobInvalidTransition
+	}
+	now := time.Now()
+	j.Status = ExportJobStatusProcessing
+	j.StartedAt = &now
+	return nil
+}
+
+// Complete stores the object key of the finished file
+func (j *ExportJob) Complete(resultKey string) error {
+	if j.Status != ExportJobStatusProcessing {
+		return ErrExportJobInvalidTransition
+	}
+	now := time.Now()
+	j.Status = ExportJobStatusDone
+	j.ResultKey = &resultKey
+	j.CompletedAt = &now
+	return nil
+}
+
+// Fail records why the export could not be built
+func (j *ExportJob) Fail(reason string) {
+	now := time.Now()
+	j.Status = ExportJobStatusFailed
+	j.Error = &reason
+	j.CompletedAt = &now
+}
+
+// Export job domain errors
+var (
+	ErrExportJobInvalidTransition = NewDomainError("invalid export job status transition")
+	ErrExportJobNotFound          = NewLocalizedDomainError("export.not_found", "export not found")
+	ErrExportJobInvalidType       = NewLocalizedDomainError("export.invalid_type", "invalid export type")
+)
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"time"
+)
+
+// FailedWebhook is a dead-lettered webhook delivery whose processing returned an error. The
+// raw payload is kept so the delivery can be replayed once the underlying data is fixed.
+type FailedWebhook struct {
+	ID            int        `json:"id" gorm:"primaryKey;autoIncrement"`
+	StripeEventID string     `json:"stripe_event_id" gorm:"type:varchar(255);index"`
+	Type          string     `json:"type" gorm:"type:varchar(100);not null"`
+	Payload       string     `json:"payload" gorm:"type:text;not null"`
+	Error         string     `json:"error" gorm:"type:text;not null"`
+	Attempts      int        `json:"attempts" gorm:"not null;default:1"`
+	RetryingAt    *time.Time `json:"-" gorm:"default:null"` // set while a retry holds the webhook
+	ResolvedAt    *time.Time `json:"resolved_at" gorm:"index"`
+	CreatedAt     time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt     time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+func NewFailedWebhook(stripeEventID, eventType string, payload []byte, err error) *FailedWebhook {
+	return &FailedWebhook{
+		StripeEventID: stripeEventID,
+		Type:          eventType,
+		Payload:       string(payload),
+		Error:         err.Error(),
+		Attempts:      1,
+	}
+}
+
+// IsResolved reports whether a retry has processed the webhook
+func (w *FailedWebhook) IsResolved() bool {
+	return w.ResolvedAt != nil
+}
+
+// RecordRetry counts a replay attempt; a nil error resolves the webhook
+func (w *FailedWebhook) RecordRetry(err error) {
+	w.Attempts++
+	w.RetryingAt = nil
+	if err != nil {
+		w.Error = err.Error()
+		return
+	}
+	now := time.Now()
+	w.ResolvedAt = &now
+}
+
+// Failed webhook domain errors
+var (
+	ErrFailedWebhookNotFound        = NewLocalizedDomainError("webhook.failed.not_found", "failed webhook not found")
+	ErrFailedWebhookAlreadyResolved = NewLocalizedDomainError("webhook.failed.already_resolved", "failed webhook was already processed")
+	ErrFailedWebhookRetryInProgress = NewLocalizedDomainError("webhook.failed.retry_in_progress", "failed webhook is already being retried")
+)
@@ -1,23 +1,40 @@
 package domain
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"time"
 )
 
+// invitationTokenBytes is the entropy of an RSVP link token; hex encoding doubles its length
+const invitationTokenBytes = 32
+
 type InvitationStatus string
 
 const (
 	InvitationStatusPending  InvitationStatus = "pending"
 	InvitationStatusApproved InvitationStatus = "approved"
 	InvitationStatusRejected InvitationStatus = "rejected"
+	// InvitationStatusLateApproved is an acceptance received after the invitation expired but
+	// within the late response grace; it counts as approved everywhere
+	InvitationStatusLateApproved InvitationStatus = "late_approved"
 )
 
+// ApprovedInvitationStatuses are the statuses of invitations that were accepted
+var ApprovedInvitationStatuses = []InvitationStatus{InvitationStatusApproved, InvitationStatusLateApproved}
+
+// IsApproved reports whether the status is one of ApprovedInvitationStatuses
+func (s InvitationStatus) IsApproved() bool {
+	return s == InvitationStatusApproved || s == InvitationStatusLateApproved
+}
+
 type Invitation struct {
 	ID            int              `json:"id" gorm:"primaryKey;autoIncrement"`
 	EventID       int              `json:"event_id" gorm:"not null;index"`
 	InvitedUserID *int             `json:"invited_user_id" gorm:"index"` // nullable for non-members
 	InvitedEmail  string           `json:"invited_email" gorm:"type:varchar(255);not null;index"`
 	Status        InvitationStatus `json:"status" gorm:"type:varchar(20);not null;default:'pending'"`
+	Token         *string          `json:"-" gorm:"type:varchar(64);uniqueIndex"` // RSVP link token; nil for invitations created before links existed
 	InvitedAt     time.Time        `json:"invited_at" gorm:"autoCreateTime"`
 	RespondedAt   *time.Time       `json:"responded_at"`
 	CreatedAt     time.Time        `json:"created_at" gorm:"autoCreateTime"`
@@ -34,12 +51,22 @@ func NewInvitation(eventID int, invitedEmail string, invitedUserID *int) *Invita
 		InvitedEmail:  invitedEmail,
 		InvitedUserID: invitedUserID,
 		Status:        InvitationStatusPending,
+		Token:         NewInvitationToken(),
 		InvitedAt:     time.Now(),
 		CreatedAt:     time.Now(),
 		UpdatedAt:     time.Now(),
 	}
 }
 
+// NewInvitationToken returns a random token for an invitation's RSVP link
+func NewInvitationToken() *string {
+	buf := make([]byte, invitationTokenBytes)
+	// crypto/rand.Read never returns an error on supported platforms
+	_, _ = rand.Read(buf)
+	token := hex.EncodeToString(buf)
+	return &token
+}
+
 func (i *Invitation) Approve() error {
 	if i.Status != InvitationStatusPending {
 		return ErrInvitationInvalidStatusTransition
@@ -96,7 +123,11 @@ func (i *Invitation) IsPending() bool {
 }
 
 func (i *Invitation) IsApproved() bool {
-	return i.Status == InvitationStatusApproved
+	return i.Status == InvitationStatusApproved || i.Status == InvitationStatusLateApproved
+}
+
+func (i *Invitation) IsLateApproved() bool {
+	return i.Status == InvitationStatusLateApproved
 }
 
 func (i *Invitation) IsRejected() bool {
@@ -162,6 +193,32 @@ func (i *Invitation) IsExpired(expirationHours int) bool {
 	return time.Now().After(expirationTime) && i.IsPending()
 }
 
+// IsLinkExpired reports whether the RSVP link can no longer be used: the invitation was
+// already answered, or the link is older than linkTTL. A zero linkTTL never expires links.
+func (i *Invitation) IsLinkExpired(linkTTL time.Duration) bool {
+	if !i.IsPending() {
+		return true
+	}
+	return linkTTL > 0 && time.Now().After(i.InvitedAt.Add(linkTTL))
+}
+
+// ResolveResponse returns the status to store for a guest's answer given when the invitation
+// expires. Answers before expiresAt are kept as is; within grace after it an acceptance becomes
+// late_approved and a decline stays rejected; later answers fail with ErrInvitationExpired.
+// A nil expiresAt never expires.
+func (i *Invitation) ResolveResponse(status InvitationStatus, expiresAt *time.Time, grace time.Duration, now time.Time) (InvitationStatus, error) {
+	if expiresAt == nil || now.Before(*expiresAt) {
+		return status, nil
+	}
+	if grace <= 0 || now.After(expiresAt.Add(grace)) {
+		return "", ErrInvitationExpired
+	}
+	if status == InvitationStatusApproved {
+		return InvitationStatusLateApproved, nil
+	}
+	return status, nil
+}
+
 func (i *Invitation) GetDaysUntilExpiration(expirationHours int) int {
 	if expirationHours <= 0 {
 		return -1 // No expiration
@@ -186,17 +243,38 @@ func (i *Invitation) IsForEmail(email string) bool {
 	return i.InvitedEmail == email
 }
 
+// InvitationLimit returns the number of invitations one event may have: the lower of the
+// platform cap and the creator's subscription cap. Zero means unlimited.
+func InvitationLimit(platformLimit int, subscription *UserSubscription) int {
+	limit := platformLimit
+	if subscription != nil && subscription.MaxInvitationsPerEvent != nil && *subscription.MaxInvitationsPerEvent > 0 {
+		if planLimit := *subscription.MaxInvitationsPerEvent; limit <= 0 || planLimit < limit {
+			limit = planLimit
+		}
+	}
+	return limit
+}
+
 // Invitation domain errors
 var (
-	ErrInvitationEmailRequired           = NewDomainError("invited email is required")
-	ErrInvitationEventRequired           = NewDomainError("event ID is required")
-	ErrInvitationInvalidStatusTransition = NewDomainError("invalid invitation status transition")
-	ErrInvitationAlreadyPending          = NewDomainError("invitation is already pending")
-	ErrInvitationNotFound                = NewDomainError("invitation not found")
-	ErrInvitationAlreadyResponded        = NewDomainError("invitation has already been responded to")
-	ErrInvitationExpired                 = NewDomainError("invitation has expired")
-	ErrInvitationCannotBeDeleted         = NewDomainError("invitation cannot be deleted")
-	ErrInvitationCannotBeResent          = NewDomainError("invitation cannot be resent")
-	ErrInvitationUnauthorized            = NewDomainError("unauthorized to access this invitation")
-	ErrInvitationDuplicateEmail          = NewDomainError("invitation already exists for this email")
+	ErrInvitationEmailRequired           = NewLocalizedDomainError("invitation.email_required", "invited email is required")
+	ErrInvitationEventRequired           = NewLocalizedDomainError("invitation.event_required", "event ID is required")
+	ErrInvitationInvalidStatusTransition = NewLocalizedDomainError("invitation.invalid_status_transition", "invalid invitation status transition")
+	ErrInvitationAlreadyPending          = NewLocalizedDomainError("invitation.already_pending", "invitation is already pending")
+	ErrInvitationNotFound                = NewLocalizedDomainError("invitation.not_found", "invitation not found")
+	ErrInvitationAlreadyResponded        = NewLocalizedDomainError("invitation.already_responded", "invitation has already been responded to")
+	ErrInvitationExpired                 = NewLocalizedDomainError("invitation.expired", "invitation has expired")
+	ErrInvitationCannotBeDeleted         = NewLocalizedDomainError("invitation.cannot_be_deleted", "invitation cannot be deleted")
+	ErrInvitationCannotBeResent          = NewLocalizedDomainError("invitation.cannot_be_resent", "invitation cannot be resent")
+	ErrInvitationUnauthorized            = NewLocalizedDomainError("invitation.access_denied", "unauthorized to access this invitation")
+	ErrInvitationDuplicateEmail          = NewLocalizedDomainError("invitation.already_exists", "invitation already exists for this email")
+	ErrInvitationUserAlreadyInvited      = NewLocalizedDomainError("invitation.user_already_invited", "user is already invited to this event")
+	ErrInvitationInvalidEmail            = NewLocalizedDomainError("invitation.invalid_email", "invalid email format")
+	ErrInvitationNoneToCreate            = NewLocalizedDomainError("invitation.none_to_create", "no invitations to create")
+	ErrInvitationEventLimitReached       = NewLocalizedDomainError("invitation.event_limit_reached", "event invitation limit reached")
+	ErrInvitationDuplicateInBatch        = NewLocalizedDomainError("invitation.duplicate_in_batch", "the same email appears more than once in the request")
+	ErrInvitationInvalidDateRange        = NewLocalizedDomainError("invitation.invalid_date_range", "date range start cannot be after its end")
+	ErrInvitationLinkExpired             = NewLocalizedDomainError("invitation.link_expired", "invitation link has expired or was already used")
+	ErrInvitationStatsTooManyEvents      = NewLocalizedDomainError("invitation.stats.too_many_events", "too many events in one stats request")
+	ErrInvitationTooManyUsers            = NewLocalizedDomainError("invitation.by_users.too_many", "too many users in one request")
 )
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"time"
+)
+
+type PendingCheckoutStatus string
+
+const (
+	PendingCheckoutStatusPending   PendingCheckoutStatus = "pending"
+	PendingCheckoutStatusCompleted PendingCheckoutStatus = "completed"
+	PendingCheckoutStatusExpired   PendingCheckoutStatus = "expired"
+)
+
+// PendingCheckout ties a Stripe checkout session to the ticket cart it pays for. It stays
+// pending until Stripe reports the session completed or expired.
+type PendingCheckout struct {
+	ID                int                   `json:"id" gorm:"primaryKey;autoIncrement"`
+	StripeSessionID   string                `json:"stripe_session_id" gorm:"type:varchar(255);not null;uniqueIndex"`
+	CartReservationID string                `json:"cart_reservation_id" gorm:"type:varchar(36);not null;index"`
+	UserID            int                   `json:"user_id" gorm:"not null;index"`
+	Status            PendingCheckoutStatus `json:"status" gorm:"type:varchar(20);not null;default:'pending';index"`
+	ExpiresAt         time.Time             `json:"expires_at" gorm:"not null"`
+	CreatedAt         time.Time             `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt         time.Time             `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+func NewPendingCheckout(stripeSessionID, cartReservationID string, userID int, expiresAt time.Time) *PendingCheckout {
+	return &PendingCheckout{
+		StripeSessionID:   stripeSessionID,
+		CartReservationID: cartReservationID,
+		UserID:            userID,
+		Status:            PendingCheckoutStatusPending,
+		ExpiresAt:         expiresAt,
+	}
+}
+
+// Pending checkout domain errors
+var (
+	ErrPendingCheckoutNotFound = NewLocalizedDomainError("ticket.checkout.not_found", "pending checkout not found")
+	ErrCartNotPayable          = NewLocalizedDomainError("ticket.checkout.not_payable", "cart has no paid tickets left to pay for")
+)
@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"time"
+)
+
+// ShareTokenAccess records one visit to an event through its share link
+type ShareTokenAccess struct {
+	ID         int       `json:"id" gorm:"primaryKey;autoIncrement"`
+	EventID    int       `json:"event_id" gorm:"not null;index"`
+	UserID     *int      `json:"user_id" gorm:"index"`
+	IPAddress  string    `json:"ip_address" gorm:"type:varchar(45)"`
+	AccessedAt time.Time `json:"accessed_at" gorm:"not null;index"`
+
+	// Relations
+	Event *Event `json:"event,omitempty" gorm:"foreignKey:EventID;references:ID"`
+}
+
+func NewShareTokenAccess(eventID int, userID *int, ipAddress string) *ShareTokenAccess {
+	return &ShareTokenAccess{
+		EventID:    eventID,
+		UserID:     userID,
+		IPAddress:  ipAddress,
+		AccessedAt: time.Now(),
+	}
+}
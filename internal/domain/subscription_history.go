@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"time"
+)
+
+type SubscriptionHistoryAction string
+
+const (
+	// SubscriptionHistoryActionCancellationScheduled is a cancellation at the end of the period
+	SubscriptionHistoryActionCancellationScheduled SubscriptionHistoryAction = "cancellation_scheduled"
+	SubscriptionHistoryActionCancelled             SubscriptionHistoryAction = "cancelled"
+)
+
+// SubscriptionHistory is the audit trail of a subscription. It keeps the reason a user gave for
+// cancelling even after a reactivation clears it from the subscription.
+type SubscriptionHistory struct {
+	ID             int                       `json:"id" gorm:"primaryKey;autoIncrement"`
+	SubscriptionID int                       `json:"subscription_id" gorm:"not null;index"`
+	ActorUserID    *int                      `json:"actor_user_id" gorm:"index"` // nil for system changes
+	Action         SubscriptionHistoryAction `json:"action" gorm:"type:varchar(30);not null"`
+	Reason         *string                   `json:"reason,omitempty" gorm:"type:text"`
+	CreatedAt      time.Time                 `json:"created_at" gorm:"autoCreateTime;index"`
+}
+
+// TableName returns the table name for SubscriptionHistory entity
+func (SubscriptionHistory) TableName() string {
+	return "subscription_histories"
+}
+
+// NewSubscriptionCancellationHistory records a user cancelling their subscription, now or at the
+// end of the period
+func NewSubscriptionCancellationHistory(subscription *UserSubscription, actorUserID int, atPeriodEnd bool) *SubscriptionHistory {
+	action := SubscriptionHistoryActionCancelled
+	if atPeriodEnd {
+		action = SubscriptionHistoryActionCancellationScheduled
+	}
+	return &SubscriptionHistory{
+		SubscriptionID: subscription.ID,
+		ActorUserID:    &actorUserID,
+		Action:         action,
+		Reason:         subscription.CancellationReason,
+	}
+}
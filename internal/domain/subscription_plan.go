@@ -27,12 +27,15 @@ type SubscriptionPlan struct {
 	MonthlyLimit *int             `gorm:"default:null" json:"monthly_limit,omitempty"`                  // Only for subscriptions
 	TotalCredits *int             `gorm:"default:null" json:"total_credits,omitempty"`                  // Only for packages
 	DurationDays *int             `gorm:"default:null" json:"duration_days,omitempty"`                  // Duration in days (365 for packages, 30 for subscriptions)
-	IsActive     bool             `gorm:"default:true" json:"is_active"`
-	SortOrder    int              `gorm:"default:0" json:"sort_order"`                                     // For display ordering
-	StripeID     *string          `gorm:"type:varchar(255);default:null;index" json:"stripe_id,omitempty"` // Stripe price/product ID
-	Metadata     json.RawMessage  `gorm:"type:jsonb;default:'{}'" json:"metadata"`
-	CreatedAt    time.Time        `gorm:"autoCreateTime" json:"created_at"`
-	UpdatedAt    time.Time        `gorm:"autoUpdateTime" json:"updated_at"`
+	TrialDays    int              `gorm:"default:0" json:"trial_days"`                                  // Free trial length, only for subscriptions
+	// MaxInvitationsPerEvent caps the guest list of each event; nil leaves only the platform cap
+	MaxInvitationsPerEvent *int            `gorm:"default:null" json:"max_invitations_per_event,omitempty"`
+	IsActive               bool            `gorm:"default:true" json:"is_active"`
+	SortOrder              int             `gorm:"default:0" json:"sort_order"`                                     // For display ordering
+	StripeID               *string         `gorm:"type:varchar(255);default:null;index" json:"stripe_id,omitempty"` // Stripe price/product ID
+	Metadata               json.RawMessage `gorm:"type:jsonb;default:'{}'" json:"metadata"`
+	CreatedAt              time.Time       `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt              time.Time       `gorm:"autoUpdateTime" json:"updated_at"`
 }
 
 // TableName returns the table name for SubscriptionPlan
@@ -62,6 +65,10 @@ func (sp *SubscriptionPlan) Validate() error {
 		return NewDomainError("Price cannot be negative")
 	}
 
+	if sp.TrialDays < 0 {
+		return NewDomainError("Trial days cannot be negative")
+	}
+
 	if sp.Currency == "" {
 		sp.Currency = "EUR" // Default currency
 	}
@@ -91,6 +98,9 @@ func (sp *SubscriptionPlan) Validate() error {
 		if sp.WeeklyLimit != nil || sp.MonthlyLimit != nil {
 			return NewDomainError("Weekly/Monthly limits should not be set for packages")
 		}
+		if sp.TrialDays > 0 {
+			return NewDomainError("Trial days should not be set for packages")
+		}
 		if sp.DurationDays == nil {
 			defaultDuration := 365 // 1 year for packages
 			sp.DurationDays = &defaultDuration
@@ -118,6 +128,11 @@ func (sp *SubscriptionPlan) IsPackage() bool {
 	return sp.Type == SubscriptionTypePackage
 }
 
+// HasTrial checks if the plan offers a free trial
+func (sp *SubscriptionPlan) HasTrial() bool {
+	return sp.IsSubscription() && sp.TrialDays > 0
+}
+
 // GetDurationDays returns the duration in days
 func (sp *SubscriptionPlan) GetDurationDays() int {
 	if sp.DurationDays == nil {
@@ -149,6 +164,7 @@ func (sp *SubscriptionPlan) CreateUserSubscription(userID int) *UserSubscription
 	}
 
 	// Copy plan-specific fields
+	us.MaxInvitationsPerEvent = sp.MaxInvitationsPerEvent
 	if sp.IsSubscription() {
 		us.WeeklyLimit = sp.WeeklyLimit
 		us.MonthlyLimit = sp.MonthlyLimit
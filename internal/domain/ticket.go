@@ -1,9 +1,20 @@
 package domain
 
 import (
+	"math"
 	"time"
 )
 
+type TicketPriceAdjustmentType string
+
+const (
+	TicketPriceAdjustmentPercentage TicketPriceAdjustmentType = "percentage"
+	TicketPriceAdjustmentFixed      TicketPriceAdjustmentType = "fixed"
+
+	// MaxTicketPrice is the highest price a ticket can be set to
+	MaxTicketPrice = 100000.0
+)
+
 type Ticket struct {
 	ID            int       `json:"id" gorm:"primaryKey;autoIncrement"`
 	EventID       int       `json:"event_id" gorm:"not null;index"`
@@ -12,6 +23,7 @@ type Ticket struct {
 	TotalQuantity int       `json:"total_quantity" gorm:"not null"`
 	SoldQuantity  int       `json:"sold_quantity" gorm:"default:0"`
 	IsActive      bool      `json:"is_active" gorm:"default:true"`
+	Version       int       `json:"-" gorm:"not null;default:1"` // bumped on every write, for optimistic locking
 	CreatedAt     time.Time `json:"created_at" gorm:"autoCreateTime"`
 	UpdatedAt     time.Time `json:"updated_at" gorm:"autoUpdateTime"`
 
@@ -27,6 +39,7 @@ func NewTicket(eventID int, title string, price float64, totalQuantity int) *Tic
 		TotalQuantity: totalQuantity,
 		SoldQuantity:  0,
 		IsActive:      true,
+		Version:       1,
 		CreatedAt:     time.Now(),
 		UpdatedAt:     time.Now(),
 	}
@@ -112,6 +125,34 @@ func (t *Ticket) IsFree() bool {
 	return t.Price == 0
 }
 
+// MeetsMinimumPrice reports whether the ticket is free or priced at least minPrice
+func (t *Ticket) MeetsMinimumPrice(minPrice float64) bool {
+	return t.IsFree() || t.Price >= minPrice
+}
+
+// AdjustedPrice returns the price after a percentage or fixed adjustment, rounded to cents
+// and floored at zero. Negative values lower the price.
+func (t *Ticket) AdjustedPrice(adjustmentType TicketPriceAdjustmentType, value float64) (float64, error) {
+	var price float64
+	switch adjustmentType {
+	case TicketPriceAdjustmentPercentage:
+		price = t.Price * (1 + value/100)
+	case TicketPriceAdjustmentFixed:
+		price = t.Price + value
+	default:
+		return 0, ErrTicketInvalidPriceAdjustment
+	}
+
+	price = math.Round(price*100) / 100
+	if price < 0 {
+		price = 0
+	}
+	if price > MaxTicketPrice {
+		return 0, ErrTicketPriceTooHigh
+	}
+	return price, nil
+}
+
 func (t *Ticket) ValidateRequiredFields() error {
 	if t.Title == "" {
 		return ErrTicketTitleRequired
@@ -137,16 +178,31 @@ func (t *Ticket) CanBeDeleted() bool {
 
 // Ticket domain errors
 var (
-	ErrTicketTitleRequired            = NewDomainError("ticket title is required")
-	ErrTicketInvalidPrice             = NewDomainError("ticket price cannot be negative")
-	ErrTicketInvalidTotalQuantity     = NewDomainError("ticket total quantity must be greater than 0")
-	ErrTicketInvalidQuantity          = NewDomainError("quantity must be greater than 0")
-	ErrTicketInsufficientQuantity     = NewDomainError("insufficient ticket quantity available")
-	ErrTicketQuantityBelowSold        = NewDomainError("total quantity cannot be less than sold quantity")
-	ErrTicketInvalidQuantityDecrement = NewDomainError("cannot decrement sold quantity below 0")
-	ErrTicketNotFound                 = NewDomainError("ticket not found")
-	ErrTicketCannotBeUpdated          = NewDomainError("ticket cannot be updated after sales have started")
-	ErrTicketCannotBeDeleted          = NewDomainError("ticket cannot be deleted after sales have started")
-	ErrTicketSoldOut                  = NewDomainError("ticket is sold out")
-	ErrTicketNotActive                = NewDomainError("ticket is not active")
+	ErrTicketTitleRequired            = NewLocalizedDomainError("ticket.title_required", "ticket title is required")
+	ErrTicketInvalidPrice             = NewLocalizedDomainError("ticket.invalid_price", "ticket price cannot be negative")
+	ErrTicketInvalidTotalQuantity     = NewLocalizedDomainError("ticket.invalid_total_quantity", "ticket total quantity must be greater than 0")
+	ErrTicketInvalidQuantity          = NewLocalizedDomainError("ticket.invalid_quantity", "quantity must be greater than 0")
+	ErrTicketInsufficientQuantity     = NewLocalizedDomainError("ticket.insufficient_quantity", "insufficient ticket quantity available")
+	ErrTicketQuantityBelowSold        = NewLocalizedDomainError("ticket.quantity_below_sold", "total quantity cannot be less than sold quantity")
+	ErrTicketInvalidQuantityDecrement = NewLocalizedDomainError("ticket.invalid_quantity_decrement", "cannot decrement sold quantity below 0")
+	ErrTicketNotFound                 = NewLocalizedDomainError("ticket.not_found", "ticket not found")
+	ErrTicketCannotBeUpdated          = NewLocalizedDomainError("ticket.cannot_be_updated", "ticket cannot be updated after sales have started")
+	ErrTicketCannotBeDeleted          = NewLocalizedDomainError("ticket.cannot_be_deleted", "ticket cannot be deleted after sales have started")
+	ErrTicketSoldOut                  = NewLocalizedDomainError("ticket.sold_out", "ticket is sold out")
+	ErrTicketNotActive                = NewLocalizedDomainError("ticket.not_active", "ticket is not active")
+	ErrTicketInvalidPriceAdjustment   = NewLocalizedDomainError("ticket.bulk_price.invalid_adjustment", "invalid ticket price adjustment")
+	ErrTicketPriceTooHigh             = NewLocalizedDomainError("ticket.bulk_price.too_high", "ticket price exceeds the maximum allowed")
+	ErrTicketNoActiveTickets          = NewLocalizedDomainError("ticket.bulk_price.no_active_tickets", "event has no active tickets")
+	ErrTicketPriceBelowMinimum        = NewLocalizedDomainError("ticket.price_below_minimum", "paid ticket price is below the minimum allowed")
+	ErrTicketSoldQuantityExceedsTotal = NewLocalizedDomainError("ticket.sold_exceeds_total", "sold quantity cannot exceed total quantity")
+	ErrTicketInvalidPriceRange        = NewLocalizedDomainError("ticket.invalid_price_range", "minimum price cannot be greater than maximum price")
+	ErrTicketNoneToCreate             = NewLocalizedDomainError("ticket.none_to_create", "no tickets to create")
+	ErrTicketTooManyToCreate          = NewLocalizedDomainError("ticket.bulk_create.too_many", "too many tickets in one bulk create")
+	ErrTicketConcurrentUpdate         = NewLocalizedDomainError("ticket.concurrent_update", "ticket was changed by another request; reload it and try again")
+	ErrTicketDuplicateTitle           = NewLocalizedDomainError("ticket.duplicate_title", "another ticket of this event already has this title")
+	ErrTicketNoneToCheck              = NewLocalizedDomainError("ticket.availability_check.empty", "no tickets to check")
+	ErrTicketTooManyToCheck           = NewLocalizedDomainError("ticket.availability_check.too_many", "too many tickets in one availability check")
+	ErrTicketSalesStartTimeRequired   = NewLocalizedDomainError("ticket.sales.start_time_required", "event start time is not set")
+	ErrTicketInvalidSegment           = NewLocalizedDomainError("ticket.my_tickets.invalid_segment", "segment must be upcoming or past")
+	ErrTicketRefundExceedsSold        = NewLocalizedDomainError("ticket.refund_exceeds_sold", "cannot refund more tickets than were sold")
 )
@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"time"
+)
+
+// TicketPurchase records tickets bought by a user
+type TicketPurchase struct {
+	ID          int       `json:"id" gorm:"primaryKey;autoIncrement"`
+	TicketID    int       `json:"ticket_id" gorm:"not null;index"`
+	UserID      int       `json:"user_id" gorm:"not null;index"`
+	Quantity    int       `json:"quantity" gorm:"not null"`
+	PurchasedAt time.Time `json:"purchased_at" gorm:"not null;index"`
+
+	// Relations
+	Ticket *Ticket `json:"ticket,omitempty" gorm:"foreignKey:TicketID;references:ID"`
+	User   *User   `json:"user,omitempty" gorm:"foreignKey:UserID;references:ID"`
+}
+
+func NewTicketPurchase(ticketID, userID, quantity int) *TicketPurchase {
+	return &TicketPurchase{
+		TicketID:    ticketID,
+		UserID:      userID,
+		Quantity:    quantity,
+		PurchasedAt: time.Now(),
+	}
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"time"
+)
+
+type TicketReservationStatus string
+
+const (
+	TicketReservationStatusActive    TicketReservationStatus = "active"
+	TicketReservationStatusConfirmed TicketReservationStatus = "confirmed"
+	TicketReservationStatusReleased  TicketReservationStatus = "released"
+)
+
+// TicketReservation holds tickets of one tier for a buyer until checkout. Holds reserved
+// together share a CartID so a cart is confirmed or released as a whole. An active hold past
+// ExpiresAt no longer counts against availability.
+type TicketReservation struct {
+	ID        int                     `json:"id" gorm:"primaryKey;autoIncrement"`
+	CartID    string                  `json:"cart_id" gorm:"type:varchar(36);not null;index"`
+	TicketID  int                     `json:"ticket_id" gorm:"not null;index:idx_ticket_reservations_ticket_status"`
+	UserID    int                     `json:"user_id" gorm:"not null;index"`
+	Quantity  int                     `json:"quantity" gorm:"not null"`
+	Status    TicketReservationStatus `json:"status" gorm:"type:varchar(20);not null;default:'active';index:idx_ticket_reservations_ticket_status"`
+	ExpiresAt time.Time               `json:"expires_at" gorm:"not null"`
+	CreatedAt time.Time               `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time               `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+func NewTicketReservation(cartID string, ticketID, userID, quantity int, expiresAt time.Time) *TicketReservation {
+	return &TicketReservation{
+		CartID:    cartID,
+		TicketID:  ticketID,
+		UserID:    userID,
+		Quantity:  quantity,
+		Status:    TicketReservationStatusActive,
+		ExpiresAt: expiresAt,
+	}
+}
+
+// IsHeld reports whether the reservation still blocks the tickets
+func (r *TicketReservation) IsHeld() bool {
+	return r.Status == TicketReservationStatusActive && time.Now().Before(r.ExpiresAt)
+}
+
+// Ticket reservation domain errors
+var (
+	ErrTicketReservationNotFound = NewLocalizedDomainError("ticket.reservation.not_found", "cart reservation not found")
+	ErrTicketReservationExpired  = NewLocalizedDomainError("ticket.reservation.expired", "cart reservation has expired")
+)
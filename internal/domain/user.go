@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"context"
 	"time"
 )
 
@@ -11,6 +12,14 @@ const (
 	UserTypeCreator UserType = "creator"
 )
 
+// UserRole is a platform-level permission, independent of the user/creator account type
+type UserRole string
+
+const (
+	UserRoleMember UserRole = "member"
+	UserRoleAdmin  UserRole = "admin"
+)
+
 type User struct {
 	ID              int        `json:"id" db:"id"`
 	FullName        string     `json:"full_name" db:"full_name"`
@@ -19,6 +28,7 @@ type User struct {
 	Phone           *string    `json:"phone" db:"phone"`
 	Password        string     `json:"-" db:"password"`
 	UserType        UserType   `json:"user_type" db:"user_type"`
+	Role            UserRole   `json:"role" db:"role" gorm:"type:varchar(20);not null;default:'member'"`
 	AppleID         *string    `json:"apple_id" db:"apple_id"`
 	GoogleID        *string    `json:"google_id" db:"google_id"`
 	Biography       *string    `json:"biography" db:"biography"`
@@ -30,6 +40,8 @@ type User struct {
 	FollowersCount  int        `json:"followers_count" db:"followers_count" gorm:"default:0"`
 	FollowingCount  int        `json:"following_count" db:"following_count" gorm:"default:0"`
 	IsActive        bool       `json:"is_active" db:"is_active"`
+	HasUsedTrial    bool       `json:"has_used_trial" db:"has_used_trial" gorm:"default:false"`
+	Language        string     `json:"language" db:"language" gorm:"type:varchar(10);not null;default:''"` // for emails sent outside a request; empty uses the default
 	CreatedAt       time.Time  `json:"created_at" db:"created_at"`
 	UpdatedAt       time.Time  `json:"updated_at" db:"updated_at"`
 
@@ -44,6 +56,7 @@ func NewUser(fullName, password string, userType UserType) *User {
 		FullName:  fullName,
 		Password:  password,
 		UserType:  userType,
+		Role:      UserRoleMember,
 		IsActive:  true,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
@@ -158,6 +171,25 @@ func (u *User) IsCreator() bool {
 	return u.UserType == UserTypeCreator
 }
 
+func (u *User) IsAdmin() bool {
+	return u.Role == UserRoleAdmin
+}
+
+type userRoleContextKey struct{}
+
+// ContextWithUserRole returns a context carrying the authenticated user's role, so services
+// can apply role-based rules without loading the user
+func ContextWithUserRole(ctx context.Context, role UserRole) context.Context {
+	return context.WithValue(ctx, userRoleContextKey{}, role)
+}
+
+// UserRoleFromContext returns the role stored by ContextWithUserRole, or "" when the request
+// is anonymous
+func UserRoleFromContext(ctx context.Context) UserRole {
+	role, _ := ctx.Value(userRoleContextKey{}).(UserRole)
+	return role
+}
+
 func (u *User) ValidateRequiredFields() error {
 	// Creator-specific validations are now handled in Creator entity
 	return nil
@@ -197,12 +229,21 @@ var ()
 
 type DomainError struct {
 	Message string
+	// Key is the i18n key shown to users instead of Message; empty when the error has no
+	// translation yet
+	Key string
 }
 
 func NewDomainError(message string) *DomainError {
 	return &DomainError{Message: message}
 }
 
+// NewLocalizedDomainError creates a domain error that handlers translate through key. Message
+// stays the English text used in logs and error matching.
+func NewLocalizedDomainError(key, message string) *DomainError {
+	return &DomainError{Message: message, Key: key}
+}
+
 func (e *DomainError) Error() string {
 	return e.Message
 }
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"time"
+)
+
+type UserActivityType string
+
+const (
+	UserActivityEventCreated        UserActivityType = "event_created"
+	UserActivityEventStatusChanged  UserActivityType = "event_status_changed"
+	UserActivityInvitationResponded UserActivityType = "invitation_responded"
+	UserActivityTicketPurchased     UserActivityType = "ticket_purchased"
+)
+
+// UserActivity is one entry of a user's activity timeline. It is read from the tables that
+// record the activity and is not stored on its own; SourceID is the id of the row in the
+// table the entry came from.
+type UserActivity struct {
+	Type        UserActivityType
+	SourceID    int
+	EventID     int
+	EventName   string
+	Status      *string // new event status or invitation answer
+	TicketTitle *string
+	Quantity    *int
+	OccurredAt  time.Time
+}
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"time"
+)
+
+// UserEventRelation is how a user is connected to an event in their combined event list
+type UserEventRelation string
+
+const (
+	UserEventRelationOwned     UserEventRelation = "owned"
+	UserEventRelationAttending UserEventRelation = "attending"
+	UserEventRelationInvited   UserEventRelation = "invited"
+)
+
+func (r UserEventRelation) IsValid() bool {
+	switch r {
+	case UserEventRelationOwned, UserEventRelationAttending, UserEventRelationInvited:
+		return true
+	}
+	return false
+}
+
+// UserEventRef is one entry of a user's combined event list. An event the user relates to in
+// several ways appears once, tagged owned before attending before invited. SortAt is the
+// event's start, or its creation time when it has no start date.
+type UserEventRef struct {
+	EventID  int
+	Relation UserEventRelation
+	SortAt   time.Time
+}
+
+// User event list domain errors
+var (
+	ErrUserEventInvalidRelation = NewLocalizedDomainError("user.events.invalid_relation", "invalid relation")
+)
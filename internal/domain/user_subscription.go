@@ -69,6 +69,8 @@ type PublishingRights struct {
 	ActiveSubscription *UserSubscription   `json:"active_subscription,omitempty"`
 	ActivePackages     []*UserSubscription `json:"active_packages,omitempty"`
 	RestrictionReason  string              `json:"restriction_reason,omitempty"`
+	PeriodStart        *time.Time          `json:"period_start,omitempty"`
+	PeriodEnd          *time.Time          `json:"period_end,omitempty"`
 }
 
 // SubscriptionStats represents user's subscription statistics
@@ -85,27 +87,90 @@ type SubscriptionStats struct {
 	NextBillingDate     *time.Time `json:"next_billing_date,omitempty"`
 }
 
+// CancellationReasonStat represents how often a cancellation reason was given
+type CancellationReasonStat struct {
+	Reason string `json:"reason"`
+	Count  int64  `json:"count"`
+}
+
+// PlanChangePreview represents the prorated cost of switching to another plan
+type PlanChangePreview struct {
+	SubscriptionID   int              `json:"subscription_id,omitempty"`
+	CurrentPlan      SubscriptionName `json:"current_plan,omitempty"`
+	NewPlanID        int              `json:"new_plan_id"`
+	NewPlan          SubscriptionName `json:"new_plan"`
+	IsNewPurchase    bool             `json:"is_new_purchase"`
+	ImmediateCharge  float64          `json:"immediate_charge"` // Negative when the change results in a credit
+	NextInvoiceTotal float64          `json:"next_invoice_total"`
+	Currency         string           `json:"currency"`
+	NextBillingDate  *time.Time       `json:"next_billing_date,omitempty"`
+}
+
+// SubscriptionReconcileAction is the correction reconciliation makes for a drifted subscription
+type SubscriptionReconcileAction string
+
+const (
+	// SubscriptionReconcileCancel cancels locally a subscription Stripe has ended or no longer knows
+	SubscriptionReconcileCancel SubscriptionReconcileAction = "cancel"
+	// SubscriptionReconcileSyncPeriod copies the billing period and cancellation flag from Stripe
+	SubscriptionReconcileSyncPeriod SubscriptionReconcileAction = "sync_period"
+	// SubscriptionReconcileReview flags a Stripe status that has no automatic local correction
+	SubscriptionReconcileReview SubscriptionReconcileAction = "review"
+)
+
+// SubscriptionDrift describes one local subscription that disagrees with Stripe
+type SubscriptionDrift struct {
+	SubscriptionID          int                         `json:"subscription_id"`
+	UserID                  int                         `json:"user_id"`
+	StripeID                string                      `json:"stripe_id"`
+	StripeStatus            string                      `json:"stripe_status"` // "missing" when Stripe no longer knows the subscription
+	Action                  SubscriptionReconcileAction `json:"action"`
+	LocalPeriodEnd          *time.Time                  `json:"local_period_end,omitempty"`
+	StripePeriodEnd         *time.Time                  `json:"stripe_period_end,omitempty"`
+	LocalCancelAtPeriodEnd  bool                        `json:"local_cancel_at_period_end"`
+	StripeCancelAtPeriodEnd bool                        `json:"stripe_cancel_at_period_end"`
+	Applied                 bool                        `json:"applied"`
+	Error                   string                      `json:"error,omitempty"`
+}
+
+// SubscriptionReconciliation reports the outcome of comparing local subscriptions with Stripe
+type SubscriptionReconciliation struct {
+	DryRun    bool                 `json:"dry_run"`
+	Checked   int                  `json:"checked"`
+	Corrected int                  `json:"corrected"`
+	Failed    int                  `json:"failed"` // Subscriptions Stripe could not be asked about
+	Drifts    []*SubscriptionDrift `json:"drifts"`
+}
+
 // UserSubscription represents both subscriptions and packages in a unified table
 type UserSubscription struct {
-	ID           int                `gorm:"primaryKey;autoIncrement" json:"id"`
-	UserID       int                `gorm:"not null;index" json:"user_id"`
-	Type         SubscriptionType   `gorm:"type:varchar(20);not null;index" json:"type"`
-	Name         SubscriptionName   `gorm:"type:varchar(50);not null" json:"name"`
-	Price        float64            `gorm:"type:decimal(10,2);not null" json:"price"`
-	Currency     string             `gorm:"type:varchar(3);not null;default:'EUR'" json:"currency"`
-	WeeklyLimit  *int               `gorm:"default:null" json:"weekly_limit,omitempty"`  // Only for subscriptions
-	MonthlyLimit *int               `gorm:"default:null" json:"monthly_limit,omitempty"` // Only for subscriptions
-	TotalCredits *int               `gorm:"default:null" json:"total_credits,omitempty"` // Only for packages
-	UsedCredits  int                `gorm:"default:0" json:"used_credits"`               // Used credits for packages
-	WeeklyUsed   int                `gorm:"default:0" json:"weekly_used"`                // Used this week for subscriptions
-	MonthlyUsed  int                `gorm:"default:0" json:"monthly_used"`               // Used this month for subscriptions
-	Status       SubscriptionStatus `gorm:"type:varchar(20);not null;default:'pending'" json:"status"`
-	StartedAt    *time.Time         `gorm:"default:null" json:"started_at,omitempty"`
-	ExpiredAt    *time.Time         `gorm:"default:null" json:"expired_at,omitempty"`
-	StripeID     *string            `gorm:"type:varchar(255);default:null;index" json:"stripe_id,omitempty"` // Stripe subscription/payment ID
-	Metadata     json.RawMessage    `gorm:"type:jsonb;default:'{}'" json:"metadata"`
-	CreatedAt    time.Time          `gorm:"autoCreateTime" json:"created_at"`
-	UpdatedAt    time.Time          `gorm:"autoUpdateTime" json:"updated_at"`
+	ID           int              `gorm:"primaryKey;autoIncrement" json:"id"`
+	UserID       int              `gorm:"not null;index" json:"user_id"`
+	Type         SubscriptionType `gorm:"type:varchar(20);not null;index" json:"type"`
+	Name         SubscriptionName `gorm:"type:varchar(50);not null" json:"name"`
+	Price        float64          `gorm:"type:decimal(10,2);not null" json:"price"`
+	Currency     string           `gorm:"type:varchar(3);not null;default:'EUR'" json:"currency"`
+	WeeklyLimit  *int             `gorm:"default:null" json:"weekly_limit,omitempty"`  // Only for subscriptions
+	MonthlyLimit *int             `gorm:"default:null" json:"monthly_limit,omitempty"` // Only for subscriptions
+	TotalCredits *int             `gorm:"default:null" json:"total_credits,omitempty"` // Only for packages
+	UsedCredits  int              `gorm:"default:0" json:"used_credits"`               // Used credits for packages
+	WeeklyUsed   int              `gorm:"default:0" json:"weekly_used"`                // Used this week for subscriptions
+	MonthlyUsed  int              `gorm:"default:0" json:"monthly_used"`               // Used this month for subscriptions
+	// MaxInvitationsPerEvent is copied from the plan; nil leaves only the platform cap
+	MaxInvitationsPerEvent *int               `gorm:"default:null" json:"max_invitations_per_event,omitempty"`
+	Status                 SubscriptionStatus `gorm:"type:varchar(20);not null;default:'pending'" json:"status"`
+	StartedAt              *time.Time         `gorm:"default:null" json:"started_at,omitempty"`
+	ExpiredAt              *time.Time         `gorm:"default:null" json:"expired_at,omitempty"`
+	PeriodStart            *time.Time         `gorm:"default:null" json:"period_start,omitempty"` // Current billing period window for subscriptions
+	PeriodEnd              *time.Time         `gorm:"default:null" json:"period_end,omitempty"`
+	TrialEndsAt            *time.Time         `gorm:"default:null" json:"trial_ends_at,omitempty"` // Set when the subscription started with a free trial
+	CancelAtPeriodEnd      bool               `gorm:"default:false" json:"cancel_at_period_end"`   // Cancelled, but entitlements are kept until the period ends
+	CancellationReason     *string            `gorm:"type:text;default:null" json:"cancellation_reason,omitempty"`
+	CancelledAt            *time.Time         `gorm:"default:null" json:"cancelled_at,omitempty"`
+	StripeID               *string            `gorm:"type:varchar(255);default:null;index" json:"stripe_id,omitempty"` // Stripe subscription/payment ID
+	Metadata               json.RawMessage    `gorm:"type:jsonb;default:'{}'" json:"metadata"`
+	CreatedAt              time.Time          `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt              time.Time          `gorm:"autoUpdateTime" json:"updated_at"`
 
 	// Relations
 	User User `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE" json:"user,omitempty"`
@@ -129,6 +194,16 @@ func (us *UserSubscription) IsActive() bool {
 	return true
 }
 
+// IsTrialing checks if the subscription is currently in its free trial period
+func (us *UserSubscription) IsTrialing() bool {
+	return us.IsActive() && us.TrialEndsAt != nil && us.TrialEndsAt.After(time.Now())
+}
+
+// IsCanceledButActive checks if the subscription is scheduled to cancel at period end but still grants entitlements
+func (us *UserSubscription) IsCanceledButActive() bool {
+	return us.CancelAtPeriodEnd && us.IsActive()
+}
+
 // IsExpired checks if the subscription/package has expired
 func (us *UserSubscription) IsExpired() bool {
 	return us.ExpiredAt != nil && us.ExpiredAt.Before(time.Now())
@@ -139,7 +214,7 @@ func (us *UserSubscription) HasWeeklyLimit() bool {
 	if us.Type != SubscriptionTypeSubscription || us.WeeklyLimit == nil {
 		return false
 	}
-	return us.WeeklyUsed >= *us.WeeklyLimit
+	return us.PeriodWeeklyUsed() >= *us.WeeklyLimit
 }
 
 // HasMonthlyLimit checks if user has reached monthly limit (for subscriptions)
@@ -147,7 +222,7 @@ func (us *UserSubscription) HasMonthlyLimit() bool {
 	if us.Type != SubscriptionTypeSubscription || us.MonthlyLimit == nil {
 		return false
 	}
-	return us.MonthlyUsed >= *us.MonthlyLimit
+	return us.PeriodMonthlyUsed() >= *us.MonthlyLimit
 }
 
 // HasCredits checks if package has remaining credits (for packages)
@@ -175,7 +250,7 @@ func (us *UserSubscription) RemainingWeeklyLimit() int {
 	if us.Type != SubscriptionTypeSubscription || us.WeeklyLimit == nil {
 		return 0
 	}
-	remaining := *us.WeeklyLimit - us.WeeklyUsed
+	remaining := *us.WeeklyLimit - us.PeriodWeeklyUsed()
 	if remaining < 0 {
 		return 0
 	}
@@ -187,7 +262,7 @@ func (us *UserSubscription) RemainingMonthlyLimit() int {
 	if us.Type != SubscriptionTypeSubscription || us.MonthlyLimit == nil {
 		return 0
 	}
-	remaining := *us.MonthlyLimit - us.MonthlyUsed
+	remaining := *us.MonthlyLimit - us.PeriodMonthlyUsed()
 	if remaining < 0 {
 		return 0
 	}
@@ -223,6 +298,37 @@ func (us *UserSubscription) ConsumeUsage() {
 	}
 }
 
+// StartNewPeriod zeroes the consumed usage and records the new billing period window
+func (us *UserSubscription) StartNewPeriod(start, end time.Time) {
+	us.WeeklyUsed = 0
+	us.MonthlyUsed = 0
+	us.UsedCredits = 0
+	us.PeriodStart = &start
+	us.PeriodEnd = &end
+	us.ExpiredAt = &end
+}
+
+// IsInCurrentPeriod checks if the subscription's recorded billing period is still running
+func (us *UserSubscription) IsInCurrentPeriod() bool {
+	return us.PeriodEnd == nil || us.PeriodEnd.After(time.Now())
+}
+
+// PeriodWeeklyUsed returns weekly usage counted against the current billing period only
+func (us *UserSubscription) PeriodWeeklyUsed() int {
+	if !us.IsInCurrentPeriod() {
+		return 0
+	}
+	return us.WeeklyUsed
+}
+
+// PeriodMonthlyUsed returns monthly usage counted against the current billing period only
+func (us *UserSubscription) PeriodMonthlyUsed() int {
+	if !us.IsInCurrentPeriod() {
+		return 0
+	}
+	return us.MonthlyUsed
+}
+
 // ResetWeeklyUsage resets weekly usage counter (called every Monday)
 func (us *UserSubscription) ResetWeeklyUsage() {
 	if us.Type == SubscriptionTypeSubscription {
@@ -308,3 +414,8 @@ func (us *UserSubscription) Validate() error {
 
 	return nil
 }
+
+// User subscription domain errors
+var (
+	ErrTrialAlreadyUsed = NewLocalizedDomainError("subscription.trial_already_used", "trial already used")
+)
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"time"
+)
+
+// WaitlistStatus tells whether a waitlist entry is still queued
+type WaitlistStatus string
+
+const (
+	WaitlistStatusWaiting  WaitlistStatus = "waiting"
+	WaitlistStatusPromoted WaitlistStatus = "promoted"
+)
+
+// Waitlist is a user's place in the queue of an event at capacity. The waiting entries of an
+// event are numbered 1..n without gaps: when one is promoted or leaves, everyone behind it moves
+// up one place.
+type Waitlist struct {
+	ID      int            `json:"id" gorm:"primaryKey;autoIncrement"`
+	EventID int            `json:"event_id" gorm:"not null;uniqueIndex:idx_waitlists_event_user;index:idx_waitlists_event_position"`
+	UserID  int            `json:"user_id" gorm:"not null;uniqueIndex:idx_waitlists_event_user;index"`
+	Email   string         `json:"email" gorm:"type:varchar(255);not null"`
+	Status  WaitlistStatus `json:"status" gorm:"type:varchar(20);not null;default:'waiting'"`
+	// Place in the queue starting at 1; 0 once promoted
+	Position   int        `json:"position" gorm:"not null;index:idx_waitlists_event_position"`
+	PromotedAt *time.Time `json:"promoted_at" gorm:"default:null"`
+	CreatedAt  time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt  time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+func NewWaitlist(eventID, userID int, email string) *Waitlist {
+	return &Waitlist{
+		EventID: eventID,
+		UserID:  userID,
+		Email:   email,
+		Status:  WaitlistStatusWaiting,
+	}
+}
+
+// IsWaiting reports whether the entry is still queued
+func (w *Waitlist) IsWaiting() bool {
+	return w.Status == WaitlistStatusWaiting
+}
+
+// Waitlist domain errors
+var (
+	ErrWaitlistAlreadyJoined  = NewLocalizedDomainError("event.waitlist.already_joined", "already on the waitlist of this event")
+	ErrWaitlistNotJoined      = NewLocalizedDomainError("event.waitlist.not_joined", "not on the waitlist of this event")
+	ErrWaitlistOwnEvent       = NewLocalizedDomainError("event.waitlist.own_event", "cannot join the waitlist of your own event")
+	ErrWaitlistNotAllowed     = NewLocalizedDomainError("event.waitlist.not_allowed", "only published events with a capacity and open sales have a waitlist")
+	ErrWaitlistSeatsAvailable = NewLocalizedDomainError("event.waitlist.seats_available", "the event still has seats available")
+)
@@ -0,0 +1,48 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/louco-event/internal/domain"
+)
+
+// Activity timeline DTOs
+type ActivityTimelineRequest struct {
+	Cursor string `form:"cursor"`
+	Limit  int    `form:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+// UserActivityResponse is one timeline entry; the payload keys depend on the type
+type UserActivityResponse struct {
+	Type       domain.UserActivityType `json:"type"`
+	EventID    int                     `json:"event_id"`
+	OccurredAt time.Time               `json:"occurred_at"`
+	Payload    map[string]interface{}  `json:"payload"`
+}
+
+// ActivityTimelineResponse is one page of the timeline. NextCursor is set while older
+// entries remain and is passed back as cursor to fetch them.
+type ActivityTimelineResponse struct {
+	Items      []*UserActivityResponse `json:"items"`
+	NextCursor *string                 `json:"next_cursor"`
+}
+
+// UserEventsRequest pages through the user's combined event list. Relation is one of all,
+// owned, attending or invited; empty means all.
+type UserEventsRequest struct {
+	Relation string `form:"relation" validate:"omitempty,oneof=all owned attending invited"`
+	Cursor   string `form:"cursor"`
+	Limit    int    `form:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+// UserEventResponse is an event of the combined list with how the user relates to it
+type UserEventResponse struct {
+	Relation domain.UserEventRelation `json:"relation"`
+	Event    *EventListResponse       `json:"event"`
+}
+
+// UserEventsResponse is one page of the combined event list
+type UserEventsResponse struct {
+	Items      []*UserEventResponse `json:"items"`
+	NextCursor *string              `json:"next_cursor"`
+}
@@ -68,6 +68,7 @@ type JWTClaims struct {
 	Email    string `json:"email"`
 	Username string `json:"username"`
 	UserType string `json:"user_type"`
+	Role     string `json:"role"`
 }
 
 // Password Reset
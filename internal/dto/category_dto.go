@@ -37,6 +37,8 @@ type CategoryListRequest struct {
 type CategorySearchRequest struct {
 	Query string `form:"q" json:"query" binding:"required,min=2"`
 	Type  string `form:"type" json:"type"`
+	// SortBy "name" orders results alphabetically in the request language instead of tree order
+	SortBy string `form:"sort_by" json:"sort_by" binding:"omitempty,oneof=name"`
 }
 
 // CategoryByTypeRequest represents request for categories by type
@@ -0,0 +1,59 @@
+package dto
+
+import (
+	"github.com/louco-event/internal/domain"
+)
+
+// ClientConfigResponse is the effective, non-sensitive configuration clients validate against.
+// Zero limits mean the rule is disabled.
+type ClientConfigResponse struct {
+	Pagination       ClientPaginationConfig `json:"pagination"`
+	Limits           ClientLimitsConfig     `json:"limits"`
+	Payments         ClientPaymentsConfig   `json:"payments"`
+	Features         ClientFeaturesConfig   `json:"features"`
+	Enums            ClientEnumsConfig      `json:"enums"`
+	SupportedLocales []string               `json:"supported_locales"`
+	DefaultLocale    string                 `json:"default_locale"`
+}
+
+type ClientPaginationConfig struct {
+	DefaultPageSize int `json:"default_page_size"`
+	MaxPageSize     int `json:"max_page_size"`
+}
+
+type ClientLimitsConfig struct {
+	MaxEventNameLength         int `json:"max_event_name_length"`
+	MaxDraftsPerCreator        int `json:"max_drafts_per_creator"`
+	MaxInvitationsPerEvent     int `json:"max_invitations_per_event"`
+	MaxInvitationsPerRequest   int `json:"max_invitations_per_request"`
+	MaxTicketsPerBulkRequest   int `json:"max_tickets_per_bulk_request"`
+	MaxCartItems               int `json:"max_cart_items"`
+	MaxBulkStatusEvents        int `json:"max_bulk_status_events"`
+	SearchMinQueryLength       int `json:"search_min_query_length"`
+	CartHoldSeconds            int `json:"cart_hold_seconds"`
+	InvitationLinkTTLSeconds   int `json:"invitation_link_ttl_seconds"`
+	InvitationLateGraceSeconds int `json:"invitation_late_grace_seconds"`
+}
+
+type ClientPaymentsConfig struct {
+	Currency       string  `json:"currency"`
+	MinTicketPrice float64 `json:"min_ticket_price"`
+	MaxTicketPrice float64 `json:"max_ticket_price"`
+	PublishableKey string  `json:"publishable_key,omitempty"`
+	Environment    string  `json:"environment"`
+}
+
+type ClientFeaturesConfig struct {
+	RequireEventImage bool   `json:"require_event_image"`
+	DescriptionFormat string `json:"description_format"`
+	VenueTimezones    bool   `json:"venue_timezones"`
+}
+
+// ClientEnumsConfig lists the values the server accepts; event and location types are
+// narrowed to the ones this deployment allows
+type ClientEnumsConfig struct {
+	EventTypes         []domain.EventType         `json:"event_types"`
+	EventLocationTypes []domain.EventLocationType `json:"event_location_types"`
+	EventStatuses      []domain.EventStatus       `json:"event_statuses"`
+	CategoryTypes      []domain.CategoryType      `json:"category_types"`
+}
@@ -1,11 +1,22 @@
 package dto
 
+import "time"
+
 // Standard API Response format
 type APIResponse struct {
-	Success bool        `json:"success"`
-	Message string      `json:"message"`
-	Data    interface{} `json:"data,omitempty"`
-	Errors  interface{} `json:"errors,omitempty"`
+	Success bool          `json:"success"`
+	Message string        `json:"message"`
+	Data    interface{}   `json:"data,omitempty"`
+	Errors  interface{}   `json:"errors,omitempty"`
+	Meta    *ResponseMeta `json:"meta,omitempty"`
+}
+
+// ResponseMeta carries request metadata alongside the payload
+type ResponseMeta struct {
+	RequestID  string                    `json:"request_id,omitempty"`
+	Timestamp  time.Time                 `json:"timestamp"`
+	Pagination *PaginationMeta           `json:"pagination,omitempty"`
+	Cursor     *CursorPaginationResponse `json:"cursor,omitempty"`
 }
 
 // Success response helper
@@ -14,18 +25,44 @@ func NewSuccessResponse(message string, data interface{}) *APIResponse {
 		Success: true,
 		Message: message,
 		Data:    data,
+		Meta:    newResponseMeta(),
 	}
 }
 
+// List response helper; pagination stays in data for existing clients and is mirrored in meta
+func NewListSuccessResponse(message string, items interface{}, pagination PaginationMeta) *APIResponse {
+	response := NewSuccessResponse(message, ListResponse{
+		Items:      items,
+		Pagination: pagination,
+	})
+	response.Meta.Pagination = &pagination
+	return response
+}
+
+// Cursor list response helper; like NewListSuccessResponse but for cursor pages
+func NewCursorListSuccessResponse(message string, items interface{}, pagination CursorPaginationResponse) *APIResponse {
+	response := NewSuccessResponse(message, CursorListResponse{
+		Items:      items,
+		Pagination: pagination,
+	})
+	response.Meta.Cursor = &pagination
+	return response
+}
+
 // Error response helper
 func NewErrorResponse(message string, errors interface{}) *APIResponse {
 	return &APIResponse{
 		Success: false,
 		Message: message,
 		Errors:  errors,
+		Meta:    newResponseMeta(),
 	}
 }
 
+func newResponseMeta() *ResponseMeta {
+	return &ResponseMeta{Timestamp: time.Now().UTC()}
+}
+
 // Validation Error
 type ValidationError struct {
 	Field   string `json:"field"`
@@ -33,6 +70,12 @@ type ValidationError struct {
 	Value   string `json:"value,omitempty"`
 }
 
+// Page sizes applied when a list request asks for none or for too many
+const (
+	DefaultPageSize = 10
+	MaxPageSize     = 100
+)
+
 // Pagination Request
 type PaginationRequest struct {
 	Page     int `json:"page" validate:"omitempty,min=1" form:"page" query:"page"`
@@ -50,6 +93,22 @@ type PaginationMeta struct {
 	TotalPages int `json:"total_pages"`
 }
 
+// NewPaginationResponse describes one page of a list of total items. An empty list still
+// has one (empty) page, so TotalPages is always at least 1 and Page <= TotalPages holds for
+// the first page whatever the total.
+func NewPaginationResponse(page, pageSize int, total int64) *PaginationResponse {
+	totalPages := 1
+	if pageSize > 0 && total > 0 {
+		totalPages = int((total + int64(pageSize) - 1) / int64(pageSize))
+	}
+	return &PaginationResponse{
+		Page:       page,
+		PageSize:   pageSize,
+		Total:      int(total),
+		TotalPages: totalPages,
+	}
+}
+
 // Helper functions for pagination
 func (p *PaginationRequest) GetPageWithDefault() int {
 	if p.Page <= 0 {
@@ -60,10 +119,10 @@ func (p *PaginationRequest) GetPageWithDefault() int {
 
 func (p *PaginationRequest) GetPageSizeWithDefault() int {
 	if p.PageSize <= 0 {
-		return 10
+		return DefaultPageSize
 	}
-	if p.PageSize > 100 {
-		return 100
+	if p.PageSize > MaxPageSize {
+		return MaxPageSize
 	}
 	return p.PageSize
 }
@@ -74,6 +133,35 @@ func (p *PaginationRequest) GetOffset() int {
 	return (page - 1) * pageSize
 }
 
+// CursorPaginationRequest pages through a list by position instead of offset, so deep pages
+// stay fast and rows added while scrolling do not shift the following pages
+type CursorPaginationRequest struct {
+	After string `json:"after" form:"after" query:"after"`
+	Limit int    `json:"limit" validate:"omitempty,min=1,max=100" form:"limit" query:"limit"`
+}
+
+// CursorPaginationResponse describes a cursor page; NextCursor is empty on the last page
+type CursorPaginationResponse struct {
+	Limit      int    `json:"limit"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	HasMore    bool   `json:"has_more"`
+}
+
+// IsSet reports whether the client asked for cursor pagination
+func (p *CursorPaginationRequest) IsSet() bool {
+	return p.After != "" || p.Limit > 0
+}
+
+func (p *CursorPaginationRequest) GetLimitWithDefault() int {
+	if p.Limit <= 0 {
+		return DefaultPageSize
+	}
+	if p.Limit > MaxPageSize {
+		return MaxPageSize
+	}
+	return p.Limit
+}
+
 // Health Check
 type HealthResponse struct {
 	Status    string `json:"status"`
@@ -88,6 +176,12 @@ type ListResponse struct {
 	Pagination PaginationMeta `json:"pagination"`
 }
 
+// Generic cursor list response
+type CursorListResponse struct {
+	Items      interface{}              `json:"items"`
+	Pagination CursorPaginationResponse `json:"pagination"`
+}
+
 // Error codes
 const (
 	ErrCodeValidation     = "VALIDATION_ERROR"
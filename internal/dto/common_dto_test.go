@@ -0,0 +1,31 @@
+package dto
+
+import "testing"
+
+func TestNewPaginationResponse(t *testing.T) {
+	tests := []struct {
+		name           string
+		page, pageSize int
+		total          int64
+		wantPages      int
+	}{
+		{"empty result", 1, 20, 0, 1},
+		{"single partial page", 1, 20, 5, 1},
+		{"exactly one page", 1, 20, 20, 1},
+		{"one over a page", 2, 20, 21, 2},
+		{"several pages", 3, 10, 95, 10},
+		{"zero page size", 1, 0, 50, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewPaginationResponse(tt.page, tt.pageSize, tt.total)
+			if got.Page != tt.page || got.PageSize != tt.pageSize || got.Total != int(tt.total) {
+				t.Errorf("NewPaginationResponse() = %+v, want page %d, size %d, total %d", *got, tt.page, tt.pageSize, tt.total)
+			}
+			if got.TotalPages != tt.wantPages {
+				t.Errorf("TotalPages = %d, want %d", got.TotalPages, tt.wantPages)
+			}
+		})
+	}
+}
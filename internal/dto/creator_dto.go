@@ -4,16 +4,18 @@ import "time"
 
 // Creator Response
 type CreatorResponse struct {
-	ID               int                `json:"id"`
-	UserID           int                `json:"user_id"`
-	WeeztixToken     *string            `json:"weeztix_token"`
-	CompanyName      string             `json:"company_name"`
-	Address          string             `json:"address"`
-	EstimatedTickets int                `json:"estimated_tickets"`
-	EstimatedEvents  int                `json:"estimated_events"`
-	Industries       []IndustryResponse `json:"industries"`
-	CreatedAt        time.Time          `json:"created_at"`
-	UpdatedAt        time.Time          `json:"updated_at"`
+	ID               int                    `json:"id"`
+	UserID           int                    `json:"user_id"`
+	WeeztixToken     *string                `json:"weeztix_token"`
+	CompanyName      string                 `json:"company_name"`
+	Address          string                 `json:"address"`
+	EstimatedTickets int                    `json:"estimated_tickets"`
+	EstimatedEvents  int                    `json:"estimated_events"`
+	Timezone         string                 `json:"timezone"`
+	Industries       []IndustryResponse     `json:"industries"`
+	Rating           *RatingSummaryResponse `json:"rating,omitempty"` // set on profile views
+	CreatedAt        time.Time              `json:"created_at"`
+	UpdatedAt        time.Time              `json:"updated_at"`
 }
 
 // Create Creator Request
@@ -23,16 +25,18 @@ type CreateCreatorRequest struct {
 	Address          string  `json:"address" validate:"required,min=5,max=500"`
 	EstimatedTickets int     `json:"estimated_tickets" validate:"required,min=1"`
 	EstimatedEvents  int     `json:"estimated_events" validate:"required,min=1"`
+	Timezone         string  `json:"timezone" validate:"omitempty,max=64"` // IANA name; empty means UTC
 	IndustryIDs      []int   `json:"industry_ids" validate:"required,min=1,dive,min=1"`
 }
 
 // Update Creator Request
 type UpdateCreatorRequest struct {
-	CompanyName      string `json:"company_name" validate:"omitempty,min=2,max=200"`
-	Address          string `json:"address" validate:"omitempty,min=5,max=500"`
-	EstimatedTickets int    `json:"estimated_tickets" validate:"omitempty,min=1"`
-	EstimatedEvents  int    `json:"estimated_events" validate:"omitempty,min=1"`
-	IndustryIDs      []int  `json:"industry_ids" validate:"omitempty,min=1,dive,min=1"`
+	CompanyName      string  `json:"company_name" validate:"omitempty,min=2,max=200"`
+	Address          string  `json:"address" validate:"omitempty,min=5,max=500"`
+	EstimatedTickets int     `json:"estimated_tickets" validate:"omitempty,min=1"`
+	EstimatedEvents  int     `json:"estimated_events" validate:"omitempty,min=1"`
+	Timezone         *string `json:"timezone" validate:"omitempty,max=64"`
+	IndustryIDs      []int   `json:"industry_ids" validate:"omitempty,min=1,dive,min=1"`
 }
 
 // Set Weeztix Token Request
@@ -48,9 +52,10 @@ type CreatorProfileResponse struct {
 
 // Creator List Request
 type CreatorListRequest struct {
-	Page       int `json:"page" validate:"omitempty,min=1"`
-	PageSize   int `json:"page_size" validate:"omitempty,min=1,max=100"`
-	IndustryID int `json:"industry_id" validate:"omitempty,min=1"`
+	Page       int    `json:"page" validate:"omitempty,min=1"`
+	PageSize   int    `json:"page_size" validate:"omitempty,min=1,max=100"`
+	IndustryID int    `json:"industry_id" validate:"omitempty,min=1"`
+	SortBy     string `json:"sort_by" validate:"omitempty,oneof=created_at rating"`
 }
 
 // Creator List Response
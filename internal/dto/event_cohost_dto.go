@@ -0,0 +1,42 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/louco-event/internal/domain"
+)
+
+// Event Co-host DTOs
+type InviteEventCoHostRequest struct {
+	CreatorID int                     `json:"creator_id" validate:"required,min=1"`
+	Role      *domain.EventCoHostRole `json:"role" validate:"omitempty,oneof=host editor"`
+}
+
+type EventCoHostResponse struct {
+	ID          int                    `json:"id"`
+	EventID     int                    `json:"event_id"`
+	CreatorID   int                    `json:"creator_id"`
+	CompanyName string                 `json:"company_name,omitempty"`
+	Role        domain.EventCoHostRole `json:"role"`
+	Accepted    bool                   `json:"accepted"`
+	AcceptedAt  *time.Time             `json:"accepted_at,omitempty"`
+	CreatedAt   time.Time              `json:"created_at"`
+}
+
+func EventCoHostToResponse(coHost *domain.EventCoHost) *EventCoHostResponse {
+	response := &EventCoHostResponse{
+		ID:         coHost.ID,
+		EventID:    coHost.EventID,
+		CreatorID:  coHost.CreatorID,
+		Role:       coHost.Role,
+		Accepted:   coHost.IsAccepted(),
+		AcceptedAt: coHost.AcceptedAt,
+		CreatedAt:  coHost.CreatedAt,
+	}
+
+	if coHost.Creator != nil {
+		response.CompanyName = coHost.Creator.CompanyName
+	}
+
+	return response
+}
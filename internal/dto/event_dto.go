@@ -1,88 +1,196 @@
 package dto
 
 import (
+	"encoding/json"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/pkg/sanitizer"
 )
 
 // Event creation and update requests
 type CreateEventRequest struct {
-	Name             string                   `json:"name" validate:"required,min=3,max=200"`
-	Description      *string                  `json:"description" validate:"omitempty,max=2000"`
-	ImageID          *int                     `json:"image_id" validate:"omitempty,gt=0"`
-	VideoID          *int                     `json:"video_id" validate:"omitempty,gt=0"`
-	Type             domain.EventType         `json:"type" validate:"required,oneof=public private"`
-	LocationType     domain.EventLocationType `json:"location_type" validate:"required,oneof=location online announcement"`
-	StartDate        *string                  `json:"start_date" validate:"omitempty,datetime=2006-01-02"`
-	StartTime        *string                  `json:"start_time" validate:"omitempty,datetime=15:04"`
-	EndDate          *string                  `json:"end_date" validate:"omitempty,datetime=2006-01-02"`
-	EndTime          *string                  `json:"end_time" validate:"omitempty,datetime=15:04"`
-	AddressID        *int                     `json:"address_id" validate:"omitempty,gt=0"`
-	OnlineEventURL   *string                  `json:"online_event_url" validate:"omitempty,url,max=500"`
-	OnlineEventType  *string                  `json:"online_event_type" validate:"omitempty,max=50"`
-	TicketURL        *string                  `json:"ticket_url" validate:"omitempty,url,max=500"`
-	HasSystemTickets bool                     `json:"has_system_tickets"`
-	AdditionalInfo   *string                  `json:"additional_info" validate:"omitempty,max=2000"`
-	CategoryIDs      []int                    `json:"category_ids" validate:"omitempty,dive,gt=0"`
+	Name              string                    `json:"name" validate:"required,min=3,max=200"`
+	Description       *string                   `json:"description" validate:"omitempty,max=2000"`
+	ImageID           *int                      `json:"image_id" validate:"omitempty,gt=0"`
+	VideoID           *int                      `json:"video_id" validate:"omitempty,gt=0"`
+	Type              domain.EventType          `json:"type" validate:"required,oneof=public private"`
+	LocationType      domain.EventLocationType  `json:"location_type" validate:"required,oneof=location online announcement"`
+	StartDate         *string                   `json:"start_date" validate:"omitempty,datetime=2006-01-02"`
+	StartTime         *string                   `json:"start_time" validate:"omitempty,datetime=15:04"`
+	EndDate           *string                   `json:"end_date" validate:"omitempty,datetime=2006-01-02"`
+	EndTime           *string                   `json:"end_time" validate:"omitempty,datetime=15:04"`
+	Timezone          string                    `json:"timezone" validate:"omitempty,max=64"` // IANA name; empty means UTC
+	AddressID         *int                      `json:"address_id" validate:"omitempty,gt=0"`
+	OnlineEventURL    *string                   `json:"online_event_url" validate:"omitempty,url,max=500"`
+	OnlineEventType   *string                   `json:"online_event_type" validate:"omitempty,max=50"`
+	TicketURL         *string                   `json:"ticket_url" validate:"omitempty,url,max=500"`
+	HasSystemTickets  bool                      `json:"has_system_tickets"`
+	AllowJoinRequests bool                      `json:"allow_join_requests"`
+	HideGoingCount    bool                      `json:"hide_going_count"`
+	Capacity          *int                      `json:"capacity" validate:"omitempty,gt=0"` // nil means unlimited
+	AdditionalInfo    *string                   `json:"additional_info" validate:"omitempty,max=2000"`
+	CategoryIDs       []int                     `json:"category_ids" validate:"omitempty,dive,gt=0"`
+	Invitations       []CreateInvitationRequest `json:"invitations" validate:"omitempty,max=100,dive"` // Guest list for private events, created with the event
 }
 
 type UpdateEventRequest struct {
-	Name             *string                   `json:"name" validate:"omitempty,min=3,max=200"`
-	Description      *string                   `json:"description" validate:"omitempty,max=2000"`
-	ImageID          *int                      `json:"image_id" validate:"omitempty,gt=0"`
-	VideoID          *int                      `json:"video_id" validate:"omitempty,gt=0"`
-	Type             *domain.EventType         `json:"type" validate:"omitempty,oneof=public private"`
-	LocationType     *domain.EventLocationType `json:"location_type" validate:"omitempty,oneof=location online announcement"`
-	StartDate        *string                   `json:"start_date" validate:"omitempty,datetime=2006-01-02"`
-	StartTime        *string                   `json:"start_time" validate:"omitempty,datetime=15:04"`
-	EndDate          *string                   `json:"end_date" validate:"omitempty,datetime=2006-01-02"`
-	EndTime          *string                   `json:"end_time" validate:"omitempty,datetime=15:04"`
-	AddressID        *int                      `json:"address_id" validate:"omitempty,gt=0"`
-	OnlineEventURL   *string                   `json:"online_event_url" validate:"omitempty,url,max=500"`
-	OnlineEventType  *string                   `json:"online_event_type" validate:"omitempty,max=50"`
-	TicketURL        *string                   `json:"ticket_url" validate:"omitempty,url,max=500"`
-	HasSystemTickets *bool                     `json:"has_system_tickets"`
-	AdditionalInfo   *string                   `json:"additional_info" validate:"omitempty,max=2000"`
-	CategoryIDs      []int                     `json:"category_ids" validate:"omitempty,dive,gt=0"`
+	Name              *string                   `json:"name" validate:"omitempty,min=3,max=200"`
+	Description       *string                   `json:"description" validate:"omitempty,max=2000"`
+	ImageID           *int                      `json:"image_id" validate:"omitempty,gt=0"`
+	VideoID           *int                      `json:"video_id" validate:"omitempty,gt=0"`
+	Type              *domain.EventType         `json:"type" validate:"omitempty,oneof=public private"`
+	LocationType      *domain.EventLocationType `json:"location_type" validate:"omitempty,oneof=location online announcement"`
+	StartDate         *string                   `json:"start_date" validate:"omitempty,datetime=2006-01-02"`
+	StartTime         *string                   `json:"start_time" validate:"omitempty,datetime=15:04"`
+	EndDate           *string                   `json:"end_date" validate:"omitempty,datetime=2006-01-02"`
+	EndTime           *string                   `json:"end_time" validate:"omitempty,datetime=15:04"`
+	Timezone          *string                   `json:"timezone" validate:"omitempty,max=64"`
+	AddressID         *int                      `json:"address_id" validate:"omitempty,gt=0"`
+	OnlineEventURL    *string                   `json:"online_event_url" validate:"omitempty,url,max=500"`
+	OnlineEventType   *string                   `json:"online_event_type" validate:"omitempty,max=50"`
+	TicketURL         *string                   `json:"ticket_url" validate:"omitempty,url,max=500"`
+	HasSystemTickets  *bool                     `json:"has_system_tickets"`
+	AllowJoinRequests *bool                     `json:"allow_join_requests"`
+	HideGoingCount    *bool                     `json:"hide_going_count"`
+	Capacity          *int                      `json:"capacity" validate:"omitempty,gte=0"` // 0 removes the limit
+	AdditionalInfo    *string                   `json:"additional_info" validate:"omitempty,max=2000"`
+	CategoryIDs       []int                     `json:"category_ids" validate:"omitempty,dive,gt=0"`
 }
 
 type UpdateEventStatusRequest struct {
 	Status domain.EventStatus `json:"status" validate:"required,oneof=draft pending rejected stopped cancelled published"`
 }
 
+// Reasons an event is on the creator's needs-attention list
+const (
+	EventAttentionRejected   = "rejected"
+	EventAttentionIncomplete = "incomplete"
+	EventAttentionLowSales   = "low_sales"
+)
+
+// EventAttentionItem is an event the creator should act on. Err is the unmet publish
+// requirement of an incomplete draft and is translated into Detail by the handler.
+type EventAttentionItem struct {
+	Event          *EventListResponse `json:"event"`
+	Reason         string             `json:"reason"`
+	Detail         string             `json:"detail,omitempty"`
+	SoldPercentage *float64           `json:"sold_percentage,omitempty"`
+	Err            error              `json:"-"`
+}
+
+// StatusTransitionErrorDetails tells the client which statuses a rejected change could use instead
+type StatusTransitionErrorDetails struct {
+	CurrentStatus   domain.EventStatus   `json:"current_status"`
+	RequestedStatus domain.EventStatus   `json:"requested_status"`
+	AllowedStatuses []domain.EventStatus `json:"allowed_statuses"`
+}
+
+// BulkUpdateEventStatusRequest moves several of the creator's events to the same status
+type BulkUpdateEventStatusRequest struct {
+	EventIDs []int              `json:"event_ids" validate:"required,min=1,max=100,dive,gt=0"`
+	Status   domain.EventStatus `json:"status" validate:"required,oneof=draft pending rejected stopped cancelled published"`
+}
+
+// Per-event outcomes of a bulk status update
+const (
+	BulkEventStatusResultUpdated           = "updated"
+	BulkEventStatusResultNotFound          = "not_found"
+	BulkEventStatusResultAccessDenied      = "access_denied"
+	BulkEventStatusResultInvalidTransition = "invalid_transition"
+	BulkEventStatusResultNotReady          = "not_ready"
+)
+
+type BulkEventStatusResult struct {
+	EventID        int                `json:"event_id"`
+	Result         string             `json:"result"`
+	PreviousStatus domain.EventStatus `json:"previous_status,omitempty"`
+}
+
+type BulkUpdateEventStatusResponse struct {
+	Updated  int                     `json:"updated"`
+	Rejected int                     `json:"rejected"`
+	Results  []BulkEventStatusResult `json:"results"`
+}
+
+// BulkAddEventCategoryRequest adds one category to several of the creator's events
+type BulkAddEventCategoryRequest struct {
+	EventIDs   []int `json:"event_ids" binding:"required,min=1,max=100,dive,gt=0"`
+	CategoryID int   `json:"category_id" binding:"required,gt=0"`
+}
+
+// Per-event outcomes of a bulk category assignment
+const (
+	BulkEventCategoryResultAdded        = "added"
+	BulkEventCategoryResultSkipped      = "skipped" // already in the category
+	BulkEventCategoryResultNotFound     = "not_found"
+	BulkEventCategoryResultAccessDenied = "access_denied"
+	BulkEventCategoryResultNotEditable  = "not_editable" // its status does not allow edits
+)
+
+type BulkEventCategoryResult struct {
+	EventID int    `json:"event_id"`
+	Result  string `json:"result"`
+}
+
+type BulkAddEventCategoryResponse struct {
+	CategoryID int                       `json:"category_id"`
+	Added      int                       `json:"added"`
+	Skipped    int                       `json:"skipped"`
+	Rejected   int                       `json:"rejected"`
+	Results    []BulkEventCategoryResult `json:"results"`
+}
+
+// PublishAndNotifyResponse is a published private event and how many pending invitees were emailed
+type PublishAndNotifyResponse struct {
+	Event    *EventResponse `json:"event"`
+	Notified int            `json:"notified"`
+	Failed   int            `json:"failed"`
+}
+
 // Event response DTOs
 type EventResponse struct {
-	ID               int                      `json:"id"`
-	CreatorID        int                      `json:"creator_id"`
-	Name             string                   `json:"name"`
-	Description      *string                  `json:"description"`
-	ImageID          *int                     `json:"image_id"`
-	VideoID          *int                     `json:"video_id"`
-	Type             domain.EventType         `json:"type"`
-	LocationType     domain.EventLocationType `json:"location_type"`
-	Status           domain.EventStatus       `json:"status"`
-	StartDate        *string                  `json:"start_date"`
-	StartTime        *string                  `json:"start_time"`
-	EndDate          *string                  `json:"end_date"`
-	EndTime          *string                  `json:"end_time"`
-	AddressID        *int                     `json:"address_id"`
-	OnlineEventURL   *string                  `json:"online_event_url"`
-	OnlineEventType  *string                  `json:"online_event_type"`
-	TicketURL        *string                  `json:"ticket_url"`
-	HasSystemTickets bool                     `json:"has_system_tickets"`
-	AdditionalInfo   *string                  `json:"additional_info"`
-	CreatedAt        time.Time                `json:"created_at"`
-	UpdatedAt        time.Time                `json:"updated_at"`
+	ID                int                      `json:"id"`
+	CreatorID         int                      `json:"creator_id"`
+	Name              string                   `json:"name"`
+	Description       *string                  `json:"description"`
+	ImageID           *int                     `json:"image_id"`
+	VideoID           *int                     `json:"video_id"`
+	Type              domain.EventType         `json:"type"`
+	LocationType      domain.EventLocationType `json:"location_type"`
+	Status            domain.EventStatus       `json:"status"`
+	StartDate         *string                  `json:"start_date"`
+	StartTime         *string                  `json:"start_time"`
+	EndDate           *string                  `json:"end_date"`
+	EndTime           *string                  `json:"end_time"`
+	Timezone          string                   `json:"timezone"`
+	AddressID         *int                     `json:"address_id"`
+	OnlineEventURL    *string                  `json:"online_event_url"`
+	OnlineEventType   *string                  `json:"online_event_type"`
+	TicketURL         *string                  `json:"ticket_url"`
+	HasSystemTickets  bool                     `json:"has_system_tickets"`
+	AllowJoinRequests bool                     `json:"allow_join_requests"`
+	HideGoingCount    bool                     `json:"hide_going_count"`
+	Capacity          *int                     `json:"capacity"`
+	Featured          bool                     `json:"featured"`
+	AdditionalInfo    *string                  `json:"additional_info"`
+	SalesClosed       bool                     `json:"sales_closed"`
+	SalesClosedAt     *time.Time               `json:"sales_closed_at,omitempty"`
+	CloseSalesAtStart bool                     `json:"close_sales_at_start"`
+	CreatedAt         time.Time                `json:"created_at"`
+	UpdatedAt         time.Time                `json:"updated_at"`
 
 	// Relations
-	Creator     *CreatorResponse     `json:"creator,omitempty"`
-	Image       *MediaResponse       `json:"image,omitempty"`
-	Video       *MediaResponse       `json:"video,omitempty"`
-	Address     *AddressResponse     `json:"address,omitempty"`
-	Categories  []CategoryResponse   `json:"categories,omitempty"`
-	Tickets     []TicketResponse     `json:"tickets,omitempty"`
-	Invitations []InvitationResponse `json:"invitations,omitempty"`
+	Creator     *CreatorResponse      `json:"creator,omitempty"`
+	Image       *MediaResponse        `json:"image,omitempty"`
+	Video       *MediaResponse        `json:"video,omitempty"`
+	Address     *AddressResponse      `json:"address,omitempty"`
+	Categories  []CategoryResponse    `json:"categories,omitempty"`
+	Tickets     []TicketResponse      `json:"tickets,omitempty"`
+	Invitations []InvitationResponse  `json:"invitations,omitempty"`
+	CoHosts     []EventCoHostResponse `json:"co_hosts,omitempty"`
 }
 
 type EventListResponse struct {
@@ -97,6 +205,8 @@ type EventListResponse struct {
 	StartTime        *string                  `json:"start_time"`
 	HasSystemTickets bool                     `json:"has_system_tickets"`
 	CreatedAt        time.Time                `json:"created_at"`
+	DeletedAt        *time.Time               `json:"deleted_at,omitempty"`  // only set in trash listings
+	DistanceKm       *float64                 `json:"distance_km,omitempty"` // only set in geo searches
 
 	// Basic relations for list view
 	Creator     *CreatorBasicResponse `json:"creator,omitempty"`
@@ -118,6 +228,7 @@ type AddressResponse struct {
 	PostalCode  *string   `json:"postal_code"`
 	Latitude    float64   `json:"latitude"`
 	Longitude   float64   `json:"longitude"`
+	Timezone    string    `json:"timezone"`
 	DoorNumber  *string   `json:"door_number"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
@@ -157,12 +268,165 @@ type TicketResponse struct {
 	UpdatedAt     time.Time `json:"updated_at"`
 }
 
+// TicketQuantityItem is one cart line: a ticket tier and how many of it the buyer wants
+type TicketQuantityItem struct {
+	TicketID int `json:"ticket_id" validate:"required,gt=0"`
+	Quantity int `json:"quantity" validate:"required,gt=0"`
+}
+
+type CheckTicketAvailabilityRequest struct {
+	Items []TicketQuantityItem `json:"items" validate:"required,min=1,max=50,dive"`
+}
+
+// Per-item outcomes of a bulk availability check
+const (
+	TicketAvailabilityAvailable    = "available"
+	TicketAvailabilityNotFound     = "not_found"
+	TicketAvailabilityInactive     = "inactive"
+	TicketAvailabilityInsufficient = "insufficient"
+	TicketAvailabilitySalesClosed  = "sales_closed"
+)
+
+type TicketAvailabilityItemResponse struct {
+	TicketID          int    `json:"ticket_id"`
+	Quantity          int    `json:"quantity"`
+	AvailableQuantity int    `json:"available_quantity"`
+	IsAvailable       bool   `json:"is_available"`
+	Result            string `json:"result"`
+}
+
+type CheckTicketAvailabilityResponse struct {
+	AllAvailable bool                             `json:"all_available"`
+	Items        []TicketAvailabilityItemResponse `json:"items"`
+}
+
+// ValidateCartRequest is a checkout cart; its lines may belong to different events
+type ValidateCartRequest struct {
+	Items []TicketQuantityItem `json:"items" validate:"required,min=1,max=50,dive"`
+}
+
+// CartValidationItemResponse adds the current price and the parent event's sales state to the
+// availability of one cart line. EventID, Price and Currency are empty for unknown tickets.
+type CartValidationItemResponse struct {
+	TicketAvailabilityItemResponse
+	EventID   int     `json:"event_id,omitempty"`
+	Price     float64 `json:"price"`
+	Currency  string  `json:"currency,omitempty"`
+	SalesOpen bool    `json:"sales_open"`
+}
+
+type ValidateCartResponse struct {
+	Valid bool                         `json:"valid"`
+	Items []CartValidationItemResponse `json:"items"`
+}
+
+// ReserveCartRequest holds every tier of a cart at once. HoldMinutes is optional and capped by
+// the server's cart hold duration.
+type ReserveCartRequest struct {
+	Items       []TicketQuantityItem `json:"items" validate:"required,min=1,max=50,dive"`
+	HoldMinutes int                  `json:"hold_minutes" validate:"omitempty,gt=0"`
+}
+
+type TicketReservationResponse struct {
+	TicketID int `json:"ticket_id"`
+	Quantity int `json:"quantity"`
+}
+
+type CartReservationResponse struct {
+	CartReservationID string                      `json:"cart_reservation_id"`
+	ExpiresAt         time.Time                   `json:"expires_at"`
+	Items             []TicketReservationResponse `json:"items"`
+}
+
+// CartCheckoutResponse is the Stripe checkout session a held cart is paid through
+type CartCheckoutResponse struct {
+	CartReservationID string    `json:"cart_reservation_id"`
+	SessionID         string    `json:"session_id"`
+	CheckoutURL       string    `json:"checkout_url"`
+	ExpiresAt         time.Time `json:"expires_at"`
+}
+
+type UserTicketsRequest struct {
+	Segment string `form:"segment" validate:"omitempty,oneof=upcoming past"`
+}
+
+// UserEventTicketsResponse groups a user's purchases for one event
+type UserEventTicketsResponse struct {
+	Event         UserTicketEventResponse       `json:"event"`
+	IsUpcoming    bool                          `json:"is_upcoming"`
+	TotalQuantity int                           `json:"total_quantity"`
+	Purchases     []*UserTicketPurchaseResponse `json:"purchases"`
+}
+
+type UserTicketEventResponse struct {
+	ID             int                      `json:"id"`
+	Name           string                   `json:"name"`
+	LocationType   domain.EventLocationType `json:"location_type"`
+	StartDate      *time.Time               `json:"start_date"`
+	StartTime      *time.Time               `json:"start_time"`
+	EndDate        *time.Time               `json:"end_date"`
+	EndTime        *time.Time               `json:"end_time"`
+	OnlineEventURL *string                  `json:"online_event_url,omitempty"`
+	Address        *AddressResponse         `json:"address,omitempty"`
+}
+
+type UserTicketPurchaseResponse struct {
+	ID          int       `json:"id"`
+	TicketID    int       `json:"ticket_id"`
+	TicketTitle string    `json:"ticket_title"`
+	Price       float64   `json:"price"`
+	Quantity    int       `json:"quantity"`
+	PurchasedAt time.Time `json:"purchased_at"`
+}
+
 type CreateTicketRequest struct {
 	Title         string  `json:"title" validate:"required,min=3,max=200"`
 	Price         float64 `json:"price" validate:"required,min=0"`
 	TotalQuantity int     `json:"total_quantity" validate:"required,min=1"`
 }
 
+type BulkCreateTicketsRequest struct {
+	Tickets []CreateTicketRequest `json:"tickets" validate:"required,min=1,max=50,dive"`
+}
+
+// BulkCreateTicketsQuery selects best-effort mode, where valid tiers are created even when
+// others in the batch fail
+type BulkCreateTicketsQuery struct {
+	ContinueOnError bool `form:"continue_on_error"`
+}
+
+type BulkTicketResultStatus string
+
+const (
+	BulkTicketResultCreated BulkTicketResultStatus = "created"
+	BulkTicketResultError   BulkTicketResultStatus = "error"
+)
+
+// BulkTicketResult is the outcome for the ticket at Index of a best-effort bulk request.
+// Err is translated into Reason by the handler.
+type BulkTicketResult struct {
+	Index  int                    `json:"index"`
+	Status BulkTicketResultStatus `json:"status"`
+	Ticket *TicketResponse        `json:"ticket,omitempty"`
+	Reason string                 `json:"reason,omitempty"`
+	Err    error                  `json:"-"`
+}
+
+type BulkCreateTicketsResponse struct {
+	Created int                `json:"created"`
+	Failed  int                `json:"failed"`
+	Results []BulkTicketResult `json:"results"`
+}
+
+type BulkUpdateTicketPriceRequest struct {
+	AdjustmentType domain.TicketPriceAdjustmentType `json:"adjustment_type" validate:"required,oneof=percentage fixed"`
+	Value          float64                          `json:"value" validate:"required"` // Signed; -20 with percentage is a 20% discount
+}
+
+type CloseTicketSalesRequest struct {
+	AtEventStart bool `json:"at_event_start"` // Close automatically when the event starts instead of now
+}
+
 type UpdateTicketRequest struct {
 	Title         *string  `json:"title" validate:"omitempty,min=3,max=200"`
 	Price         *float64 `json:"price" validate:"omitempty,min=0"`
@@ -194,25 +458,193 @@ type UpdateInvitationStatusRequest struct {
 	Status domain.InvitationStatus `json:"status" validate:"required,oneof=pending approved rejected"`
 }
 
+// RespondToInvitationRequest is a guest's answer through an RSVP link
+type RespondToInvitationRequest struct {
+	Status domain.InvitationStatus `json:"status" binding:"required,oneof=approved rejected"`
+}
+
 type BulkCreateInvitationRequest struct {
 	Invitations []CreateInvitationRequest `json:"invitations" validate:"required,min=1,max=100,dive"`
 }
 
+// Per-email outcomes of a bulk invitation request
+const (
+	BulkInvitationResultCreated      = "created"
+	BulkInvitationResultLimitReached = "limit_reached"
+)
+
+// BulkInvitationResult is the outcome for the invitation at Index of a bulk request
+type BulkInvitationResult struct {
+	Index        int    `json:"index"`
+	Email        string `json:"email"`
+	Result       string `json:"result"`
+	InvitationID *int   `json:"invitation_id,omitempty"`
+}
+
+// BulkCreateInvitationResponse reports which invitations of a batch were created; those past
+// the event's invitation cap are rejected rather than failing the whole batch
+type BulkCreateInvitationResponse struct {
+	Created     int                    `json:"created"`
+	Rejected    int                    `json:"rejected"`
+	Invitations []*InvitationResponse  `json:"invitations"`
+	Results     []BulkInvitationResult `json:"results"`
+}
+
+// InviteUsersByIDsRequest invites existing platform users without asking for their emails
+type InviteUsersByIDsRequest struct {
+	UserIDs []int `json:"user_ids" validate:"required,min=1,max=100,dive,gt=0"`
+}
+
+// Per-user outcomes of an invite-by-user-ids request. Unknown users, users the creator has no
+// relationship with, users already invited and users past the cap all get skipped so the
+// outcome never reveals which ids exist.
+const (
+	InviteUserResultInvited = "invited"
+	InviteUserResultSkipped = "skipped"
+)
+
+type InviteUserResult struct {
+	UserID       int    `json:"user_id"`
+	Result       string `json:"result"`
+	InvitationID *int   `json:"invitation_id,omitempty"`
+}
+
+type InviteUsersByIDsResponse struct {
+	Invited int                `json:"invited"`
+	Skipped int                `json:"skipped"`
+	Results []InviteUserResult `json:"results"`
+}
+
+// InvitationLookupResponse is what an RSVP landing page needs to render from a link token
+type InvitationLookupResponse struct {
+	Invitation *InvitationResponse `json:"invitation"`
+	Event      *EventListResponse  `json:"event"`
+}
+
+// UpcomingEventsRequest lets discovery clients list undated announcements after upcoming events
+type UpcomingEventsRequest struct {
+	IncludeAnnouncements bool `form:"include_announcements"`
+}
+
+// StartingSoonRequest selects the look-ahead window, in hours, of the starting-soon listing
+// EventShowcaseRequest filters the featured and trending lists
+type EventShowcaseRequest struct {
+	CategoryID *int    `form:"category_id" validate:"omitempty,gt=0"`
+	City       *string `form:"city" validate:"omitempty,max=100"`
+	Limit      int     `form:"limit" validate:"omitempty,min=1,max=50"`
+}
+
+// FeatureEventRequest features an event, or unfeatures it with featured set to false
+type FeatureEventRequest struct {
+	Featured *bool `json:"featured"`
+}
+
+type StartingSoonRequest struct {
+	WithinHours int     `form:"within_hours" validate:"omitempty,min=1,max=168"`
+	City        *string `form:"city" validate:"omitempty,max=100"`
+}
+
 // Filter and search DTOs
 type EventFilterRequest struct {
-	Type         *domain.EventType         `json:"type" validate:"omitempty,oneof=public private"`
-	LocationType *domain.EventLocationType `json:"location_type" validate:"omitempty,oneof=location online announcement"`
-	Status       *domain.EventStatus       `json:"status" validate:"omitempty,oneof=draft pending rejected stopped cancelled published"`
-	CategoryIDs  []int                     `json:"category_ids" validate:"omitempty,dive,gt=0"`
-	City         *string                   `json:"city" validate:"omitempty,max=100"`
-	Country      *string                   `json:"country" validate:"omitempty,max=100"`
-	StartDate    *string                   `json:"start_date" validate:"omitempty,datetime=2006-01-02"`
-	EndDate      *string                   `json:"end_date" validate:"omitempty,datetime=2006-01-02"`
-	MinPrice     *float64                  `json:"min_price" validate:"omitempty,min=0"`
-	MaxPrice     *float64                  `json:"max_price" validate:"omitempty,min=0"`
-	HasTickets   *bool                     `json:"has_tickets"`
-	CreatorID    *int                      `json:"creator_id" validate:"omitempty,gt=0"`
-	Query        *string                   `json:"query" validate:"omitempty,max=200"`
+	Type         *domain.EventType         `json:"type" form:"type" validate:"omitempty,oneof=public private"`
+	LocationType *domain.EventLocationType `json:"location_type" form:"location_type" validate:"omitempty,oneof=location online announcement"`
+	Status       *domain.EventStatus       `json:"status" form:"status" validate:"omitempty,oneof=draft pending rejected stopped cancelled published"`
+	CategoryIDs  []int                     `json:"category_ids" form:"category_ids" validate:"omitempty,dive,gt=0"`
+	// MatchAllCategories requires events to have every category in CategoryIDs instead of any
+	MatchAllCategories *bool    `json:"match_all_categories" form:"match_all_categories"`
+	City               *string  `json:"city" form:"city" validate:"omitempty,max=100"`
+	Country            *string  `json:"country" form:"country" validate:"omitempty,max=100"`
+	StartDate          *string  `json:"start_date" form:"start_date" validate:"omitempty,datetime=2006-01-02"`
+	EndDate            *string  `json:"end_date" form:"end_date" validate:"omitempty,datetime=2006-01-02"`
+	MinPrice           *float64 `json:"min_price" form:"min_price" validate:"omitempty,min=0"`
+	MaxPrice           *float64 `json:"max_price" form:"max_price" validate:"omitempty,min=0"`
+	HasTickets         *bool    `json:"has_tickets" form:"has_tickets"`
+	CreatorID          *int     `json:"creator_id" form:"creator_id" validate:"omitempty,gt=0"`
+	Query              *string  `json:"query" form:"query" validate:"omitempty,max=200"`
+	Latitude           *float64 `json:"latitude" form:"latitude" validate:"omitempty,min=-90,max=90"`
+	Longitude          *float64 `json:"longitude" form:"longitude" validate:"omitempty,min=-180,max=180"`
+	RadiusKm           *float64 `json:"radius_km" form:"radius_km" validate:"omitempty,gt=0,max=500"`
+	SortBy             *string  `json:"sort_by" form:"sort_by" validate:"omitempty,oneof=created_at distance name"`
+	// Locale picks the collation for sort_by=name; set from the request language
+	Locale string `json:"-" form:"-"`
+}
+
+// AdminEventFilterRequest narrows the platform-wide event listing for admins
+type AdminEventFilterRequest struct {
+	Status    domain.EventStatus `json:"status" form:"status" binding:"required,oneof=draft pending rejected stopped cancelled published"`
+	CreatorID *int               `json:"creator_id" form:"creator_id" validate:"omitempty,gt=0"`
+	StartDate *string            `json:"start_date" form:"start_date" validate:"omitempty,datetime=2006-01-02"`
+	EndDate   *string            `json:"end_date" form:"end_date" validate:"omitempty,datetime=2006-01-02"`
+}
+
+// HasCoordinates reports whether both latitude and longitude were given
+func (f EventFilterRequest) HasCoordinates() bool {
+	return f.Latitude != nil && f.Longitude != nil
+}
+
+// HasAddressFilters reports whether any filter reads the event address, so the addresses
+// table has to be joined (once) into the query
+func (f EventFilterRequest) HasAddressFilters() bool {
+	return f.City != nil || f.Country != nil || f.HasCoordinates()
+}
+
+// MatchesAllCategories reports whether the category filter is an AND rather than an OR
+func (f EventFilterRequest) MatchesAllCategories() bool {
+	return f.MatchAllCategories != nil && *f.MatchAllCategories
+}
+
+// SortsByName reports whether results should be ordered alphabetically by name
+func (f EventFilterRequest) SortsByName() bool {
+	return f.SortBy != nil && *f.SortBy == "name"
+}
+
+// SortsByDistance reports whether results should be ordered nearest first
+func (f EventFilterRequest) SortsByDistance() bool {
+	return f.SortBy != nil && *f.SortBy == "distance"
+}
+
+type EventHistoryResponse struct {
+	ID            int                       `json:"id"`
+	EventID       int                       `json:"event_id"`
+	Action        domain.EventHistoryAction `json:"action"`
+	FromStatus    *domain.EventStatus       `json:"from_status,omitempty"`
+	ToStatus      *domain.EventStatus       `json:"to_status,omitempty"`
+	ChangedFields []string                  `json:"changed_fields,omitempty"`
+	Threshold     *int                      `json:"threshold,omitempty"`
+	Changes       json.RawMessage           `json:"changes,omitempty"`
+	Note          *string                   `json:"note,omitempty"`
+	Actor         *UserBasicResponse        `json:"actor,omitempty"`
+	CreatedAt     time.Time                 `json:"created_at"`
+}
+
+type EventCountResponse struct {
+	Count int64 `json:"count"`
+}
+
+// EventNearbyRequest finds published events within a radius of a point, e.g.
+// ?latitude=41.01&longitude=28.97&radius_km=10&sort=date
+type EventNearbyRequest struct {
+	Latitude  *float64 `form:"latitude" binding:"required,min=-90,max=90"`
+	Longitude *float64 `form:"longitude" binding:"required,min=-180,max=180"`
+	RadiusKm  int      `form:"radius_km" binding:"omitempty,gt=0,max=500"`
+	// Sort orders nearest first by default; "date" orders newest first
+	Sort string `form:"sort" binding:"omitempty,oneof=distance date"`
+}
+
+// DefaultNearbyRadiusKm is the search radius when none is given
+const DefaultNearbyRadiusKm = 25
+
+// GetRadiusKmWithDefault returns the requested radius or DefaultNearbyRadiusKm
+func (r EventNearbyRequest) GetRadiusKmWithDefault() int {
+	if r.RadiusKm <= 0 {
+		return DefaultNearbyRadiusKm
+	}
+	return r.RadiusKm
+}
+
+// SortsByDistance reports whether results should be ordered nearest first
+func (r EventNearbyRequest) SortsByDistance() bool {
+	return r.Sort != "date"
 }
 
 type EventSearchRequest struct {
@@ -224,6 +656,28 @@ type EventSearchRequest struct {
 }
 
 // Statistics DTOs
+
+// EventPublicStatsResponse is the social proof shown on a public event page; unlike
+// EventStatsResponse it is about a single event and visible to everyone.
+type EventPublicStatsResponse struct {
+	EventID             int     `json:"event_id"`
+	ViewCount           int64   `json:"view_count"`
+	TicketsSold         int64   `json:"tickets_sold"`
+	GoingCount          *int64  `json:"going_count,omitempty"` // distinct ticket holders; nil when the organizer hides it
+	Capacity            int64   `json:"-"`
+	CapacityUsedPercent float64 `json:"capacity_used_percent"`
+}
+
+// EventShareMetaResponse is the Open Graph preview of a public event link
+type EventShareMetaResponse struct {
+	Title        string  `json:"title"`
+	Description  string  `json:"description"`
+	ImageURL     *string `json:"image_url"`
+	ImageWidth   *int    `json:"image_width,omitempty"`
+	ImageHeight  *int    `json:"image_height,omitempty"`
+	CanonicalURL string  `json:"canonical_url"`
+}
+
 type EventStatsResponse struct {
 	TotalEvents        int64 `json:"total_events"`
 	DraftEvents        int64 `json:"draft_events"`
@@ -287,25 +741,54 @@ func (r *CreateEventRequest) ToEndDateTime() (*time.Time, error) {
 	return &t, nil
 }
 
-func EventToResponse(event *domain.Event) *EventResponse {
+// StartDateTimeUTC combines the start date and time in the event's timezone and returns the
+// instant in UTC; nil when the event has no start time
+func (r *EventResponse) StartDateTimeUTC() *time.Time {
+	if r.StartDate == nil || r.StartTime == nil {
+		return nil
+	}
+	location, err := domain.LoadEventTimezone(r.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+
+	start, err := time.ParseInLocation("2006-01-02 15:04", *r.StartDate+" "+*r.StartTime, location)
+	if err != nil {
+		return nil
+	}
+	start = start.UTC()
+	return &start
+}
+
+// EventToResponse maps an event with its relations. signer signs the image and video links of
+// private events.
+func EventToResponse(event *domain.Event, signer MediaSigner) *EventResponse {
 	response := &EventResponse{
-		ID:               event.ID,
-		CreatorID:        event.CreatorID,
-		Name:             event.Name,
-		Description:      event.Description,
-		ImageID:          event.ImageID,
-		VideoID:          event.VideoID,
-		Type:             event.Type,
-		LocationType:     event.LocationType,
-		Status:           event.Status,
-		AddressID:        event.AddressID,
-		OnlineEventURL:   event.OnlineEventURL,
-		OnlineEventType:  event.OnlineEventType,
-		TicketURL:        event.TicketURL,
-		HasSystemTickets: event.HasSystemTickets,
-		AdditionalInfo:   event.AdditionalInfo,
-		CreatedAt:        event.CreatedAt,
-		UpdatedAt:        event.UpdatedAt,
+		ID:                event.ID,
+		CreatorID:         event.CreatorID,
+		Name:              event.Name,
+		Description:       event.Description,
+		ImageID:           event.ImageID,
+		VideoID:           event.VideoID,
+		Type:              event.Type,
+		LocationType:      event.LocationType,
+		Status:            event.Status,
+		Timezone:          event.Timezone,
+		AddressID:         event.AddressID,
+		OnlineEventURL:    event.OnlineEventURL,
+		OnlineEventType:   event.OnlineEventType,
+		TicketURL:         event.TicketURL,
+		HasSystemTickets:  event.HasSystemTickets,
+		AllowJoinRequests: event.AllowJoinRequests,
+		HideGoingCount:    event.HideGoingCount,
+		Capacity:          event.Capacity,
+		Featured:          event.Featured,
+		AdditionalInfo:    event.AdditionalInfo,
+		SalesClosed:       event.AreSalesClosed(),
+		SalesClosedAt:     event.SalesClosedAt,
+		CloseSalesAtStart: event.CloseSalesAtStart,
+		CreatedAt:         event.CreatedAt,
+		UpdatedAt:         event.UpdatedAt,
 	}
 
 	// Format dates
@@ -332,11 +815,11 @@ func EventToResponse(event *domain.Event) *EventResponse {
 	}
 
 	if event.Image != nil {
-		response.Image = MediaToResponse(event.Image)
+		response.Image = eventMediaToResponse(event, event.Image, signer)
 	}
 
 	if event.Video != nil {
-		response.Video = MediaToResponse(event.Video)
+		response.Video = eventMediaToResponse(event, event.Video, signer)
 	}
 
 	if event.Address != nil {
@@ -357,6 +840,13 @@ func EventToResponse(event *domain.Event) *EventResponse {
 		}
 	}
 
+	// Add accepted co-hosts
+	for i := range event.CoHosts {
+		if event.CoHosts[i].IsAccepted() {
+			response.CoHosts = append(response.CoHosts, *EventCoHostToResponse(&event.CoHosts[i]))
+		}
+	}
+
 	// Add tickets
 	if len(event.Tickets) > 0 {
 		response.Tickets = make([]TicketResponse, len(event.Tickets))
@@ -406,6 +896,12 @@ type InvitationFilterRequest struct {
 	IsExpired     *bool                    `json:"is_expired"`
 	HasResponded  *bool                    `json:"has_responded"`
 	Query         *string                  `json:"query" validate:"omitempty,max=200"`
+
+	// Date windows are inclusive; either end may be left open
+	InvitedFrom   *time.Time `json:"invited_from"`
+	InvitedTo     *time.Time `json:"invited_to"`
+	RespondedFrom *time.Time `json:"responded_from"`
+	RespondedTo   *time.Time `json:"responded_to"`
 }
 
 // Statistics DTOs
@@ -419,6 +915,15 @@ type TicketSalesStatsResponse struct {
 	SoldPercentage   float64 `json:"sold_percentage"`
 }
 
+// TicketAvailabilitySummaryResponse is the "X of Y tickets left" figure across all active tiers
+type TicketAvailabilitySummaryResponse struct {
+	EventID        int  `json:"event_id"`
+	TotalCapacity  int  `json:"total_capacity"`
+	TotalSold      int  `json:"total_sold"`
+	TotalAvailable int  `json:"total_available"`
+	IsSoldOut      bool `json:"is_sold_out"`
+}
+
 type TicketTypeStatsResponse struct {
 	TicketID          int     `json:"ticket_id"`
 	Title             string  `json:"title"`
@@ -476,6 +981,10 @@ func EventToListResponse(event *domain.Event) *EventListResponse {
 		HasSystemTickets: event.HasSystemTickets,
 		CreatedAt:        event.CreatedAt,
 		TicketCount:      len(event.Tickets),
+		DistanceKm:       event.DistanceKm,
+	}
+	if event.DeletedAt.Valid {
+		response.DeletedAt = &event.DeletedAt.Time
 	}
 
 	// Format dates
@@ -491,6 +1000,37 @@ func EventToListResponse(event *domain.Event) *EventListResponse {
 	return response
 }
 
+// shareDescriptionMaxRunes keeps preview descriptions within what link unfurlers display
+const shareDescriptionMaxRunes = 200
+
+var sharePlainText = sanitizer.New(sanitizer.ModePlainText)
+
+// EventToShareMeta builds the link preview of an event. The description is reduced to plain
+// text on one line and truncated; the event image, when loaded, is the preview image.
+func EventToShareMeta(event *domain.Event, baseURL string) *EventShareMetaResponse {
+	meta := &EventShareMetaResponse{
+		Title:        event.Name,
+		CanonicalURL: strings.TrimRight(baseURL, "/") + "/events/" + strconv.Itoa(event.ID),
+	}
+
+	if event.Description != nil {
+		description := strings.Join(strings.Fields(sharePlainText.Sanitize(*event.Description)), " ")
+		if runes := []rune(description); len(runes) > shareDescriptionMaxRunes {
+			description = strings.TrimSpace(string(runes[:shareDescriptionMaxRunes-1])) + "…"
+		}
+		meta.Description = description
+	}
+
+	if event.Image != nil && event.Image.FileURL != "" {
+		imageURL := event.Image.FileURL
+		meta.ImageURL = &imageURL
+		meta.ImageWidth = event.Image.Width
+		meta.ImageHeight = event.Image.Height
+	}
+
+	return meta
+}
+
 // Location and Address related DTOs
 type LocationRequest struct {
 	Latitude  float64 `json:"latitude" validate:"required,min=-90,max=90"`
@@ -537,6 +1077,7 @@ func CreatorToResponse(creator *domain.Creator) *CreatorResponse {
 		Address:          creator.Address,
 		EstimatedTickets: creator.EstimatedTickets,
 		EstimatedEvents:  creator.EstimatedEvents,
+		Timezone:         creator.Timezone,
 		CreatedAt:        creator.CreatedAt,
 		UpdatedAt:        creator.UpdatedAt,
 	}
@@ -579,6 +1120,80 @@ func MediaToResponse(media *domain.Media) *MediaResponse {
 	}
 }
 
+// MediaSigner issues the expiring links that stand in for the file URL of private event media
+type MediaSigner interface {
+	PrivateMediaURL(media *domain.Media) string
+}
+
+// PrivateMediaToResponse is MediaToResponse with the file URL replaced by a signed, expiring
+// link, so the file cannot be shared beyond the people who can see the event. Without a
+// signer the media is returned without a URL.
+func PrivateMediaToResponse(media *domain.Media, signer MediaSigner) *MediaResponse {
+	response := MediaToResponse(media)
+	if response == nil {
+		return nil
+	}
+
+	response.FileURL = ""
+	if signer != nil {
+		response.FileURL = signer.PrivateMediaURL(media)
+	}
+	return response
+}
+
+func eventMediaToResponse(event *domain.Event, media *domain.Media, signer MediaSigner) *MediaResponse {
+	if event.IsPrivate() {
+		return PrivateMediaToResponse(media, signer)
+	}
+	return MediaToResponse(media)
+}
+
+// UserTicketsToResponse groups purchases by event, keeping the order in which events first appear
+func UserTicketsToResponse(purchases []*domain.TicketPurchase) []*UserEventTicketsResponse {
+	groups := make([]*UserEventTicketsResponse, 0)
+	byEvent := make(map[int]*UserEventTicketsResponse)
+
+	for _, purchase := range purchases {
+		if purchase.Ticket == nil {
+			continue
+		}
+		event := &purchase.Ticket.Event
+
+		group, ok := byEvent[event.ID]
+		if !ok {
+			group = &UserEventTicketsResponse{
+				Event: UserTicketEventResponse{
+					ID:             event.ID,
+					Name:           event.Name,
+					LocationType:   event.LocationType,
+					StartDate:      event.StartDate,
+					StartTime:      event.StartTime,
+					EndDate:        event.EndDate,
+					EndTime:        event.EndTime,
+					OnlineEventURL: event.OnlineEventURL,
+					Address:        AddressToResponse(event.Address),
+				},
+				IsUpcoming: !event.IsPast(),
+				Purchases:  make([]*UserTicketPurchaseResponse, 0),
+			}
+			byEvent[event.ID] = group
+			groups = append(groups, group)
+		}
+
+		group.TotalQuantity += purchase.Quantity
+		group.Purchases = append(group.Purchases, &UserTicketPurchaseResponse{
+			ID:          purchase.ID,
+			TicketID:    purchase.TicketID,
+			TicketTitle: purchase.Ticket.Title,
+			Price:       purchase.Ticket.Price,
+			Quantity:    purchase.Quantity,
+			PurchasedAt: purchase.PurchasedAt,
+		})
+	}
+
+	return groups
+}
+
 func AddressToResponse(address *domain.Address) *AddressResponse {
 	if address == nil {
 		return nil
@@ -595,6 +1210,7 @@ func AddressToResponse(address *domain.Address) *AddressResponse {
 		PostalCode:  address.PostalCode,
 		Latitude:    address.Latitude,
 		Longitude:   address.Longitude,
+		Timezone:    address.Timezone,
 		DoorNumber:  address.DoorNumber,
 		CreatedAt:   address.CreatedAt,
 		UpdatedAt:   address.UpdatedAt,
@@ -639,3 +1255,28 @@ func InvitationToResponse(invitation *domain.Invitation) InvitationResponse {
 
 	return response
 }
+
+func EventHistoryToResponse(history *domain.EventHistory) *EventHistoryResponse {
+	response := &EventHistoryResponse{
+		ID:            history.ID,
+		EventID:       history.EventID,
+		Action:        history.Action,
+		FromStatus:    history.FromStatus,
+		ToStatus:      history.ToStatus,
+		ChangedFields: history.Fields(),
+		Threshold:     history.Threshold,
+		Changes:       history.Changes,
+		Note:          history.Note,
+		CreatedAt:     history.CreatedAt,
+	}
+
+	if history.Actor != nil {
+		response.Actor = &UserBasicResponse{
+			ID:       history.Actor.ID,
+			FullName: history.Actor.FullName,
+			Username: history.Actor.Username,
+		}
+	}
+
+	return response
+}
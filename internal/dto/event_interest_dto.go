@@ -0,0 +1,16 @@
+package dto
+
+import (
+	"time"
+)
+
+// Event Interest DTOs
+type EventInterestResponse struct {
+	EventID   int       `json:"event_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type EventInterestCountResponse struct {
+	EventID int   `json:"event_id"`
+	Count   int64 `json:"count"`
+}
@@ -0,0 +1,50 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/louco-event/internal/domain"
+)
+
+// Event Join Request DTOs
+type CreateEventJoinRequestRequest struct {
+	Message *string `json:"message" validate:"omitempty,max=1000"`
+}
+
+type EventJoinRequestFilterRequest struct {
+	Status *domain.EventJoinRequestStatus `form:"status" validate:"omitempty,oneof=pending approved rejected"`
+}
+
+type EventJoinRequestResponse struct {
+	ID           int                           `json:"id"`
+	EventID      int                           `json:"event_id"`
+	Email        string                        `json:"email"`
+	Message      *string                       `json:"message,omitempty"`
+	Status       domain.EventJoinRequestStatus `json:"status"`
+	User         *UserBasicResponse            `json:"user,omitempty"`
+	InvitationID *int                          `json:"invitation_id,omitempty"`
+	RespondedAt  *time.Time                    `json:"responded_at,omitempty"`
+	CreatedAt    time.Time                     `json:"created_at"`
+}
+
+func EventJoinRequestToResponse(request *domain.EventJoinRequest) *EventJoinRequestResponse {
+	response := &EventJoinRequestResponse{
+		ID:          request.ID,
+		EventID:     request.EventID,
+		Email:       request.Email,
+		Message:     request.Message,
+		Status:      request.Status,
+		RespondedAt: request.RespondedAt,
+		CreatedAt:   request.CreatedAt,
+	}
+
+	if request.User != nil {
+		response.User = &UserBasicResponse{
+			ID:       request.User.ID,
+			FullName: request.User.FullName,
+			Username: request.User.Username,
+		}
+	}
+
+	return response
+}
@@ -0,0 +1,46 @@
+package dto
+
+import (
+	"math"
+	"time"
+
+	"github.com/louco-event/internal/domain"
+)
+
+// Event Rating DTOs
+type RateEventRequest struct {
+	Stars   int     `json:"stars" validate:"required,min=1,max=5"`
+	Comment *string `json:"comment" validate:"omitempty,max=1000"`
+}
+
+type EventRatingResponse struct {
+	EventID   int       `json:"event_id"`
+	Stars     int       `json:"stars"`
+	Comment   *string   `json:"comment,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// RatingSummaryResponse is the public average of a set of ratings
+type RatingSummaryResponse struct {
+	Average float64 `json:"average"`
+	Count   int64   `json:"count"`
+}
+
+func EventRatingToResponse(rating *domain.EventRating) *EventRatingResponse {
+	return &EventRatingResponse{
+		EventID:   rating.EventID,
+		Stars:     rating.Stars,
+		Comment:   rating.Comment,
+		CreatedAt: rating.CreatedAt,
+		UpdatedAt: rating.UpdatedAt,
+	}
+}
+
+// NewRatingSummaryResponse rounds the average to two decimals
+func NewRatingSummaryResponse(average float64, count int64) *RatingSummaryResponse {
+	return &RatingSummaryResponse{
+		Average: math.Round(average*100) / 100,
+		Count:   count,
+	}
+}
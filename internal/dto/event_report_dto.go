@@ -0,0 +1,63 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/louco-event/internal/domain"
+)
+
+// Event Report DTOs
+type ReportEventRequest struct {
+	Reason  domain.EventReportReason `json:"reason" validate:"required,oneof=spam inappropriate fraud misleading other"`
+	Details *string                  `json:"details" validate:"omitempty,max=2000"`
+}
+
+type ResolveEventReportRequest struct {
+	Status domain.EventReportStatus `json:"status" validate:"required,oneof=resolved dismissed"`
+	Note   *string                  `json:"note" validate:"omitempty,max=2000"`
+}
+
+type EventReportFilterRequest struct {
+	Status *domain.EventReportStatus `form:"status" validate:"omitempty,oneof=pending resolved dismissed"`
+}
+
+type EventReportResponse struct {
+	ID               int                      `json:"id"`
+	EventID          int                      `json:"event_id"`
+	EventName        string                   `json:"event_name,omitempty"`
+	Reason           domain.EventReportReason `json:"reason"`
+	Details          *string                  `json:"details,omitempty"`
+	Status           domain.EventReportStatus `json:"status"`
+	Reporter         *UserBasicResponse       `json:"reporter,omitempty"`
+	ResolvedByUserID *int                     `json:"resolved_by_user_id,omitempty"`
+	ResolutionNote   *string                  `json:"resolution_note,omitempty"`
+	ResolvedAt       *time.Time               `json:"resolved_at,omitempty"`
+	CreatedAt        time.Time                `json:"created_at"`
+}
+
+func EventReportToResponse(report *domain.EventReport) *EventReportResponse {
+	response := &EventReportResponse{
+		ID:               report.ID,
+		EventID:          report.EventID,
+		Reason:           report.Reason,
+		Details:          report.Details,
+		Status:           report.Status,
+		ResolvedByUserID: report.ResolvedByUserID,
+		ResolutionNote:   report.ResolutionNote,
+		ResolvedAt:       report.ResolvedAt,
+		CreatedAt:        report.CreatedAt,
+	}
+
+	if report.Event != nil {
+		response.EventName = report.Event.Name
+	}
+	if report.Reporter != nil {
+		response.Reporter = &UserBasicResponse{
+			ID:       report.Reporter.ID,
+			FullName: report.Reporter.FullName,
+			Username: report.Reporter.Username,
+		}
+	}
+
+	return response
+}
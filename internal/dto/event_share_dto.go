@@ -0,0 +1,106 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/louco-event/internal/domain"
+)
+
+// Event Share DTOs
+type EventShareTokenResponse struct {
+	EventID   int        `json:"event_id"`
+	Token     *string    `json:"token"`
+	Enabled   bool       `json:"enabled"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+type EventShareTokenStatsResponse struct {
+	EventShareTokenResponse
+	TotalAccesses  int64      `json:"total_accesses"`
+	UniqueVisitors int64      `json:"unique_visitors"`
+	LastAccessedAt *time.Time `json:"last_accessed_at,omitempty"`
+}
+
+func EventToShareTokenResponse(event *domain.Event) *EventShareTokenResponse {
+	return &EventShareTokenResponse{
+		EventID:   event.ID,
+		Token:     event.ShareToken,
+		Enabled:   event.ShareTokenEnabled,
+		CreatedAt: event.ShareTokenCreatedAt,
+	}
+}
+
+// SharedEventResponse is the event as an anonymous share link visitor sees it. It leaves out
+// the guest list and the creator's account details.
+type SharedEventResponse struct {
+	ID                int                      `json:"id"`
+	CreatorID         int                      `json:"creator_id"`
+	Name              string                   `json:"name"`
+	Description       *string                  `json:"description"`
+	Type              domain.EventType         `json:"type"`
+	LocationType      domain.EventLocationType `json:"location_type"`
+	Status            domain.EventStatus       `json:"status"`
+	StartDate         *string                  `json:"start_date"`
+	StartTime         *string                  `json:"start_time"`
+	EndDate           *string                  `json:"end_date"`
+	EndTime           *string                  `json:"end_time"`
+	Timezone          string                   `json:"timezone"`
+	OnlineEventURL    *string                  `json:"online_event_url"`
+	OnlineEventType   *string                  `json:"online_event_type"`
+	TicketURL         *string                  `json:"ticket_url"`
+	HasSystemTickets  bool                     `json:"has_system_tickets"`
+	AllowJoinRequests bool                     `json:"allow_join_requests"`
+	Capacity          *int                     `json:"capacity"`
+	AdditionalInfo    *string                  `json:"additional_info"`
+	SalesClosed       bool                     `json:"sales_closed"`
+
+	// Relations
+	Creator    *CreatorBasicResponse `json:"creator,omitempty"`
+	Image      *MediaResponse        `json:"image,omitempty"`
+	Video      *MediaResponse        `json:"video,omitempty"`
+	Address    *AddressResponse      `json:"address,omitempty"`
+	Categories []CategoryResponse    `json:"categories,omitempty"`
+	Tickets    []TicketResponse      `json:"tickets,omitempty"`
+	CoHosts    []EventCoHostResponse `json:"co_hosts,omitempty"`
+}
+
+// EventToSharedResponse maps an event with its relations for a share link visitor
+func EventToSharedResponse(event *domain.Event, signer MediaSigner) *SharedEventResponse {
+	full := EventToResponse(event, signer)
+	response := &SharedEventResponse{
+		ID:                full.ID,
+		CreatorID:         full.CreatorID,
+		Name:              full.Name,
+		Description:       full.Description,
+		Type:              full.Type,
+		LocationType:      full.LocationType,
+		Status:            full.Status,
+		StartDate:         full.StartDate,
+		StartTime:         full.StartTime,
+		EndDate:           full.EndDate,
+		EndTime:           full.EndTime,
+		Timezone:          full.Timezone,
+		OnlineEventURL:    full.OnlineEventURL,
+		OnlineEventType:   full.OnlineEventType,
+		TicketURL:         full.TicketURL,
+		HasSystemTickets:  full.HasSystemTickets,
+		AllowJoinRequests: full.AllowJoinRequests,
+		Capacity:          full.Capacity,
+		AdditionalInfo:    full.AdditionalInfo,
+		SalesClosed:       full.SalesClosed,
+		Image:             full.Image,
+		Video:             full.Video,
+		Address:           full.Address,
+		Categories:        full.Categories,
+		Tickets:           full.Tickets,
+		CoHosts:           full.CoHosts,
+	}
+	if event.Creator.ID != 0 {
+		response.Creator = &CreatorBasicResponse{
+			ID:          event.Creator.ID,
+			UserID:      event.Creator.UserID,
+			CompanyName: event.Creator.CompanyName,
+		}
+	}
+	return response
+}
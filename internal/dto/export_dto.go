@@ -0,0 +1,40 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/louco-event/internal/domain"
+)
+
+// Export DTOs
+type CreateExportRequest struct {
+	Type    domain.ExportJobType `json:"type" validate:"required,oneof=invitations attendees"`
+	EventID int                  `json:"event_id" validate:"required,min=1"`
+}
+
+type ExportJobResponse struct {
+	ID          int                    `json:"id"`
+	Type        domain.ExportJobType   `json:"type"`
+	Status      domain.ExportJobStatus `json:"status"`
+	EventID     int                    `json:"event_id,omitempty"`
+	DownloadURL *string                `json:"download_url,omitempty"`
+	Error       *string                `json:"error,omitempty"`
+	CreatedAt   time.Time              `json:"created_at"`
+	CompletedAt *time.Time             `json:"completed_at,omitempty"`
+}
+
+// ExportJobToResponse converts a job; the download URL is filled in by the service once the job is done
+func ExportJobToResponse(job *domain.ExportJob) *ExportJobResponse {
+	response := &ExportJobResponse{
+		ID:          job.ID,
+		Type:        job.Type,
+		Status:      job.Status,
+		Error:       job.Error,
+		CreatedAt:   job.CreatedAt,
+		CompletedAt: job.CompletedAt,
+	}
+	if params, err := job.DecodeParams(); err == nil {
+		response.EventID = params.EventID
+	}
+	return response
+}
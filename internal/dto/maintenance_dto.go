@@ -0,0 +1,25 @@
+package dto
+
+import (
+	"time"
+)
+
+// Maintenance DTOs
+type PurgeRequest struct {
+	DryRun bool `form:"dry_run"`
+}
+
+// PurgeTargetResult reports one table of a purge run. In a dry run Deleted is the number of
+// rows that would be deleted.
+type PurgeTargetResult struct {
+	Target    string    `json:"target"`
+	Retention string    `json:"retention"`
+	Before    time.Time `json:"before"`
+	Deleted   int64     `json:"deleted"`
+}
+
+type PurgeResultResponse struct {
+	DryRun  bool                `json:"dry_run"`
+	Total   int64               `json:"total"`
+	Targets []PurgeTargetResult `json:"targets"`
+}
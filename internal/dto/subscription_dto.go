@@ -20,6 +20,7 @@ type SubscriptionPlanResponse struct {
 	MonthlyLimit *int                    `json:"monthly_limit,omitempty"`
 	TotalCredits *int                    `json:"total_credits,omitempty"`
 	DurationDays *int                    `json:"duration_days,omitempty"`
+	TrialDays    int                     `json:"trial_days"`
 	IsActive     bool                    `json:"is_active"`
 	SortOrder    int                     `json:"sort_order"`
 	Features     []string                `json:"features,omitempty"`
@@ -35,23 +36,31 @@ type PlansResponse struct {
 
 // User Subscription DTOs
 type UserSubscriptionResponse struct {
-	ID           int                       `json:"id"`
-	UserID       int                       `json:"user_id"`
-	Type         domain.SubscriptionType   `json:"type"`
-	Name         domain.SubscriptionName   `json:"name"`
-	Price        float64                   `json:"price"`
-	Currency     string                    `json:"currency"`
-	WeeklyLimit  *int                      `json:"weekly_limit,omitempty"`
-	MonthlyLimit *int                      `json:"monthly_limit,omitempty"`
-	TotalCredits *int                      `json:"total_credits,omitempty"`
-	UsedCredits  int                       `json:"used_credits"`
-	WeeklyUsed   int                       `json:"weekly_used"`
-	MonthlyUsed  int                       `json:"monthly_used"`
-	Status       domain.SubscriptionStatus `json:"status"`
-	StartedAt    *time.Time                `json:"started_at,omitempty"`
-	ExpiredAt    *time.Time                `json:"expired_at,omitempty"`
-	CreatedAt    time.Time                 `json:"created_at"`
-	UpdatedAt    time.Time                 `json:"updated_at"`
+	ID                 int                       `json:"id"`
+	UserID             int                       `json:"user_id"`
+	Type               domain.SubscriptionType   `json:"type"`
+	Name               domain.SubscriptionName   `json:"name"`
+	Price              float64                   `json:"price"`
+	Currency           string                    `json:"currency"`
+	WeeklyLimit        *int                      `json:"weekly_limit,omitempty"`
+	MonthlyLimit       *int                      `json:"monthly_limit,omitempty"`
+	TotalCredits       *int                      `json:"total_credits,omitempty"`
+	UsedCredits        int                       `json:"used_credits"`
+	WeeklyUsed         int                       `json:"weekly_used"`
+	MonthlyUsed        int                       `json:"monthly_used"`
+	Status             domain.SubscriptionStatus `json:"status"`
+	StartedAt          *time.Time                `json:"started_at,omitempty"`
+	ExpiredAt          *time.Time                `json:"expired_at,omitempty"`
+	PeriodStart        *time.Time                `json:"period_start,omitempty"`
+	PeriodEnd          *time.Time                `json:"period_end,omitempty"`
+	TrialEndsAt        *time.Time                `json:"trial_ends_at,omitempty"`
+	IsTrialing         bool                      `json:"is_trialing"`
+	CancelAtPeriodEnd  bool                      `json:"cancel_at_period_end"`
+	CanceledButActive  bool                      `json:"canceled_but_active"`
+	CancellationReason *string                   `json:"cancellation_reason,omitempty"`
+	CancelledAt        *time.Time                `json:"cancelled_at,omitempty"`
+	CreatedAt          time.Time                 `json:"created_at"`
+	UpdatedAt          time.Time                 `json:"updated_at"`
 }
 
 type PublishingRightsResponse struct {
@@ -68,6 +77,8 @@ type PublishingRightsResponse struct {
 	ActiveSubscription *UserSubscriptionResponse   `json:"active_subscription,omitempty"`
 	ActivePackages     []*UserSubscriptionResponse `json:"active_packages,omitempty"`
 	RestrictionReason  string                      `json:"restriction_reason,omitempty"`
+	PeriodStart        *time.Time                  `json:"period_start,omitempty"`
+	PeriodEnd          *time.Time                  `json:"period_end,omitempty"`
 }
 
 type SubscriptionStatsResponse struct {
@@ -105,19 +116,49 @@ type PurchaseResponse struct {
 
 // Subscription Management DTOs
 type CancelSubscriptionRequest struct {
-	Reason string `json:"reason,omitempty"`
+	Reason            string `json:"reason,omitempty" binding:"max=500"`
+	CancelAtPeriodEnd bool   `json:"cancel_at_period_end,omitempty"`
+}
+
+type CancellationStatsResponse struct {
+	TotalCancellations int64                            `json:"total_cancellations"`
+	Reasons            []*domain.CancellationReasonStat `json:"reasons"`
 }
 
 type UpdateSubscriptionRequest struct {
 	PlanID uint `json:"plan_id" validate:"required"`
 }
 
+type PlanChangePreviewResponse struct {
+	SubscriptionID   int                     `json:"subscription_id,omitempty"`
+	CurrentPlan      domain.SubscriptionName `json:"current_plan,omitempty"`
+	NewPlanID        int                     `json:"new_plan_id"`
+	NewPlan          domain.SubscriptionName `json:"new_plan"`
+	IsNewPurchase    bool                    `json:"is_new_purchase"`
+	ImmediateCharge  float64                 `json:"immediate_charge"`
+	NextInvoiceTotal float64                 `json:"next_invoice_total"`
+	Currency         string                  `json:"currency"`
+	NextBillingDate  *time.Time              `json:"next_billing_date,omitempty"`
+}
+
 // Webhook DTOs
 type StripeWebhookRequest struct {
+	ID   string          `json:"id"`
 	Type string          `json:"type"`
 	Data json.RawMessage `json:"data"`
 }
 
+// SubscriptionReconcileRequest controls an admin reconciliation run against Stripe
+type SubscriptionReconcileRequest struct {
+	DryRun bool `form:"dry_run"`
+}
+
+// FailedWebhookListRequest filters the dead-lettered webhooks admins review
+type FailedWebhookListRequest struct {
+	PaginationRequest
+	IncludeResolved bool `form:"include_resolved"`
+}
+
 type StripeEventData struct {
 	Object json.RawMessage `json:"object"`
 }
@@ -148,6 +189,7 @@ func ToSubscriptionPlanResponse(plan *domain.SubscriptionPlan) *SubscriptionPlan
 		MonthlyLimit: plan.MonthlyLimit,
 		TotalCredits: plan.TotalCredits,
 		DurationDays: plan.DurationDays,
+		TrialDays:    plan.TrialDays,
 		IsActive:     plan.IsActive,
 		SortOrder:    plan.SortOrder,
 		CreatedAt:    plan.CreatedAt,
@@ -176,23 +218,31 @@ func ToSubscriptionPlanResponse(plan *domain.SubscriptionPlan) *SubscriptionPlan
 
 func ToUserSubscriptionResponse(subscription *domain.UserSubscription) *UserSubscriptionResponse {
 	return &UserSubscriptionResponse{
-		ID:           subscription.ID,
-		UserID:       subscription.UserID,
-		Type:         subscription.Type,
-		Name:         subscription.Name,
-		Price:        subscription.Price,
-		Currency:     subscription.Currency,
-		WeeklyLimit:  subscription.WeeklyLimit,
-		MonthlyLimit: subscription.MonthlyLimit,
-		TotalCredits: subscription.TotalCredits,
-		UsedCredits:  subscription.UsedCredits,
-		WeeklyUsed:   subscription.WeeklyUsed,
-		MonthlyUsed:  subscription.MonthlyUsed,
-		Status:       subscription.Status,
-		StartedAt:    subscription.StartedAt,
-		ExpiredAt:    subscription.ExpiredAt,
-		CreatedAt:    subscription.CreatedAt,
-		UpdatedAt:    subscription.UpdatedAt,
+		ID:                 subscription.ID,
+		UserID:             subscription.UserID,
+		Type:               subscription.Type,
+		Name:               subscription.Name,
+		Price:              subscription.Price,
+		Currency:           subscription.Currency,
+		WeeklyLimit:        subscription.WeeklyLimit,
+		MonthlyLimit:       subscription.MonthlyLimit,
+		TotalCredits:       subscription.TotalCredits,
+		UsedCredits:        subscription.UsedCredits,
+		WeeklyUsed:         subscription.PeriodWeeklyUsed(),
+		MonthlyUsed:        subscription.PeriodMonthlyUsed(),
+		Status:             subscription.Status,
+		StartedAt:          subscription.StartedAt,
+		ExpiredAt:          subscription.ExpiredAt,
+		PeriodStart:        subscription.PeriodStart,
+		PeriodEnd:          subscription.PeriodEnd,
+		TrialEndsAt:        subscription.TrialEndsAt,
+		IsTrialing:         subscription.IsTrialing(),
+		CancelAtPeriodEnd:  subscription.CancelAtPeriodEnd,
+		CanceledButActive:  subscription.IsCanceledButActive(),
+		CancellationReason: subscription.CancellationReason,
+		CancelledAt:        subscription.CancelledAt,
+		CreatedAt:          subscription.CreatedAt,
+		UpdatedAt:          subscription.UpdatedAt,
 	}
 }
 
@@ -209,6 +259,8 @@ func ToPublishingRightsResponse(rights *domain.PublishingRights) *PublishingRigh
 		UsedCredits:       rights.UsedCredits,
 		RemainingCredits:  rights.RemainingCredits,
 		RestrictionReason: rights.RestrictionReason,
+		PeriodStart:       rights.PeriodStart,
+		PeriodEnd:         rights.PeriodEnd,
 	}
 
 	if rights.ActiveSubscription != nil {
@@ -240,6 +292,20 @@ func ToSubscriptionStatsResponse(stats *domain.SubscriptionStats) *SubscriptionS
 	}
 }
 
+func ToPlanChangePreviewResponse(preview *domain.PlanChangePreview) *PlanChangePreviewResponse {
+	return &PlanChangePreviewResponse{
+		SubscriptionID:   preview.SubscriptionID,
+		CurrentPlan:      preview.CurrentPlan,
+		NewPlanID:        preview.NewPlanID,
+		NewPlan:          preview.NewPlan,
+		IsNewPurchase:    preview.IsNewPurchase,
+		ImmediateCharge:  preview.ImmediateCharge,
+		NextInvoiceTotal: preview.NextInvoiceTotal,
+		Currency:         preview.Currency,
+		NextBillingDate:  preview.NextBillingDate,
+	}
+}
+
 func ToSubscriptionPlanResponses(plans []*domain.SubscriptionPlan) []*SubscriptionPlanResponse {
 	responses := make([]*SubscriptionPlanResponse, len(plans))
 	for i, plan := range plans {
@@ -19,6 +19,7 @@ type UserResponse struct {
 	FollowingCount  int            `json:"following_count"`
 	EmailVerifiedAt *time.Time     `json:"email_verified_at"`
 	PhoneVerifiedAt *time.Time     `json:"phone_verified_at"`
+	Language        string         `json:"language"`
 	IsActive        bool           `json:"is_active"`
 	CreatedAt       time.Time      `json:"created_at"`
 	UpdatedAt       time.Time      `json:"updated_at"`
@@ -28,6 +29,8 @@ type UpdateProfileRequest struct {
 	FullName  string     `json:"full_name" validate:"omitempty,min=2,max=100"`
 	Biography *string    `json:"biography" validate:"omitempty,max=500"`
 	BirthDate *time.Time `json:"birth_date" validate:"omitempty"`
+	// Language of the emails the user receives
+	Language *string `json:"language" validate:"omitempty,oneof=en tr"`
 }
 
 type UpdateContactRequest struct {
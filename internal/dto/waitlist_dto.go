@@ -0,0 +1,29 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/louco-event/internal/domain"
+)
+
+// Waitlist DTOs
+type WaitlistResponse struct {
+	EventID int                   `json:"event_id"`
+	Status  domain.WaitlistStatus `json:"status"`
+	// Place in the queue starting at 1; 0 once promoted
+	Position   int        `json:"position"`
+	Waiting    int64      `json:"waiting"`
+	PromotedAt *time.Time `json:"promoted_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+func WaitlistToResponse(entry *domain.Waitlist, waiting int64) *WaitlistResponse {
+	return &WaitlistResponse{
+		EventID:    entry.EventID,
+		Status:     entry.Status,
+		Position:   entry.Position,
+		Waiting:    waiting,
+		PromotedAt: entry.PromotedAt,
+		CreatedAt:  entry.CreatedAt,
+	}
+}
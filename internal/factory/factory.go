@@ -2,57 +2,100 @@ package factory
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/louco-event/internal/config"
 	"github.com/louco-event/internal/i18n"
 	"github.com/louco-event/internal/repository"
 	"github.com/louco-event/internal/repository/postgres"
+	"github.com/louco-event/internal/scheduler"
 	"github.com/louco-event/internal/service"
 	"github.com/louco-event/pkg/cache"
 	"github.com/louco-event/pkg/database"
 	"github.com/louco-event/pkg/email"
 	"github.com/louco-event/pkg/logger"
+	"github.com/louco-event/pkg/pagination"
+	"github.com/louco-event/pkg/storage"
 	"github.com/louco-event/pkg/stripe"
+	"github.com/louco-event/pkg/timezone"
 	"github.com/louco-event/pkg/twilio"
+	"github.com/louco-event/pkg/urlsign"
 )
 
 type Dependencies struct {
+	// Configuration
+	Config *config.Config
+
 	// Database
 	DB *database.Database
 
 	// Repositories
-	UserRepo             repository.UserRepository
-	MediaRepo            repository.MediaRepository
-	IndustryRepo         repository.IndustryRepository
-	CreatorRepo          repository.CreatorRepository
-	CategoryRepo         repository.CategoryRepository
-	VerificationRepo     repository.VerificationRepository
-	FollowRepo           repository.FollowRepository
-	EventRepo            repository.EventRepository
-	AddressRepo          repository.AddressRepository
-	TicketRepo           repository.TicketRepository
-	InvitationRepo       repository.InvitationRepository
-	UserSubscriptionRepo repository.UserSubscriptionRepository
-	SubscriptionPlanRepo repository.SubscriptionPlanRepository
+	UserRepo              repository.UserRepository
+	MediaRepo             repository.MediaRepository
+	IndustryRepo          repository.IndustryRepository
+	CreatorRepo           repository.CreatorRepository
+	CategoryRepo          repository.CategoryRepository
+	VerificationRepo      repository.VerificationRepository
+	FollowRepo            repository.FollowRepository
+	EventRepo             repository.EventRepository
+	AddressRepo           repository.AddressRepository
+	TicketRepo            repository.TicketRepository
+	TicketPurchaseRepo    repository.TicketPurchaseRepository
+	TicketReservationRepo repository.TicketReservationRepository
+	PendingCheckoutRepo   repository.PendingCheckoutRepository
+	InvitationRepo        repository.InvitationRepository
+	EventHistoryRepo      repository.EventHistoryRepository
+	EventReportRepo       repository.EventReportRepository
+	ShareTokenAccessRepo  repository.ShareTokenAccessRepository
+	ExportJobRepo         repository.ExportJobRepository
+	MaintenanceRepo       repository.MaintenanceRepository
+	FailedWebhookRepo     repository.FailedWebhookRepository
+	UserActivityRepo      repository.UserActivityRepository
+	EventJoinRequestRepo  repository.EventJoinRequestRepository
+	EventCoHostRepo       repository.EventCoHostRepository
+	EventInterestRepo     repository.EventInterestRepository
+	EventRatingRepo       repository.EventRatingRepository
+	WaitlistRepo          repository.WaitlistRepository
+	UserSubscriptionRepo  repository.UserSubscriptionRepository
+	SubscriptionPlanRepo  repository.SubscriptionPlanRepository
 
 	// Services
-	UserService         service.UserService
-	MediaService        service.MediaService
-	JWTService          service.JWTService
-	IndustryService     service.IndustryService
-	CreatorService      service.CreatorService
-	CategoryService     service.CategoryService
-	VerificationService service.VerificationService
-	FollowService       *service.FollowService
-	EventService        service.EventService
-	AddressService      service.AddressService
-	TicketService       service.TicketService
-	InvitationService   service.InvitationService
-	SubscriptionService service.SubscriptionService
+	UserService             service.UserService
+	MediaService            service.MediaService
+	JWTService              service.JWTService
+	IndustryService         service.IndustryService
+	CreatorService          service.CreatorService
+	CategoryService         service.CategoryService
+	VerificationService     service.VerificationService
+	FollowService           *service.FollowService
+	EventService            service.EventService
+	AddressService          service.AddressService
+	TicketService           service.TicketService
+	CheckoutService         service.CheckoutService
+	InvitationService       service.InvitationService
+	EventReportService      service.EventReportService
+	EventShareService       service.EventShareService
+	ExportService           service.ExportService
+	MaintenanceService      service.MaintenanceService
+	ActivityService         service.ActivityService
+	EventJoinRequestService service.EventJoinRequestService
+	EventCoHostService      service.EventCoHostService
+	EventInterestService    service.EventInterestService
+	EventRatingService      service.EventRatingService
+	WaitlistService         service.WaitlistService
+	ClientConfigService     service.ClientConfigService
+	SubscriptionService     service.SubscriptionService
+	FailedWebhookService    service.FailedWebhookService
 
 	// External Services
 	StripeService *stripe.StripeService
 
+	// Background jobs
+	Scheduler *scheduler.Scheduler
+
+	// Pagination
+	CursorCodec *pagination.CursorCodec
+
 	// I18n
 	I18n *i18n.I18n
 
@@ -61,21 +104,16 @@ type Dependencies struct {
 }
 
 func NewDependencies(cfg *config.Config, logger *logger.Logger) (*Dependencies, error) {
-	// Initialize database
-	db, err := database.New(cfg, logger)
+	// Initialize database and run migrations
+	db, err := ConnectDatabase(cfg, logger)
 	if err != nil {
-		return nil, fmt.Errorf("failed to initialize database: %w", err)
+		return nil, err
 	}
 
 	// Initialize Redis cache
 	redisAddr := cfg.Redis.Host + ":" + cfg.Redis.Port
 	redisCache := cache.NewRedisCache(redisAddr, cfg.Redis.Password, cfg.Redis.DB)
 
-	// Run auto migration
-	if err := db.AutoMigrate(); err != nil {
-		return nil, fmt.Errorf("failed to run auto migration: %w", err)
-	}
-
 	// Initialize i18n
 	i18nService, err := i18n.New("internal/i18n/locales", "en")
 	if err != nil {
@@ -93,15 +131,32 @@ func NewDependencies(cfg *config.Config, logger *logger.Logger) (*Dependencies,
 	eventRepo := postgres.NewEventRepository(db.DB)
 	addressRepo := postgres.NewAddressRepository(db.DB)
 	ticketRepo := postgres.NewTicketRepository(db.DB)
+	ticketPurchaseRepo := postgres.NewTicketPurchaseRepository(db.DB)
+	ticketReservationRepo := postgres.NewTicketReservationRepository(db.DB)
+	pendingCheckoutRepo := postgres.NewPendingCheckoutRepository(db.DB)
 	invitationRepo := postgres.NewInvitationRepository(db.DB)
+	eventHistoryRepo := postgres.NewEventHistoryRepository(db.DB)
+	eventViewRepo := postgres.NewEventViewRepository(db.DB)
+	eventReportRepo := postgres.NewEventReportRepository(db.DB)
+	shareTokenAccessRepo := postgres.NewShareTokenAccessRepository(db.DB)
+	exportJobRepo := postgres.NewExportJobRepository(db.DB)
+	maintenanceRepo := postgres.NewMaintenanceRepository(db.DB)
+	failedWebhookRepo := postgres.NewFailedWebhookRepository(db.DB)
+	userActivityRepo := postgres.NewUserActivityRepository(db.DB)
+	eventJoinRequestRepo := postgres.NewEventJoinRequestRepository(db.DB)
+	eventCoHostRepo := postgres.NewEventCoHostRepository(db.DB)
+	eventInterestRepo := postgres.NewEventInterestRepository(db.DB)
+	eventRatingRepo := postgres.NewEventRatingRepository(db.DB)
+	waitlistRepo := postgres.NewWaitlistRepository(db.DB)
 	userSubscriptionRepo := postgres.NewUserSubscriptionRepository(db.DB, logger)
 	subscriptionPlanRepo := postgres.NewSubscriptionPlanRepository(db.DB, logger)
+	subscriptionHistoryRepo := postgres.NewSubscriptionHistoryRepository(db.DB)
 
 	// Initialize services
 	jwtService := service.NewJWTService(cfg.JWT.Secret, cfg.JWT.Expiration)
 	industryService := service.NewIndustryService(industryRepo, *logger.Logger)
 	categoryService := service.NewCategoryService(categoryRepo, mediaRepo, redisCache, logger)
-	creatorService := service.NewCreatorService(creatorRepo, userRepo, industryRepo, mediaRepo, logger)
+	creatorService := service.NewCreatorService(creatorRepo, userRepo, industryRepo, mediaRepo, eventRatingRepo, redisCache, logger)
 
 	// Initialize email service
 	emailService := email.NewEmailService(email.EmailConfig{
@@ -143,20 +198,11 @@ func NewDependencies(cfg *config.Config, logger *logger.Logger) (*Dependencies,
 		Bucket:               cfg.AWS.Bucket,
 		UsePathStyleEndpoint: cfg.AWS.UsePathStyleEndpoint,
 	}
-	mediaService := service.NewMediaService(mediaRepo, awsConfig, logger)
+	mediaService := service.NewMediaService(mediaRepo, awsConfig, urlsign.NewSigner(cfg.Media.SigningSecret), cfg.Media.SignedURLBase, cfg.Media.SignedURLTTL, logger)
 
 	// Initialize follow service
 	followService := service.NewFollowService(followRepo, userRepo)
 
-	// Initialize subscription service first (needed by event service)
-	subscriptionService := service.NewSubscriptionService(userSubscriptionRepo, subscriptionPlanRepo, logger)
-
-	// Initialize event-related services
-	addressService := service.NewAddressService(addressRepo, *logger.Logger)
-	ticketService := service.NewTicketService(ticketRepo, eventRepo, *logger.Logger)
-	invitationService := service.NewInvitationService(invitationRepo, eventRepo, userRepo, *logger.Logger)
-	eventService := service.NewEventService(eventRepo, addressRepo, ticketRepo, invitationRepo, categoryRepo, creatorRepo, mediaRepo, subscriptionService, logger)
-
 	// Initialize Stripe service
 	stripeConfig := stripe.StripeConfig{
 		SecretKey:      cfg.Stripe.SecretKey,
@@ -170,40 +216,173 @@ func NewDependencies(cfg *config.Config, logger *logger.Logger) (*Dependencies,
 	}
 	stripeService := stripe.NewStripeService(stripeConfig, logger)
 
+	// Initialize subscription service first (needed by event service)
+	subscriptionService := service.NewSubscriptionService(userSubscriptionRepo, subscriptionPlanRepo, subscriptionHistoryRepo, userRepo, stripeService, emailService, i18nService, logger)
+
+	// Initialize event-related services
+	timezoneResolver := timezone.NewResolver(timezone.ResolverConfig{
+		GoogleAPIKey: cfg.Maps.GoogleAPIKey,
+		Timeout:      cfg.Maps.TimezoneTimeout,
+	})
+	addressService := service.NewAddressService(addressRepo, timezoneResolver, *logger.Logger)
+	cursorCodec := pagination.NewCursorCodec(cfg.Pagination.CursorSecret)
+	eventService := service.NewEventService(eventRepo, eventHistoryRepo, eventViewRepo, addressRepo, ticketRepo, invitationRepo, categoryRepo, creatorRepo, mediaRepo, mediaService, subscriptionService, emailService, i18nService, redisCache, cursorCodec, cfg.Event, logger)
+	waitlistService := service.NewWaitlistService(waitlistRepo, eventRepo, userRepo, eventService, emailService, i18nService, *logger.Logger)
+	ticketService := service.NewTicketService(ticketRepo, ticketPurchaseRepo, ticketReservationRepo, eventRepo, eventHistoryRepo, waitlistService, cfg.Event.CapacityWarningThresholds, cfg.Event.CartHoldDuration, cfg.Event.MinTicketPrice(cfg.Stripe.Currency), cfg.Stripe.Currency, *logger.Logger)
+	checkoutService := service.NewCheckoutService(pendingCheckoutRepo, ticketReservationRepo, ticketRepo, userRepo, ticketService, stripeService, *logger.Logger)
+	invitationService := service.NewInvitationService(invitationRepo, eventRepo, userRepo, userSubscriptionRepo, waitlistService, cfg.Event.MaxInvitationsPerEvent, cfg.Event.InvitationLinkTTL, cfg.Event.InvitationLateResponseGrace, *logger.Logger)
+	eventReportService := service.NewEventReportService(eventReportRepo, eventRepo, eventHistoryRepo, cfg.Event.ReportUnpublishThreshold, *logger.Logger)
+	eventShareService := service.NewEventShareService(eventService, eventRepo, shareTokenAccessRepo, mediaService, *logger.Logger)
+	eventJoinRequestService := service.NewEventJoinRequestService(eventJoinRequestRepo, eventRepo, userRepo, invitationRepo, eventService, invitationService, *logger.Logger)
+	eventCoHostService := service.NewEventCoHostService(eventCoHostRepo, eventRepo, creatorRepo, eventService, *logger.Logger)
+	eventInterestService := service.NewEventInterestService(eventInterestRepo, eventRepo, eventHistoryRepo, userRepo, eventService, cfg.Event.InterestMilestones, *logger.Logger)
+	eventRatingService := service.NewEventRatingService(eventRatingRepo, eventRepo, ticketPurchaseRepo, invitationRepo, eventService, *logger.Logger)
+
+	// Exports are written to the media bucket as private objects
+	exportStorage := storage.NewS3Storage(storage.S3Config{
+		Endpoint:             cfg.AWS.Endpoint,
+		AccessKeyID:          cfg.AWS.AccessKeyID,
+		SecretAccessKey:      cfg.AWS.SecretAccessKey,
+		Region:               cfg.AWS.DefaultRegion,
+		Bucket:               cfg.AWS.Bucket,
+		UsePathStyleEndpoint: cfg.AWS.UsePathStyleEndpoint,
+	})
+	exportService := service.NewExportService(exportJobRepo, invitationRepo, ticketPurchaseRepo, eventService, exportStorage, cfg.Export.BatchSize, cfg.Export.DownloadURLTTL, cfg.Export.ProcessingTimeout, *logger.Logger)
+	maintenanceService := service.NewMaintenanceService(maintenanceRepo, eventRepo, cfg.Retention, *logger.Logger)
+	failedWebhookService := service.NewFailedWebhookService(failedWebhookRepo, *logger.Logger)
+	eventNotificationService := service.NewEventNotificationService(eventRepo, eventHistoryRepo, addressRepo, emailService, i18nService, *logger.Logger)
+
+	// Initialize background jobs
+	jobScheduler := scheduler.New(*logger.Logger)
+	jobScheduler.Register("subscription_expiry", cfg.Scheduler.SubscriptionExpiryInterval, subscriptionService.ProcessExpiredSubscriptions)
+	jobScheduler.Register("export_worker", cfg.Scheduler.ExportInterval, exportService.ProcessQueuedExports)
+	jobScheduler.Register("retention_purge", cfg.Scheduler.PurgeInterval, maintenanceService.RunScheduledPurge)
+	jobScheduler.Register("event_details_changed", cfg.Scheduler.NotificationInterval, eventNotificationService.ProcessDetailsChanged)
+
+	clientConfigService := service.NewClientConfigService(cfg, i18nService.GetSupportedLanguages())
+	activityService := service.NewActivityService(userActivityRepo, eventRepo, userRepo, cursorCodec, *logger.Logger)
+
 	return &Dependencies{
-		DB:                   db,
-		UserRepo:             userRepo,
-		MediaRepo:            mediaRepo,
-		IndustryRepo:         industryRepo,
-		CreatorRepo:          creatorRepo,
-		CategoryRepo:         categoryRepo,
-		VerificationRepo:     verificationRepo,
-		FollowRepo:           followRepo,
-		EventRepo:            eventRepo,
-		AddressRepo:          addressRepo,
-		TicketRepo:           ticketRepo,
-		InvitationRepo:       invitationRepo,
-		UserSubscriptionRepo: userSubscriptionRepo,
-		SubscriptionPlanRepo: subscriptionPlanRepo,
-		UserService:          userService,
-		MediaService:         mediaService,
-		JWTService:           jwtService,
-		IndustryService:      industryService,
-		CreatorService:       creatorService,
-		CategoryService:      categoryService,
-		VerificationService:  verificationService,
-		FollowService:        followService,
-		EventService:         eventService,
-		AddressService:       addressService,
-		TicketService:        ticketService,
-		InvitationService:    invitationService,
-		SubscriptionService:  subscriptionService,
-		StripeService:        stripeService,
-		I18n:                 i18nService,
-		Logger:               logger,
+		Config:                  cfg,
+		DB:                      db,
+		UserRepo:                userRepo,
+		MediaRepo:               mediaRepo,
+		IndustryRepo:            industryRepo,
+		CreatorRepo:             creatorRepo,
+		CategoryRepo:            categoryRepo,
+		VerificationRepo:        verificationRepo,
+		FollowRepo:              followRepo,
+		EventRepo:               eventRepo,
+		AddressRepo:             addressRepo,
+		TicketRepo:              ticketRepo,
+		TicketPurchaseRepo:      ticketPurchaseRepo,
+		TicketReservationRepo:   ticketReservationRepo,
+		PendingCheckoutRepo:     pendingCheckoutRepo,
+		InvitationRepo:          invitationRepo,
+		EventHistoryRepo:        eventHistoryRepo,
+		EventReportRepo:         eventReportRepo,
+		ShareTokenAccessRepo:    shareTokenAccessRepo,
+		ExportJobRepo:           exportJobRepo,
+		MaintenanceRepo:         maintenanceRepo,
+		FailedWebhookRepo:       failedWebhookRepo,
+		UserActivityRepo:        userActivityRepo,
+		EventJoinRequestRepo:    eventJoinRequestRepo,
+		EventCoHostRepo:         eventCoHostRepo,
+		EventInterestRepo:       eventInterestRepo,
+		EventRatingRepo:         eventRatingRepo,
+		WaitlistRepo:            waitlistRepo,
+		UserSubscriptionRepo:    userSubscriptionRepo,
+		SubscriptionPlanRepo:    subscriptionPlanRepo,
+		UserService:             userService,
+		MediaService:            mediaService,
+		JWTService:              jwtService,
+		IndustryService:         industryService,
+		CreatorService:          creatorService,
+		CategoryService:         categoryService,
+		VerificationService:     verificationService,
+		FollowService:           followService,
+		EventService:            eventService,
+		AddressService:          addressService,
+		TicketService:           ticketService,
+		CheckoutService:         checkoutService,
+		InvitationService:       invitationService,
+		EventReportService:      eventReportService,
+		EventShareService:       eventShareService,
+		ExportService:           exportService,
+		MaintenanceService:      maintenanceService,
+		ActivityService:         activityService,
+		EventJoinRequestService: eventJoinRequestService,
+		EventCoHostService:      eventCoHostService,
+		EventInterestService:    eventInterestService,
+		EventRatingService:      eventRatingService,
+		WaitlistService:         waitlistService,
+		ClientConfigService:     clientConfigService,
+		SubscriptionService:     subscriptionService,
+		FailedWebhookService:    failedWebhookService,
+		StripeService:           stripeService,
+		Scheduler:               jobScheduler,
+		CursorCodec:             cursorCodec,
+		I18n:                    i18nService,
+		Logger:                  logger,
 	}, nil
 }
 
+// maxDatabaseRetryInterval caps the backoff between connection attempts
+const maxDatabaseRetryInterval = 30 * time.Second
+
+// databaseConnector opens and pings one database connection
+type databaseConnector func(cfg *config.Config, logger *logger.Logger) (*database.Database, error)
+
+// ConnectDatabase connects, retrying with exponential backoff so the app can start before the
+// database is ready, and then migrates. Migration failures are not transient, so they are never
+// retried.
+func ConnectDatabase(cfg *config.Config, logger *logger.Logger) (*database.Database, error) {
+	db, err := connectWithRetry(cfg, logger, database.New, time.Sleep)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.Migrate(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to run migrations: %w", err)
+	}
+	return db, nil
+}
+
+// connectWithRetry calls connect until it succeeds or cfg.Database.ConnectRetries retries are
+// used up, sleeping between attempts
+func connectWithRetry(cfg *config.Config, logger *logger.Logger, connect databaseConnector, sleep func(time.Duration)) (*database.Database, error) {
+	attempts := cfg.Database.ConnectRetries + 1
+	interval := cfg.Database.RetryInterval
+
+	var lastErr error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		db, err := connect(cfg, logger)
+		if err == nil {
+			return db, nil
+		}
+		lastErr = fmt.Errorf("failed to initialize database: %w", err)
+
+		if attempt == attempts {
+			break
+		}
+
+		logger.Warn().Err(lastErr).
+			Int("attempt", attempt).
+			Int("max_attempts", attempts).
+			Dur("retry_in", interval).
+			Msg("Database not ready, retrying")
+
+		sleep(interval)
+		interval *= 2
+		if interval > maxDatabaseRetryInterval {
+			interval = maxDatabaseRetryInterval
+		}
+	}
+
+	return nil, lastErr
+}
+
 func (d *Dependencies) Close() error {
 	if d.DB != nil {
 		return d.DB.Close()
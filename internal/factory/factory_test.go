@@ -0,0 +1,51 @@
+package factory
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/louco-event/internal/config"
+	"github.com/louco-event/pkg/database"
+	"github.com/louco-event/pkg/logger"
+	"github.com/rs/zerolog"
+)
+
+func TestConnectWithRetry(t *testing.T) {
+	nop := zerolog.Nop()
+	log := &logger.Logger{Logger: &nop}
+	cfg := &config.Config{Database: config.DatabaseConfig{ConnectRetries: 3, RetryInterval: time.Second}}
+
+	// The database comes up on the third attempt
+	attempts := 0
+	var sleeps []time.Duration
+	connect := func(cfg *config.Config, logger *logger.Logger) (*database.Database, error) {
+		attempts++
+		if attempts < 3 {
+			return nil, errors.New("connection refused")
+		}
+		return &database.Database{}, nil
+	}
+	db, err := connectWithRetry(cfg, log, connect, func(d time.Duration) { sleeps = append(sleeps, d) })
+	if err != nil || db == nil {
+		t.Fatalf("connectWithRetry = %v, %v; want a database", db, err)
+	}
+	if attempts != 3 || len(sleeps) != 2 || sleeps[0] != time.Second || sleeps[1] != 2*time.Second {
+		t.Errorf("attempts %d, sleeps %v; want 3 attempts with a doubling backoff", attempts, sleeps)
+	}
+
+	// Retries run out
+	attempts = 0
+	sleeps = nil
+	refused := errors.New("connection refused")
+	_, err = connectWithRetry(cfg, log, func(cfg *config.Config, logger *logger.Logger) (*database.Database, error) {
+		attempts++
+		return nil, refused
+	}, func(d time.Duration) { sleeps = append(sleeps, d) })
+	if !errors.Is(err, refused) {
+		t.Errorf("err = %v, want the last connection error", err)
+	}
+	if attempts != 4 || len(sleeps) != 3 {
+		t.Errorf("attempts %d, sleeps %d; want 4 attempts and 3 sleeps", attempts, len(sleeps))
+	}
+}
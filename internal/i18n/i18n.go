@@ -1,6 +1,7 @@
 package i18n
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -88,6 +89,30 @@ func (i *I18n) Translate(lang, key string) string {
 	return key
 }
 
+// Format translates key and fills its {name} placeholders from params
+func (i *I18n) Format(lang, key string, params map[string]string) string {
+	message := i.Translate(lang, key)
+	for name, value := range params {
+		message = strings.ReplaceAll(message, "{"+name+"}", value)
+	}
+	return message
+}
+
+type languageContextKey struct{}
+
+// WithLanguage returns a context carrying the request language, so services can localize
+// messages they send on behalf of the request
+func WithLanguage(ctx context.Context, lang string) context.Context {
+	return context.WithValue(ctx, languageContextKey{}, lang)
+}
+
+// LanguageFromContext returns the language stored by WithLanguage, or "" when there is none;
+// Translate then uses the fallback language
+func LanguageFromContext(ctx context.Context) string {
+	lang, _ := ctx.Value(languageContextKey{}).(string)
+	return lang
+}
+
 func (i *I18n) GetSupportedLanguages() []string {
 	var languages []string
 	for lang := range i.translations {
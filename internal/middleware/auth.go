@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/louco-event/internal/domain"
 	"github.com/louco-event/internal/dto"
 	"github.com/louco-event/internal/service"
 )
@@ -42,7 +43,9 @@ func JWTAuth(jwtService service.JWTService) gin.HandlerFunc {
 		c.Set("user_email", claims.Email)
 		c.Set("user_username", claims.Username)
 		c.Set("user_type", claims.UserType)
+		c.Set("user_role", claims.Role)
 		c.Set("jwt_claims", claims)
+		c.Request = c.Request.WithContext(domain.ContextWithUserRole(c.Request.Context(), domain.UserRole(claims.Role)))
 
 		c.Next()
 	}
@@ -61,7 +64,9 @@ func OptionalJWTAuth(jwtService service.JWTService) gin.HandlerFunc {
 					c.Set("user_email", claims.Email)
 					c.Set("user_username", claims.Username)
 					c.Set("user_type", claims.UserType)
+					c.Set("user_role", claims.Role)
 					c.Set("jwt_claims", claims)
+					c.Request = c.Request.WithContext(domain.ContextWithUserRole(c.Request.Context(), domain.UserRole(claims.Role)))
 				}
 			}
 		}
@@ -91,6 +96,28 @@ func RequireUserType(userType string) gin.HandlerFunc {
 	}
 }
 
+// RequireRole middleware that checks if user has a specific platform role
+func RequireRole(role string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		currentRole, exists := c.Get("user_role")
+		if !exists {
+			response := dto.NewErrorResponse("common.unauthorized", nil)
+			c.JSON(http.StatusUnauthorized, response)
+			c.Abort()
+			return
+		}
+
+		if currentRole != role {
+			response := dto.NewErrorResponse("common.forbidden", nil)
+			c.JSON(http.StatusForbidden, response)
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
 // GetCurrentUserID extracts current user ID from context
 func GetCurrentUserID(c *gin.Context) (int, bool) {
 	if userID, exists := c.Get("user_id"); exists {
@@ -110,3 +137,9 @@ func GetCurrentUserType(c *gin.Context) (string, bool) {
 	}
 	return "", false
 }
+
+// IsAdmin reports whether the current user has the admin role
+func IsAdmin(c *gin.Context) bool {
+	role, exists := c.Get("user_role")
+	return exists && role == string(domain.UserRoleAdmin)
+}
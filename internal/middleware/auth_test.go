@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/service"
+)
+
+func newAdminTestEngine(jwtService service.JWTService) *gin.Engine {
+	engine := gin.New()
+	admin := engine.Group("/admin")
+	admin.Use(JWTAuth(jwtService))
+	admin.Use(RequireRole("admin"))
+	admin.GET("/events", func(c *gin.Context) { c.Status(http.StatusOK) })
+	return engine
+}
+
+func TestRequireRoleAdmin(t *testing.T) {
+	jwtService := service.NewJWTService("test-secret", time.Hour)
+	engine := newAdminTestEngine(jwtService)
+
+	tests := []struct {
+		name   string
+		claims *dto.JWTClaims
+		want   int
+	}{
+		{name: "no token", want: http.StatusUnauthorized},
+		{name: "normal user", claims: &dto.JWTClaims{UserID: 1, UserType: "user", Role: "member"}, want: http.StatusForbidden},
+		{name: "creator", claims: &dto.JWTClaims{UserID: 2, UserType: "creator", Role: "member"}, want: http.StatusForbidden},
+		{name: "token without role", claims: &dto.JWTClaims{UserID: 3, UserType: "creator"}, want: http.StatusForbidden},
+		{name: "admin", claims: &dto.JWTClaims{UserID: 4, UserType: "user", Role: "admin"}, want: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/admin/events", nil)
+			if tt.claims != nil {
+				token, err := jwtService.GenerateToken(tt.claims)
+				if err != nil {
+					t.Fatalf("GenerateToken: %v", err)
+				}
+				req.Header.Set("Authorization", "Bearer "+token)
+			}
+			recorder := httptest.NewRecorder()
+			engine.ServeHTTP(recorder, req)
+			if recorder.Code != tt.want {
+				t.Fatalf("got %d, want %d", recorder.Code, tt.want)
+			}
+		})
+	}
+}
@@ -1,7 +1,10 @@
 package middleware
 
 import (
+	"errors"
+
 	"github.com/gin-gonic/gin"
+	"github.com/louco-event/internal/domain"
 	"github.com/louco-event/internal/i18n"
 )
 
@@ -19,6 +22,7 @@ func I18n(i18nService *i18n.I18n) gin.HandlerFunc {
 		// Set language in context
 		c.Set("language", lang)
 		c.Set("i18n", i18nService)
+		c.Request = c.Request.WithContext(i18n.WithLanguage(c.Request.Context(), lang))
 
 		c.Next()
 	}
@@ -44,3 +48,14 @@ func Translate(c *gin.Context, key string) string {
 	}
 	return key
 }
+
+// TranslateError returns the translated message for a service error. Domain errors carrying an
+// i18n key are shown to the user and reported as known; anything else is hidden behind
+// fallbackKey so internal details never leak into responses.
+func TranslateError(c *gin.Context, err error, fallbackKey string) (string, bool) {
+	var domainErr *domain.DomainError
+	if errors.As(err, &domainErr) && domainErr.Key != "" {
+		return Translate(c, domainErr.Key), true
+	}
+	return Translate(c, fallbackKey), false
+}
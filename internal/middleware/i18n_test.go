@@ -0,0 +1,88 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/i18n"
+)
+
+func TestTranslateErrorLocalizesDomainErrors(t *testing.T) {
+	translator, err := i18n.New("../i18n/locales", "en")
+	if err != nil {
+		t.Fatalf("failed to load translations: %v", err)
+	}
+
+	domainErrs := []*domain.DomainError{
+		domain.ErrEventNotFound,
+		domain.ErrEventUnauthorized,
+		domain.ErrCreatorProfileNotFound,
+		domain.ErrInvitationUnauthorized,
+		domain.ErrTicketNotFound,
+		domain.ErrTicketQuantityBelowSold,
+		domain.ErrTicketCannotBeDeleted,
+		domain.ErrTicketRefundExceedsSold,
+		domain.ErrInvitationNotFound,
+		domain.ErrEventAddressNotFound,
+		domain.ErrEventImageNotFound,
+		domain.ErrEventVideoNotFound,
+		domain.ErrEventTypeNotAllowed,
+		domain.ErrEventInvitationsPrivateOnly,
+		domain.ErrEventInvitationsLimitExceeded,
+		domain.ErrEventInvitationInvalidEmail,
+		domain.ErrEventInvitationDuplicateEmail,
+		domain.ErrEventPublishingRights,
+		domain.ErrEventInvalidStatus,
+		domain.ErrEventReportInvalidReason,
+		domain.ErrEventReportDuplicate,
+		domain.ErrEventReportNotFound,
+		domain.ErrEventReportAlreadyClosed,
+		domain.ErrEventJoinRequestNotFound,
+		domain.ErrUserEventInvalidRelation,
+	}
+	for _, domainErr := range domainErrs {
+		messages := make(map[string]string)
+		for _, lang := range []string{"en", "tr"} {
+			var message string
+			var isDomainErr bool
+			engine := gin.New()
+			engine.Use(I18n(translator))
+			engine.GET("/", func(c *gin.Context) {
+				// Services wrap the errors they pass along
+				message, isDomainErr = TranslateError(c, fmt.Errorf("failed to load event: %w", domainErr), "common.internal_server_error")
+			})
+			request := httptest.NewRequest(http.MethodGet, "/", nil)
+			request.Header.Set("Accept-Language", lang)
+			engine.ServeHTTP(httptest.NewRecorder(), request)
+
+			if !isDomainErr {
+				t.Errorf("%s in %s: not reported as a domain error", domainErr.Key, lang)
+			}
+			if message == domainErr.Key || message == "" {
+				t.Errorf("%s has no %s translation", domainErr.Key, lang)
+			}
+			messages[lang] = message
+		}
+		if messages["en"] == messages["tr"] {
+			t.Errorf("%s reads %q in both en and tr", domainErr.Key, messages["en"])
+		}
+	}
+
+	// Anything that is not a domain error hides behind the fallback
+	engine := gin.New()
+	engine.Use(I18n(translator))
+	var message string
+	var isDomainErr bool
+	engine.GET("/", func(c *gin.Context) {
+		message, isDomainErr = TranslateError(c, errors.New("pq: connection refused"), "event.not_found")
+	})
+	engine.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	if isDomainErr || message != translator.Translate("en", "event.not_found") {
+		t.Errorf("plain error translated to %q (domain %v), want the fallback", message, isDomainErr)
+	}
+}
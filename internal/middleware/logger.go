@@ -13,6 +13,7 @@ func Logger(logger *logger.Logger) gin.HandlerFunc {
 		// Generate request ID
 		requestID := uuid.New().String()
 		c.Set("request_id", requestID)
+		c.Header("X-Request-ID", requestID)
 
 		// Start timer
 		start := time.Now()
@@ -1,7 +1,9 @@
 package middleware
 
 import (
+	"math"
 	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
@@ -79,3 +81,41 @@ func RateLimit(cfg config.RateLimitConfig) gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// SearchRateLimit is a stricter per-IP bucket for the public search endpoints. Unlike the
+// global limiter it tells the client how long to back off via Retry-After.
+func SearchRateLimit(cfg config.RateLimitConfig) gin.HandlerFunc {
+	return limitWithRetryAfter(newRateLimiter(cfg.SearchRequestsPerMinute, cfg.SearchBurstSize))
+}
+
+// WebhookRateLimit is a per-IP bucket for inbound webhooks, so a flood of bogus payloads is
+// turned away before each one costs a signature verification. Its default is generous
+// enough that a burst of genuine deliveries and retries from the provider is not throttled.
+func WebhookRateLimit(cfg config.RateLimitConfig) gin.HandlerFunc {
+	return limitWithRetryAfter(newRateLimiter(cfg.WebhookRequestsPerMinute, cfg.WebhookBurstSize))
+}
+
+func limitWithRetryAfter(rl *rateLimiter) gin.HandlerFunc {
+	go rl.cleanupVisitors()
+
+	return func(c *gin.Context) {
+		reservation := rl.getVisitor(c.ClientIP()).Reserve()
+		if !reservation.OK() || reservation.Delay() > 0 {
+			retryAfter := 60
+			if reservation.OK() {
+				retryAfter = int(math.Ceil(reservation.Delay().Seconds()))
+				// Give the token back so a client that keeps hammering does not push its
+				// own retry window further out
+				reservation.Cancel()
+			}
+			c.Header("Retry-After", strconv.Itoa(retryAfter))
+
+			response := dto.NewErrorResponse(Translate(c, "common.rate_limited"), nil)
+			c.JSON(http.StatusTooManyRequests, response)
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
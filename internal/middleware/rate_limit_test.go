@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/louco-event/internal/config"
+)
+
+func TestSearchRateLimitThrottlesPerIP(t *testing.T) {
+	const burst = 2
+	engine := gin.New()
+	engine.GET("/events/search", SearchRateLimit(config.RateLimitConfig{
+		SearchRequestsPerMinute: 1,
+		SearchBurstSize:         burst,
+	}), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	search := func(remoteAddr string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/events/search?query=jazz", nil)
+		req.RemoteAddr = remoteAddr
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	for i := 0; i < burst; i++ {
+		if recorder := search("203.0.113.7:4242"); recorder.Code != http.StatusOK {
+			t.Fatalf("request %d: got %d, want %d", i, recorder.Code, http.StatusOK)
+		}
+	}
+	recorder := search("203.0.113.7:4243")
+	if recorder.Code != http.StatusTooManyRequests {
+		t.Fatalf("request over the burst: got %d, want %d", recorder.Code, http.StatusTooManyRequests)
+	}
+	if recorder.Header().Get("Retry-After") == "" {
+		t.Error("throttled response has no Retry-After header")
+	}
+
+	// Another address has a bucket of its own
+	if recorder := search("198.51.100.9:4242"); recorder.Code != http.StatusOK {
+		t.Errorf("other address: got %d, want %d", recorder.Code, http.StatusOK)
+	}
+}
@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/louco-event/internal/dto"
+)
+
+// ResponseMeta stamps the request id into the meta block of every JSON response envelope, so
+// handlers never set it themselves. It must run after Logger, which assigns the request id.
+func ResponseMeta() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Writer = &responseMetaWriter{ResponseWriter: c.Writer, requestID: GetRequestID(c)}
+		c.Next()
+	}
+}
+
+// responseEnvelope mirrors dto.APIResponse but keeps the payload as raw JSON, so rewriting the
+// meta block leaves data and errors byte for byte as the handler rendered them
+type responseEnvelope struct {
+	Success *bool             `json:"success"`
+	Message string            `json:"message"`
+	Data    json.RawMessage   `json:"data,omitempty"`
+	Errors  json.RawMessage   `json:"errors,omitempty"`
+	Meta    *dto.ResponseMeta `json:"meta,omitempty"`
+}
+
+type responseMetaWriter struct {
+	gin.ResponseWriter
+	requestID string
+}
+
+// Write rewrites a JSON envelope with the request id. Gin renders JSON in a single write;
+// anything that is not an envelope, such as CSV exports or calendar files, passes through.
+func (w *responseMetaWriter) Write(data []byte) (int, error) {
+	if w.requestID == "" || !strings.HasPrefix(w.Header().Get("Content-Type"), "application/json") {
+		return w.ResponseWriter.Write(data)
+	}
+
+	body, ok := withResponseMeta(data, w.requestID)
+	if !ok {
+		return w.ResponseWriter.Write(data)
+	}
+	if _, err := w.ResponseWriter.Write(body); err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+func (w *responseMetaWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// withResponseMeta returns the envelope in data with its meta block carrying the request id.
+// Envelopes built as literals have no meta yet and get one stamped with the current time.
+func withResponseMeta(data []byte, requestID string) ([]byte, bool) {
+	var envelope responseEnvelope
+	if err := json.Unmarshal(data, &envelope); err != nil || envelope.Success == nil {
+		return nil, false
+	}
+	if envelope.Meta == nil {
+		envelope.Meta = &dto.ResponseMeta{Timestamp: time.Now().UTC()}
+	}
+	envelope.Meta.RequestID = requestID
+
+	body, err := json.Marshal(envelope)
+	if err != nil {
+		return nil, false
+	}
+	return body, true
+}
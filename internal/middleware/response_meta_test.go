@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/pkg/logger"
+	"github.com/rs/zerolog"
+)
+
+// newResponseMetaTestEngine mirrors the production middleware order
+func newResponseMetaTestEngine() *gin.Engine {
+	nop := zerolog.Nop()
+	engine := gin.New()
+	engine.Use(Logger(&logger.Logger{Logger: &nop}), ResponseMeta(), Recovery(&logger.Logger{Logger: &nop}))
+
+	engine.GET("/event", func(c *gin.Context) {
+		c.JSON(http.StatusOK, dto.NewSuccessResponse("event.get.success", gin.H{"id": 7}))
+	})
+	engine.GET("/events", func(c *gin.Context) {
+		items := []gin.H{{"id": 7}, {"id": 8}}
+		c.JSON(http.StatusOK, dto.NewListSuccessResponse("event.list.success", items, *dto.NewPaginationResponse(2, 2, 5)))
+	})
+	// Subscription responses are built as literals without a meta block
+	engine.GET("/subscription", func(c *gin.Context) {
+		c.JSON(http.StatusNotFound, dto.APIResponse{Success: false, Message: "subscription.not_found"})
+	})
+	engine.GET("/panic", func(c *gin.Context) {
+		panic("boom")
+	})
+	engine.GET("/calendar", func(c *gin.Context) {
+		c.Data(http.StatusOK, "text/calendar", []byte("BEGIN:VCALENDAR"))
+	})
+	return engine
+}
+
+func TestResponseMetaStampsRequestID(t *testing.T) {
+	engine := newResponseMetaTestEngine()
+
+	for _, path := range []string{"/event", "/events", "/subscription", "/panic"} {
+		t.Run(path, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			engine.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+
+			var body struct {
+				Success *bool            `json:"success"`
+				Message string           `json:"message"`
+				Data    json.RawMessage  `json:"data"`
+				Meta    dto.ResponseMeta `json:"meta"`
+			}
+			if err := json.Unmarshal(recorder.Body.Bytes(), &body); err != nil {
+				t.Fatalf("failed to decode response %s: %v", recorder.Body, err)
+			}
+			requestID := recorder.Header().Get("X-Request-ID")
+			if requestID == "" || body.Meta.RequestID != requestID {
+				t.Errorf("meta request id %q, want the X-Request-ID header %q", body.Meta.RequestID, requestID)
+			}
+			if body.Success == nil || body.Message == "" || body.Meta.Timestamp.IsZero() {
+				t.Errorf("envelope = %s, want success, message and a timestamp", recorder.Body)
+			}
+		})
+	}
+}
+
+func TestResponseMetaKeepsListPagination(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	newResponseMetaTestEngine().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/events", nil))
+
+	var body struct {
+		Data dto.ListResponse `json:"data"`
+		Meta dto.ResponseMeta `json:"meta"`
+	}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &body); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	want := dto.PaginationMeta{Page: 2, PageSize: 2, Total: 5, TotalPages: 3}
+	if body.Data.Pagination != want || body.Meta.Pagination == nil || *body.Meta.Pagination != want {
+		t.Errorf("data pagination %+v, meta pagination %+v; want %+v in both", body.Data.Pagination, body.Meta.Pagination, want)
+	}
+	if items, ok := body.Data.Items.([]interface{}); !ok || len(items) != 2 {
+		t.Errorf("items = %v, want the 2 events", body.Data.Items)
+	}
+}
+
+func TestResponseMetaLeavesOtherBodiesAlone(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	newResponseMetaTestEngine().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/calendar", nil))
+	if got := recorder.Body.String(); got != "BEGIN:VCALENDAR" {
+		t.Errorf("body = %q, want the calendar unchanged", got)
+	}
+}
@@ -0,0 +1,101 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/louco-event/internal/config"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+// newWebhookTestEngine mirrors the production engine: no proxy is trusted, so the client IP
+// is always the connection's address
+func newWebhookTestEngine(t *testing.T, handlers ...gin.HandlerFunc) *gin.Engine {
+	t.Helper()
+	engine := gin.New()
+	if err := engine.SetTrustedProxies(nil); err != nil {
+		t.Fatalf("SetTrustedProxies: %v", err)
+	}
+	handlers = append(handlers, func(c *gin.Context) { c.Status(http.StatusOK) })
+	engine.POST("/webhooks/stripe", handlers...)
+	return engine
+}
+
+func sendWebhook(engine *gin.Engine, remoteAddr, forwardedFor string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/stripe", nil)
+	req.RemoteAddr = remoteAddr
+	if forwardedFor != "" {
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+	}
+	recorder := httptest.NewRecorder()
+	engine.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestWebhookRateLimitThrottlesFlood(t *testing.T) {
+	const burst = 3
+	engine := newWebhookTestEngine(t, WebhookRateLimit(config.RateLimitConfig{
+		WebhookRequestsPerMinute: 1,
+		WebhookBurstSize:         burst,
+	}))
+
+	// A flood from one address, each request claiming a different forwarded IP
+	for i := 0; i < burst+5; i++ {
+		recorder := sendWebhook(engine, "203.0.113.7:4242", "198.51.100."+strconv.Itoa(i))
+		if i < burst {
+			if recorder.Code != http.StatusOK {
+				t.Fatalf("request %d: got %d, want %d", i, recorder.Code, http.StatusOK)
+			}
+			continue
+		}
+		if recorder.Code != http.StatusTooManyRequests {
+			t.Fatalf("request %d: got %d, want %d", i, recorder.Code, http.StatusTooManyRequests)
+		}
+		if recorder.Header().Get("Retry-After") == "" {
+			t.Fatalf("request %d: missing Retry-After header", i)
+		}
+	}
+
+	// Other senders keep their own bucket
+	if recorder := sendWebhook(engine, "203.0.113.8:4242", ""); recorder.Code != http.StatusOK {
+		t.Fatalf("other sender: got %d, want %d", recorder.Code, http.StatusOK)
+	}
+}
+
+func TestAllowSourceIPs(t *testing.T) {
+	engine := newWebhookTestEngine(t, AllowSourceIPs([]string{"3.18.12.63", "13.235.14.0/28"}))
+
+	tests := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor string
+		want         int
+	}{
+		{name: "listed ip", remoteAddr: "3.18.12.63:443", want: http.StatusOK},
+		{name: "inside range", remoteAddr: "13.235.14.5:443", want: http.StatusOK},
+		{name: "outside range", remoteAddr: "13.235.14.16:443", want: http.StatusForbidden},
+		{name: "spoofed forwarded ip", remoteAddr: "203.0.113.7:443", forwardedFor: "3.18.12.63", want: http.StatusForbidden},
+		{name: "ipv4 mapped ipv6", remoteAddr: "[::ffff:3.18.12.63]:443", want: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if recorder := sendWebhook(engine, tt.remoteAddr, tt.forwardedFor); recorder.Code != tt.want {
+				t.Fatalf("got %d, want %d", recorder.Code, tt.want)
+			}
+		})
+	}
+}
+
+func TestAllowSourceIPsEmptyListAllowsAll(t *testing.T) {
+	engine := newWebhookTestEngine(t, AllowSourceIPs(nil))
+	if recorder := sendWebhook(engine, "203.0.113.7:443", ""); recorder.Code != http.StatusOK {
+		t.Fatalf("got %d, want %d", recorder.Code, http.StatusOK)
+	}
+}
@@ -26,8 +26,10 @@ type CategoryRepository interface {
 	GetLeafCategories(ctx context.Context) ([]*domain.Category, error)
 
 	// Search operations
-	Search(ctx context.Context, query string) ([]*domain.Category, error)
-	SearchByType(ctx context.Context, query string, categoryType domain.CategoryType) ([]*domain.Category, error)
+	// Search and SearchByType return categories in tree order, or by name in the collation of
+	// sortLocale when it is set
+	Search(ctx context.Context, query string, sortLocale string) ([]*domain.Category, error)
+	SearchByType(ctx context.Context, query string, categoryType domain.CategoryType, sortLocale string) ([]*domain.Category, error)
 
 	// Count operations
 	Count(ctx context.Context) (int, error)
@@ -14,6 +14,9 @@ type CreatorRepository interface {
 	Delete(ctx context.Context, id int) error
 	List(ctx context.Context, limit, offset int) ([]*domain.Creator, error)
 	ListByIndustryID(ctx context.Context, industryID, limit, offset int) ([]*domain.Creator, error)
+	// ListByRating orders creators by the average rating of their events, unrated creators
+	// last. An industryID of zero lists every industry.
+	ListByRating(ctx context.Context, industryID, limit, offset int) ([]*domain.Creator, error)
 	Count(ctx context.Context) (int, error)
 	CountByIndustryID(ctx context.Context, industryID int) (int, error)
 	AddIndustries(ctx context.Context, creatorID int, industryIDs []int) error
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/louco-event/internal/domain"
+)
+
+// EventCoHostRepository defines the interface for event co-host operations
+type EventCoHostRepository interface {
+	Create(ctx context.Context, coHost *domain.EventCoHost) error
+	Update(ctx context.Context, coHost *domain.EventCoHost) error
+	// GetByEventAndCreator returns gorm.ErrRecordNotFound when the creator was never invited
+	GetByEventAndCreator(ctx context.Context, eventID, creatorID int) (*domain.EventCoHost, error)
+	// GetByEventID returns every co-host of the event, pending ones included, oldest first
+	GetByEventID(ctx context.Context, eventID int) ([]*domain.EventCoHost, error)
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+)
+
+// EventHistoryRepository defines the interface for event change history operations
+type EventHistoryRepository interface {
+	Create(ctx context.Context, history *domain.EventHistory) error
+
+	// GetByEventID returns the history of an event, newest first
+	GetByEventID(ctx context.Context, eventID int, pagination dto.PaginationRequest) ([]*domain.EventHistory, *dto.PaginationResponse, error)
+
+	// GetUnnotified returns up to limit entries of an action attendees were not told about yet,
+	// oldest first
+	GetUnnotified(ctx context.Context, action domain.EventHistoryAction, limit int) ([]*domain.EventHistory, error)
+	MarkNotified(ctx context.Context, id int) error
+}
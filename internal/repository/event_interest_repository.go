@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/louco-event/internal/domain"
+)
+
+// EventInterestRepository defines the interface for event interest operations
+type EventInterestRepository interface {
+	Create(ctx context.Context, interest *domain.EventInterest) error
+	ExistsByEventAndUser(ctx context.Context, eventID, userID int) (bool, error)
+	CountByEventID(ctx context.Context, eventID int) (int64, error)
+}
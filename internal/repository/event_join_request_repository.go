@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+)
+
+// EventJoinRequestRepository defines the interface for private event join request operations
+type EventJoinRequestRepository interface {
+	Create(ctx context.Context, request *domain.EventJoinRequest) error
+	GetByID(ctx context.Context, id int) (*domain.EventJoinRequest, error)
+	Update(ctx context.Context, request *domain.EventJoinRequest) error
+	ExistsByEventAndUser(ctx context.Context, eventID, userID int) (bool, error)
+
+	// GetByEventID returns the event's requests oldest first, optionally filtered by status
+	GetByEventID(ctx context.Context, eventID int, status *domain.EventJoinRequestStatus, pagination dto.PaginationRequest) ([]*domain.EventJoinRequest, *dto.PaginationResponse, error)
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/louco-event/internal/domain"
+)
+
+// EventRatingRepository defines the interface for event rating operations
+type EventRatingRepository interface {
+	// Upsert stores the rating, replacing the stars and comment of the user's earlier rating
+	// of the same event
+	Upsert(ctx context.Context, rating *domain.EventRating) error
+	GetByEventAndUser(ctx context.Context, eventID, userID int) (*domain.EventRating, error)
+
+	// GetSummaryByEventID returns the average stars and number of ratings of the event
+	GetSummaryByEventID(ctx context.Context, eventID int) (float64, int64, error)
+	// GetSummaryByCreatorID returns the average stars and number of ratings across all of the
+	// creator's events
+	GetSummaryByCreatorID(ctx context.Context, creatorID int) (float64, int64, error)
+	// GetSummariesByCreatorIDs is GetSummaryByCreatorID for a page of creators in one query;
+	// creators without ratings are left out of the map
+	GetSummariesByCreatorIDs(ctx context.Context, creatorIDs []int) (map[int]domain.RatingSummary, error)
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+)
+
+// EventReportRepository defines the interface for event abuse report operations
+type EventReportRepository interface {
+	Create(ctx context.Context, report *domain.EventReport) error
+	GetByID(ctx context.Context, id int) (*domain.EventReport, error)
+	Update(ctx context.Context, report *domain.EventReport) error
+	ExistsByEventAndReporter(ctx context.Context, eventID, reporterUserID int) (bool, error)
+	CountPendingByEventID(ctx context.Context, eventID int) (int64, error)
+
+	// GetReports returns reports newest first, optionally filtered by status
+	GetReports(ctx context.Context, status *domain.EventReportStatus, pagination dto.PaginationRequest) ([]*domain.EventReport, *dto.PaginationResponse, error)
+}
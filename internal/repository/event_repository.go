@@ -2,40 +2,85 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/louco-event/internal/domain"
 	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/pkg/pagination"
 )
 
 type EventRepository interface {
 	// Basic CRUD operations
 	Create(ctx context.Context, event *domain.Event) error
+	// CreateWithInvitations creates the event with its categories and invitations in one
+	// transaction, so none of them is kept when another fails
+	CreateWithInvitations(ctx context.Context, event *domain.Event, categoryIDs []int, invitations []*domain.Invitation) error
 	GetByID(ctx context.Context, id int) (*domain.Event, error)
 	GetByIDWithRelations(ctx context.Context, id int) (*domain.Event, error)
 	Update(ctx context.Context, event *domain.Event) error
+	// Delete moves the event to the trash; it is hidden from every query until purged
 	Delete(ctx context.Context, id int) error
 
+	// Trash operations
+	// GetTrashed lists soft-deleted events, most recently deleted first. A creatorID of zero
+	// lists the trash of every creator.
+	GetTrashed(ctx context.Context, creatorID int, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error)
+	// Restore takes an event of the creator out of the trash. Returns gorm.ErrRecordNotFound
+	// when the creator has no such deleted event.
+	Restore(ctx context.Context, id, creatorID int) error
+	// GetPurgeableIDs returns up to limit events deleted before the given time that have no
+	// ticket purchases
+	GetPurgeableIDs(ctx context.Context, deletedBefore time.Time, limit int) ([]int, error)
+	// Purge permanently deletes a soft-deleted event with everything attached to it. Returns
+	// gorm.ErrRecordNotFound when the event is not in the trash and domain.ErrEventHasPurchases
+	// when tickets of it were bought.
+	Purge(ctx context.Context, id int) error
+
 	// Creator-specific operations
 	GetByCreatorID(ctx context.Context, creatorID int, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error)
 	GetByCreatorIDAndStatus(ctx context.Context, creatorID int, status domain.EventStatus, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error)
+	GetByCreatorIDAndStatuses(ctx context.Context, creatorID int, statuses []domain.EventStatus, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error)
+	// GetAttentionCandidates returns up to limit of the creator's rejected and draft events and
+	// published events with system tickets starting before startingBefore, with their tickets
+	GetAttentionCandidates(ctx context.Context, creatorID int, startingBefore time.Time, limit int) ([]*domain.Event, error)
 	CountByCreatorID(ctx context.Context, creatorID int) (int64, error)
 	CountByCreatorIDAndStatus(ctx context.Context, creatorID int, status domain.EventStatus) (int64, error)
+	// CountByCreatorIDGroupedByStatus counts the creator's events per status in one query;
+	// statuses without events are absent from the map
+	CountByCreatorIDGroupedByStatus(ctx context.Context, creatorID int) (map[domain.EventStatus]int64, error)
 
 	// Public event operations
 	GetPublicEvents(ctx context.Context, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error)
+	// GetPublicEventsAfter returns up to limit public events following the cursor, newest first;
+	// a nil cursor starts at the newest event
+	GetPublicEventsAfter(ctx context.Context, cursor *pagination.Cursor, limit int) ([]*domain.Event, error)
 	GetPublicEventsByCategory(ctx context.Context, categoryID int, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error)
-	GetPublicEventsByLocation(ctx context.Context, city string, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error)
+	GetPublicEventsByLocation(ctx context.Context, city, country string, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error)
 	SearchPublicEvents(ctx context.Context, query string, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error)
 
 	// Status management operations
 	GetByStatus(ctx context.Context, status domain.EventStatus, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error)
+	// GetByStatusWithFilters lists events of every creator in one status, for admins
+	GetByStatusWithFilters(ctx context.Context, filters dto.AdminEventFilterRequest, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error)
 	UpdateStatus(ctx context.Context, id int, status domain.EventStatus) error
+	UpdateSalesClosure(ctx context.Context, id int, salesClosedAt *time.Time, closeAtStart bool) error
+	UpdateShareToken(ctx context.Context, event *domain.Event) error
+	RaiseCapacityWarningLevel(ctx context.Context, id int, threshold int) (bool, error)
+	// CountAttendees returns the seats taken at an event: approved invitations plus sold tickets
+	CountAttendees(ctx context.Context, id int) (int, error)
+	// GetAttendeeEmails returns the distinct emails of approved invitees and ticket holders
+	GetAttendeeEmails(ctx context.Context, id int) ([]string, error)
+	RaiseInterestMilestoneLevel(ctx context.Context, id int, threshold int) (bool, error)
+	GetByShareToken(ctx context.Context, token string) (*domain.Event, error)
 	GetPendingEvents(ctx context.Context, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error)
 
 	// Category operations
 	AddCategories(ctx context.Context, eventID int, categoryIDs []int) error
 	RemoveCategories(ctx context.Context, eventID int, categoryIDs []int) error
 	UpdateCategories(ctx context.Context, eventID int, categoryIDs []int) error
+	// AddCategoryToEvents links one category to several events in a single statement. Events
+	// already in the category are left alone; returns the ids of the events that were added.
+	AddCategoryToEvents(ctx context.Context, eventIDs []int, categoryID int) ([]int, error)
 	GetEventsByCategories(ctx context.Context, categoryIDs []int, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error)
 
 	// Private event operations
@@ -43,13 +88,26 @@ type EventRepository interface {
 	GetPrivateEventsByInvitedEmail(ctx context.Context, email string, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error)
 
 	// Date-based operations
-	GetEventsByDateRange(ctx context.Context, startDate, endDate string, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error)
-	GetUpcomingEvents(ctx context.Context, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error)
+	// GetEventsByDateRange and GetEventsStartingFrom match on start date. Announcements without a
+	// date never match; includeAnnouncements lists them after the dated events.
+	GetEventsByDateRange(ctx context.Context, startDate, endDate string, includeAnnouncements bool, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error)
+	// GetEventsStartingFrom has no upper bound: every event on or after startDate matches
+	GetEventsStartingFrom(ctx context.Context, startDate string, includeAnnouncements bool, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error)
+	// GetAnnouncements lists published public announcements, dated or not, newest first
+	GetAnnouncements(ctx context.Context, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error)
 	GetPastEvents(ctx context.Context, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error)
+	GetOngoingEvents(ctx context.Context, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error)
+	// GetEventsStartingSoon returns published public events starting before the given time that
+	// still have tickets, soonest first. city is optional.
+	GetEventsStartingSoon(ctx context.Context, before time.Time, city *string, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error)
+	// GetCreatorUpcomingEvents returns a creator's published public events that have not started, soonest first
+	GetCreatorUpcomingEvents(ctx context.Context, creatorID int, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error)
 
 	// Location-based operations
 	GetEventsByCity(ctx context.Context, city string, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error)
-	GetEventsByCoordinates(ctx context.Context, latitude, longitude float64, radiusKm int, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error)
+	// GetEventsByCoordinates returns published public events within radiusKm with their DistanceKm set,
+	// nearest first when sortByDistance is set and newest first otherwise
+	GetEventsByCoordinates(ctx context.Context, latitude, longitude float64, radiusKm int, sortByDistance bool, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error)
 
 	// Type-based operations
 	GetEventsByType(ctx context.Context, eventType domain.EventType, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error)
@@ -58,6 +116,7 @@ type EventRepository interface {
 	// Statistics operations
 	GetEventStats(ctx context.Context, creatorID int) (*dto.EventStatsResponse, error)
 	GetSystemEventStats(ctx context.Context) (*dto.SystemEventStatsResponse, error)
+	GetEventPublicStats(ctx context.Context, eventID int) (*dto.EventPublicStatsResponse, error)
 
 	// Validation operations
 	ExistsByID(ctx context.Context, id int) (bool, error)
@@ -70,9 +129,15 @@ type EventRepository interface {
 	DeleteMultiple(ctx context.Context, ids []int) error
 
 	// Advanced filtering
-	GetEventsWithFilters(ctx context.Context, filters dto.EventFilterRequest, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error)
-	GetFeaturedEvents(ctx context.Context, limit int) ([]*domain.Event, error)
-	GetTrendingEvents(ctx context.Context, limit int) ([]*domain.Event, error)
+	GetEventsWithFilters(ctx context.Context, filters dto.EventFilterRequest, viewerID *int, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error)
+	CountEventsWithFilters(ctx context.Context, filters dto.EventFilterRequest, viewerID *int) (int64, error)
+	// GetFeaturedEvents returns curated published public events, most recently featured first
+	GetFeaturedEvents(ctx context.Context, filters dto.EventShowcaseRequest) ([]*domain.Event, error)
+	// GetTrendingEvents ranks events by their views since the given time, each view decaying by
+	// half every halfLife. Events created since then are listed after viewed ones.
+	GetTrendingEvents(ctx context.Context, filters dto.EventShowcaseRequest, since time.Time, halfLife time.Duration) ([]*domain.Event, error)
+	SetFeatured(ctx context.Context, id int, featured bool) error
+	GetSimilarEvents(ctx context.Context, eventID int, limit int) ([]*domain.Event, error)
 
 	// Preloading operations
 	PreloadCategories(ctx context.Context, events []*domain.Event) error
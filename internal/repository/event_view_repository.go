@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/louco-event/internal/domain"
+)
+
+// EventViewRepository defines the interface for event page view operations
+type EventViewRepository interface {
+	// Record stores a view unless the same visitor already viewed the event since dedupeSince;
+	// it reports whether the view was stored. Signed-in visitors are matched by user and
+	// anonymous ones by IP address.
+	Record(ctx context.Context, view *domain.EventView, dedupeSince time.Time) (bool, error)
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/louco-event/internal/domain"
+)
+
+// ExportJobRepository defines the interface for background export job operations
+type ExportJobRepository interface {
+	Create(ctx context.Context, job *domain.ExportJob) error
+	GetByID(ctx context.Context, id int) (*domain.ExportJob, error)
+	Update(ctx context.Context, job *domain.ExportJob) error
+
+	// ClaimNextQueued moves the oldest queued job to processing and returns it. Concurrent
+	// workers never claim the same job. Returns nil when the queue is empty.
+	ClaimNextQueued(ctx context.Context) (*domain.ExportJob, error)
+	// RequeueStale gives jobs processing since before startedBefore back to the queue, so an
+	// export whose worker died is built again. Returns how many jobs were requeued.
+	RequeueStale(ctx context.Context, startedBefore time.Time) (int64, error)
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+)
+
+// FailedWebhookRepository defines the interface for dead-lettered webhook operations
+type FailedWebhookRepository interface {
+	Create(ctx context.Context, webhook *domain.FailedWebhook) error
+	GetByID(ctx context.Context, id int) (*domain.FailedWebhook, error)
+	Update(ctx context.Context, webhook *domain.FailedWebhook) error
+	// ClaimForRetry marks an unresolved webhook as being retried. It returns false when the
+	// webhook is missing, resolved, or claimed by another retry after staleBefore.
+	ClaimForRetry(ctx context.Context, id int, staleBefore time.Time) (bool, error)
+
+	// List returns failed webhooks newest first; resolved ones only when includeResolved is set
+	List(ctx context.Context, includeResolved bool, pagination dto.PaginationRequest) ([]*domain.FailedWebhook, *dto.PaginationResponse, error)
+}
@@ -42,8 +42,16 @@ type InvitationRepository interface {
 	// Duplicate and validation operations
 	ExistsByEventAndEmail(ctx context.Context, eventID int, email string) (bool, error)
 	ExistsByEventAndUser(ctx context.Context, eventID int, userID int) (bool, error)
+	// ExistsForInvitee reports whether the user is invited to the event, by user id or by an
+	// invitation sent to their email before it was linked to the account
+	ExistsForInvitee(ctx context.Context, eventID int, userID int) (bool, error)
+	// ExistsApprovedByEventAndUser reports whether the user accepted an invitation to the event
+	ExistsApprovedByEventAndUser(ctx context.Context, eventID int, userID int) (bool, error)
 	GetByEventAndEmail(ctx context.Context, eventID int, email string) (*domain.Invitation, error)
 	GetByEventAndUser(ctx context.Context, eventID int, userID int) (*domain.Invitation, error)
+	// GetByEventAndInvitees returns the event's invitations addressed to any of the users or emails
+	GetByEventAndInvitees(ctx context.Context, eventID int, userIDs []int, emails []string) ([]*domain.Invitation, error)
+	GetByToken(ctx context.Context, token string) (*domain.Invitation, error)
 
 	// Validation operations
 	ExistsByID(ctx context.Context, id int) (bool, error)
@@ -59,6 +67,7 @@ type InvitationRepository interface {
 
 	// Statistics operations
 	GetInvitationStats(ctx context.Context, eventID int) (*dto.InvitationStatsResponse, error)
+	GetInvitationStatsForEvents(ctx context.Context, eventIDs []int) (map[int]*dto.InvitationStatsResponse, error)
 	GetUserInvitationStats(ctx context.Context, userID int) (*dto.UserInvitationStatsResponse, error)
 	GetSystemInvitationStats(ctx context.Context) (*dto.SystemInvitationStatsResponse, error)
 
@@ -77,6 +86,8 @@ type InvitationRepository interface {
 
 	// Email-based operations for external users
 	GetPendingInvitationsByEmail(ctx context.Context, email string) ([]*domain.Invitation, error)
+	// GetPendingByEventID returns every pending invitation of an event, unpaginated
+	GetPendingByEventID(ctx context.Context, eventID int) ([]*domain.Invitation, error)
 	GetEventInvitationByEmail(ctx context.Context, eventID int, email string) (*domain.Invitation, error)
 	UpdateInvitedUserByEmail(ctx context.Context, email string, userID int) error
 }
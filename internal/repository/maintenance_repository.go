@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// PurgeTarget names a table the retention purge may delete from. Financial tables such as
+// subscriptions and ticket purchases are deliberately not targets.
+type PurgeTarget string
+
+const (
+	PurgeTargetEventHistory       PurgeTarget = "event_history"
+	PurgeTargetShareTokenAccesses PurgeTarget = "share_token_accesses"
+	PurgeTargetExportJobs         PurgeTarget = "export_jobs"
+	PurgeTargetTicketReservations PurgeTarget = "ticket_reservations"
+	PurgeTargetVerificationCodes  PurgeTarget = "verification_codes"
+	PurgeTargetEventViews         PurgeTarget = "event_views"
+)
+
+// MaintenanceRepository defines the interface for data retention operations
+type MaintenanceRepository interface {
+	// CountPurgeable counts the rows of a target that are older than before
+	CountPurgeable(ctx context.Context, target PurgeTarget, before time.Time) (int64, error)
+	// PurgeBatch deletes up to batchSize rows of a target older than before and returns how
+	// many were deleted
+	PurgeBatch(ctx context.Context, target PurgeTarget, before time.Time, batchSize int) (int64, error)
+}
@@ -17,4 +17,7 @@ type MediaRepository interface {
 	GetByFileName(ctx context.Context, fileName string) (*domain.Media, error)
 	GetByMediaType(ctx context.Context, mediaType domain.MediaType, limit, offset int) ([]*domain.Media, error)
 	GetUnconvertedMedia(ctx context.Context, limit int) ([]*domain.Media, error)
+	// GetPrivateEventMediaIDs returns which of mediaIDs are the image or video of a private
+	// event, including events in the trash
+	GetPrivateEventMediaIDs(ctx context.Context, mediaIDs []int) ([]int, error)
 }
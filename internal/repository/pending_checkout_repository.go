@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/louco-event/internal/domain"
+)
+
+// PendingCheckoutRepository defines the interface for pending ticket checkout operations
+type PendingCheckoutRepository interface {
+	Create(ctx context.Context, checkout *domain.PendingCheckout) error
+	GetByStripeSessionID(ctx context.Context, sessionID string) (*domain.PendingCheckout, error)
+
+	// Resolve moves a pending checkout to status and reports whether it did. Checkouts already
+	// completed or expired are left alone, so redelivered webhooks change nothing.
+	Resolve(ctx context.Context, sessionID string, status domain.PendingCheckoutStatus) (bool, error)
+}
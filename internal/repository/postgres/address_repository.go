@@ -81,6 +81,9 @@ func (r *addressRepository) CreateOrUpdateByPlaceID(ctx context.Context, address
 	existingAddress.Latitude = address.Latitude
 	existingAddress.Longitude = address.Longitude
 	existingAddress.DoorNumber = address.DoorNumber
+	if address.Timezone != "" {
+		existingAddress.Timezone = address.Timezone
+	}
 
 	if err := r.db.WithContext(ctx).Save(&existingAddress).Error; err != nil {
 		return nil, err
@@ -115,12 +118,7 @@ func (r *addressRepository) SearchByCity(ctx context.Context, city string, pagin
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
 
 	return addresses, paginationResponse, nil
 }
@@ -150,12 +148,7 @@ func (r *addressRepository) SearchByCountry(ctx context.Context, country string,
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
 
 	return addresses, paginationResponse, nil
 }
@@ -187,12 +180,7 @@ func (r *addressRepository) SearchByFullAddress(ctx context.Context, query strin
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
 
 	return addresses, paginationResponse, nil
 }
@@ -226,12 +214,7 @@ func (r *addressRepository) GetByCoordinates(ctx context.Context, latitude, long
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
 
 	return addresses, paginationResponse, nil
 }
@@ -373,12 +356,7 @@ func (r *addressRepository) GetAddressesWithFilters(ctx context.Context, filters
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
 
 	return addresses, paginationResponse, nil
 }
@@ -183,32 +183,39 @@ func (r *categoryRepository) GetLeafCategories(ctx context.Context) ([]*domain.C
 }
 
 // Search operations
-func (r *categoryRepository) Search(ctx context.Context, query string) ([]*domain.Category, error) {
+func (r *categoryRepository) Search(ctx context.Context, query string, sortLocale string) ([]*domain.Category, error) {
 	var categories []*domain.Category
 	searchPattern := "%" + query + "%"
 
 	err := r.db.WithContext(ctx).
 		Preload("Icon").
 		Where("name ILIKE ? OR slug ILIKE ?", searchPattern, searchPattern).
-		Order("lft ASC").
+		Order(categorySearchOrder(sortLocale)).
 		Find(&categories).Error
 
 	return categories, err
 }
 
-func (r *categoryRepository) SearchByType(ctx context.Context, query string, categoryType domain.CategoryType) ([]*domain.Category, error) {
+func (r *categoryRepository) SearchByType(ctx context.Context, query string, categoryType domain.CategoryType, sortLocale string) ([]*domain.Category, error) {
 	var categories []*domain.Category
 	searchPattern := "%" + query + "%"
 
 	err := r.db.WithContext(ctx).
 		Preload("Icon").
 		Where("type = ? AND (name ILIKE ? OR slug ILIKE ?)", categoryType, searchPattern, searchPattern).
-		Order("lft ASC").
+		Order(categorySearchOrder(sortLocale)).
 		Find(&categories).Error
 
 	return categories, err
 }
 
+func categorySearchOrder(sortLocale string) string {
+	if sortLocale == "" {
+		return "lft ASC"
+	}
+	return orderByName("name", sortLocale)
+}
+
 // Count operations
 func (r *categoryRepository) Count(ctx context.Context) (int, error) {
 	var count int64
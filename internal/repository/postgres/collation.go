@@ -0,0 +1,18 @@
+package postgres
+
+// nameCollations maps the supported request languages to the ICU collations created by the
+// name_collations migration. Only these names ever reach a COLLATE clause.
+var nameCollations = map[string]string{
+	"en": "en-US",
+	"tr": "tr-TR",
+}
+
+// orderByName orders column alphabetically in the collation of locale, so e.g. Turkish "ı"
+// sorts before "i"; unsupported locales keep the database default ordering
+func orderByName(column, locale string) string {
+	collation, ok := nameCollations[locale]
+	if !ok {
+		return column + " ASC"
+	}
+	return column + ` COLLATE "` + collation + `" ASC`
+}
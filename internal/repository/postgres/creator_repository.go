@@ -104,6 +104,33 @@ func (r *creatorRepository) ListByIndustryID(ctx context.Context, industryID, li
 	return creators, err
 }
 
+func (r *creatorRepository) ListByRating(ctx context.Context, industryID, limit, offset int) ([]*domain.Creator, error) {
+	var creators []*domain.Creator
+
+	ratings := r.db.Model(&domain.EventRating{}).
+		Select("events.creator_id, AVG(event_ratings.stars) AS average, COUNT(*) AS count").
+		Joins("JOIN events ON events.id = event_ratings.event_id").
+		Group("events.creator_id")
+
+	query := r.db.WithContext(ctx).
+		Preload("User").
+		Preload("Industries").
+		Joins("LEFT JOIN (?) AS ratings ON ratings.creator_id = creators.id", ratings)
+	if industryID > 0 {
+		query = query.
+			Joins("JOIN creator_industries ON creators.id = creator_industries.creator_id").
+			Where("creator_industries.industry_id = ?", industryID)
+	}
+
+	err := query.
+		Limit(limit).
+		Offset(offset).
+		Order("ratings.average DESC NULLS LAST, ratings.count DESC NULLS LAST, creators.id DESC").
+		Find(&creators).Error
+
+	return creators, err
+}
+
 func (r *creatorRepository) Count(ctx context.Context) (int, error) {
 	var count int64
 	err := r.db.WithContext(ctx).Model(&domain.Creator{}).Count(&count).Error
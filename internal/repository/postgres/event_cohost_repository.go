@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/repository"
+)
+
+type eventCoHostRepository struct {
+	db *gorm.DB
+}
+
+// NewEventCoHostRepository creates a new event co-host repository instance
+func NewEventCoHostRepository(db *gorm.DB) repository.EventCoHostRepository {
+	return &eventCoHostRepository{db: db}
+}
+
+func (r *eventCoHostRepository) Create(ctx context.Context, coHost *domain.EventCoHost) error {
+	return r.db.WithContext(ctx).Omit("Creator").Create(coHost).Error
+}
+
+func (r *eventCoHostRepository) Update(ctx context.Context, coHost *domain.EventCoHost) error {
+	return r.db.WithContext(ctx).Omit("Creator").Save(coHost).Error
+}
+
+func (r *eventCoHostRepository) GetByEventAndCreator(ctx context.Context, eventID, creatorID int) (*domain.EventCoHost, error) {
+	var coHost domain.EventCoHost
+	err := r.db.WithContext(ctx).
+		Preload("Creator").
+		Where("event_id = ? AND creator_id = ?", eventID, creatorID).
+		First(&coHost).Error
+	if err != nil {
+		return nil, err
+	}
+	return &coHost, nil
+}
+
+func (r *eventCoHostRepository) GetByEventID(ctx context.Context, eventID int) ([]*domain.EventCoHost, error) {
+	var coHosts []*domain.EventCoHost
+	err := r.db.WithContext(ctx).
+		Preload("Creator").
+		Where("event_id = ?", eventID).
+		Order("created_at ASC, id ASC").
+		Find(&coHosts).Error
+	return coHosts, err
+}
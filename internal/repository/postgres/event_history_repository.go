@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/repository"
+)
+
+type eventHistoryRepository struct {
+	db *gorm.DB
+}
+
+// NewEventHistoryRepository creates a new event history repository instance
+func NewEventHistoryRepository(db *gorm.DB) repository.EventHistoryRepository {
+	return &eventHistoryRepository{db: db}
+}
+
+func (r *eventHistoryRepository) Create(ctx context.Context, history *domain.EventHistory) error {
+	return r.db.WithContext(ctx).Create(history).Error
+}
+
+func (r *eventHistoryRepository) GetByEventID(ctx context.Context, eventID int, pagination dto.PaginationRequest) ([]*domain.EventHistory, *dto.PaginationResponse, error) {
+	var histories []*domain.EventHistory
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&domain.EventHistory{}).Where("event_id = ?", eventID)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, nil, err
+	}
+
+	offset := pagination.GetOffset()
+	pageSize := pagination.GetPageSizeWithDefault()
+
+	err := query.
+		Preload("Actor").
+		Offset(offset).
+		Limit(pageSize).
+		Order("created_at DESC, id DESC").
+		Find(&histories).Error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
+
+	return histories, paginationResponse, nil
+}
+
+func (r *eventHistoryRepository) GetUnnotified(ctx context.Context, action domain.EventHistoryAction, limit int) ([]*domain.EventHistory, error) {
+	var histories []*domain.EventHistory
+	err := r.db.WithContext(ctx).
+		Where("action = ? AND notified_at IS NULL", action).
+		Order("id ASC").
+		Limit(limit).
+		Find(&histories).Error
+	return histories, err
+}
+
+func (r *eventHistoryRepository) MarkNotified(ctx context.Context, id int) error {
+	return r.db.WithContext(ctx).Model(&domain.EventHistory{}).
+		Where("id = ?", id).
+		Update("notified_at", time.Now()).Error
+}
@@ -0,0 +1,39 @@
+package postgres
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/repository"
+)
+
+type eventInterestRepository struct {
+	db *gorm.DB
+}
+
+// NewEventInterestRepository creates a new event interest repository instance
+func NewEventInterestRepository(db *gorm.DB) repository.EventInterestRepository {
+	return &eventInterestRepository{db: db}
+}
+
+func (r *eventInterestRepository) Create(ctx context.Context, interest *domain.EventInterest) error {
+	return r.db.WithContext(ctx).Create(interest).Error
+}
+
+func (r *eventInterestRepository) ExistsByEventAndUser(ctx context.Context, eventID, userID int) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&domain.EventInterest{}).
+		Where("event_id = ? AND user_id = ?", eventID, userID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *eventInterestRepository) CountByEventID(ctx context.Context, eventID int) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&domain.EventInterest{}).
+		Where("event_id = ?", eventID).
+		Count(&count).Error
+	return count, err
+}
@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/repository"
+)
+
+type eventJoinRequestRepository struct {
+	db *gorm.DB
+}
+
+// NewEventJoinRequestRepository creates a new event join request repository instance
+func NewEventJoinRequestRepository(db *gorm.DB) repository.EventJoinRequestRepository {
+	return &eventJoinRequestRepository{db: db}
+}
+
+func (r *eventJoinRequestRepository) Create(ctx context.Context, request *domain.EventJoinRequest) error {
+	return r.db.WithContext(ctx).Create(request).Error
+}
+
+func (r *eventJoinRequestRepository) GetByID(ctx context.Context, id int) (*domain.EventJoinRequest, error) {
+	var request domain.EventJoinRequest
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		First(&request, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+func (r *eventJoinRequestRepository) Update(ctx context.Context, request *domain.EventJoinRequest) error {
+	return r.db.WithContext(ctx).Omit("User").Save(request).Error
+}
+
+func (r *eventJoinRequestRepository) ExistsByEventAndUser(ctx context.Context, eventID, userID int) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&domain.EventJoinRequest{}).
+		Where("event_id = ? AND user_id = ?", eventID, userID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *eventJoinRequestRepository) GetByEventID(ctx context.Context, eventID int, status *domain.EventJoinRequestStatus, pagination dto.PaginationRequest) ([]*domain.EventJoinRequest, *dto.PaginationResponse, error) {
+	var requests []*domain.EventJoinRequest
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&domain.EventJoinRequest{}).Where("event_id = ?", eventID)
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, nil, err
+	}
+
+	offset := pagination.GetOffset()
+	pageSize := pagination.GetPageSizeWithDefault()
+
+	err := query.
+		Preload("User").
+		Offset(offset).
+		Limit(pageSize).
+		Order("created_at ASC, id ASC").
+		Find(&requests).Error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
+
+	return requests, paginationResponse, nil
+}
@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/repository"
+)
+
+type eventRatingRepository struct {
+	db *gorm.DB
+}
+
+// NewEventRatingRepository creates a new event rating repository instance
+func NewEventRatingRepository(db *gorm.DB) repository.EventRatingRepository {
+	return &eventRatingRepository{db: db}
+}
+
+func (r *eventRatingRepository) Upsert(ctx context.Context, rating *domain.EventRating) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "event_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"stars", "comment", "updated_at"}),
+	}).Create(rating).Error
+}
+
+func (r *eventRatingRepository) GetByEventAndUser(ctx context.Context, eventID, userID int) (*domain.EventRating, error) {
+	var rating domain.EventRating
+	err := r.db.WithContext(ctx).
+		Where("event_id = ? AND user_id = ?", eventID, userID).
+		First(&rating).Error
+	if err != nil {
+		return nil, err
+	}
+	return &rating, nil
+}
+
+type ratingSummaryRow struct {
+	CreatorID int
+	Average   float64
+	Count     int64
+}
+
+func (r *eventRatingRepository) GetSummaryByEventID(ctx context.Context, eventID int) (float64, int64, error) {
+	var row ratingSummaryRow
+	err := r.db.WithContext(ctx).Model(&domain.EventRating{}).
+		Select("COALESCE(AVG(stars), 0) AS average, COUNT(*) AS count").
+		Where("event_id = ?", eventID).
+		Scan(&row).Error
+	return row.Average, row.Count, err
+}
+
+func (r *eventRatingRepository) GetSummaryByCreatorID(ctx context.Context, creatorID int) (float64, int64, error) {
+	var row ratingSummaryRow
+	err := r.db.WithContext(ctx).Model(&domain.EventRating{}).
+		Select("COALESCE(AVG(event_ratings.stars), 0) AS average, COUNT(*) AS count").
+		Joins("JOIN events ON events.id = event_ratings.event_id").
+		Where("events.creator_id = ?", creatorID).
+		Scan(&row).Error
+	return row.Average, row.Count, err
+}
+
+func (r *eventRatingRepository) GetSummariesByCreatorIDs(ctx context.Context, creatorIDs []int) (map[int]domain.RatingSummary, error) {
+	summaries := make(map[int]domain.RatingSummary, len(creatorIDs))
+	if len(creatorIDs) == 0 {
+		return summaries, nil
+	}
+
+	var rows []ratingSummaryRow
+	err := r.db.WithContext(ctx).Model(&domain.EventRating{}).
+		Select("events.creator_id, AVG(event_ratings.stars) AS average, COUNT(*) AS count").
+		Joins("JOIN events ON events.id = event_ratings.event_id").
+		Where("events.creator_id IN ?", creatorIDs).
+		Group("events.creator_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		summaries[row.CreatorID] = domain.RatingSummary{Average: row.Average, Count: row.Count}
+	}
+	return summaries, nil
+}
@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/repository"
+)
+
+type eventReportRepository struct {
+	db *gorm.DB
+}
+
+// NewEventReportRepository creates a new event report repository instance
+func NewEventReportRepository(db *gorm.DB) repository.EventReportRepository {
+	return &eventReportRepository{db: db}
+}
+
+func (r *eventReportRepository) Create(ctx context.Context, report *domain.EventReport) error {
+	return r.db.WithContext(ctx).Create(report).Error
+}
+
+func (r *eventReportRepository) GetByID(ctx context.Context, id int) (*domain.EventReport, error) {
+	var report domain.EventReport
+	err := r.db.WithContext(ctx).
+		Preload("Event").
+		Preload("Reporter").
+		First(&report, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+func (r *eventReportRepository) Update(ctx context.Context, report *domain.EventReport) error {
+	return r.db.WithContext(ctx).Omit("Event", "Reporter").Save(report).Error
+}
+
+func (r *eventReportRepository) ExistsByEventAndReporter(ctx context.Context, eventID, reporterUserID int) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&domain.EventReport{}).
+		Where("event_id = ? AND reporter_user_id = ?", eventID, reporterUserID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *eventReportRepository) CountPendingByEventID(ctx context.Context, eventID int) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&domain.EventReport{}).
+		Where("event_id = ? AND status = ?", eventID, domain.EventReportStatusPending).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *eventReportRepository) GetReports(ctx context.Context, status *domain.EventReportStatus, pagination dto.PaginationRequest) ([]*domain.EventReport, *dto.PaginationResponse, error) {
+	var reports []*domain.EventReport
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&domain.EventReport{})
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, nil, err
+	}
+
+	offset := pagination.GetOffset()
+	pageSize := pagination.GetPageSizeWithDefault()
+
+	err := query.
+		Preload("Event").
+		Preload("Reporter").
+		Offset(offset).
+		Limit(pageSize).
+		Order("created_at DESC, id DESC").
+		Find(&reports).Error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
+
+	return reports, paginationResponse, nil
+}
@@ -2,13 +2,19 @@ package postgres
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/louco-event/internal/domain"
 	"github.com/louco-event/internal/dto"
 	"github.com/louco-event/internal/repository"
+	"github.com/louco-event/pkg/pagination"
 )
 
 type eventRepository struct {
@@ -24,6 +30,38 @@ func (r *eventRepository) Create(ctx context.Context, event *domain.Event) error
 	return r.db.WithContext(ctx).Create(event).Error
 }
 
+func (r *eventRepository) CreateWithInvitations(ctx context.Context, event *domain.Event, categoryIDs []int, invitations []*domain.Invitation) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(event).Error; err != nil {
+			return err
+		}
+
+		if len(categoryIDs) > 0 {
+			eventCategories := make([]domain.EventCategory, len(categoryIDs))
+			for i, categoryID := range categoryIDs {
+				eventCategories[i] = domain.EventCategory{
+					EventID:    event.ID,
+					CategoryID: categoryID,
+					CreatedAt:  time.Now(),
+				}
+			}
+			if err := tx.Create(&eventCategories).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(invitations) == 0 {
+			return nil
+		}
+
+		for _, invitation := range invitations {
+			invitation.EventID = event.ID
+		}
+
+		return tx.Create(&invitations).Error
+	})
+}
+
 func (r *eventRepository) GetByID(ctx context.Context, id int) (*domain.Event, error) {
 	var event domain.Event
 	err := r.db.WithContext(ctx).
@@ -36,6 +74,8 @@ func (r *eventRepository) GetByID(ctx context.Context, id int) (*domain.Event, e
 		Preload("Tickets").
 		Preload("Invitations").
 		Preload("Invitations.InvitedUser").
+		Preload("CoHosts", "accepted_at IS NOT NULL").
+		Preload("CoHosts.Creator").
 		First(&event, id).Error
 	if err != nil {
 		return nil, err
@@ -56,6 +96,8 @@ func (r *eventRepository) GetByIDWithRelations(ctx context.Context, id int) (*do
 		Preload("Tickets").
 		Preload("Invitations").
 		Preload("Invitations.InvitedUser").
+		Preload("CoHosts", "accepted_at IS NOT NULL").
+		Preload("CoHosts.Creator").
 		First(&event, id).Error
 	if err != nil {
 		return nil, err
@@ -71,6 +113,136 @@ func (r *eventRepository) Delete(ctx context.Context, id int) error {
 	return r.db.WithContext(ctx).Delete(&domain.Event{}, id).Error
 }
 
+// Trash operations
+func (r *eventRepository) GetTrashed(ctx context.Context, creatorID int, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error) {
+	var events []*domain.Event
+	var total int64
+
+	query := r.db.WithContext(ctx).Unscoped().Model(&domain.Event{}).Where("events.deleted_at IS NOT NULL")
+	if creatorID > 0 {
+		query = query.Where("events.creator_id = ?", creatorID)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, nil, err
+	}
+
+	offset := pagination.GetOffset()
+	pageSize := pagination.GetPageSizeWithDefault()
+
+	err := query.
+		Preload("Creator").
+		Preload("Creator.User").
+		Preload("Image").
+		Preload("Address").
+		Preload("Categories").
+		Offset(offset).
+		Limit(pageSize).
+		Order("events.deleted_at DESC, events.id DESC").
+		Find(&events).Error
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
+
+	return events, paginationResponse, nil
+}
+
+func (r *eventRepository) Restore(ctx context.Context, id, creatorID int) error {
+	result := r.db.WithContext(ctx).Unscoped().Model(&domain.Event{}).
+		Where("id = ? AND creator_id = ? AND deleted_at IS NOT NULL", id, creatorID).
+		Update("deleted_at", nil)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *eventRepository) GetPurgeableIDs(ctx context.Context, deletedBefore time.Time, limit int) ([]int, error) {
+	var ids []int
+	err := r.db.WithContext(ctx).Unscoped().Model(&domain.Event{}).
+		Where("events.deleted_at IS NOT NULL AND events.deleted_at < ?", deletedBefore).
+		Where("NOT EXISTS (?)", eventPurchasesQuery(r.db).Where("tickets.event_id = events.id")).
+		Order("events.id ASC").
+		Limit(limit).
+		Pluck("events.id", &ids).Error
+	return ids, err
+}
+
+// eventPurgeChildren are the tables whose rows belong to one event and go with it on purge,
+// in delete order. Media is left alone: it lives in the uploader's library and may be shared.
+var eventPurgeChildren = []any{
+	&domain.Invitation{},
+	&domain.EventCategory{},
+	&domain.EventCoHost{},
+	&domain.EventInterest{},
+	&domain.EventJoinRequest{},
+	&domain.EventRating{},
+	&domain.EventReport{},
+	&domain.ShareTokenAccess{},
+	&domain.EventView{},
+	&domain.EventHistory{},
+	&domain.Waitlist{},
+}
+
+func (r *eventRepository) Purge(ctx context.Context, id int) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var event domain.Event
+		err := tx.Unscoped().
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND deleted_at IS NOT NULL", id).
+			First(&event).Error
+		if err != nil {
+			return err
+		}
+
+		// Purchases are financial records and are never deleted with an event
+		var hasPurchases bool
+		err = tx.Raw("SELECT EXISTS (?)", eventPurchasesQuery(tx).Where("tickets.event_id = ?", id)).
+			Scan(&hasPurchases).Error
+		if err != nil {
+			return err
+		}
+		if hasPurchases {
+			return domain.ErrEventHasPurchases
+		}
+
+		ticketIDs := tx.Model(&domain.Ticket{}).Select("id").Where("event_id = ?", id)
+		if err := tx.Where("ticket_id IN (?)", ticketIDs).Delete(&domain.TicketReservation{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("event_id = ?", id).Delete(&domain.Ticket{}).Error; err != nil {
+			return err
+		}
+		for _, model := range eventPurgeChildren {
+			if err := tx.Where("event_id = ?", id).Delete(model).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Unscoped().Delete(&event).Error
+	})
+}
+
+// eventNotDeleted limits rows that reference an event to events that are not in the trash. GORM
+// only hides soft-deleted rows of the model being queried, not of the events behind event_id.
+func eventNotDeleted(db *gorm.DB) *gorm.DB {
+	return db.Where("event_id IN (?)", db.Session(&gorm.Session{NewDB: true}).Model(&domain.Event{}).Select("id"))
+}
+
+// eventPurchasesQuery selects ticket purchases joined to their tickets; callers narrow it to an event
+func eventPurchasesQuery(db *gorm.DB) *gorm.DB {
+	return db.Session(&gorm.Session{NewDB: true}).
+		Table("ticket_purchases").
+		Select("1").
+		Joins("JOIN tickets ON tickets.id = ticket_purchases.ticket_id")
+}
+
 // Creator-specific operations
 func (r *eventRepository) GetByCreatorID(ctx context.Context, creatorID int, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error) {
 	var events []*domain.Event
@@ -99,29 +271,28 @@ func (r *eventRepository) GetByCreatorID(ctx context.Context, creatorID int, pag
 		Preload("Invitations.InvitedUser").
 		Offset(offset).
 		Limit(pageSize).
-		Order("created_at DESC").
+		Order("created_at DESC, id DESC").
 		Find(&events).Error
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
 
 	return events, paginationResponse, nil
 }
 
 func (r *eventRepository) GetByCreatorIDAndStatus(ctx context.Context, creatorID int, status domain.EventStatus, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error) {
+	return r.GetByCreatorIDAndStatuses(ctx, creatorID, []domain.EventStatus{status}, pagination)
+}
+
+func (r *eventRepository) GetByCreatorIDAndStatuses(ctx context.Context, creatorID int, statuses []domain.EventStatus, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error) {
 	var events []*domain.Event
 	var total int64
 
 	query := r.db.WithContext(ctx).Model(&domain.Event{}).
-		Where("creator_id = ? AND status = ?", creatorID, status)
+		Where("creator_id = ? AND status IN ?", creatorID, statuses)
 
 	// Count total
 	if err := query.Count(&total).Error; err != nil {
@@ -144,19 +315,14 @@ func (r *eventRepository) GetByCreatorIDAndStatus(ctx context.Context, creatorID
 		Preload("Invitations.InvitedUser").
 		Offset(offset).
 		Limit(pageSize).
-		Order("created_at DESC").
+		Order("created_at DESC, id DESC").
 		Find(&events).Error
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
 
 	return events, paginationResponse, nil
 }
@@ -177,6 +343,27 @@ func (r *eventRepository) CountByCreatorIDAndStatus(ctx context.Context, creator
 	return count, err
 }
 
+func (r *eventRepository) CountByCreatorIDGroupedByStatus(ctx context.Context, creatorID int) (map[domain.EventStatus]int64, error) {
+	var rows []struct {
+		Status domain.EventStatus
+		Count  int64
+	}
+	err := r.db.WithContext(ctx).Model(&domain.Event{}).
+		Select("status, COUNT(*) AS count").
+		Where("creator_id = ?", creatorID).
+		Group("status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[domain.EventStatus]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts, nil
+}
+
 // Public event operations
 func (r *eventRepository) GetPublicEvents(ctx context.Context, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error) {
 	var events []*domain.Event
@@ -204,23 +391,42 @@ func (r *eventRepository) GetPublicEvents(ctx context.Context, pagination dto.Pa
 		Preload("Tickets").
 		Offset(offset).
 		Limit(pageSize).
-		Order("created_at DESC").
+		Order("created_at DESC, id DESC").
 		Find(&events).Error
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
 
 	return events, paginationResponse, nil
 }
 
+func (r *eventRepository) GetPublicEventsAfter(ctx context.Context, cursor *pagination.Cursor, limit int) ([]*domain.Event, error) {
+	var events []*domain.Event
+
+	query := r.db.WithContext(ctx).Model(&domain.Event{}).
+		Where("type = ? AND status = ?", domain.EventTypePublic, domain.EventStatusPublished)
+	if cursor != nil {
+		query = query.Where("(created_at, id) < (?, ?)", cursor.CreatedAt, cursor.ID)
+	}
+
+	err := query.
+		Preload("Creator").
+		Preload("Creator.User").
+		Preload("Image").
+		Preload("Video").
+		Preload("Address").
+		Preload("Categories").
+		Preload("Tickets").
+		Limit(limit).
+		Order("created_at DESC, id DESC").
+		Find(&events).Error
+
+	return events, err
+}
+
 func (r *eventRepository) GetPublicEventsByCategory(ctx context.Context, categoryID int, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error) {
 	var events []*domain.Event
 	var total int64
@@ -249,24 +455,19 @@ func (r *eventRepository) GetPublicEventsByCategory(ctx context.Context, categor
 		Preload("Tickets").
 		Offset(offset).
 		Limit(pageSize).
-		Order("events.created_at DESC").
+		Order("events.created_at DESC, events.id DESC").
 		Find(&events).Error
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
 
 	return events, paginationResponse, nil
 }
 
-func (r *eventRepository) GetPublicEventsByLocation(ctx context.Context, city string, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error) {
+func (r *eventRepository) GetPublicEventsByLocation(ctx context.Context, city, country string, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error) {
 	var events []*domain.Event
 	var total int64
 
@@ -274,6 +475,10 @@ func (r *eventRepository) GetPublicEventsByLocation(ctx context.Context, city st
 		Joins("JOIN addresses ON events.address_id = addresses.id").
 		Where("addresses.city ILIKE ? AND events.type = ? AND events.status = ?",
 			"%"+city+"%", domain.EventTypePublic, domain.EventStatusPublished)
+	// Same city names exist in several countries; narrow down when a country is given
+	if country != "" {
+		query = query.Where("addresses.country ILIKE ?", "%"+country+"%")
+	}
 
 	// Count total
 	if err := query.Count(&total).Error; err != nil {
@@ -294,19 +499,14 @@ func (r *eventRepository) GetPublicEventsByLocation(ctx context.Context, city st
 		Preload("Tickets").
 		Offset(offset).
 		Limit(pageSize).
-		Order("events.created_at DESC").
+		Order("events.created_at DESC, events.id DESC").
 		Find(&events).Error
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
 
 	return events, paginationResponse, nil
 }
@@ -338,19 +538,14 @@ func (r *eventRepository) SearchPublicEvents(ctx context.Context, query string,
 		Preload("Tickets").
 		Offset(offset).
 		Limit(pageSize).
-		Order("created_at DESC").
+		Order("created_at DESC, id DESC").
 		Find(&events).Error
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
 
 	return events, paginationResponse, nil
 }
@@ -383,20 +578,59 @@ func (r *eventRepository) GetByStatus(ctx context.Context, status domain.EventSt
 		Preload("Invitations.InvitedUser").
 		Offset(offset).
 		Limit(pageSize).
-		Order("created_at DESC").
+		Order("created_at DESC, id DESC").
 		Find(&events).Error
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
+
+	return events, paginationResponse, nil
+}
+
+func (r *eventRepository) GetByStatusWithFilters(ctx context.Context, filters dto.AdminEventFilterRequest, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error) {
+	var events []*domain.Event
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&domain.Event{}).Where("events.status = ?", filters.Status)
+	if filters.CreatorID != nil {
+		query = query.Where("events.creator_id = ?", *filters.CreatorID)
+	}
+	if filters.StartDate != nil {
+		query = query.Where("events.start_date >= ?", *filters.StartDate)
+	}
+	if filters.EndDate != nil {
+		query = query.Where("events.start_date <= ?", *filters.EndDate)
+	}
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, nil, err
+	}
+
+	// Get paginated results
+	offset := pagination.GetOffset()
+	pageSize := pagination.GetPageSizeWithDefault()
+
+	err := query.
+		Preload("Creator").
+		Preload("Creator.User").
+		Preload("Image").
+		Preload("Address").
+		Preload("Categories").
+		Offset(offset).
+		Limit(pageSize).
+		Order("events.created_at DESC, events.id DESC").
+		Find(&events).Error
+
+	if err != nil {
+		return nil, nil, err
 	}
 
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
+
 	return events, paginationResponse, nil
 }
 
@@ -409,6 +643,117 @@ func (r *eventRepository) UpdateStatus(ctx context.Context, id int, status domai
 		}).Error
 }
 
+func (r *eventRepository) UpdateSalesClosure(ctx context.Context, id int, salesClosedAt *time.Time, closeAtStart bool) error {
+	return r.db.WithContext(ctx).Model(&domain.Event{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"sales_closed_at":      salesClosedAt,
+			"close_sales_at_start": closeAtStart,
+		}).Error
+}
+
+func (r *eventRepository) UpdateShareToken(ctx context.Context, event *domain.Event) error {
+	return r.db.WithContext(ctx).Model(&domain.Event{}).
+		Where("id = ?", event.ID).
+		Updates(map[string]interface{}{
+			"share_token":            event.ShareToken,
+			"share_token_enabled":    event.ShareTokenEnabled,
+			"share_token_created_at": event.ShareTokenCreatedAt,
+			"updated_at":             event.UpdatedAt,
+		}).Error
+}
+
+// RaiseCapacityWarningLevel moves the event's capacity warning level up to threshold. It reports
+// false when the level was already there, so concurrent sales warn only once.
+func (r *eventRepository) RaiseCapacityWarningLevel(ctx context.Context, id int, threshold int) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&domain.Event{}).
+		Where("id = ? AND capacity_warning_level < ?", id, threshold).
+		Update("capacity_warning_level", threshold)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *eventRepository) CountAttendees(ctx context.Context, id int) (int, error) {
+	return countAttendees(r.db.WithContext(ctx), id)
+}
+
+func countAttendees(db *gorm.DB, eventID int) (int, error) {
+	var attendees int
+	err := db.Raw(`
+		SELECT
+			(SELECT COUNT(*) FROM invitations WHERE event_id = ? AND status IN ?) +
+			(SELECT COALESCE(SUM(sold_quantity), 0) FROM tickets WHERE event_id = ?)`,
+		eventID, domain.ApprovedInvitationStatuses, eventID,
+	).Scan(&attendees).Error
+	return attendees, err
+}
+
+// lockEventForSale locks the event row and checks that its sales are open and that seats more
+// attendees fit its capacity. Sales of one event wait for each other on the lock, so two buyers
+// cannot both take the last seat. Callers lock events before tickets.
+func lockEventForSale(tx *gorm.DB, eventID, seats int) error {
+	var event domain.Event
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&event, eventID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.ErrEventNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	if event.AreSalesClosed() {
+		return domain.ErrEventSalesClosed
+	}
+	if event.Capacity == nil {
+		return nil
+	}
+	attendees, err := countAttendees(tx, eventID)
+	if err != nil {
+		return err
+	}
+	return event.CheckCapacity(attendees, seats)
+}
+
+func (r *eventRepository) GetAttendeeEmails(ctx context.Context, id int) ([]string, error) {
+	var emails []string
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT invited_email FROM invitations
+			WHERE event_id = ? AND status IN ? AND invited_email <> ''
+		UNION
+		SELECT users.email FROM ticket_purchases
+			JOIN tickets ON tickets.id = ticket_purchases.ticket_id
+			JOIN users ON users.id = ticket_purchases.user_id
+			WHERE tickets.event_id = ? AND users.email IS NOT NULL AND users.email <> ''`,
+		id, domain.ApprovedInvitationStatuses, id,
+	).Scan(&emails).Error
+	return emails, err
+}
+
+// RaiseInterestMilestoneLevel works like RaiseCapacityWarningLevel for interest milestones
+func (r *eventRepository) RaiseInterestMilestoneLevel(ctx context.Context, id int, threshold int) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&domain.Event{}).
+		Where("id = ? AND interest_milestone_level < ?", id, threshold).
+		Update("interest_milestone_level", threshold)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// GetByShareToken finds the event behind an enabled share link
+func (r *eventRepository) GetByShareToken(ctx context.Context, token string) (*domain.Event, error) {
+	var event domain.Event
+	err := r.db.WithContext(ctx).
+		Where("share_token = ? AND share_token_enabled = ?", token, true).
+		First(&event).Error
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
 func (r *eventRepository) GetPendingEvents(ctx context.Context, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error) {
 	return r.GetByStatus(ctx, domain.EventStatusPending, pagination)
 }
@@ -426,6 +771,26 @@ func (r *eventRepository) AddCategories(ctx context.Context, eventID int, catego
 	return r.db.WithContext(ctx).Create(&eventCategories).Error
 }
 
+func (r *eventRepository) AddCategoryToEvents(ctx context.Context, eventIDs []int, categoryID int) ([]int, error) {
+	if len(eventIDs) == 0 {
+		return nil, nil
+	}
+
+	values := make([]string, 0, len(eventIDs))
+	args := make([]interface{}, 0, len(eventIDs)*2)
+	for _, eventID := range eventIDs {
+		values = append(values, "(?, ?, NOW())")
+		args = append(args, eventID, categoryID)
+	}
+
+	var added []int
+	err := r.db.WithContext(ctx).
+		Raw("INSERT INTO event_categories (event_id, category_id, created_at) VALUES "+strings.Join(values, ", ")+
+			" ON CONFLICT (event_id, category_id) DO NOTHING RETURNING event_id", args...).
+		Scan(&added).Error
+	return added, err
+}
+
 func (r *eventRepository) RemoveCategories(ctx context.Context, eventID int, categoryIDs []int) error {
 	return r.db.WithContext(ctx).
 		Where("event_id = ? AND category_id IN ?", eventID, categoryIDs).
@@ -483,19 +848,14 @@ func (r *eventRepository) GetEventsByCategories(ctx context.Context, categoryIDs
 		Preload("Tickets").
 		Offset(offset).
 		Limit(pageSize).
-		Order("events.created_at DESC").
+		Order("events.created_at DESC, events.id DESC").
 		Find(&events).Error
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
 
 	return events, paginationResponse, nil
 }
@@ -532,19 +892,14 @@ func (r *eventRepository) GetPrivateEventsByInvitedUser(ctx context.Context, use
 		Preload("Invitations.InvitedUser").
 		Offset(offset).
 		Limit(pageSize).
-		Order("events.created_at DESC").
+		Order("events.created_at DESC, events.id DESC").
 		Find(&events).Error
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
 
 	return events, paginationResponse, nil
 }
@@ -580,19 +935,14 @@ func (r *eventRepository) GetPrivateEventsByInvitedEmail(ctx context.Context, em
 		Preload("Invitations.InvitedUser").
 		Offset(offset).
 		Limit(pageSize).
-		Order("events.created_at DESC").
+		Order("events.created_at DESC, events.id DESC").
 		Find(&events).Error
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
 
 	return events, paginationResponse, nil
 }
@@ -620,12 +970,27 @@ func (r *eventRepository) IsEventOwner(ctx context.Context, eventID, creatorID i
 // This is a partial implementation focusing on the core functionality
 
 // Date-based operations
-func (r *eventRepository) GetEventsByDateRange(ctx context.Context, startDate, endDate string, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error) {
+func (r *eventRepository) GetEventsByDateRange(ctx context.Context, startDate, endDate string, includeAnnouncements bool, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error) {
+	return r.getPublishedEventsByStartDate(ctx, "start_date >= ? AND start_date <= ?", []interface{}{startDate, endDate}, includeAnnouncements, pagination)
+}
+
+func (r *eventRepository) GetEventsStartingFrom(ctx context.Context, startDate string, includeAnnouncements bool, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error) {
+	return r.getPublishedEventsByStartDate(ctx, "start_date >= ?", []interface{}{startDate}, includeAnnouncements, pagination)
+}
+
+// getPublishedEventsByStartDate lists published events whose start date matches dateCondition,
+// optionally followed by undated announcements
+func (r *eventRepository) getPublishedEventsByStartDate(ctx context.Context, dateCondition string, args []interface{}, includeAnnouncements bool, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error) {
 	var events []*domain.Event
 	var total int64
 
-	query := r.db.WithContext(ctx).Model(&domain.Event{}).
-		Where("start_date >= ? AND start_date <= ? AND status = ?", startDate, endDate, domain.EventStatusPublished)
+	query := r.db.WithContext(ctx).Model(&domain.Event{}).Where("status = ?", domain.EventStatusPublished)
+	if includeAnnouncements {
+		query = query.Where("("+dateCondition+") OR (start_date IS NULL AND location_type = ?)",
+			append(args, domain.EventLocationTypeAnnouncement)...)
+	} else {
+		query = query.Where(dateCondition, args...)
+	}
 
 	// Count total
 	if err := query.Count(&total).Error; err != nil {
@@ -644,26 +1009,135 @@ func (r *eventRepository) GetEventsByDateRange(ctx context.Context, startDate, e
 		Preload("Categories").
 		Offset(offset).
 		Limit(pageSize).
-		Order("start_date ASC").
+		Order("start_date ASC NULLS LAST, created_at DESC, id DESC").
 		Find(&events).Error
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
 
 	return events, paginationResponse, nil
 }
 
-func (r *eventRepository) GetUpcomingEvents(ctx context.Context, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error) {
-	today := time.Now().Format("2006-01-02")
-	return r.GetEventsByDateRange(ctx, today, "2099-12-31", pagination)
+func (r *eventRepository) GetAnnouncements(ctx context.Context, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error) {
+	query := r.db.WithContext(ctx).Model(&domain.Event{}).
+		Where("events.type = ? AND events.status = ? AND events.location_type = ?",
+			domain.EventTypePublic, domain.EventStatusPublished, domain.EventLocationTypeAnnouncement)
+
+	return r.findEventsPage(query, pagination, "events.created_at DESC, events.id DESC")
+}
+
+// Event start and end as absolute instants. Dates and times are wall-clock values in the
+// event's timezone, UTC when it has none. Events without an end run for
+// domain.DefaultEventDuration; an end time without an end date ends on the start date.
+var (
+	eventTimezoneSQL = "COALESCE(NULLIF(events.timezone, ''), 'UTC')"
+	eventStartAtSQL  = fmt.Sprintf("((events.start_date + COALESCE(events.start_time, TIME '00:00')) AT TIME ZONE %s)", eventTimezoneSQL)
+	eventEndAtSQL    = fmt.Sprintf(`(CASE
+		WHEN events.end_date IS NOT NULL THEN (events.end_date + COALESCE(events.end_time, TIME '23:59:59')) AT TIME ZONE %[1]s
+		WHEN events.end_time IS NOT NULL THEN (events.start_date + events.end_time) AT TIME ZONE %[1]s
+		ELSE %[2]s + INTERVAL '%[3]d minutes'
+	END)`, eventTimezoneSQL, eventStartAtSQL, int(domain.DefaultEventDuration.Minutes()))
+)
+
+func (r *eventRepository) GetOngoingEvents(ctx context.Context, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error) {
+	now := time.Now()
+	query := r.db.WithContext(ctx).Model(&domain.Event{}).
+		Where("events.type = ? AND events.status = ?", domain.EventTypePublic, domain.EventStatusPublished).
+		Where("events.start_date IS NOT NULL").
+		Where(eventStartAtSQL+" <= ?", now).
+		Where(eventEndAtSQL+" > ?", now)
+
+	return r.findEventsPage(query, pagination, eventEndAtSQL+" ASC, events.id ASC")
+}
+
+func (r *eventRepository) GetCreatorUpcomingEvents(ctx context.Context, creatorID int, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error) {
+	now := time.Now()
+	query := r.db.WithContext(ctx).Model(&domain.Event{}).
+		Where("events.creator_id = ? AND events.type = ? AND events.status = ?", creatorID, domain.EventTypePublic, domain.EventStatusPublished).
+		Where("events.start_date IS NOT NULL").
+		Where(eventStartAtSQL+" > ?", now)
+
+	return r.findEventsPage(query, pagination, eventStartAtSQL+" ASC, events.id ASC")
+}
+
+// eventSoldOutSQL matches events selling system tickets whose active tiers are all sold out
+const eventSoldOutSQL = `(events.has_system_tickets AND NOT EXISTS (
+	SELECT 1 FROM tickets
+	WHERE tickets.event_id = events.id AND tickets.is_active AND tickets.sold_quantity < tickets.total_quantity
+))`
+
+func (r *eventRepository) GetAttentionCandidates(ctx context.Context, creatorID int, startingBefore time.Time, limit int) ([]*domain.Event, error) {
+	now := time.Now()
+	startingSoon := r.db.
+		Where("events.status = ? AND events.has_system_tickets", domain.EventStatusPublished).
+		Where("events.start_date IS NOT NULL").
+		Where(eventStartAtSQL+" > ?", now).
+		Where(eventStartAtSQL+" <= ?", startingBefore)
+
+	var events []*domain.Event
+	err := r.db.WithContext(ctx).
+		Where("events.creator_id = ?", creatorID).
+		Where(r.db.Where("events.status IN ?", []domain.EventStatus{domain.EventStatusRejected, domain.EventStatusDraft}).Or(startingSoon)).
+		Preload("Image").
+		Preload("Address").
+		Preload("Categories").
+		Preload("Tickets").
+		Order("events.updated_at DESC, events.id DESC").
+		Limit(limit).
+		Find(&events).Error
+	return events, err
+}
+
+func (r *eventRepository) GetEventsStartingSoon(ctx context.Context, before time.Time, city *string, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error) {
+	now := time.Now()
+	query := r.db.WithContext(ctx).Model(&domain.Event{}).
+		Where("events.type = ? AND events.status = ?", domain.EventTypePublic, domain.EventStatusPublished).
+		Where("events.start_date IS NOT NULL").
+		Where(eventStartAtSQL+" > ?", now).
+		Where(eventStartAtSQL+" <= ?", before).
+		Where("NOT " + eventSoldOutSQL)
+
+	if city != nil {
+		query = query.
+			Joins("JOIN addresses ON events.address_id = addresses.id").
+			Where("addresses.city ILIKE ?", "%"+*city+"%")
+	}
+
+	return r.findEventsPage(query, pagination, eventStartAtSQL+" ASC, events.id ASC")
+}
+
+// findEventsPage counts the query, then loads one page of it with the list relations
+func (r *eventRepository) findEventsPage(query *gorm.DB, pagination dto.PaginationRequest, order string) ([]*domain.Event, *dto.PaginationResponse, error) {
+	var events []*domain.Event
+	var total int64
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, nil, err
+	}
+
+	offset := pagination.GetOffset()
+	pageSize := pagination.GetPageSizeWithDefault()
+
+	err := query.
+		Preload("Creator").
+		Preload("Creator.User").
+		Preload("Image").
+		Preload("Address").
+		Preload("Categories").
+		Offset(offset).
+		Limit(pageSize).
+		Order(order).
+		Find(&events).Error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
+
+	return events, paginationResponse, nil
 }
 
 func (r *eventRepository) GetPastEvents(ctx context.Context, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error) {
@@ -691,34 +1165,64 @@ func (r *eventRepository) GetPastEvents(ctx context.Context, pagination dto.Pagi
 		Preload("Categories").
 		Offset(offset).
 		Limit(pageSize).
-		Order("start_date DESC").
+		Order("start_date DESC, id DESC").
 		Find(&events).Error
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
 
 	return events, paginationResponse, nil
 }
 
 // Bulk operations
+// Limits for id-list lookups. Long lists are split so each IN clause, and the IN clauses of its
+// preloads, stay well inside the Postgres bind parameter limit.
+const (
+	maxBatchIDs      = 5000
+	batchIDChunkSize = 500
+)
+
+// chunkIDs validates an id list against maxBatchIDs and splits it into batchIDChunkSize pieces
+func chunkIDs(ids []int) ([][]int, error) {
+	if len(ids) > maxBatchIDs {
+		return nil, fmt.Errorf("too many ids requested: %d exceeds the limit of %d", len(ids), maxBatchIDs)
+	}
+
+	chunks := make([][]int, 0, (len(ids)+batchIDChunkSize-1)/batchIDChunkSize)
+	for start := 0; start < len(ids); start += batchIDChunkSize {
+		end := min(start+batchIDChunkSize, len(ids))
+		chunks = append(chunks, ids[start:end])
+	}
+	return chunks, nil
+}
+
 func (r *eventRepository) GetMultipleByIDs(ctx context.Context, ids []int) ([]*domain.Event, error) {
-	var events []*domain.Event
-	err := r.db.WithContext(ctx).
-		Preload("Creator").
-		Preload("Image").
-		Preload("Address").
-		Preload("Categories").
-		Where("id IN ?", ids).
-		Find(&events).Error
-	return events, err
+	chunks, err := chunkIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*domain.Event, 0, len(ids))
+	for _, chunk := range chunks {
+		var batch []*domain.Event
+		err := r.db.WithContext(ctx).
+			Preload("Creator").
+			Preload("Image").
+			Preload("Address").
+			Preload("Categories").
+			Preload("CoHosts", "accepted_at IS NOT NULL").
+			Preload("CoHosts.Creator").
+			Where("id IN ?", chunk).
+			Find(&batch).Error
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, batch...)
+	}
+	return events, nil
 }
 
 func (r *eventRepository) UpdateMultipleStatus(ctx context.Context, ids []int, status domain.EventStatus) error {
@@ -760,6 +1264,31 @@ func (r *eventRepository) GetEventStats(ctx context.Context, creatorID int) (*dt
 	return stats, nil
 }
 
+func (r *eventRepository) GetEventPublicStats(ctx context.Context, eventID int) (*dto.EventPublicStatsResponse, error) {
+	stats := &dto.EventPublicStatsResponse{EventID: eventID}
+
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT
+			(SELECT COUNT(*) FROM event_views WHERE event_id = ?) AS view_count,
+			(SELECT COALESCE(SUM(sold_quantity), 0) FROM tickets WHERE event_id = ?) AS tickets_sold,
+			(SELECT COALESCE(SUM(total_quantity), 0) FROM tickets WHERE event_id = ?) AS capacity,
+			(SELECT COUNT(DISTINCT ticket_purchases.user_id)
+				FROM ticket_purchases
+				JOIN tickets ON tickets.id = ticket_purchases.ticket_id
+				WHERE tickets.event_id = ?) AS going_count`,
+		eventID, eventID, eventID, eventID,
+	).Scan(stats).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if stats.Capacity > 0 {
+		stats.CapacityUsedPercent = math.Round(float64(stats.TicketsSold)/float64(stats.Capacity)*10000) / 100
+	}
+
+	return stats, nil
+}
+
 func (r *eventRepository) GetSystemEventStats(ctx context.Context) (*dto.SystemEventStatsResponse, error) {
 	stats := &dto.SystemEventStatsResponse{}
 
@@ -778,83 +1307,147 @@ func (r *eventRepository) GetSystemEventStats(ctx context.Context) (*dto.SystemE
 }
 
 // Advanced filtering with complex where conditions
-func (r *eventRepository) GetEventsWithFilters(ctx context.Context, filters dto.EventFilterRequest, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error) {
+func (r *eventRepository) GetEventsWithFilters(ctx context.Context, filters dto.EventFilterRequest, viewerID *int, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error) {
 	var events []*domain.Event
 	var total int64
 
+	query := r.filteredEventsQuery(ctx, filters, viewerID)
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, nil, err
+	}
+
+	// Get paginated results
+	offset := pagination.GetOffset()
+	pageSize := pagination.GetPageSizeWithDefault()
+
+	if filters.HasCoordinates() {
+		query = query.Select("events.*, "+haversineDistanceSQL+" AS distance_km", *filters.Latitude, *filters.Longitude, *filters.Latitude)
+		if filters.SortsByDistance() {
+			query = query.Order("distance_km ASC")
+		}
+	}
+	if filters.SortsByName() {
+		query = query.Order(orderByName("events.name", filters.Locale))
+	}
+
+	err := query.
+		Preload("Creator").
+		Preload("Creator.User").
+		Preload("Image").
+		Preload("Address").
+		Preload("Categories").
+		Offset(offset).
+		Limit(pageSize).
+		Order("events.created_at DESC, events.id DESC").
+		Find(&events).Error
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
+
+	return events, paginationResponse, nil
+}
+
+func (r *eventRepository) CountEventsWithFilters(ctx context.Context, filters dto.EventFilterRequest, viewerID *int) (int64, error) {
+	var total int64
+	err := r.filteredEventsQuery(ctx, filters, viewerID).Count(&total).Error
+	return total, err
+}
+
+// haversineDistanceSQL is the distance in km from (latitude, longitude, latitude) to the joined address.
+// LEAST guards acos against rounding just above 1 for identical points.
+const haversineDistanceSQL = "(6371 * acos(LEAST(1, cos(radians(?)) * cos(radians(addresses.latitude)) * cos(radians(addresses.longitude) - radians(?)) + sin(radians(?)) * sin(radians(addresses.latitude)))))"
+
+// filteredEventsQuery builds the query shared by GetEventsWithFilters and CountEventsWithFilters,
+// so a count always matches what a paginated fetch would return.
+func (r *eventRepository) filteredEventsQuery(ctx context.Context, filters dto.EventFilterRequest, viewerID *int) *gorm.DB {
 	query := r.db.WithContext(ctx).Model(&domain.Event{})
 
 	// Apply filters
 	if filters.Type != nil {
-		query = query.Where("type = ?", *filters.Type)
+		query = query.Where("events.type = ?", *filters.Type)
 	}
 	if filters.LocationType != nil {
-		query = query.Where("location_type = ?", *filters.LocationType)
+		query = query.Where("events.location_type = ?", *filters.LocationType)
 	}
 	if filters.Status != nil {
-		query = query.Where("status = ?", *filters.Status)
+		query = query.Where("events.status = ?", *filters.Status)
 	}
 	if filters.CreatorID != nil {
-		query = query.Where("creator_id = ?", *filters.CreatorID)
+		query = query.Where("events.creator_id = ?", *filters.CreatorID)
 	}
 	if filters.StartDate != nil {
-		query = query.Where("start_date >= ?", *filters.StartDate)
+		query = query.Where("events.start_date >= ?", *filters.StartDate)
 	}
 	if filters.EndDate != nil {
-		query = query.Where("start_date <= ?", *filters.EndDate)
+		query = query.Where("events.start_date <= ?", *filters.EndDate)
 	}
 	if filters.Query != nil && *filters.Query != "" {
-		query = query.Where("(name ILIKE ? OR description ILIKE ?)", "%"+*filters.Query+"%", "%"+*filters.Query+"%")
+		query = query.Where("(events.name ILIKE ? OR events.description ILIKE ?)", "%"+*filters.Query+"%", "%"+*filters.Query+"%")
 	}
 
-	// Category filter
-	if len(filters.CategoryIDs) > 0 {
-		query = query.Joins("JOIN event_categories ON events.id = event_categories.event_id").
-			Where("event_categories.category_id IN ?", filters.CategoryIDs)
+	// Category filter (subquery so events with several matching categories are not duplicated)
+	if len(filters.CategoryIDs) > 0 && filters.MatchesAllCategories() {
+		query = query.Where(`events.id IN (SELECT event_id FROM event_categories WHERE category_id IN ?
+			GROUP BY event_id HAVING COUNT(DISTINCT category_id) = ?)`, filters.CategoryIDs, countDistinct(filters.CategoryIDs))
+	} else if len(filters.CategoryIDs) > 0 {
+		query = query.Where("events.id IN (SELECT event_id FROM event_categories WHERE category_id IN ?)", filters.CategoryIDs)
 	}
 
-	// Location filters
+	// Location filters share a single addresses join; adding it per filter makes Postgres
+	// reject the query with "table name addresses specified more than once"
+	if filters.HasAddressFilters() {
+		query = query.Joins("JOIN addresses ON events.address_id = addresses.id")
+	}
+	if filters.HasCoordinates() && filters.RadiusKm != nil {
+		query = query.Where(haversineDistanceSQL+" <= ?", *filters.Latitude, *filters.Longitude, *filters.Latitude, *filters.RadiusKm)
+	}
 	if filters.City != nil {
-		query = query.Joins("JOIN addresses ON events.address_id = addresses.id").
-			Where("addresses.city ILIKE ?", "%"+*filters.City+"%")
+		query = query.Where("addresses.city ILIKE ?", "%"+*filters.City+"%")
 	}
 	if filters.Country != nil {
-		query = query.Joins("JOIN addresses ON events.address_id = addresses.id").
-			Where("addresses.country ILIKE ?", "%"+*filters.Country+"%")
-	}
-
-	// Count total
-	if err := query.Count(&total).Error; err != nil {
-		return nil, nil, err
+		query = query.Where("addresses.country ILIKE ?", "%"+*filters.Country+"%")
 	}
 
-	// Get paginated results
-	offset := pagination.GetOffset()
-	pageSize := pagination.GetPageSizeWithDefault()
-
-	err := query.
-		Preload("Creator").
-		Preload("Creator.User").
-		Preload("Image").
-		Preload("Address").
-		Preload("Categories").
-		Offset(offset).
-		Limit(pageSize).
-		Order("created_at DESC").
-		Find(&events).Error
+	return query.Scopes(eventAccessScope(viewerID))
+}
 
-	if err != nil {
-		return nil, nil, err
+// countDistinct counts the different values in ids, so a repeated category id cannot make
+// the AND filter unsatisfiable
+func countDistinct(ids []int) int {
+	seen := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		seen[id] = true
 	}
+	return len(seen)
+}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+// eventAccessScope mirrors EventPolicy.CanView in SQL: published public events are visible to
+// everyone, creators and accepted co-hosts see all of their events and invited users, including
+// those only invited by email, see published private events.
+func eventAccessScope(viewerID *int) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if viewerID == nil {
+			return db.Where("events.type = ? AND events.status = ?", domain.EventTypePublic, domain.EventStatusPublished)
+		}
 
-	return events, paginationResponse, nil
+		return db.Where(`((events.type = ? AND events.status = ?)
+			OR events.creator_id IN (SELECT id FROM creators WHERE user_id = ?)
+			OR EXISTS (
+				SELECT 1 FROM event_co_hosts JOIN creators ON creators.id = event_co_hosts.creator_id
+				WHERE event_co_hosts.event_id = events.id AND event_co_hosts.accepted_at IS NOT NULL AND creators.user_id = ?)
+			OR (events.type = ? AND events.status = ? AND EXISTS (
+				SELECT 1 FROM invitations WHERE invitations.event_id = events.id AND (`+invitedUserSQL+`))))`,
+			domain.EventTypePublic, domain.EventStatusPublished,
+			*viewerID,
+			*viewerID,
+			domain.EventTypePrivate, domain.EventStatusPublished, *viewerID, *viewerID,
+		)
+	}
 }
 
 // Preloading operations
@@ -961,18 +1554,17 @@ func (r *eventRepository) PreloadAllRelations(ctx context.Context, events []*dom
 
 // Additional methods for location and type operations
 func (r *eventRepository) GetEventsByCity(ctx context.Context, city string, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error) {
-	return r.GetPublicEventsByLocation(ctx, city, pagination)
+	return r.GetPublicEventsByLocation(ctx, city, "", pagination)
 }
 
-func (r *eventRepository) GetEventsByCoordinates(ctx context.Context, latitude, longitude float64, radiusKm int, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error) {
+func (r *eventRepository) GetEventsByCoordinates(ctx context.Context, latitude, longitude float64, radiusKm int, sortByDistance bool, pagination dto.PaginationRequest) ([]*domain.Event, *dto.PaginationResponse, error) {
 	var events []*domain.Event
 	var total int64
 
-	// Using Haversine formula for distance calculation
 	query := r.db.WithContext(ctx).Model(&domain.Event{}).
 		Joins("JOIN addresses ON events.address_id = addresses.id").
-		Where("events.status = ? AND (6371 * acos(cos(radians(?)) * cos(radians(addresses.latitude)) * cos(radians(addresses.longitude) - radians(?)) + sin(radians(?)) * sin(radians(addresses.latitude)))) <= ?",
-			domain.EventStatusPublished, latitude, longitude, latitude, radiusKm)
+		Where("events.type = ? AND events.status = ?", domain.EventTypePublic, domain.EventStatusPublished).
+		Where(haversineDistanceSQL+" <= ?", latitude, longitude, latitude, radiusKm)
 
 	// Count total
 	if err := query.Count(&total).Error; err != nil {
@@ -983,6 +1575,11 @@ func (r *eventRepository) GetEventsByCoordinates(ctx context.Context, latitude,
 	offset := pagination.GetOffset()
 	pageSize := pagination.GetPageSizeWithDefault()
 
+	query = query.Select("events.*, "+haversineDistanceSQL+" AS distance_km", latitude, longitude, latitude)
+	if sortByDistance {
+		query = query.Order("distance_km ASC")
+	}
+
 	err := query.
 		Preload("Creator").
 		Preload("Creator.User").
@@ -991,19 +1588,14 @@ func (r *eventRepository) GetEventsByCoordinates(ctx context.Context, latitude,
 		Preload("Categories").
 		Offset(offset).
 		Limit(pageSize).
-		Order("events.created_at DESC").
+		Order("events.created_at DESC, events.id DESC").
 		Find(&events).Error
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
 
 	return events, paginationResponse, nil
 }
@@ -1034,19 +1626,14 @@ func (r *eventRepository) GetEventsByType(ctx context.Context, eventType domain.
 		Preload("Tickets").
 		Offset(offset).
 		Limit(pageSize).
-		Order("created_at DESC").
+		Order("created_at DESC, id DESC").
 		Find(&events).Error
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
 
 	return events, paginationResponse, nil
 }
@@ -1075,25 +1662,20 @@ func (r *eventRepository) GetEventsByLocationType(ctx context.Context, locationT
 		Preload("Categories").
 		Offset(offset).
 		Limit(pageSize).
-		Order("created_at DESC").
+		Order("created_at DESC, id DESC").
 		Find(&events).Error
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
 
 	return events, paginationResponse, nil
 }
 
 // Featured and trending events
-func (r *eventRepository) GetFeaturedEvents(ctx context.Context, limit int) ([]*domain.Event, error) {
+func (r *eventRepository) GetFeaturedEvents(ctx context.Context, filters dto.EventShowcaseRequest) ([]*domain.Event, error) {
 	var events []*domain.Event
 	err := r.db.WithContext(ctx).
 		Preload("Creator").
@@ -1101,27 +1683,92 @@ func (r *eventRepository) GetFeaturedEvents(ctx context.Context, limit int) ([]*
 		Preload("Image").
 		Preload("Address").
 		Preload("Categories").
-		Where("status = ? AND type = ?", domain.EventStatusPublished, domain.EventTypePublic).
-		Order("created_at DESC").
-		Limit(limit).
+		Scopes(eventShowcaseScope(filters)).
+		Where("events.featured = ?", true).
+		Order("events.featured_at DESC NULLS LAST, events.id DESC").
+		Limit(filters.Limit).
 		Find(&events).Error
 	return events, err
 }
 
-func (r *eventRepository) GetTrendingEvents(ctx context.Context, limit int) ([]*domain.Event, error) {
+func (r *eventRepository) SetFeatured(ctx context.Context, id int, featured bool) error {
+	var featuredAt *time.Time
+	if featured {
+		now := time.Now()
+		featuredAt = &now
+	}
+	return r.db.WithContext(ctx).Model(&domain.Event{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"featured":    featured,
+			"featured_at": featuredAt,
+		}).Error
+}
+
+// eventShowcaseScope limits featured and trending lists to published public events, optionally
+// of one category or in one city
+func eventShowcaseScope(filters dto.EventShowcaseRequest) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		db = db.Where("events.status = ? AND events.type = ?", domain.EventStatusPublished, domain.EventTypePublic)
+		if filters.CategoryID != nil {
+			db = db.Where("events.id IN (SELECT event_id FROM event_categories WHERE category_id = ?)", *filters.CategoryID)
+		}
+		if filters.City != nil && *filters.City != "" {
+			db = db.Where("events.address_id IN (SELECT id FROM addresses WHERE city ILIKE ?)", "%"+*filters.City+"%")
+		}
+		return db
+	}
+}
+
+// GetSimilarEvents ranks published public events by the number of categories they share with the
+// given event, then by whether they take place in the same city, then by recency. Events sharing
+// neither categories nor city are left out, as are the creator's copies of the same event.
+func (r *eventRepository) GetSimilarEvents(ctx context.Context, eventID int, limit int) ([]*domain.Event, error) {
+	const categoryOverlap = `(SELECT COUNT(*) FROM event_categories ec
+		WHERE ec.event_id = events.id
+		AND ec.category_id IN (SELECT category_id FROM event_categories WHERE event_id = ?))`
+	const sameCity = `(CASE WHEN EXISTS (SELECT 1 FROM addresses a
+		JOIN events se ON se.id = ?
+		JOIN addresses sa ON sa.id = se.address_id
+		WHERE a.id = events.address_id AND LOWER(a.city) = LOWER(sa.city)) THEN 1 ELSE 0 END)`
+
 	var events []*domain.Event
-	// For now, we'll use creation date as trending indicator
-	// In a real implementation, this could be based on views, likes, ticket sales, etc.
 	err := r.db.WithContext(ctx).
 		Preload("Creator").
 		Preload("Creator.User").
 		Preload("Image").
 		Preload("Address").
 		Preload("Categories").
-		Where("status = ? AND type = ? AND created_at >= ?",
-			domain.EventStatusPublished, domain.EventTypePublic, time.Now().AddDate(0, 0, -30)).
-		Order("created_at DESC").
+		Select("events.*, "+categoryOverlap+" AS category_overlap, "+sameCity+" AS same_city", eventID, eventID).
+		Where("events.status = ? AND events.type = ? AND events.id <> ?", domain.EventStatusPublished, domain.EventTypePublic, eventID).
+		Where(`NOT EXISTS (SELECT 1 FROM events src WHERE src.id = ?
+			AND src.creator_id = events.creator_id AND LOWER(src.name) = LOWER(events.name))`, eventID).
+		Where("("+categoryOverlap+" > 0 OR "+sameCity+" = 1)", eventID, eventID).
+		Order("category_overlap DESC, same_city DESC, events.created_at DESC, events.id DESC").
 		Limit(limit).
 		Find(&events).Error
 	return events, err
 }
+
+func (r *eventRepository) GetTrendingEvents(ctx context.Context, filters dto.EventShowcaseRequest, since time.Time, halfLife time.Duration) ([]*domain.Event, error) {
+	scores := r.db.Model(&domain.EventView{}).
+		Select("event_id, SUM(POWER(0.5, EXTRACT(EPOCH FROM (NOW() - viewed_at)) / ?)) AS score", halfLife.Seconds()).
+		Where("viewed_at >= ?", since).
+		Group("event_id")
+
+	var events []*domain.Event
+	err := r.db.WithContext(ctx).
+		Preload("Creator").
+		Preload("Creator.User").
+		Preload("Image").
+		Preload("Address").
+		Preload("Categories").
+		Select("events.*").
+		Joins("LEFT JOIN (?) AS view_scores ON view_scores.event_id = events.id", scores).
+		Scopes(eventShowcaseScope(filters)).
+		Where("view_scores.score IS NOT NULL OR events.created_at >= ?", since).
+		Order("COALESCE(view_scores.score, 0) DESC, events.created_at DESC, events.id DESC").
+		Limit(filters.Limit).
+		Find(&events).Error
+	return events, err
+}
@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/repository"
+)
+
+type eventViewRepository struct {
+	db *gorm.DB
+}
+
+// NewEventViewRepository creates a new event view repository instance
+func NewEventViewRepository(db *gorm.DB) repository.EventViewRepository {
+	return &eventViewRepository{db: db}
+}
+
+func (r *eventViewRepository) Record(ctx context.Context, view *domain.EventView, dedupeSince time.Time) (bool, error) {
+	visitor := r.db.Where("user_id IS NULL AND ip_address = ?", view.IPAddress)
+	if view.UserID != nil {
+		visitor = r.db.Where("user_id = ?", *view.UserID)
+	}
+
+	result := r.db.WithContext(ctx).Exec(`
+		INSERT INTO event_views (event_id, user_id, ip_address, viewed_at)
+		SELECT ?, CAST(? AS integer), ?, ?
+		WHERE NOT EXISTS (?)`,
+		view.EventID, view.UserID, view.IPAddress, view.ViewedAt,
+		r.db.Table("event_views").Select("1").
+			Where("event_id = ? AND viewed_at >= ?", view.EventID, dedupeSince).
+			Where(visitor),
+	)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
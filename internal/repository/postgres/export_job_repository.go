@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/repository"
+)
+
+type exportJobRepository struct {
+	db *gorm.DB
+}
+
+// NewExportJobRepository creates a new export job repository instance
+func NewExportJobRepository(db *gorm.DB) repository.ExportJobRepository {
+	return &exportJobRepository{db: db}
+}
+
+func (r *exportJobRepository) Create(ctx context.Context, job *domain.ExportJob) error {
+	return r.db.WithContext(ctx).Create(job).Error
+}
+
+func (r *exportJobRepository) GetByID(ctx context.Context, id int) (*domain.ExportJob, error) {
+	var job domain.ExportJob
+	if err := r.db.WithContext(ctx).First(&job, id).Error; err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (r *exportJobRepository) Update(ctx context.Context, job *domain.ExportJob) error {
+	return r.db.WithContext(ctx).Save(job).Error
+}
+
+func (r *exportJobRepository) ClaimNextQueued(ctx context.Context) (*domain.ExportJob, error) {
+	var claimed *domain.ExportJob
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var job domain.ExportJob
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ?", domain.ExportJobStatusQueued).
+			Order("created_at ASC, id ASC").
+			First(&job).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return err
+		}
+
+		if err := job.Start(); err != nil {
+			return err
+		}
+		if err := tx.Save(&job).Error; err != nil {
+			return err
+		}
+		claimed = &job
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return claimed, nil
+}
+
+func (r *exportJobRepository) RequeueStale(ctx context.Context, startedBefore time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Model(&domain.ExportJob{}).
+		Where("status = ? AND started_at < ?", domain.ExportJobStatusProcessing, startedBefore).
+		Updates(map[string]interface{}{
+			"status":     domain.ExportJobStatusQueued,
+			"started_at": nil,
+		})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,77 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/repository"
+)
+
+type failedWebhookRepository struct {
+	db *gorm.DB
+}
+
+func NewFailedWebhookRepository(db *gorm.DB) repository.FailedWebhookRepository {
+	return &failedWebhookRepository{db: db}
+}
+
+func (r *failedWebhookRepository) Create(ctx context.Context, webhook *domain.FailedWebhook) error {
+	return r.db.WithContext(ctx).Create(webhook).Error
+}
+
+func (r *failedWebhookRepository) GetByID(ctx context.Context, id int) (*domain.FailedWebhook, error) {
+	var webhook domain.FailedWebhook
+	if err := r.db.WithContext(ctx).First(&webhook, id).Error; err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+func (r *failedWebhookRepository) Update(ctx context.Context, webhook *domain.FailedWebhook) error {
+	return r.db.WithContext(ctx).Save(webhook).Error
+}
+
+func (r *failedWebhookRepository) ClaimForRetry(ctx context.Context, id int, staleBefore time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&domain.FailedWebhook{}).
+		Where("id = ? AND resolved_at IS NULL", id).
+		Where("retrying_at IS NULL OR retrying_at < ?", staleBefore).
+		Update("retrying_at", time.Now())
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (r *failedWebhookRepository) List(ctx context.Context, includeResolved bool, pagination dto.PaginationRequest) ([]*domain.FailedWebhook, *dto.PaginationResponse, error) {
+	var webhooks []*domain.FailedWebhook
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&domain.FailedWebhook{})
+	if !includeResolved {
+		query = query.Where("resolved_at IS NULL")
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, nil, err
+	}
+
+	offset := pagination.GetOffset()
+	pageSize := pagination.GetPageSizeWithDefault()
+
+	err := query.
+		Offset(offset).
+		Limit(pageSize).
+		Order("created_at DESC, id DESC").
+		Find(&webhooks).Error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
+
+	return webhooks, paginationResponse, nil
+}
@@ -74,19 +74,14 @@ func (r *invitationRepository) GetByEventID(ctx context.Context, eventID int, pa
 		Preload("InvitedUser").
 		Offset(offset).
 		Limit(pageSize).
-		Order("created_at DESC").
+		Order("created_at DESC, id DESC").
 		Find(&invitations).Error
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
 
 	return invitations, paginationResponse, nil
 }
@@ -112,19 +107,14 @@ func (r *invitationRepository) GetByEventIDWithRelations(ctx context.Context, ev
 		Preload("InvitedUser").
 		Offset(offset).
 		Limit(pageSize).
-		Order("created_at DESC").
+		Order("created_at DESC, id DESC").
 		Find(&invitations).Error
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
 
 	return invitations, paginationResponse, nil
 }
@@ -148,7 +138,7 @@ func (r *invitationRepository) GetByUserID(ctx context.Context, userID int, pagi
 	var invitations []*domain.Invitation
 	var total int64
 
-	query := r.db.WithContext(ctx).Model(&domain.Invitation{}).Where("invited_user_id = ?", userID)
+	query := r.db.WithContext(ctx).Model(&domain.Invitation{}).Scopes(eventNotDeleted).Where("invited_user_id = ?", userID)
 
 	// Count total
 	if err := query.Count(&total).Error; err != nil {
@@ -164,19 +154,14 @@ func (r *invitationRepository) GetByUserID(ctx context.Context, userID int, pagi
 		Preload("Event.Creator").
 		Offset(offset).
 		Limit(pageSize).
-		Order("created_at DESC").
+		Order("created_at DESC, id DESC").
 		Find(&invitations).Error
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
 
 	return invitations, paginationResponse, nil
 }
@@ -185,7 +170,7 @@ func (r *invitationRepository) GetByEmail(ctx context.Context, email string, pag
 	var invitations []*domain.Invitation
 	var total int64
 
-	query := r.db.WithContext(ctx).Model(&domain.Invitation{}).Where("invited_email = ?", email)
+	query := r.db.WithContext(ctx).Model(&domain.Invitation{}).Scopes(eventNotDeleted).Where("invited_email = ?", email)
 
 	// Count total
 	if err := query.Count(&total).Error; err != nil {
@@ -201,19 +186,14 @@ func (r *invitationRepository) GetByEmail(ctx context.Context, email string, pag
 		Preload("Event.Creator").
 		Offset(offset).
 		Limit(pageSize).
-		Order("created_at DESC").
+		Order("created_at DESC, id DESC").
 		Find(&invitations).Error
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
 
 	return invitations, paginationResponse, nil
 }
@@ -222,7 +202,7 @@ func (r *invitationRepository) GetByUserIDAndStatus(ctx context.Context, userID
 	var invitations []*domain.Invitation
 	var total int64
 
-	query := r.db.WithContext(ctx).Model(&domain.Invitation{}).Where("invited_user_id = ? AND status = ?", userID, status)
+	query := r.db.WithContext(ctx).Model(&domain.Invitation{}).Scopes(eventNotDeleted).Where("invited_user_id = ? AND status = ?", userID, status)
 
 	// Count total
 	if err := query.Count(&total).Error; err != nil {
@@ -238,19 +218,14 @@ func (r *invitationRepository) GetByUserIDAndStatus(ctx context.Context, userID
 		Preload("Event.Creator").
 		Offset(offset).
 		Limit(pageSize).
-		Order("created_at DESC").
+		Order("created_at DESC, id DESC").
 		Find(&invitations).Error
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
 
 	return invitations, paginationResponse, nil
 }
@@ -259,7 +234,7 @@ func (r *invitationRepository) GetByEmailAndStatus(ctx context.Context, email st
 	var invitations []*domain.Invitation
 	var total int64
 
-	query := r.db.WithContext(ctx).Model(&domain.Invitation{}).Where("invited_email = ? AND status = ?", email, status)
+	query := r.db.WithContext(ctx).Model(&domain.Invitation{}).Scopes(eventNotDeleted).Where("invited_email = ? AND status = ?", email, status)
 
 	// Count total
 	if err := query.Count(&total).Error; err != nil {
@@ -275,19 +250,14 @@ func (r *invitationRepository) GetByEmailAndStatus(ctx context.Context, email st
 		Preload("Event.Creator").
 		Offset(offset).
 		Limit(pageSize).
-		Order("created_at DESC").
+		Order("created_at DESC, id DESC").
 		Find(&invitations).Error
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
 
 	return invitations, paginationResponse, nil
 }
@@ -313,19 +283,14 @@ func (r *invitationRepository) GetByStatus(ctx context.Context, status domain.In
 		Preload("InvitedUser").
 		Offset(offset).
 		Limit(pageSize).
-		Order("created_at DESC").
+		Order("created_at DESC, id DESC").
 		Find(&invitations).Error
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
 
 	return invitations, paginationResponse, nil
 }
@@ -381,6 +346,11 @@ func (r *invitationRepository) ExistsByEventAndEmail(ctx context.Context, eventI
 	return count > 0, err
 }
 
+// invitedUserSQL matches the invitations of a user: those linked to the account and those sent
+// to its email that are not linked yet. It takes the user id twice.
+const invitedUserSQL = `invitations.invited_user_id = ? OR (invitations.invited_user_id IS NULL
+	AND LOWER(invitations.invited_email) = (SELECT LOWER(email) FROM users WHERE id = ?))`
+
 func (r *invitationRepository) ExistsByEventAndUser(ctx context.Context, eventID int, userID int) (bool, error) {
 	var count int64
 	err := r.db.WithContext(ctx).Model(&domain.Invitation{}).
@@ -389,6 +359,22 @@ func (r *invitationRepository) ExistsByEventAndUser(ctx context.Context, eventID
 	return count > 0, err
 }
 
+func (r *invitationRepository) ExistsForInvitee(ctx context.Context, eventID int, userID int) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&domain.Invitation{}).
+		Where("invitations.event_id = ? AND ("+invitedUserSQL+")", eventID, userID, userID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *invitationRepository) ExistsApprovedByEventAndUser(ctx context.Context, eventID int, userID int) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&domain.Invitation{}).
+		Where("event_id = ? AND invited_user_id = ? AND status IN ?", eventID, userID, domain.ApprovedInvitationStatuses).
+		Count(&count).Error
+	return count > 0, err
+}
+
 func (r *invitationRepository) GetByEventAndEmail(ctx context.Context, eventID int, email string) (*domain.Invitation, error) {
 	var invitation domain.Invitation
 	err := r.db.WithContext(ctx).
@@ -411,6 +397,17 @@ func (r *invitationRepository) GetByEventAndUser(ctx context.Context, eventID in
 	return &invitation, nil
 }
 
+func (r *invitationRepository) GetByToken(ctx context.Context, token string) (*domain.Invitation, error) {
+	var invitation domain.Invitation
+	err := r.db.WithContext(ctx).
+		Where("token = ?", token).
+		First(&invitation).Error
+	if err != nil {
+		return nil, err
+	}
+	return &invitation, nil
+}
+
 // Validation operations
 func (r *invitationRepository) ExistsByID(ctx context.Context, id int) (bool, error) {
 	var count int64
@@ -436,13 +433,25 @@ func (r *invitationRepository) CanUserAccessInvitation(ctx context.Context, invi
 
 // Bulk operations
 func (r *invitationRepository) GetMultipleByIDs(ctx context.Context, ids []int) ([]*domain.Invitation, error) {
-	var invitations []*domain.Invitation
-	err := r.db.WithContext(ctx).
-		Preload("Event").
-		Preload("InvitedUser").
-		Where("id IN ?", ids).
-		Find(&invitations).Error
-	return invitations, err
+	chunks, err := chunkIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	invitations := make([]*domain.Invitation, 0, len(ids))
+	for _, chunk := range chunks {
+		var batch []*domain.Invitation
+		err := r.db.WithContext(ctx).
+			Preload("Event").
+			Preload("InvitedUser").
+			Where("id IN ?", chunk).
+			Find(&batch).Error
+		if err != nil {
+			return nil, err
+		}
+		invitations = append(invitations, batch...)
+	}
+	return invitations, nil
 }
 
 func (r *invitationRepository) CreateMultiple(ctx context.Context, invitations []*domain.Invitation) error {
@@ -489,7 +498,7 @@ func (r *invitationRepository) GetInvitationStats(ctx context.Context, eventID i
 	// Approved invitations
 	var approvedInvitations int64
 	err = r.db.WithContext(ctx).Model(&domain.Invitation{}).
-		Where("event_id = ? AND status = ?", eventID, domain.InvitationStatusApproved).
+		Where("event_id = ? AND status IN ?", eventID, domain.ApprovedInvitationStatuses).
 		Count(&approvedInvitations).Error
 	if err != nil {
 		return nil, err
@@ -539,6 +548,70 @@ func (r *invitationRepository) GetInvitationStats(ctx context.Context, eventID i
 	return &stats, nil
 }
 
+// GetInvitationStatsForEvents computes the same numbers as GetInvitationStats for several events
+// in one grouped query. Every requested event is present in the result, with zeros if it has no
+// invitations.
+func (r *invitationRepository) GetInvitationStatsForEvents(ctx context.Context, eventIDs []int) (map[int]*dto.InvitationStatsResponse, error) {
+	result := make(map[int]*dto.InvitationStatsResponse, len(eventIDs))
+	for _, eventID := range eventIDs {
+		result[eventID] = &dto.InvitationStatsResponse{EventID: eventID}
+	}
+	if len(eventIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []struct {
+		EventID    int
+		Status     domain.InvitationStatus
+		SystemUser bool
+		Count      int
+	}
+	err := r.db.WithContext(ctx).Model(&domain.Invitation{}).
+		Select("event_id, status, invited_user_id IS NOT NULL AS system_user, COUNT(*) AS count").
+		Where("event_id IN ?", eventIDs).
+		Group("event_id, status, invited_user_id IS NOT NULL").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		stats, ok := result[row.EventID]
+		if !ok {
+			continue
+		}
+
+		stats.TotalInvitations += row.Count
+		switch row.Status {
+		case domain.InvitationStatusPending:
+			stats.PendingInvitations += row.Count
+		case domain.InvitationStatusApproved, domain.InvitationStatusLateApproved:
+			stats.ApprovedInvitations += row.Count
+		case domain.InvitationStatusRejected:
+			stats.RejectedInvitations += row.Count
+		}
+		if row.SystemUser {
+			stats.SystemUserInvitations += row.Count
+		} else {
+			stats.ExternalUserInvitations += row.Count
+		}
+	}
+
+	// Calculate rates
+	for _, stats := range result {
+		if stats.TotalInvitations > 0 {
+			respondedInvitations := stats.ApprovedInvitations + stats.RejectedInvitations
+			stats.ResponseRate = (float64(respondedInvitations) / float64(stats.TotalInvitations)) * 100
+
+			if respondedInvitations > 0 {
+				stats.ApprovalRate = (float64(stats.ApprovedInvitations) / float64(respondedInvitations)) * 100
+			}
+		}
+	}
+
+	return result, nil
+}
+
 func (r *invitationRepository) GetUserInvitationStats(ctx context.Context, userID int) (*dto.UserInvitationStatsResponse, error) {
 	var stats dto.UserInvitationStatsResponse
 	stats.UserID = userID
@@ -566,7 +639,7 @@ func (r *invitationRepository) GetUserInvitationStats(ctx context.Context, userI
 	// Approved invitations
 	var approvedInvitations int64
 	err = r.db.WithContext(ctx).Model(&domain.Invitation{}).
-		Where("invited_user_id = ? AND status = ?", userID, domain.InvitationStatusApproved).
+		Where("invited_user_id = ? AND status IN ?", userID, domain.ApprovedInvitationStatuses).
 		Count(&approvedInvitations).Error
 	if err != nil {
 		return nil, err
@@ -621,7 +694,7 @@ func (r *invitationRepository) GetSystemInvitationStats(ctx context.Context) (*d
 	// Approved invitations
 	var approvedInvitations int64
 	err = r.db.WithContext(ctx).Model(&domain.Invitation{}).
-		Where("status = ?", domain.InvitationStatusApproved).
+		Where("status IN ?", domain.ApprovedInvitationStatuses).
 		Count(&approvedInvitations).Error
 	if err != nil {
 		return nil, err
@@ -705,19 +778,14 @@ func (r *invitationRepository) GetExpiredInvitations(ctx context.Context, expira
 		Preload("InvitedUser").
 		Offset(offset).
 		Limit(pageSize).
-		Order("created_at ASC").
+		Order("created_at ASC, id ASC").
 		Find(&invitations).Error
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
 
 	return invitations, paginationResponse, nil
 }
@@ -752,19 +820,14 @@ func (r *invitationRepository) GetInvitationsExpiringIn(ctx context.Context, hou
 		Preload("InvitedUser").
 		Offset(offset).
 		Limit(pageSize).
-		Order("created_at ASC").
+		Order("created_at ASC, id ASC").
 		Find(&invitations).Error
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
 
 	return invitations, paginationResponse, nil
 }
@@ -803,6 +866,8 @@ func (r *invitationRepository) GetInvitationsWithFilters(ctx context.Context, fi
 	if filters.Query != nil && *filters.Query != "" {
 		query = query.Where("invited_email ILIKE ?", "%"+*filters.Query+"%")
 	}
+	query = whereTimeBetween(query, "invited_at", filters.InvitedFrom, filters.InvitedTo)
+	query = whereTimeBetween(query, "responded_at", filters.RespondedFrom, filters.RespondedTo)
 
 	// Count total
 	if err := query.Count(&total).Error; err != nil {
@@ -818,19 +883,14 @@ func (r *invitationRepository) GetInvitationsWithFilters(ctx context.Context, fi
 		Preload("InvitedUser").
 		Offset(offset).
 		Limit(pageSize).
-		Order("created_at DESC").
+		Order("created_at DESC, id DESC").
 		Find(&invitations).Error
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
 
 	return invitations, paginationResponse, nil
 }
@@ -881,6 +941,35 @@ func (r *invitationRepository) GetPendingInvitationsByEmail(ctx context.Context,
 	return invitations, err
 }
 
+func (r *invitationRepository) GetPendingByEventID(ctx context.Context, eventID int) ([]*domain.Invitation, error) {
+	var invitations []*domain.Invitation
+	err := r.db.WithContext(ctx).
+		Where("event_id = ? AND status = ?", eventID, domain.InvitationStatusPending).
+		Order("id ASC").
+		Find(&invitations).Error
+	return invitations, err
+}
+
+func (r *invitationRepository) GetByEventAndInvitees(ctx context.Context, eventID int, userIDs []int, emails []string) ([]*domain.Invitation, error) {
+	var invitations []*domain.Invitation
+	if len(userIDs) == 0 && len(emails) == 0 {
+		return invitations, nil
+	}
+	query := r.db.WithContext(ctx).Where("event_id = ?", eventID)
+	switch {
+	case len(userIDs) > 0 && len(emails) > 0:
+		query = query.Where("(invited_user_id IN ? OR invited_email IN ?)", userIDs, emails)
+	case len(userIDs) > 0:
+		query = query.Where("invited_user_id IN ?", userIDs)
+	default:
+		query = query.Where("invited_email IN ?", emails)
+	}
+	if err := query.Find(&invitations).Error; err != nil {
+		return nil, err
+	}
+	return invitations, nil
+}
+
 func (r *invitationRepository) GetEventInvitationByEmail(ctx context.Context, eventID int, email string) (*domain.Invitation, error) {
 	return r.GetByEventAndEmail(ctx, eventID, email)
 }
@@ -890,3 +979,16 @@ func (r *invitationRepository) UpdateInvitedUserByEmail(ctx context.Context, ema
 		Where("invited_email = ? AND invited_user_id IS NULL", email).
 		Update("invited_user_id", userID).Error
 }
+
+// whereTimeBetween limits column to an inclusive window; a nil bound leaves that side open
+func whereTimeBetween(query *gorm.DB, column string, from, to *time.Time) *gorm.DB {
+	switch {
+	case from != nil && to != nil:
+		return query.Where(column+" BETWEEN ? AND ?", *from, *to)
+	case from != nil:
+		return query.Where(column+" >= ?", *from)
+	case to != nil:
+		return query.Where(column+" <= ?", *to)
+	}
+	return query
+}
@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/louco-event/internal/repository"
+)
+
+// purgeRule is the table and age condition of a purge target. Only finished rows qualify:
+// exports that are done or failed, holds that have ended, codes that have expired. Export files
+// in storage are left to the bucket's lifecycle rules.
+type purgeRule struct {
+	table     string
+	condition string
+}
+
+var purgeRules = map[repository.PurgeTarget]purgeRule{
+	repository.PurgeTargetEventHistory:       {table: "event_histories", condition: "created_at < ?"},
+	repository.PurgeTargetShareTokenAccesses: {table: "share_token_accesses", condition: "accessed_at < ?"},
+	repository.PurgeTargetExportJobs:         {table: "export_jobs", condition: "status IN ('done', 'failed') AND created_at < ?"},
+	repository.PurgeTargetTicketReservations: {table: "ticket_reservations", condition: "expires_at < ?"},
+	repository.PurgeTargetVerificationCodes:  {table: "verification_codes", condition: "expires_at < ?"},
+	repository.PurgeTargetEventViews:         {table: "event_views", condition: "viewed_at < ?"},
+}
+
+type maintenanceRepository struct {
+	db *gorm.DB
+}
+
+func NewMaintenanceRepository(db *gorm.DB) repository.MaintenanceRepository {
+	return &maintenanceRepository{db: db}
+}
+
+func (r *maintenanceRepository) CountPurgeable(ctx context.Context, target repository.PurgeTarget, before time.Time) (int64, error) {
+	rule, ok := purgeRules[target]
+	if !ok {
+		return 0, fmt.Errorf("unknown purge target: %s", target)
+	}
+
+	var count int64
+	err := r.db.WithContext(ctx).Table(rule.table).Where(rule.condition, before).Count(&count).Error
+	return count, err
+}
+
+// PurgeBatch deletes by primary key from a limited subquery so each statement only locks one
+// batch of rows
+func (r *maintenanceRepository) PurgeBatch(ctx context.Context, target repository.PurgeTarget, before time.Time, batchSize int) (int64, error) {
+	rule, ok := purgeRules[target]
+	if !ok {
+		return 0, fmt.Errorf("unknown purge target: %s", target)
+	}
+
+	sql := fmt.Sprintf("DELETE FROM %[1]s WHERE id IN (SELECT id FROM %[1]s WHERE %[2]s ORDER BY id LIMIT ?)", rule.table, rule.condition)
+	result := r.db.WithContext(ctx).Exec(sql, before, batchSize)
+	return result.RowsAffected, result.Error
+}
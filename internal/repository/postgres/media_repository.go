@@ -85,6 +85,25 @@ func (r *mediaRepository) Delete(ctx context.Context, id int) error {
 	return nil
 }
 
+func (r *mediaRepository) GetPrivateEventMediaIDs(ctx context.Context, mediaIDs []int) ([]int, error) {
+	ids := make([]int, 0)
+	if len(mediaIDs) == 0 {
+		return ids, nil
+	}
+
+	// Trashed events are included: their media stays private until the event is purged
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT image_id FROM events WHERE type = @type AND image_id IN @ids
+		UNION
+		SELECT video_id FROM events WHERE type = @type AND video_id IN @ids`,
+		map[string]interface{}{"type": domain.EventTypePrivate, "ids": mediaIDs},
+	).Scan(&ids).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get private event media: %w", err)
+	}
+	return ids, nil
+}
+
 func (r *mediaRepository) GetByFileName(ctx context.Context, fileName string) (*domain.Media, error) {
 	var media domain.Media
 	if err := r.db.WithContext(ctx).Where("file_name = ?", fileName).First(&media).Error; err != nil {
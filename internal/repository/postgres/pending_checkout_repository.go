@@ -0,0 +1,46 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/repository"
+)
+
+type pendingCheckoutRepository struct {
+	db *gorm.DB
+}
+
+// NewPendingCheckoutRepository creates a new pending checkout repository instance
+func NewPendingCheckoutRepository(db *gorm.DB) repository.PendingCheckoutRepository {
+	return &pendingCheckoutRepository{db: db}
+}
+
+func (r *pendingCheckoutRepository) Create(ctx context.Context, checkout *domain.PendingCheckout) error {
+	return r.db.WithContext(ctx).Create(checkout).Error
+}
+
+func (r *pendingCheckoutRepository) GetByStripeSessionID(ctx context.Context, sessionID string) (*domain.PendingCheckout, error) {
+	var checkout domain.PendingCheckout
+	err := r.db.WithContext(ctx).Where("stripe_session_id = ?", sessionID).First(&checkout).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrPendingCheckoutNotFound
+		}
+		return nil, err
+	}
+	return &checkout, nil
+}
+
+func (r *pendingCheckoutRepository) Resolve(ctx context.Context, sessionID string, status domain.PendingCheckoutStatus) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&domain.PendingCheckout{}).
+		Where("stripe_session_id = ? AND status = ?", sessionID, domain.PendingCheckoutStatusPending).
+		Update("status", status)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
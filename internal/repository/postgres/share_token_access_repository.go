@@ -0,0 +1,36 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/repository"
+)
+
+type shareTokenAccessRepository struct {
+	db *gorm.DB
+}
+
+// NewShareTokenAccessRepository creates a new share token access repository instance
+func NewShareTokenAccessRepository(db *gorm.DB) repository.ShareTokenAccessRepository {
+	return &shareTokenAccessRepository{db: db}
+}
+
+func (r *shareTokenAccessRepository) Create(ctx context.Context, access *domain.ShareTokenAccess) error {
+	return r.db.WithContext(ctx).Create(access).Error
+}
+
+func (r *shareTokenAccessRepository) GetStats(ctx context.Context, eventID int, since time.Time) (*repository.ShareTokenAccessStats, error) {
+	var stats repository.ShareTokenAccessStats
+	err := r.db.WithContext(ctx).Model(&domain.ShareTokenAccess{}).
+		Select("COUNT(*) AS total_accesses, COUNT(DISTINCT ip_address) AS unique_visitors, MAX(accessed_at) AS last_accessed_at").
+		Where("event_id = ? AND accessed_at >= ?", eventID, since).
+		Scan(&stats).Error
+	if err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
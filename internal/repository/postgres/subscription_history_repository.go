@@ -0,0 +1,23 @@
+package postgres
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/repository"
+)
+
+type subscriptionHistoryRepository struct {
+	db *gorm.DB
+}
+
+// NewSubscriptionHistoryRepository creates a new subscription history repository instance
+func NewSubscriptionHistoryRepository(db *gorm.DB) repository.SubscriptionHistoryRepository {
+	return &subscriptionHistoryRepository{db: db}
+}
+
+func (r *subscriptionHistoryRepository) Create(ctx context.Context, history *domain.SubscriptionHistory) error {
+	return r.db.WithContext(ctx).Create(history).Error
+}
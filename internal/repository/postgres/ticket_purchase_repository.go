@@ -0,0 +1,120 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/repository"
+)
+
+type ticketPurchaseRepository struct {
+	db *gorm.DB
+}
+
+// NewTicketPurchaseRepository creates a new ticket purchase repository instance
+func NewTicketPurchaseRepository(db *gorm.DB) repository.TicketPurchaseRepository {
+	return &ticketPurchaseRepository{db: db}
+}
+
+func (r *ticketPurchaseRepository) Create(ctx context.Context, purchase *domain.TicketPurchase) error {
+	return r.db.WithContext(ctx).Create(purchase).Error
+}
+
+func (r *ticketPurchaseRepository) GetByUserID(ctx context.Context, userID int, upcoming *bool, pagination dto.PaginationRequest) ([]*domain.TicketPurchase, *dto.PaginationResponse, error) {
+	var total int64
+
+	// An event is past once its last day is before today
+	today := time.Now().Format("2006-01-02")
+	eventQuery := r.db.WithContext(ctx).
+		Table("ticket_purchases").
+		Joins("JOIN tickets ON tickets.id = ticket_purchases.ticket_id").
+		Joins("JOIN events ON events.id = tickets.event_id").
+		Where("ticket_purchases.user_id = ?", userID)
+
+	order := "events.start_date DESC NULLS LAST, events.id DESC"
+	if upcoming != nil {
+		if *upcoming {
+			eventQuery = eventQuery.Where("COALESCE(events.end_date, events.start_date) >= ? OR events.start_date IS NULL", today)
+			order = "events.start_date ASC NULLS LAST, events.id ASC"
+		} else {
+			eventQuery = eventQuery.Where("COALESCE(events.end_date, events.start_date) < ?", today)
+		}
+	}
+
+	if err := eventQuery.Session(&gorm.Session{}).Distinct("events.id").Count(&total).Error; err != nil {
+		return nil, nil, err
+	}
+
+	offset := pagination.GetOffset()
+	pageSize := pagination.GetPageSizeWithDefault()
+
+	var eventIDs []int
+	err := eventQuery.
+		Group("events.id, events.start_date").
+		Order(order).
+		Offset(offset).
+		Limit(pageSize).
+		Pluck("events.id", &eventIDs).Error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var purchases []*domain.TicketPurchase
+	if len(eventIDs) > 0 {
+		err = r.db.WithContext(ctx).
+			Joins("JOIN tickets ON tickets.id = ticket_purchases.ticket_id").
+			Where("ticket_purchases.user_id = ? AND tickets.event_id IN ?", userID, eventIDs).
+			Preload("Ticket").
+			Preload("Ticket.Event").
+			Preload("Ticket.Event.Address").
+			Order("ticket_purchases.purchased_at DESC").
+			Find(&purchases).Error
+		if err != nil {
+			return nil, nil, err
+		}
+		purchases = orderPurchasesByEvent(purchases, eventIDs)
+	}
+
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
+
+	return purchases, paginationResponse, nil
+}
+
+func (r *ticketPurchaseRepository) GetByEventID(ctx context.Context, eventID int) ([]*domain.TicketPurchase, error) {
+	var purchases []*domain.TicketPurchase
+	err := r.db.WithContext(ctx).
+		Joins("JOIN tickets ON tickets.id = ticket_purchases.ticket_id").
+		Where("tickets.event_id = ?", eventID).
+		Preload("Ticket").
+		Preload("User").
+		Order("ticket_purchases.purchased_at ASC, ticket_purchases.id ASC").
+		Find(&purchases).Error
+	return purchases, err
+}
+
+func (r *ticketPurchaseRepository) ExistsByEventAndUser(ctx context.Context, eventID, userID int) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&domain.TicketPurchase{}).
+		Joins("JOIN tickets ON tickets.id = ticket_purchases.ticket_id").
+		Where("tickets.event_id = ? AND ticket_purchases.user_id = ?", eventID, userID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// orderPurchasesByEvent keeps purchases in the event order of the page
+func orderPurchasesByEvent(purchases []*domain.TicketPurchase, eventIDs []int) []*domain.TicketPurchase {
+	byEvent := make(map[int][]*domain.TicketPurchase, len(eventIDs))
+	for _, purchase := range purchases {
+		byEvent[purchase.Ticket.EventID] = append(byEvent[purchase.Ticket.EventID], purchase)
+	}
+
+	ordered := make([]*domain.TicketPurchase, 0, len(purchases))
+	for _, eventID := range eventIDs {
+		ordered = append(ordered, byEvent[eventID]...)
+	}
+	return ordered
+}
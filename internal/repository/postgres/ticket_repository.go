@@ -2,14 +2,21 @@ package postgres
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/louco-event/internal/domain"
 	"github.com/louco-event/internal/dto"
 	"github.com/louco-event/internal/repository"
 )
 
+// ticketTitleIndex keeps ticket titles unique per event, ignoring case
+const ticketTitleIndex = "idx_tickets_event_title"
+
 type ticketRepository struct {
 	db *gorm.DB
 }
@@ -20,7 +27,7 @@ func NewTicketRepository(db *gorm.DB) repository.TicketRepository {
 
 // Basic CRUD operations
 func (r *ticketRepository) Create(ctx context.Context, ticket *domain.Ticket) error {
-	return r.db.WithContext(ctx).Create(ticket).Error
+	return ticketWriteError(r.db.WithContext(ctx).Create(ticket).Error)
 }
 
 func (r *ticketRepository) GetByID(ctx context.Context, id int) (*domain.Ticket, error) {
@@ -32,8 +39,25 @@ func (r *ticketRepository) GetByID(ctx context.Context, id int) (*domain.Ticket,
 	return &ticket, nil
 }
 
+// Update saves the ticket only if nobody wrote it since it was loaded; otherwise the stale copy
+// would overwrite a concurrent sale's sold quantity
 func (r *ticketRepository) Update(ctx context.Context, ticket *domain.Ticket) error {
-	return r.db.WithContext(ctx).Save(ticket).Error
+	loadedVersion := ticket.Version
+	ticket.Version++
+	result := r.db.WithContext(ctx).Model(ticket).
+		Where("version = ?", loadedVersion).
+		Select("*").
+		Omit("created_at", clause.Associations).
+		Updates(ticket)
+	if result.Error != nil {
+		ticket.Version = loadedVersion
+		return ticketWriteError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		ticket.Version = loadedVersion
+		return domain.ErrTicketConcurrentUpdate
+	}
+	return nil
 }
 
 func (r *ticketRepository) Delete(ctx context.Context, id int) error {
@@ -65,19 +89,14 @@ func (r *ticketRepository) GetByEventIDWithPagination(ctx context.Context, event
 	err := query.
 		Offset(offset).
 		Limit(pageSize).
-		Order("created_at ASC").
+		Order("created_at ASC, id ASC").
 		Find(&tickets).Error
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
 
 	return tickets, paginationResponse, nil
 }
@@ -147,19 +166,65 @@ func (r *ticketRepository) GetAvailableQuantity(ctx context.Context, ticketID in
 func (r *ticketRepository) UpdateSoldQuantity(ctx context.Context, ticketID int, quantity int) error {
 	return r.db.WithContext(ctx).Model(&domain.Ticket{}).
 		Where("id = ?", ticketID).
-		Update("sold_quantity", gorm.Expr("sold_quantity + ?", quantity)).Error
-}
+		Updates(soldQuantityChange("sold_quantity + ?", quantity)).Error
+}
+
+// SellTickets sells tickets that are neither sold nor held by an unexpired cart, while the
+// event's sales are open and its capacity has room, and records the purchase in the same
+// transaction. The event and ticket rows are locked, so a sale cannot race a cart or another
+// sale for the last tickets or seats.
+func (r *ticketRepository) SellTickets(ctx context.Context, purchase *domain.TicketPurchase) error {
+	ticketID, quantity := purchase.TicketID, purchase.Quantity
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var eventID int
+		err := tx.Model(&domain.Ticket{}).Where("id = ?", ticketID).Pluck("event_id", &eventID).Error
+		if err != nil {
+			return err
+		}
+		if eventID == 0 {
+			return domain.ErrTicketNotFound
+		}
+		if err := lockEventForSale(tx, eventID, quantity); err != nil {
+			return err
+		}
 
-func (r *ticketRepository) IncrementSoldQuantity(ctx context.Context, ticketID int, quantity int) error {
-	return r.db.WithContext(ctx).Model(&domain.Ticket{}).
-		Where("id = ? AND sold_quantity + ? <= total_quantity", ticketID, quantity).
-		Update("sold_quantity", gorm.Expr("sold_quantity + ?", quantity)).Error
+		var ticket domain.Ticket
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&ticket, ticketID).Error
+		if err != nil {
+			return err
+		}
+
+		held, err := heldQuantities(tx, []int{ticketID})
+		if err != nil {
+			return err
+		}
+		if ticket.GetAvailableQuantity()-held[ticketID] < quantity {
+			return domain.ErrTicketInsufficientQuantity
+		}
+
+		err = tx.Model(&domain.Ticket{}).
+			Where("id = ?", ticketID).
+			Updates(soldQuantityChange("sold_quantity + ?", quantity)).Error
+		if err != nil {
+			return err
+		}
+		return tx.Create(purchase).Error
+	})
 }
 
 func (r *ticketRepository) DecrementSoldQuantity(ctx context.Context, ticketID int, quantity int) error {
 	return r.db.WithContext(ctx).Model(&domain.Ticket{}).
 		Where("id = ? AND sold_quantity >= ?", ticketID, quantity).
-		Update("sold_quantity", gorm.Expr("sold_quantity - ?", quantity)).Error
+		Updates(soldQuantityChange("sold_quantity - ?", quantity)).Error
+}
+
+// soldQuantityChange applies a sold quantity expression and bumps the version, so a ticket
+// loaded before the sale can no longer be saved over it
+func soldQuantityChange(expr string, quantity int) map[string]interface{} {
+	return map[string]interface{}{
+		"sold_quantity": gorm.Expr(expr, quantity),
+		"version":       gorm.Expr("version + 1"),
+	}
 }
 
 func (r *ticketRepository) GetTotalSoldQuantity(ctx context.Context, eventID int) (int, error) {
@@ -308,19 +373,14 @@ func (r *ticketRepository) GetTicketsWithFilters(ctx context.Context, filters dt
 	err := query.
 		Offset(offset).
 		Limit(pageSize).
-		Order("price ASC").
+		Order("price ASC, id ASC").
 		Find(&tickets).Error
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	paginationResponse := &dto.PaginationResponse{
-		Page:       pagination.GetPageWithDefault(),
-		PageSize:   pageSize,
-		Total:      int(total),
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	}
+	paginationResponse := dto.NewPaginationResponse(pagination.GetPageWithDefault(), pageSize, total)
 
 	return tickets, paginationResponse, nil
 }
@@ -408,6 +468,31 @@ func (r *ticketRepository) GetTotalTicketsAvailable(ctx context.Context, eventID
 	return totalQuantity - soldQuantity, nil
 }
 
+// GetAvailabilitySummary totals the active tiers of an event in a single query. Tickets held by
+// unexpired cart reservations are not available. Availability is clamped per tier so an
+// oversold tier cannot hide the seats left in another one.
+func (r *ticketRepository) GetAvailabilitySummary(ctx context.Context, eventID int) (*dto.TicketAvailabilitySummaryResponse, error) {
+	summary := &dto.TicketAvailabilitySummaryResponse{EventID: eventID}
+
+	held := r.db.Model(&domain.TicketReservation{}).
+		Select("ticket_id, SUM(quantity) AS quantity").
+		Where("status = ? AND expires_at > ?", domain.TicketReservationStatusActive, time.Now()).
+		Group("ticket_id")
+
+	err := r.db.WithContext(ctx).Model(&domain.Ticket{}).
+		Joins("LEFT JOIN (?) AS held ON held.ticket_id = tickets.id", held).
+		Where("tickets.event_id = ? AND tickets.is_active = ?", eventID, true).
+		Select("COALESCE(SUM(tickets.total_quantity), 0), COALESCE(SUM(tickets.sold_quantity), 0), "+
+			"COALESCE(SUM(GREATEST(tickets.total_quantity - tickets.sold_quantity - COALESCE(held.quantity, 0), 0)), 0)").
+		Row().Scan(&summary.TotalCapacity, &summary.TotalSold, &summary.TotalAvailable)
+	if err != nil {
+		return nil, err
+	}
+
+	summary.IsSoldOut = summary.TotalAvailable == 0
+	return summary, nil
+}
+
 func (r *ticketRepository) GetTicketTypeStats(ctx context.Context, eventID int) ([]*dto.TicketTypeStatsResponse, error) {
 	var tickets []*domain.Ticket
 	err := r.db.WithContext(ctx).Where("event_id = ?", eventID).Find(&tickets).Error
@@ -460,6 +545,24 @@ func (r *ticketRepository) IsTicketOwner(ctx context.Context, ticketID, eventID
 	return count > 0, err
 }
 
+func (r *ticketRepository) ExistsByEventAndTitle(ctx context.Context, eventID int, title string, excludeTicketID int) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&domain.Ticket{}).
+		Where("event_id = ? AND LOWER(title) = LOWER(?) AND id <> ?", eventID, title, excludeTicketID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// ticketWriteError turns a duplicate title caught by the unique index, when two requests race
+// past the service check, into the domain error
+func ticketWriteError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == ticketTitleIndex {
+		return domain.ErrTicketDuplicateTitle
+	}
+	return err
+}
+
 // Bulk operations
 func (r *ticketRepository) GetMultipleByIDs(ctx context.Context, ids []int) ([]*domain.Ticket, error) {
 	var tickets []*domain.Ticket
@@ -468,7 +571,7 @@ func (r *ticketRepository) GetMultipleByIDs(ctx context.Context, ids []int) ([]*
 }
 
 func (r *ticketRepository) CreateMultiple(ctx context.Context, tickets []*domain.Ticket) error {
-	return r.db.WithContext(ctx).Create(&tickets).Error
+	return ticketWriteError(r.db.WithContext(ctx).Create(&tickets).Error)
 }
 
 func (r *ticketRepository) DeleteMultiple(ctx context.Context, ids []int) error {
@@ -479,6 +582,45 @@ func (r *ticketRepository) UpdateMultiple(ctx context.Context, tickets []*domain
 	return r.db.WithContext(ctx).Save(&tickets).Error
 }
 
+// UpdatePrices locks the active tickets of the event, so the old prices in the history are the
+// ones the new prices were computed from, and leaves columns other than the price untouched
+func (r *ticketRepository) UpdatePrices(ctx context.Context, eventID int, actorUserID *int, adjust func(ticket *domain.Ticket) (float64, error)) ([]*domain.Ticket, error) {
+	var tickets []*domain.Ticket
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("event_id = ? AND is_active = ?", eventID, true).
+			Order("id ASC").
+			Find(&tickets).Error
+		if err != nil {
+			return err
+		}
+		if len(tickets) == 0 {
+			return domain.ErrTicketNoActiveTickets
+		}
+
+		changes := make([]domain.TicketPriceChange, len(tickets))
+		for i, ticket := range tickets {
+			price, err := adjust(ticket)
+			if err != nil {
+				return err
+			}
+			changes[i] = domain.TicketPriceChange{TicketID: ticket.ID, From: ticket.Price, To: price}
+		}
+
+		for i, ticket := range tickets {
+			if err := tx.Model(&domain.Ticket{}).Where("id = ?", ticket.ID).Update("price", changes[i].To).Error; err != nil {
+				return err
+			}
+			ticket.Price = changes[i].To
+		}
+		return tx.Create(domain.NewEventTicketPricesHistory(eventID, actorUserID, changes)).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tickets, nil
+}
+
 func (r *ticketRepository) UpdateMultiplePrices(ctx context.Context, ticketIDs []int, newPrice float64) error {
 	return r.db.WithContext(ctx).Model(&domain.Ticket{}).
 		Where("id IN ?", ticketIDs).
@@ -0,0 +1,192 @@
+package postgres
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/repository"
+)
+
+type ticketReservationRepository struct {
+	db *gorm.DB
+}
+
+func NewTicketReservationRepository(db *gorm.DB) repository.TicketReservationRepository {
+	return &ticketReservationRepository{db: db}
+}
+
+func (r *ticketReservationRepository) CreateCart(ctx context.Context, reservations []*domain.TicketReservation) error {
+	requested := make(map[int]int, len(reservations))
+	for _, reservation := range reservations {
+		requested[reservation.TicketID] += reservation.Quantity
+	}
+	ticketIDs := make([]int, 0, len(requested))
+	for ticketID := range requested {
+		ticketIDs = append(ticketIDs, ticketID)
+	}
+	// Lock in id order so two carts sharing tiers cannot deadlock
+	sort.Ints(ticketIDs)
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var tickets []*domain.Ticket
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ?", ticketIDs).
+			Order("id ASC").
+			Find(&tickets).Error
+		if err != nil {
+			return err
+		}
+		if len(tickets) != len(ticketIDs) {
+			return domain.ErrTicketNotFound
+		}
+
+		held, err := heldQuantities(tx, ticketIDs)
+		if err != nil {
+			return err
+		}
+
+		for _, ticket := range tickets {
+			if !ticket.IsActive {
+				return domain.ErrTicketNotActive
+			}
+			if ticket.GetAvailableQuantity()-held[ticket.ID] < requested[ticket.ID] {
+				return domain.ErrTicketInsufficientQuantity
+			}
+		}
+
+		return tx.Create(&reservations).Error
+	})
+}
+
+func (r *ticketReservationRepository) GetByCartID(ctx context.Context, cartID string) ([]*domain.TicketReservation, error) {
+	var reservations []*domain.TicketReservation
+	err := r.db.WithContext(ctx).
+		Where("cart_id = ?", cartID).
+		Order("id ASC").
+		Find(&reservations).Error
+	return reservations, err
+}
+
+func (r *ticketReservationRepository) ConfirmCart(ctx context.Context, cartID string) ([]*domain.TicketPurchase, error) {
+	var purchases []*domain.TicketPurchase
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var reservations []*domain.TicketReservation
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("cart_id = ? AND status = ?", cartID, domain.TicketReservationStatusActive).
+			Order("ticket_id ASC").
+			Find(&reservations).Error
+		if err != nil {
+			return err
+		}
+		if len(reservations) == 0 {
+			return domain.ErrTicketReservationNotFound
+		}
+		for _, reservation := range reservations {
+			if !reservation.IsHeld() {
+				return domain.ErrTicketReservationExpired
+			}
+		}
+
+		// Sales may have closed or the capacity filled up since the cart was reserved
+		seats, err := cartSeatsByEvent(tx, reservations)
+		if err != nil {
+			return err
+		}
+		eventIDs := make([]int, 0, len(seats))
+		for eventID := range seats {
+			eventIDs = append(eventIDs, eventID)
+		}
+		sort.Ints(eventIDs)
+		for _, eventID := range eventIDs {
+			if err := lockEventForSale(tx, eventID, seats[eventID]); err != nil {
+				return err
+			}
+		}
+
+		for _, reservation := range reservations {
+
+			result := tx.Model(&domain.Ticket{}).
+				Where("id = ? AND sold_quantity + ? <= total_quantity", reservation.TicketID, reservation.Quantity).
+				Updates(soldQuantityChange("sold_quantity + ?", reservation.Quantity))
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return domain.ErrTicketInsufficientQuantity
+			}
+
+			purchases = append(purchases, domain.NewTicketPurchase(reservation.TicketID, reservation.UserID, reservation.Quantity))
+		}
+
+		if err := tx.Create(&purchases).Error; err != nil {
+			return err
+		}
+		return tx.Model(&domain.TicketReservation{}).
+			Where("cart_id = ? AND status = ?", cartID, domain.TicketReservationStatusActive).
+			Update("status", domain.TicketReservationStatusConfirmed).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return purchases, nil
+}
+
+// cartSeatsByEvent sums the held quantities of a cart per event
+func cartSeatsByEvent(tx *gorm.DB, reservations []*domain.TicketReservation) (map[int]int, error) {
+	ticketIDs := make([]int, 0, len(reservations))
+	for _, reservation := range reservations {
+		ticketIDs = append(ticketIDs, reservation.TicketID)
+	}
+	var tickets []*domain.Ticket
+	if err := tx.Select("id, event_id").Where("id IN ?", ticketIDs).Find(&tickets).Error; err != nil {
+		return nil, err
+	}
+	eventIDs := make(map[int]int, len(tickets))
+	for _, ticket := range tickets {
+		eventIDs[ticket.ID] = ticket.EventID
+	}
+
+	seats := make(map[int]int)
+	for _, reservation := range reservations {
+		seats[eventIDs[reservation.TicketID]] += reservation.Quantity
+	}
+	return seats, nil
+}
+
+func (r *ticketReservationRepository) ReleaseCart(ctx context.Context, cartID string) (int64, error) {
+	result := r.db.WithContext(ctx).Model(&domain.TicketReservation{}).
+		Where("cart_id = ? AND status = ?", cartID, domain.TicketReservationStatusActive).
+		Update("status", domain.TicketReservationStatusReleased)
+	return result.RowsAffected, result.Error
+}
+
+func (r *ticketReservationRepository) GetHeldQuantities(ctx context.Context, ticketIDs []int) (map[int]int, error) {
+	return heldQuantities(r.db.WithContext(ctx), ticketIDs)
+}
+
+// heldQuantities sums the unexpired active holds per ticket
+func heldQuantities(db *gorm.DB, ticketIDs []int) (map[int]int, error) {
+	var rows []struct {
+		TicketID int
+		Quantity int
+	}
+	err := db.Model(&domain.TicketReservation{}).
+		Select("ticket_id, COALESCE(SUM(quantity), 0) AS quantity").
+		Where("ticket_id IN ? AND status = ? AND expires_at > ?", ticketIDs, domain.TicketReservationStatusActive, time.Now()).
+		Group("ticket_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	held := make(map[int]int, len(rows))
+	for _, row := range rows {
+		held[row.TicketID] = row.Quantity
+	}
+	return held, nil
+}
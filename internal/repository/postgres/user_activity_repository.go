@@ -0,0 +1,124 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/repository"
+)
+
+// userActivitySQL merges the event changes a user made, the invitations they answered and
+// the tickets they bought into one stream. Every branch selects the same columns.
+const userActivitySQL = `
+SELECT 'event_' || h.action AS type, h.id AS source_id, h.event_id, e.name AS event_name,
+	h.to_status AS status, NULL AS ticket_title, NULL::int AS quantity, h.created_at AS occurred_at
+FROM event_histories h
+JOIN events e ON e.id = h.event_id
+WHERE h.actor_user_id = @user AND h.action IN ('created', 'status_changed')
+UNION ALL
+SELECT 'invitation_responded', i.id, i.event_id, e.name,
+	i.status, NULL, NULL, i.responded_at
+FROM invitations i
+JOIN events e ON e.id = i.event_id
+WHERE i.invited_user_id = @user AND i.responded_at IS NOT NULL
+UNION ALL
+SELECT 'ticket_purchased', p.id, t.event_id, e.name,
+	NULL, t.title, p.quantity, p.purchased_at
+FROM ticket_purchases p
+JOIN tickets t ON t.id = p.ticket_id
+JOIN events e ON e.id = t.event_id
+WHERE p.user_id = @user`
+
+type userActivityRepository struct {
+	db *gorm.DB
+}
+
+func NewUserActivityRepository(db *gorm.DB) repository.UserActivityRepository {
+	return &userActivityRepository{db: db}
+}
+
+func (r *userActivityRepository) GetTimeline(ctx context.Context, userID int, beforeAt *time.Time, beforeID int, limit int) ([]*domain.UserActivity, error) {
+	args := map[string]interface{}{"user": userID, "limit": limit}
+	keyset := ""
+	if beforeAt != nil {
+		// Rows of different tables can share a timestamp and an id; such a tie at a page
+		// boundary is rare enough to accept rather than encode the type in the cursor
+		keyset = "WHERE (activity.occurred_at, activity.source_id) < (@before_at, @before_id)"
+		args["before_at"] = *beforeAt
+		args["before_id"] = beforeID
+	}
+
+	var activities []*domain.UserActivity
+	err := r.db.WithContext(ctx).Raw(
+		"SELECT * FROM ("+userActivitySQL+") AS activity "+keyset+
+			" ORDER BY activity.occurred_at DESC, activity.source_id DESC LIMIT @limit",
+		args,
+	).Scan(&activities).Error
+	return activities, err
+}
+
+// userEventRelationSQL selects the events a user relates to in one way, ranked so that the
+// strongest relation wins when an event shows up more than once
+var userEventRelationSQL = map[domain.UserEventRelation]string{
+	domain.UserEventRelationOwned: `
+SELECT e.id AS event_id, 'owned' AS relation, 1 AS rank
+FROM events e
+JOIN creators c ON c.id = e.creator_id
+WHERE c.user_id = @user`,
+	domain.UserEventRelationAttending: `
+SELECT t.event_id, 'attending', 2
+FROM ticket_purchases p
+JOIN tickets t ON t.id = p.ticket_id
+WHERE p.user_id = @user`,
+	domain.UserEventRelationInvited: `
+SELECT i.event_id, 'invited', 3
+FROM invitations i
+WHERE i.invited_user_id = @user OR (@email <> '' AND LOWER(i.invited_email) = @email)`,
+}
+
+func (r *userActivityRepository) GetUserEvents(ctx context.Context, userID int, email string, relation domain.UserEventRelation, beforeAt *time.Time, beforeID int, limit int) ([]*domain.UserEventRef, error) {
+	var branches []string
+	for _, candidate := range []domain.UserEventRelation{domain.UserEventRelationOwned, domain.UserEventRelationAttending, domain.UserEventRelationInvited} {
+		if relation == "" || relation == candidate {
+			branches = append(branches, userEventRelationSQL[candidate])
+		}
+	}
+
+	args := map[string]interface{}{
+		"user":      userID,
+		"email":     strings.ToLower(strings.TrimSpace(email)),
+		"published": domain.EventStatusPublished,
+		"limit":     limit,
+	}
+	keyset := ""
+	if beforeAt != nil {
+		keyset = "AND (sort_at, event_id) < (@before_at, @before_id)"
+		args["before_at"] = *beforeAt
+		args["before_id"] = beforeID
+	}
+
+	// Drafts and other unpublished events are only listed to their owner
+	query := fmt.Sprintf(`
+SELECT * FROM (
+	SELECT best.event_id, best.relation, COALESCE(%s, events.created_at) AS sort_at
+	FROM (
+		SELECT DISTINCT ON (event_id) event_id, relation
+		FROM (%s) AS relations
+		ORDER BY event_id, rank
+	) AS best
+	JOIN events ON events.id = best.event_id
+	WHERE events.deleted_at IS NULL AND (best.relation = 'owned' OR events.status = @published)
+) AS user_events
+WHERE TRUE %s
+ORDER BY sort_at DESC, event_id DESC
+LIMIT @limit`, eventStartAtSQL, strings.Join(branches, "\nUNION ALL"), keyset)
+
+	var refs []*domain.UserEventRef
+	err := r.db.WithContext(ctx).Raw(query, args).Scan(&refs).Error
+	return refs, err
+}
@@ -45,6 +45,32 @@ func (r *userRepository) GetByID(ctx context.Context, id int) (*domain.User, err
 	return &user, nil
 }
 
+func (r *userRepository) GetInvitableByIDs(ctx context.Context, creatorUserID int, ids []int) ([]*domain.User, error) {
+	var users []*domain.User
+	if len(ids) == 0 {
+		return users, nil
+	}
+	// Attendees bought a ticket or hold an approved invitation to an event of the creator
+	err := r.db.WithContext(ctx).
+		Where("id IN ? AND is_active = ?", ids, true).
+		Where(`(EXISTS (SELECT 1 FROM follows WHERE follows.follower_id = users.id AND follows.following_id = ?)
+			OR EXISTS (SELECT 1 FROM ticket_purchases
+				JOIN tickets ON tickets.id = ticket_purchases.ticket_id
+				JOIN events ON events.id = tickets.event_id
+				JOIN creators ON creators.id = events.creator_id
+				WHERE ticket_purchases.user_id = users.id AND creators.user_id = ?)
+			OR EXISTS (SELECT 1 FROM invitations
+				JOIN events ON events.id = invitations.event_id
+				JOIN creators ON creators.id = events.creator_id
+				WHERE invitations.invited_user_id = users.id AND invitations.status IN ? AND creators.user_id = ?))`,
+			creatorUserID, creatorUserID, domain.ApprovedInvitationStatuses, creatorUserID).
+		Find(&users).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+	return users, nil
+}
+
 func (r *userRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
 	var user domain.User
 	if err := r.db.WithContext(ctx).Where("email = ? AND is_active = ?", email, true).First(&user).Error; err != nil {
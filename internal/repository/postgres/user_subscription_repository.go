@@ -32,6 +32,27 @@ func (r *userSubscriptionRepository) Create(ctx context.Context, subscription *d
 	return nil
 }
 
+func (r *userSubscriptionRepository) CreateTrial(ctx context.Context, subscription *domain.UserSubscription) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Conditional update so concurrent checkouts cannot grant two trials
+		result := tx.Model(&domain.User{}).
+			Where("id = ? AND has_used_trial = ?", subscription.UserID, false).
+			Update("has_used_trial", true)
+		if result.Error != nil {
+			return fmt.Errorf("failed to mark trial used: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrTrialAlreadyUsed
+		}
+
+		if err := tx.Create(subscription).Error; err != nil {
+			r.logger.Error().Err(err).Msg("Failed to create trial subscription")
+			return fmt.Errorf("failed to create user subscription: %w", err)
+		}
+		return nil
+	})
+}
+
 func (r *userSubscriptionRepository) GetByID(ctx context.Context, id uint) (*domain.UserSubscription, error) {
 	var subscription domain.UserSubscription
 	if err := r.db.WithContext(ctx).Preload("User").First(&subscription, id).Error; err != nil {
@@ -106,7 +127,8 @@ func (r *userSubscriptionRepository) GetActivePackagesByUserID(ctx context.Conte
 func (r *userSubscriptionRepository) GetCurrentWeeklyUsage(ctx context.Context, userID uint) (int, error) {
 	var totalUsage int64
 	if err := r.db.WithContext(ctx).Model(&domain.UserSubscription{}).
-		Where("user_id = ? AND type = ? AND status = ?", userID, domain.SubscriptionTypeSubscription, domain.SubscriptionStatusActive).
+		Where("user_id = ? AND type = ? AND status = ? AND (period_end IS NULL OR period_end > ?)",
+			userID, domain.SubscriptionTypeSubscription, domain.SubscriptionStatusActive, time.Now()).
 		Select("COALESCE(SUM(weekly_used), 0)").
 		Scan(&totalUsage).Error; err != nil {
 		r.logger.Error().Err(err).Uint("user_id", userID).Msg("Failed to get current weekly usage")
@@ -118,7 +140,8 @@ func (r *userSubscriptionRepository) GetCurrentWeeklyUsage(ctx context.Context,
 func (r *userSubscriptionRepository) GetCurrentMonthlyUsage(ctx context.Context, userID uint) (int, error) {
 	var totalUsage int64
 	if err := r.db.WithContext(ctx).Model(&domain.UserSubscription{}).
-		Where("user_id = ? AND type = ? AND status = ?", userID, domain.SubscriptionTypeSubscription, domain.SubscriptionStatusActive).
+		Where("user_id = ? AND type = ? AND status = ? AND (period_end IS NULL OR period_end > ?)",
+			userID, domain.SubscriptionTypeSubscription, domain.SubscriptionStatusActive, time.Now()).
 		Select("COALESCE(SUM(monthly_used), 0)").
 		Scan(&totalUsage).Error; err != nil {
 		r.logger.Error().Err(err).Uint("user_id", userID).Msg("Failed to get current monthly usage")
@@ -181,11 +204,13 @@ func (r *userSubscriptionRepository) GetPublishingRights(ctx context.Context, us
 	if subscription != nil {
 		rights.ActiveSubscription = subscription
 		rights.WeeklyLimit = subscription.RemainingWeeklyLimit()
-		rights.WeeklyUsed = subscription.WeeklyUsed
+		rights.WeeklyUsed = subscription.PeriodWeeklyUsed()
 		rights.WeeklyRemaining = subscription.RemainingWeeklyLimit()
 		rights.MonthlyLimit = subscription.RemainingMonthlyLimit()
-		rights.MonthlyUsed = subscription.MonthlyUsed
+		rights.MonthlyUsed = subscription.PeriodMonthlyUsed()
 		rights.MonthlyRemaining = subscription.RemainingMonthlyLimit()
+		rights.PeriodStart = subscription.PeriodStart
+		rights.PeriodEnd = subscription.PeriodEnd
 
 		if subscription.CanPublishEvent() {
 			rights.CanPublish = true
@@ -289,7 +314,7 @@ func (r *userSubscriptionRepository) ResetWeeklyLimits(ctx context.Context) erro
 
 func (r *userSubscriptionRepository) ResetMonthlyLimits(ctx context.Context) error {
 	if err := r.db.WithContext(ctx).Model(&domain.UserSubscription{}).
-		Where("type = ? AND status = ?", domain.SubscriptionTypeSubscription, domain.SubscriptionStatusActive).
+		Where("type = ? AND status = ? AND period_start IS NULL", domain.SubscriptionTypeSubscription, domain.SubscriptionStatusActive).
 		Update("monthly_used", 0).Error; err != nil {
 		r.logger.Error().Err(err).Msg("Failed to reset monthly limits")
 		return fmt.Errorf("failed to reset monthly limits: %w", err)
@@ -361,6 +386,19 @@ func (r *userSubscriptionRepository) GetByStripePaymentIntentID(ctx context.Cont
 	return &subscription, nil
 }
 
+func (r *userSubscriptionRepository) GetActiveStripeSubscriptions(ctx context.Context) ([]*domain.UserSubscription, error) {
+	var subscriptions []*domain.UserSubscription
+	if err := r.db.WithContext(ctx).
+		Where("type = ? AND status = ? AND stripe_id IS NOT NULL",
+			domain.SubscriptionTypeSubscription, domain.SubscriptionStatusActive).
+		Order("id ASC").
+		Find(&subscriptions).Error; err != nil {
+		r.logger.Error().Err(err).Msg("Failed to get active Stripe subscriptions")
+		return nil, fmt.Errorf("failed to get active Stripe subscriptions: %w", err)
+	}
+	return subscriptions, nil
+}
+
 func (r *userSubscriptionRepository) UpdatePaymentStatus(ctx context.Context, id uint, status domain.PaymentStatus) error {
 	var subscriptionStatus domain.SubscriptionStatus
 
@@ -478,3 +516,17 @@ func (r *userSubscriptionRepository) GetUserSubscriptionHistory(ctx context.Cont
 
 	return subscriptions, total, nil
 }
+
+func (r *userSubscriptionRepository) GetCancellationReasonStats(ctx context.Context) ([]*domain.CancellationReasonStat, error) {
+	var stats []*domain.CancellationReasonStat
+	if err := r.db.WithContext(ctx).Model(&domain.UserSubscription{}).
+		Select("COALESCE(NULLIF(TRIM(cancellation_reason), ''), 'unspecified') AS reason, COUNT(*) AS count").
+		Where("cancelled_at IS NOT NULL").
+		Group("1").
+		Order("count DESC").
+		Scan(&stats).Error; err != nil {
+		r.logger.Error().Err(err).Msg("Failed to get cancellation reason stats")
+		return nil, fmt.Errorf("failed to get cancellation reason stats: %w", err)
+	}
+	return stats, nil
+}
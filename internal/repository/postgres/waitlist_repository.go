@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/repository"
+)
+
+type waitlistRepository struct {
+	db *gorm.DB
+}
+
+// NewWaitlistRepository creates a new waitlist repository instance
+func NewWaitlistRepository(db *gorm.DB) repository.WaitlistRepository {
+	return &waitlistRepository{db: db}
+}
+
+func (r *waitlistRepository) Join(ctx context.Context, entry *domain.Waitlist) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockWaitlist(tx, entry.EventID); err != nil {
+			return err
+		}
+
+		err := tx.Where("event_id = ? AND user_id = ? AND status = ?", entry.EventID, entry.UserID, domain.WaitlistStatusPromoted).
+			Delete(&domain.Waitlist{}).Error
+		if err != nil {
+			return err
+		}
+
+		var last int
+		err = tx.Model(&domain.Waitlist{}).
+			Select("COALESCE(MAX(position), 0)").
+			Where("event_id = ? AND status = ?", entry.EventID, domain.WaitlistStatusWaiting).
+			Scan(&last).Error
+		if err != nil {
+			return err
+		}
+
+		entry.Position = last + 1
+		return tx.Create(entry).Error
+	})
+}
+
+func (r *waitlistRepository) Leave(ctx context.Context, eventID, userID int) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockWaitlist(tx, eventID); err != nil {
+			return err
+		}
+
+		var entry domain.Waitlist
+		err := tx.Where("event_id = ? AND user_id = ?", eventID, userID).First(&entry).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.ErrWaitlistNotJoined
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Delete(&entry).Error; err != nil {
+			return err
+		}
+		if !entry.IsWaiting() {
+			return nil
+		}
+		return closeWaitlistGap(tx, eventID, entry.Position)
+	})
+}
+
+func (r *waitlistRepository) PromoteNext(ctx context.Context, eventID int) (*domain.Waitlist, error) {
+	var promoted *domain.Waitlist
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockWaitlist(tx, eventID); err != nil {
+			return err
+		}
+
+		var entry domain.Waitlist
+		err := tx.Where("event_id = ? AND status = ?", eventID, domain.WaitlistStatusWaiting).
+			Order("position ASC").
+			First(&entry).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		position := entry.Position
+		now := time.Now()
+		entry.Status = domain.WaitlistStatusPromoted
+		entry.Position = 0
+		entry.PromotedAt = &now
+		if err := tx.Save(&entry).Error; err != nil {
+			return err
+		}
+		if err := closeWaitlistGap(tx, eventID, position); err != nil {
+			return err
+		}
+
+		promoted = &entry
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return promoted, nil
+}
+
+func (r *waitlistRepository) GetByEventAndUser(ctx context.Context, eventID, userID int) (*domain.Waitlist, error) {
+	var entry domain.Waitlist
+	err := r.db.WithContext(ctx).
+		Where("event_id = ? AND user_id = ?", eventID, userID).
+		First(&entry).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, domain.ErrWaitlistNotJoined
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (r *waitlistRepository) CountWaiting(ctx context.Context, eventID int) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&domain.Waitlist{}).
+		Where("event_id = ? AND status = ?", eventID, domain.WaitlistStatusWaiting).
+		Count(&count).Error
+	return count, err
+}
+
+// lockWaitlist serializes queue changes of one event by locking its row
+func lockWaitlist(tx *gorm.DB, eventID int) error {
+	var event domain.Event
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").
+		First(&event, eventID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.ErrEventNotFound
+	}
+	return err
+}
+
+// closeWaitlistGap moves the waiting entries behind position up one place
+func closeWaitlistGap(tx *gorm.DB, eventID, position int) error {
+	return tx.Model(&domain.Waitlist{}).
+		Where("event_id = ? AND status = ? AND position > ?", eventID, domain.WaitlistStatusWaiting, position).
+		Update("position", gorm.Expr("position - 1")).Error
+}
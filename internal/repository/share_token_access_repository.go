@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/louco-event/internal/domain"
+)
+
+// ShareTokenAccessRepository defines the interface for the event share link access log
+type ShareTokenAccessRepository interface {
+	Create(ctx context.Context, access *domain.ShareTokenAccess) error
+
+	// GetStats counts accesses to the event's share link since the given time
+	GetStats(ctx context.Context, eventID int, since time.Time) (*ShareTokenAccessStats, error)
+}
+
+// ShareTokenAccessStats summarizes the access log of one share link
+type ShareTokenAccessStats struct {
+	TotalAccesses  int64
+	UniqueVisitors int64
+	LastAccessedAt *time.Time
+}
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/louco-event/internal/domain"
+)
+
+// SubscriptionHistoryRepository defines the interface for subscription audit trail operations
+type SubscriptionHistoryRepository interface {
+	Create(ctx context.Context, history *domain.SubscriptionHistory) error
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+)
+
+// TicketPurchaseRepository defines the interface for ticket purchase operations
+type TicketPurchaseRepository interface {
+	Create(ctx context.Context, purchase *domain.TicketPurchase) error
+
+	// GetByUserID returns the user's purchases with ticket, event and address loaded.
+	// Pagination counts events rather than purchases so an event's tickets never span pages.
+	// upcoming nil returns both upcoming and past events.
+	GetByUserID(ctx context.Context, userID int, upcoming *bool, pagination dto.PaginationRequest) ([]*domain.TicketPurchase, *dto.PaginationResponse, error)
+
+	// GetByEventID returns every purchase for the event's tickets with ticket and buyer loaded
+	GetByEventID(ctx context.Context, eventID int) ([]*domain.TicketPurchase, error)
+	// ExistsByEventAndUser reports whether the user bought any ticket of the event
+	ExistsByEventAndUser(ctx context.Context, eventID, userID int) (bool, error)
+}
@@ -27,7 +27,11 @@ type TicketRepository interface {
 
 	// Sales operations
 	UpdateSoldQuantity(ctx context.Context, ticketID int, quantity int) error
-	IncrementSoldQuantity(ctx context.Context, ticketID int, quantity int) error
+	// SellTickets sells the purchased quantity and records the purchase in one transaction,
+	// failing with ErrTicketInsufficientQuantity when fewer are left once sales and unexpired
+	// cart holds are taken out, and with ErrEventSalesClosed or ErrEventCapacityExceeded when
+	// the event no longer sells them
+	SellTickets(ctx context.Context, purchase *domain.TicketPurchase) error
 	DecrementSoldQuantity(ctx context.Context, ticketID int, quantity int) error
 	GetSalesStats(ctx context.Context, eventID int) (*dto.TicketSalesStatsResponse, error)
 
@@ -46,11 +50,18 @@ type TicketRepository interface {
 	ExistsByID(ctx context.Context, id int) (bool, error)
 	ExistsByEventAndID(ctx context.Context, eventID, ticketID int) (bool, error)
 	IsTicketOwner(ctx context.Context, ticketID, eventID int) (bool, error)
+	// ExistsByEventAndTitle reports whether another ticket of the event, ignoring excludeTicketID,
+	// has the title regardless of case
+	ExistsByEventAndTitle(ctx context.Context, eventID int, title string, excludeTicketID int) (bool, error)
 
 	// Bulk operations
 	GetMultipleByIDs(ctx context.Context, ids []int) ([]*domain.Ticket, error)
 	CreateMultiple(ctx context.Context, tickets []*domain.Ticket) error
 	UpdateMultiple(ctx context.Context, tickets []*domain.Ticket) error
+	// UpdatePrices sets the price adjust returns on each active ticket of the event and records
+	// the old and new prices in the event history, in one transaction. It fails with
+	// ErrTicketNoActiveTickets when the event has none.
+	UpdatePrices(ctx context.Context, eventID int, actorUserID *int, adjust func(ticket *domain.Ticket) (float64, error)) ([]*domain.Ticket, error)
 	DeleteMultiple(ctx context.Context, ids []int) error
 	DeleteByEventID(ctx context.Context, eventID int) error
 
@@ -58,6 +69,7 @@ type TicketRepository interface {
 	GetTotalRevenue(ctx context.Context, eventID int) (float64, error)
 	GetTotalTicketsSold(ctx context.Context, eventID int) (int, error)
 	GetTotalTicketsAvailable(ctx context.Context, eventID int) (int, error)
+	GetAvailabilitySummary(ctx context.Context, eventID int) (*dto.TicketAvailabilitySummaryResponse, error)
 	GetTicketTypeStats(ctx context.Context, eventID int) ([]*dto.TicketTypeStatsResponse, error)
 
 	// Advanced filtering
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/louco-event/internal/domain"
+)
+
+// TicketReservationRepository defines the interface for cart ticket hold operations
+type TicketReservationRepository interface {
+	// CreateCart stores the holds of one cart atomically. The ticket rows are locked while
+	// availability is checked, so concurrent carts for the same tiers cannot both succeed.
+	// Returns domain.ErrTicketInsufficientQuantity, and stores nothing, when any tier is short.
+	CreateCart(ctx context.Context, reservations []*domain.TicketReservation) error
+	GetByCartID(ctx context.Context, cartID string) ([]*domain.TicketReservation, error)
+
+	// ConfirmCart turns the active holds of a cart into ticket sales and purchases. It fails
+	// with ErrEventSalesClosed or ErrEventCapacityExceeded when an event of the cart closed its
+	// sales or filled up since the cart was reserved.
+	ConfirmCart(ctx context.Context, cartID string) ([]*domain.TicketPurchase, error)
+	// ReleaseCart gives the active holds of a cart back; returns how many were released
+	ReleaseCart(ctx context.Context, cartID string) (int64, error)
+
+	// GetHeldQuantities returns the quantity held by unexpired reservations per ticket
+	GetHeldQuantities(ctx context.Context, ticketIDs []int) (map[int]int, error)
+}
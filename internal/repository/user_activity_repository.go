@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/louco-event/internal/domain"
+)
+
+// UserActivityRepository reads the activity timeline of a user
+type UserActivityRepository interface {
+	// GetTimeline returns up to limit activities of the user, newest first. When beforeAt is
+	// set only activities ordered after (beforeAt, beforeID) are returned, which continues a
+	// previous page.
+	GetTimeline(ctx context.Context, userID int, beforeAt *time.Time, beforeID int, limit int) ([]*domain.UserActivity, error)
+
+	// GetUserEvents returns up to limit events the user owns, holds tickets for or is invited
+	// to (by user id or email), latest start first. Only published events count for tickets
+	// and invitations. An empty relation includes all of them; beforeAt continues a previous
+	// page like in GetTimeline.
+	GetUserEvents(ctx context.Context, userID int, email string, relation domain.UserEventRelation, beforeAt *time.Time, beforeID int, limit int) ([]*domain.UserEventRef, error)
+}
@@ -10,6 +10,9 @@ import (
 type UserRepository interface {
 	Create(ctx context.Context, user *domain.User) error
 	GetByID(ctx context.Context, id int) (*domain.User, error)
+	// GetInvitableByIDs returns the active users among ids that follow creatorUserID or attended
+	// one of their events; every other id is left out
+	GetInvitableByIDs(ctx context.Context, creatorUserID int, ids []int) ([]*domain.User, error)
 	GetByEmail(ctx context.Context, email string) (*domain.User, error)
 	GetByPhone(ctx context.Context, phone string) (*domain.User, error)
 	GetByUsername(ctx context.Context, username string) (*domain.User, error)
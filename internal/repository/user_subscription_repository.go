@@ -9,6 +9,10 @@ import (
 type UserSubscriptionRepository interface {
 	// Basic CRUD operations
 	Create(ctx context.Context, subscription *domain.UserSubscription) error
+	// CreateTrial stores a trial subscription and claims the user's one-time trial in one
+	// transaction, so a failed insert never uses up the trial. Fails with domain.ErrTrialAlreadyUsed
+	// when the user had a trial before.
+	CreateTrial(ctx context.Context, subscription *domain.UserSubscription) error
 	GetByID(ctx context.Context, id uint) (*domain.UserSubscription, error)
 	GetByUserID(ctx context.Context, userID uint) ([]*domain.UserSubscription, error)
 	Update(ctx context.Context, subscription *domain.UserSubscription) error
@@ -44,11 +48,14 @@ type UserSubscriptionRepository interface {
 	// Payment integration
 	GetByStripeSubscriptionID(ctx context.Context, stripeSubscriptionID string) (*domain.UserSubscription, error)
 	GetByStripePaymentIntentID(ctx context.Context, stripePaymentIntentID string) (*domain.UserSubscription, error)
+	// GetActiveStripeSubscriptions returns the active recurring subscriptions backed by Stripe
+	GetActiveStripeSubscriptions(ctx context.Context) ([]*domain.UserSubscription, error)
 	UpdatePaymentStatus(ctx context.Context, id uint, status domain.PaymentStatus) error
 
 	// Statistics and reporting
 	GetSubscriptionStats(ctx context.Context, userID uint) (*domain.SubscriptionStats, error)
 	GetUserSubscriptionHistory(ctx context.Context, userID uint, limit, offset int) ([]*domain.UserSubscription, int64, error)
+	GetCancellationReasonStats(ctx context.Context) ([]*domain.CancellationReasonStat, error)
 }
 
 type SubscriptionPlanRepository interface {
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/louco-event/internal/domain"
+)
+
+// WaitlistRepository defines the interface for event waitlist operations. Queue changes lock
+// the event row, so concurrent joins, leaves and promotions keep the positions contiguous.
+type WaitlistRepository interface {
+	// Join appends the entry to the end of the event's queue, replacing an earlier promoted
+	// entry of the same user
+	Join(ctx context.Context, entry *domain.Waitlist) error
+	// Leave removes the user's entry and moves everyone behind it up one place. Returns
+	// domain.ErrWaitlistNotJoined when the user has no entry.
+	Leave(ctx context.Context, eventID, userID int) error
+	// PromoteNext promotes the first waiting entry and moves the rest up one place; returns
+	// nil when nobody is waiting
+	PromoteNext(ctx context.Context, eventID int) (*domain.Waitlist, error)
+
+	// GetByEventAndUser returns domain.ErrWaitlistNotJoined when the user has no entry
+	GetByEventAndUser(ctx context.Context, eventID, userID int) (*domain.Waitlist, error)
+	CountWaiting(ctx context.Context, eventID int) (int64, error)
+}
@@ -0,0 +1,167 @@
+package scheduler
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// JobFunc is the work performed on each run of a job
+type JobFunc func(ctx context.Context) error
+
+// JobStatus describes the latest execution of a job. Tracking is in-memory and resets on restart.
+type JobStatus struct {
+	Name         string     `json:"name"`
+	Interval     string     `json:"interval"`
+	Running      bool       `json:"running"`
+	LastRunAt    *time.Time `json:"last_run_at"`
+	LastDuration string     `json:"last_duration,omitempty"`
+	LastResult   string     `json:"last_result,omitempty"` // success or failed
+	LastError    *string    `json:"last_error,omitempty"`
+	NextRunAt    *time.Time `json:"next_run_at"`
+	RunCount     int        `json:"run_count"`
+	FailureCount int        `json:"failure_count"`
+}
+
+const (
+	JobResultSuccess = "success"
+	JobResultFailed  = "failed"
+)
+
+type job struct {
+	name     string
+	interval time.Duration
+	run      JobFunc
+	status   JobStatus
+}
+
+// Scheduler runs registered jobs at fixed intervals and keeps their latest status
+type Scheduler struct {
+	mu     sync.RWMutex
+	jobs   map[string]*job
+	logger zerolog.Logger
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func New(logger zerolog.Logger) *Scheduler {
+	return &Scheduler{
+		jobs:   make(map[string]*job),
+		logger: logger.With().Str("component", "scheduler").Logger(),
+	}
+}
+
+// Register adds a job; it must be called before Start
+func (s *Scheduler) Register(name string, interval time.Duration, run JobFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.jobs[name] = &job{
+		name:     name,
+		interval: interval,
+		run:      run,
+		status: JobStatus{
+			Name:     name,
+			Interval: interval.String(),
+		},
+	}
+}
+
+// Start runs every registered job in its own goroutine until Stop is called or ctx is done
+func (s *Scheduler) Start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, j := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(ctx, j)
+	}
+
+	s.logger.Info().Int("jobs", len(s.jobs)).Msg("Scheduler started")
+}
+
+// Stop cancels all jobs and waits for running executions to finish
+func (s *Scheduler) Stop() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	s.wg.Wait()
+	s.logger.Info().Msg("Scheduler stopped")
+}
+
+// Statuses returns the status of every job, sorted by name
+func (s *Scheduler) Statuses() []JobStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	statuses := make([]JobStatus, 0, len(s.jobs))
+	for _, j := range s.jobs {
+		statuses = append(statuses, j.status)
+	}
+	sort.Slice(statuses, func(i, k int) bool {
+		return statuses[i].Name < statuses[k].Name
+	})
+	return statuses
+}
+
+func (s *Scheduler) loop(ctx context.Context, j *job) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+	s.setNextRun(j, time.Now().Add(j.interval))
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.execute(ctx, j)
+			s.setNextRun(j, time.Now().Add(j.interval))
+		}
+	}
+}
+
+func (s *Scheduler) execute(ctx context.Context, j *job) {
+	startedAt := time.Now()
+	s.mu.Lock()
+	j.status.Running = true
+	j.status.LastRunAt = &startedAt
+	s.mu.Unlock()
+
+	err := j.run(ctx)
+	duration := time.Since(startedAt)
+
+	s.mu.Lock()
+	j.status.Running = false
+	j.status.LastDuration = duration.String()
+	j.status.RunCount++
+	if err != nil {
+		message := err.Error()
+		j.status.LastResult = JobResultFailed
+		j.status.LastError = &message
+		j.status.FailureCount++
+	} else {
+		j.status.LastResult = JobResultSuccess
+		j.status.LastError = nil
+	}
+	s.mu.Unlock()
+
+	if err != nil {
+		s.logger.Error().Err(err).Str("job", j.name).Dur("duration", duration).Msg("Scheduled job failed")
+		return
+	}
+	s.logger.Info().Str("job", j.name).Dur("duration", duration).Msg("Scheduled job completed")
+}
+
+func (s *Scheduler) setNextRun(j *job, next time.Time) {
+	s.mu.Lock()
+	j.status.NextRunAt = &next
+	s.mu.Unlock()
+}
@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/repository"
+	"github.com/louco-event/pkg/pagination"
+	"github.com/rs/zerolog"
+)
+
+const (
+	defaultActivityPageSize = 20
+	maxActivityPageSize     = 100
+)
+
+type ActivityService interface {
+	// GetUserActivityTimeline merges the user's event changes, invitation answers and ticket
+	// purchases, newest first, paginated by an opaque cursor
+	GetUserActivityTimeline(ctx context.Context, userID int, req dto.ActivityTimelineRequest) (*dto.ActivityTimelineResponse, error)
+	// GetUserEventsUnified lists the events the user owns, attends or is invited to in one
+	// de-duplicated list, latest start first, paginated by an opaque cursor
+	GetUserEventsUnified(ctx context.Context, userID int, req dto.UserEventsRequest) (*dto.UserEventsResponse, error)
+}
+
+type activityService struct {
+	activityRepo repository.UserActivityRepository
+	eventRepo    repository.EventRepository
+	userRepo     repository.UserRepository
+	cursorCodec  *pagination.CursorCodec
+	logger       zerolog.Logger
+}
+
+func NewActivityService(
+	activityRepo repository.UserActivityRepository,
+	eventRepo repository.EventRepository,
+	userRepo repository.UserRepository,
+	cursorCodec *pagination.CursorCodec,
+	logger zerolog.Logger,
+) ActivityService {
+	return &activityService{
+		activityRepo: activityRepo,
+		eventRepo:    eventRepo,
+		userRepo:     userRepo,
+		cursorCodec:  cursorCodec,
+		logger:       logger.With().Str("service", "activity").Logger(),
+	}
+}
+
+func (s *activityService) GetUserActivityTimeline(ctx context.Context, userID int, req dto.ActivityTimelineRequest) (*dto.ActivityTimelineResponse, error) {
+	limit := activityPageSize(req.Limit)
+	beforeAt, beforeID, err := s.decodeCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	// One extra row tells whether another page follows
+	activities, err := s.activityRepo.GetTimeline(ctx, userID, beforeAt, beforeID, limit+1)
+	if err != nil {
+		s.logger.Error().Err(err).Int("user_id", userID).Msg("Failed to get user activity timeline")
+		return nil, fmt.Errorf("failed to get activity timeline: %w", err)
+	}
+
+	response := &dto.ActivityTimelineResponse{Items: make([]*dto.UserActivityResponse, 0, min(len(activities), limit))}
+	if len(activities) > limit {
+		activities = activities[:limit]
+		last := activities[limit-1]
+		next, err := s.cursorCodec.Encode(pagination.Cursor{CreatedAt: last.OccurredAt, ID: last.SourceID})
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode cursor: %w", err)
+		}
+		response.NextCursor = &next
+	}
+
+	for _, activity := range activities {
+		response.Items = append(response.Items, activityToResponse(activity))
+	}
+	return response, nil
+}
+
+func (s *activityService) GetUserEventsUnified(ctx context.Context, userID int, req dto.UserEventsRequest) (*dto.UserEventsResponse, error) {
+	var relation domain.UserEventRelation
+	if req.Relation != "" && req.Relation != "all" {
+		relation = domain.UserEventRelation(req.Relation)
+		if !relation.IsValid() {
+			return nil, domain.ErrUserEventInvalidRelation
+		}
+	}
+
+	limit := activityPageSize(req.Limit)
+	beforeAt, beforeID, err := s.decodeCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	// Invitations sent before the user signed up are only linked by email
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	email := ""
+	if user.Email != nil {
+		email = *user.Email
+	}
+
+	refs, err := s.activityRepo.GetUserEvents(ctx, userID, email, relation, beforeAt, beforeID, limit+1)
+	if err != nil {
+		s.logger.Error().Err(err).Int("user_id", userID).Msg("Failed to get user events")
+		return nil, fmt.Errorf("failed to get user events: %w", err)
+	}
+
+	response := &dto.UserEventsResponse{Items: make([]*dto.UserEventResponse, 0, min(len(refs), limit))}
+	if len(refs) > limit {
+		refs = refs[:limit]
+		last := refs[limit-1]
+		next, err := s.cursorCodec.Encode(pagination.Cursor{CreatedAt: last.SortAt, ID: last.EventID})
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode cursor: %w", err)
+		}
+		response.NextCursor = &next
+	}
+	if len(refs) == 0 {
+		return response, nil
+	}
+
+	eventIDs := make([]int, len(refs))
+	for i, ref := range refs {
+		eventIDs[i] = ref.EventID
+	}
+	events, err := s.eventRepo.GetMultipleByIDs(ctx, eventIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events: %w", err)
+	}
+	eventsByID := make(map[int]*domain.Event, len(events))
+	for _, event := range events {
+		eventsByID[event.ID] = event
+	}
+
+	for _, ref := range refs {
+		event, ok := eventsByID[ref.EventID]
+		if !ok {
+			continue
+		}
+		response.Items = append(response.Items, &dto.UserEventResponse{
+			Relation: ref.Relation,
+			Event:    dto.EventToListResponse(event),
+		})
+	}
+	return response, nil
+}
+
+// activityPageSize applies the default and the cap to a requested page size
+func activityPageSize(limit int) int {
+	if limit <= 0 {
+		return defaultActivityPageSize
+	}
+	return min(limit, maxActivityPageSize)
+}
+
+// decodeCursor turns an opaque cursor into the keyset to continue after; an empty cursor
+// starts from the beginning
+func (s *activityService) decodeCursor(encoded string) (*time.Time, int, error) {
+	if encoded == "" {
+		return nil, 0, nil
+	}
+	cursor, err := s.cursorCodec.Decode(encoded)
+	if err != nil {
+		return nil, 0, err
+	}
+	return &cursor.CreatedAt, cursor.ID, nil
+}
+
+func activityToResponse(activity *domain.UserActivity) *dto.UserActivityResponse {
+	payload := map[string]interface{}{"event_name": activity.EventName}
+	switch activity.Type {
+	case domain.UserActivityEventStatusChanged, domain.UserActivityInvitationResponded:
+		if activity.Status != nil {
+			payload["status"] = *activity.Status
+		}
+	case domain.UserActivityTicketPurchased:
+		if activity.TicketTitle != nil {
+			payload["ticket_title"] = *activity.TicketTitle
+		}
+		if activity.Quantity != nil {
+			payload["quantity"] = *activity.Quantity
+		}
+	}
+
+	return &dto.UserActivityResponse{
+		Type:       activity.Type,
+		EventID:    activity.EventID,
+		OccurredAt: activity.OccurredAt,
+		Payload:    payload,
+	}
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/repository/postgres"
+	"github.com/louco-event/internal/testutil"
+	"github.com/louco-event/pkg/pagination"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+func newTestActivityService(db *gorm.DB) ActivityService {
+	return NewActivityService(
+		postgres.NewUserActivityRepository(db),
+		postgres.NewEventRepository(db),
+		postgres.NewUserRepository(db),
+		pagination.NewCursorCodec("test-secret"),
+		zerolog.Nop(),
+	)
+}
+
+func TestGetUserActivityTimelineMergesSources(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	host := testutil.CreateCreator(t, db)
+	stranger := testutil.CreateUser(t, db, domain.UserTypeUser)
+	service := newTestActivityService(db)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	hoursAgo := func(hours int) time.Time { return now.Add(-time.Duration(hours) * time.Hour) }
+	own := testutil.CreateEvent(t, db, creator.ID, nil)
+	party := testutil.CreateEvent(t, db, host.ID, nil)
+
+	// The creator's own event changes
+	published := domain.EventStatusPublished
+	histories := []*domain.EventHistory{
+		{EventID: own.ID, ActorUserID: &creator.UserID, Action: domain.EventHistoryActionCreated, CreatedAt: hoursAgo(5)},
+		{EventID: own.ID, ActorUserID: &creator.UserID, Action: domain.EventHistoryActionStatusChanged, ToStatus: &published, CreatedAt: hoursAgo(1)},
+	}
+	if err := db.Omit("Actor").Create(&histories).Error; err != nil {
+		t.Fatalf("failed to create history: %v", err)
+	}
+
+	// An answered invitation to someone else's event, and a ticket for it
+	responded := hoursAgo(3)
+	seedInvitations(t, db, party.ID, []domain.InvitationStatus{domain.InvitationStatusApproved}, func(invitation *domain.Invitation) {
+		invitation.InvitedUserID = &creator.UserID
+		invitation.RespondedAt = &responded
+	})
+	ticket := testutil.CreateTicket(t, db, party.ID, 10)
+	purchases := []*domain.TicketPurchase{
+		{TicketID: ticket.ID, UserID: creator.UserID, Quantity: 2, PurchasedAt: hoursAgo(2)},
+		{TicketID: ticket.ID, UserID: stranger.ID, Quantity: 1, PurchasedAt: hoursAgo(4)},
+	}
+	if err := db.Omit("Ticket", "User").Create(&purchases).Error; err != nil {
+		t.Fatalf("failed to create purchases: %v", err)
+	}
+
+	want := []struct {
+		activity domain.UserActivityType
+		eventID  int
+		at       time.Time
+	}{
+		{domain.UserActivityEventStatusChanged, own.ID, hoursAgo(1)},
+		{domain.UserActivityTicketPurchased, party.ID, hoursAgo(2)},
+		{domain.UserActivityInvitationResponded, party.ID, hoursAgo(3)},
+		{domain.UserActivityEventCreated, own.ID, hoursAgo(5)},
+	}
+
+	// Two pages of three and one entry, newest first across the sources
+	var items []*dto.UserActivityResponse
+	first, err := service.GetUserActivityTimeline(ctx, creator.UserID, dto.ActivityTimelineRequest{Limit: 3})
+	if err != nil {
+		t.Fatalf("GetUserActivityTimeline: %v", err)
+	}
+	if len(first.Items) != 3 || first.NextCursor == nil {
+		t.Fatalf("first page has %d items, next cursor %v; want 3 and a cursor", len(first.Items), first.NextCursor)
+	}
+	items = append(items, first.Items...)
+	second, err := service.GetUserActivityTimeline(ctx, creator.UserID, dto.ActivityTimelineRequest{Limit: 3, Cursor: *first.NextCursor})
+	if err != nil {
+		t.Fatalf("GetUserActivityTimeline second page: %v", err)
+	}
+	if second.NextCursor != nil {
+		t.Errorf("last page has a next cursor")
+	}
+	items = append(items, second.Items...)
+
+	if len(items) != len(want) {
+		t.Fatalf("got %d entries, want %d", len(items), len(want))
+	}
+	for i, item := range items {
+		if item.Type != want[i].activity || item.EventID != want[i].eventID || !item.OccurredAt.Equal(want[i].at) {
+			t.Errorf("entry %d = %s on event %d at %s, want %s on event %d at %s",
+				i, item.Type, item.EventID, item.OccurredAt, want[i].activity, want[i].eventID, want[i].at)
+		}
+	}
+	if quantity := items[1].Payload["quantity"]; quantity != 2 {
+		t.Errorf("purchase payload quantity = %v, want 2", quantity)
+	}
+}
+
+func TestGetUserEventsUnifiedTagsRelations(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	host := testutil.CreateCreator(t, db)
+	service := newTestActivityService(db)
+
+	startingIn := func(days int, mutate func(*domain.Event)) func(*domain.Event) {
+		return func(event *domain.Event) {
+			start := time.Now().UTC().AddDate(0, 0, days)
+			event.StartDate = &start
+			if mutate != nil {
+				mutate(event)
+			}
+		}
+	}
+	private := func(event *domain.Event) { event.Type = domain.EventTypePrivate }
+	owned := testutil.CreateEvent(t, db, creator.ID, startingIn(3, func(event *domain.Event) { event.Status = domain.EventStatusDraft }))
+	invited := testutil.CreateEvent(t, db, host.ID, startingIn(1, private))
+	attending := testutil.CreateEvent(t, db, host.ID, startingIn(5, nil))
+	unpublished := testutil.CreateEvent(t, db, host.ID, startingIn(2, func(event *domain.Event) {
+		event.Type = domain.EventTypePrivate
+		event.Status = domain.EventStatusDraft
+	}))
+
+	// Invitations linked by email only; the ticket holder was invited too
+	email := strings.ToUpper(*creator.User.Email)
+	for _, event := range []*domain.Event{invited, attending, unpublished} {
+		createInvitation(t, db, event.ID, email)
+	}
+	ticket := testutil.CreateTicket(t, db, attending.ID, 10)
+	if err := db.Omit("Ticket", "User").Create(domain.NewTicketPurchase(ticket.ID, creator.UserID, 1)).Error; err != nil {
+		t.Fatalf("failed to create purchase: %v", err)
+	}
+
+	list := func(relation string) string {
+		t.Helper()
+		var got []string
+		req := dto.UserEventsRequest{Relation: relation, Limit: 2}
+		for {
+			page, err := service.GetUserEventsUnified(ctx, creator.UserID, req)
+			if err != nil {
+				t.Fatalf("GetUserEventsUnified(%q): %v", relation, err)
+			}
+			for _, item := range page.Items {
+				got = append(got, fmt.Sprintf("%d:%s", item.Event.ID, item.Relation))
+			}
+			if page.NextCursor == nil {
+				return strings.Join(got, " ")
+			}
+			req.Cursor = *page.NextCursor
+		}
+	}
+
+	// One entry per event, the strongest relation winning, latest start first
+	want := fmt.Sprintf("%d:attending %d:owned %d:invited", attending.ID, owned.ID, invited.ID)
+	if got := list("all"); got != want {
+		t.Errorf("all = %s, want %s", got, want)
+	}
+	want = fmt.Sprintf("%d:invited %d:invited", attending.ID, invited.ID)
+	if got := list("invited"); got != want {
+		t.Errorf("invited = %s, want %s", got, want)
+	}
+	want = fmt.Sprintf("%d:owned", owned.ID)
+	if got := list("owned"); got != want {
+		t.Errorf("owned = %s, want %s", got, want)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/louco-event/internal/domain"
 	"github.com/louco-event/internal/dto"
 	"github.com/louco-event/internal/repository"
+	"github.com/louco-event/pkg/timezone"
 	"github.com/rs/zerolog"
 )
 
@@ -41,14 +42,16 @@ type AddressService interface {
 }
 
 type addressService struct {
-	addressRepo repository.AddressRepository
-	logger      zerolog.Logger
+	addressRepo      repository.AddressRepository
+	timezoneResolver timezone.Resolver
+	logger           zerolog.Logger
 }
 
-func NewAddressService(addressRepo repository.AddressRepository, logger zerolog.Logger) AddressService {
+func NewAddressService(addressRepo repository.AddressRepository, timezoneResolver timezone.Resolver, logger zerolog.Logger) AddressService {
 	return &addressService{
-		addressRepo: addressRepo,
-		logger:      logger.With().Str("service", "address").Logger(),
+		addressRepo:      addressRepo,
+		timezoneResolver: timezoneResolver,
+		logger:           logger.With().Str("service", "address").Logger(),
 	}
 }
 
@@ -78,6 +81,7 @@ func (s *addressService) CreateAddress(ctx context.Context, req dto.CreateAddres
 	if err := s.ValidateAddress(ctx, address); err != nil {
 		return nil, err
 	}
+	s.resolveTimezone(ctx, address)
 
 	// Create address
 	if err := s.addressRepo.Create(ctx, address); err != nil {
@@ -113,6 +117,9 @@ func (s *addressService) UpdateAddress(ctx context.Context, id int, req dto.Crea
 		return nil, fmt.Errorf("validation failed: %w", err)
 	}
 
+	// Only a move needs a new timezone lookup
+	moved := existingAddress.Latitude != req.Latitude || existingAddress.Longitude != req.Longitude
+
 	// Update fields
 	existingAddress.PlaceID = req.PlaceID
 	existingAddress.FullAddress = req.FullAddress
@@ -129,6 +136,9 @@ func (s *addressService) UpdateAddress(ctx context.Context, id int, req dto.Crea
 	if err := s.ValidateAddress(ctx, existingAddress); err != nil {
 		return nil, err
 	}
+	if moved || existingAddress.Timezone == "" {
+		s.resolveTimezone(ctx, existingAddress)
+	}
 
 	// Update address
 	if err := s.addressRepo.Update(ctx, existingAddress); err != nil {
@@ -196,6 +206,8 @@ func (s *addressService) CreateOrUpdateByPlaceID(ctx context.Context, req dto.Cr
 		DoorNumber:  req.DoorNumber,
 	}
 
+	s.resolveTimezone(ctx, address)
+
 	// Create or update address
 	updatedAddress, err := s.addressRepo.CreateOrUpdateByPlaceID(ctx, address)
 	if err != nil {
@@ -386,6 +398,19 @@ func (s *addressService) validateCreateAddressRequest(req *dto.CreateAddressRequ
 	return nil
 }
 
+// resolveTimezone sets the address timezone from its coordinates. A failed lookup is logged
+// and leaves the timezone as it was, so saving the address never depends on the lookup.
+func (s *addressService) resolveTimezone(ctx context.Context, address *domain.Address) {
+	name, err := s.timezoneResolver.Lookup(ctx, address.Latitude, address.Longitude)
+	if err != nil {
+		s.logger.Warn().Err(err).Str("place_id", address.PlaceID).Msg("Failed to look up address timezone")
+		return
+	}
+	if name != "" {
+		address.Timezone = name
+	}
+}
+
 func (s *addressService) addressToResponse(address *domain.Address) *dto.AddressResponse {
 	return &dto.AddressResponse{
 		ID:          address.ID,
@@ -398,6 +423,7 @@ func (s *addressService) addressToResponse(address *domain.Address) *dto.Address
 		PostalCode:  address.PostalCode,
 		Latitude:    address.Latitude,
 		Longitude:   address.Longitude,
+		Timezone:    address.Timezone,
 		DoorNumber:  address.DoorNumber,
 		CreatedAt:   address.CreatedAt,
 		UpdatedAt:   address.UpdatedAt,
@@ -40,8 +40,9 @@ type CategoryService interface {
 	GetCategoriesByType(ctx context.Context, categoryType domain.CategoryType) ([]*dto.CategoryResponse, error)
 
 	// Search operations
-	SearchCategories(ctx context.Context, query string) ([]*dto.CategoryResponse, error)
-	SearchCategoriesByType(ctx context.Context, query string, categoryType domain.CategoryType) ([]*dto.CategoryResponse, error)
+	// sortLocale orders results by name in that language; empty keeps tree order
+	SearchCategories(ctx context.Context, query string, sortLocale string) ([]*dto.CategoryResponse, error)
+	SearchCategoriesByType(ctx context.Context, query string, categoryType domain.CategoryType, sortLocale string) ([]*dto.CategoryResponse, error)
 
 	// Count operations
 	GetCategoryCount(ctx context.Context) (int, error)
@@ -305,8 +306,8 @@ func (s *categoryService) GetCategoriesByType(ctx context.Context, categoryType
 }
 
 // Search operations
-func (s *categoryService) SearchCategories(ctx context.Context, query string) ([]*dto.CategoryResponse, error) {
-	categories, err := s.categoryRepo.Search(ctx, query)
+func (s *categoryService) SearchCategories(ctx context.Context, query string, sortLocale string) ([]*dto.CategoryResponse, error) {
+	categories, err := s.categoryRepo.Search(ctx, query, sortLocale)
 	if err != nil {
 		s.logger.Error().Err(err).Str("query", query).Msg("Failed to search categories")
 		return nil, fmt.Errorf("failed to search categories")
@@ -320,8 +321,8 @@ func (s *categoryService) SearchCategories(ctx context.Context, query string) ([
 	return responses, nil
 }
 
-func (s *categoryService) SearchCategoriesByType(ctx context.Context, query string, categoryType domain.CategoryType) ([]*dto.CategoryResponse, error) {
-	categories, err := s.categoryRepo.SearchByType(ctx, query, categoryType)
+func (s *categoryService) SearchCategoriesByType(ctx context.Context, query string, categoryType domain.CategoryType, sortLocale string) ([]*dto.CategoryResponse, error) {
+	categories, err := s.categoryRepo.SearchByType(ctx, query, categoryType, sortLocale)
 	if err != nil {
 		s.logger.Error().Err(err).Str("query", query).Str("type", string(categoryType)).Msg("Failed to search categories by type")
 		return nil, fmt.Errorf("failed to search categories by type")
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/repository"
+	"github.com/louco-event/pkg/stripe"
+	"github.com/rs/zerolog"
+)
+
+type CheckoutService interface {
+	// CheckoutCart opens a Stripe checkout session for a held cart and records it as pending
+	CheckoutCart(ctx context.Context, userID int, cartReservationID string) (*dto.CartCheckoutResponse, error)
+
+	// CompleteCheckout sells the cart of a paid checkout session
+	CompleteCheckout(ctx context.Context, sessionID string) error
+	// ExpireCheckout marks an abandoned checkout expired and gives its cart back
+	ExpireCheckout(ctx context.Context, sessionID string) error
+}
+
+type checkoutService struct {
+	pendingCheckoutRepo   repository.PendingCheckoutRepository
+	ticketReservationRepo repository.TicketReservationRepository
+	ticketRepo            repository.TicketRepository
+	userRepo              repository.UserRepository
+	ticketService         TicketService
+	stripeClient          stripe.CartCheckoutClient
+	logger                zerolog.Logger
+}
+
+func NewCheckoutService(
+	pendingCheckoutRepo repository.PendingCheckoutRepository,
+	ticketReservationRepo repository.TicketReservationRepository,
+	ticketRepo repository.TicketRepository,
+	userRepo repository.UserRepository,
+	ticketService TicketService,
+	stripeClient stripe.CartCheckoutClient,
+	logger zerolog.Logger,
+) CheckoutService {
+	return &checkoutService{
+		pendingCheckoutRepo:   pendingCheckoutRepo,
+		ticketReservationRepo: ticketReservationRepo,
+		ticketRepo:            ticketRepo,
+		userRepo:              userRepo,
+		ticketService:         ticketService,
+		stripeClient:          stripeClient,
+		logger:                logger.With().Str("service", "checkout").Logger(),
+	}
+}
+
+func (s *checkoutService) CheckoutCart(ctx context.Context, userID int, cartReservationID string) (*dto.CartCheckoutResponse, error) {
+	reservations, err := s.ticketReservationRepo.GetByCartID(ctx, cartReservationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cart reservation: %w", err)
+	}
+	// Carts of other users are reported as missing so cart ids cannot be probed
+	if len(reservations) == 0 || reservations[0].UserID != userID {
+		return nil, domain.ErrTicketReservationNotFound
+	}
+
+	ticketIDs := make([]int, 0, len(reservations))
+	expiresAt := reservations[0].ExpiresAt
+	for _, reservation := range reservations {
+		if !reservation.IsHeld() {
+			return nil, domain.ErrTicketReservationExpired
+		}
+		if reservation.ExpiresAt.Before(expiresAt) {
+			expiresAt = reservation.ExpiresAt
+		}
+		ticketIDs = append(ticketIDs, reservation.TicketID)
+	}
+
+	tickets, err := s.ticketRepo.GetMultipleByIDs(ctx, ticketIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tickets: %w", err)
+	}
+	ticketsByID := make(map[int]*domain.Ticket, len(tickets))
+	for _, ticket := range tickets {
+		ticketsByID[ticket.ID] = ticket
+	}
+
+	items := make([]stripe.CartCheckoutItem, 0, len(reservations))
+	for _, reservation := range reservations {
+		ticket, ok := ticketsByID[reservation.TicketID]
+		if !ok || ticket.IsFree() {
+			continue
+		}
+		items = append(items, stripe.CartCheckoutItem{Name: ticket.Title, UnitPrice: ticket.Price, Quantity: reservation.Quantity})
+	}
+	if len(items) == 0 {
+		return nil, domain.ErrCartNotPayable
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	req := stripe.CartCheckoutRequest{
+		CartReservationID: cartReservationID,
+		UserID:            userID,
+		Items:             items,
+		ExpiresAt:         expiresAt,
+	}
+	if user.Email != nil {
+		req.CustomerEmail = *user.Email
+	}
+	session, err := s.stripeClient.CreateCheckoutSessionForCart(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	checkout := domain.NewPendingCheckout(session.ID, cartReservationID, userID, expiresAt)
+	if err := s.pendingCheckoutRepo.Create(ctx, checkout); err != nil {
+		s.logger.Error().Err(err).Str("session_id", session.ID).Str("cart_id", cartReservationID).Msg("Failed to store pending checkout")
+		return nil, fmt.Errorf("failed to store pending checkout: %w", err)
+	}
+
+	s.logger.Info().Int("user_id", userID).Str("cart_id", cartReservationID).Str("session_id", session.ID).Msg("Cart checkout started")
+	return &dto.CartCheckoutResponse{
+		CartReservationID: cartReservationID,
+		SessionID:         session.ID,
+		CheckoutURL:       session.URL,
+		ExpiresAt:         expiresAt,
+	}, nil
+}
+
+func (s *checkoutService) CompleteCheckout(ctx context.Context, sessionID string) error {
+	checkout, err := s.pendingCheckoutRepo.GetByStripeSessionID(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+	// Redelivered webhooks find the checkout already resolved
+	if checkout.Status != domain.PendingCheckoutStatusPending {
+		return nil
+	}
+
+	// The cart is sold before the checkout is resolved so a failure is retried with the webhook
+	if err := s.ticketService.ConfirmCart(ctx, checkout.UserID, checkout.CartReservationID); err != nil {
+		s.logger.Error().Err(err).Str("session_id", sessionID).Str("cart_id", checkout.CartReservationID).Msg("Failed to confirm cart of paid checkout")
+		return err
+	}
+	if _, err := s.pendingCheckoutRepo.Resolve(ctx, sessionID, domain.PendingCheckoutStatusCompleted); err != nil {
+		return fmt.Errorf("failed to complete pending checkout: %w", err)
+	}
+
+	s.logger.Info().Int("user_id", checkout.UserID).Str("cart_id", checkout.CartReservationID).Str("session_id", sessionID).Msg("Cart checkout completed")
+	return nil
+}
+
+func (s *checkoutService) ExpireCheckout(ctx context.Context, sessionID string) error {
+	checkout, err := s.pendingCheckoutRepo.GetByStripeSessionID(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+	if checkout.Status != domain.PendingCheckoutStatusPending {
+		return nil
+	}
+
+	// A cart whose hold already ran out has nothing left to give back
+	if err := s.ticketService.ReleaseCart(ctx, checkout.UserID, checkout.CartReservationID); err != nil && !errors.Is(err, domain.ErrTicketReservationNotFound) {
+		s.logger.Error().Err(err).Str("session_id", sessionID).Str("cart_id", checkout.CartReservationID).Msg("Failed to release cart of expired checkout")
+		return err
+	}
+	if _, err := s.pendingCheckoutRepo.Resolve(ctx, sessionID, domain.PendingCheckoutStatusExpired); err != nil {
+		return fmt.Errorf("failed to expire pending checkout: %w", err)
+	}
+
+	s.logger.Info().Int("user_id", checkout.UserID).Str("cart_id", checkout.CartReservationID).Str("session_id", sessionID).Msg("Cart checkout expired")
+	return nil
+}
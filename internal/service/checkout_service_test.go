@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/repository/postgres"
+	"github.com/louco-event/internal/testutil"
+	"github.com/louco-event/pkg/stripe"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+// fakeCartCheckout records the checkout sessions it is asked to open
+type fakeCartCheckout struct {
+	requests []stripe.CartCheckoutRequest
+}
+
+func (f *fakeCartCheckout) CreateCheckoutSessionForCart(ctx context.Context, req stripe.CartCheckoutRequest) (*stripe.StripeCheckoutSession, error) {
+	f.requests = append(f.requests, req)
+	id := fmt.Sprintf("cs_test_%d", len(f.requests))
+	return &stripe.StripeCheckoutSession{ID: id, URL: "https://checkout.stripe.test/" + id}, nil
+}
+
+func newTestCheckoutService(db *gorm.DB, stripeClient stripe.CartCheckoutClient) CheckoutService {
+	return NewCheckoutService(
+		postgres.NewPendingCheckoutRepository(db),
+		postgres.NewTicketReservationRepository(db),
+		postgres.NewTicketRepository(db),
+		postgres.NewUserRepository(db),
+		newTestTicketService(db, nil),
+		stripeClient,
+		zerolog.Nop(),
+	)
+}
+
+func pendingCheckoutStatus(t *testing.T, db *gorm.DB, sessionID string) domain.PendingCheckoutStatus {
+	t.Helper()
+	var checkout domain.PendingCheckout
+	if err := db.Where("stripe_session_id = ?", sessionID).First(&checkout).Error; err != nil {
+		t.Fatalf("failed to load pending checkout: %v", err)
+	}
+	return checkout.Status
+}
+
+func TestExpiredCheckoutReleasesCart(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	buyer := testutil.CreateUser(t, db, domain.UserTypeUser)
+	event := testutil.CreateEvent(t, db, creator.ID, nil)
+	ticket := testutil.CreateTicket(t, db, event.ID, 5)
+	stripeClient := &fakeCartCheckout{}
+	tickets := newTestTicketService(db, nil)
+	service := newTestCheckoutService(db, stripeClient)
+
+	cart, err := tickets.ReserveCart(ctx, buyer.ID, []dto.TicketQuantityItem{{TicketID: ticket.ID, Quantity: 2}}, time.Minute)
+	if err != nil {
+		t.Fatalf("ReserveCart: %v", err)
+	}
+	checkout, err := service.CheckoutCart(ctx, buyer.ID, cart.CartReservationID)
+	if err != nil {
+		t.Fatalf("CheckoutCart: %v", err)
+	}
+	if got := stripeClient.requests[0]; got.CartReservationID != cart.CartReservationID || len(got.Items) != 1 || got.Items[0].Quantity != 2 {
+		t.Fatalf("checkout session request = %+v, want the cart and its 2 tickets", got)
+	}
+	if status := pendingCheckoutStatus(t, db, checkout.SessionID); status != domain.PendingCheckoutStatusPending {
+		t.Fatalf("pending checkout status %q, want pending", status)
+	}
+
+	if err := service.ExpireCheckout(ctx, checkout.SessionID); err != nil {
+		t.Fatalf("ExpireCheckout: %v", err)
+	}
+	if status := pendingCheckoutStatus(t, db, checkout.SessionID); status != domain.PendingCheckoutStatusExpired {
+		t.Errorf("pending checkout status %q, want expired", status)
+	}
+	held, err := postgres.NewTicketReservationRepository(db).GetHeldQuantities(ctx, []int{ticket.ID})
+	if err != nil {
+		t.Fatalf("GetHeldQuantities: %v", err)
+	}
+	if held[ticket.ID] != 0 {
+		t.Errorf("%d tickets still held, want the cart released", held[ticket.ID])
+	}
+
+	// A redelivered webhook leaves the expired checkout alone
+	if err := service.ExpireCheckout(ctx, checkout.SessionID); err != nil {
+		t.Errorf("redelivered ExpireCheckout: %v", err)
+	}
+}
+
+func TestCompletedCheckoutSellsCart(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	buyer := testutil.CreateUser(t, db, domain.UserTypeUser)
+	event := testutil.CreateEvent(t, db, creator.ID, nil)
+	ticket := testutil.CreateTicket(t, db, event.ID, 5)
+	tickets := newTestTicketService(db, nil)
+	service := newTestCheckoutService(db, &fakeCartCheckout{})
+
+	cart, err := tickets.ReserveCart(ctx, buyer.ID, []dto.TicketQuantityItem{{TicketID: ticket.ID, Quantity: 2}}, time.Minute)
+	if err != nil {
+		t.Fatalf("ReserveCart: %v", err)
+	}
+	checkout, err := service.CheckoutCart(ctx, buyer.ID, cart.CartReservationID)
+	if err != nil {
+		t.Fatalf("CheckoutCart: %v", err)
+	}
+
+	for range 2 {
+		if err := service.CompleteCheckout(ctx, checkout.SessionID); err != nil {
+			t.Fatalf("CompleteCheckout: %v", err)
+		}
+	}
+	if sold := soldQuantity(t, db, ticket.ID); sold != 2 {
+		t.Errorf("sold %d tickets, want 2 after a redelivered webhook", sold)
+	}
+	if status := pendingCheckoutStatus(t, db, checkout.SessionID); status != domain.PendingCheckoutStatusCompleted {
+		t.Errorf("pending checkout status %q, want completed", status)
+	}
+
+	// Another user cannot pay for the cart
+	other := testutil.CreateUser(t, db, domain.UserTypeUser)
+	if _, err := service.CheckoutCart(ctx, other.ID, cart.CartReservationID); !errors.Is(err, domain.ErrTicketReservationNotFound) {
+		t.Errorf("checkout of another user's cart: err = %v, want ErrTicketReservationNotFound", err)
+	}
+}
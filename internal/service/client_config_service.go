@@ -0,0 +1,83 @@
+package service
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/louco-event/internal/config"
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+)
+
+type ClientConfigService interface {
+	// GetClientConfig returns the rules the server enforces, so clients need not hardcode them
+	GetClientConfig() *dto.ClientConfigResponse
+}
+
+type clientConfigService struct {
+	clientConfig *dto.ClientConfigResponse
+}
+
+// NewClientConfigService builds the client configuration once from the loaded config and the
+// limits the services apply. supportedLocales are the languages translations were loaded for.
+func NewClientConfigService(cfg *config.Config, supportedLocales []string) ClientConfigService {
+	locales := append([]string(nil), supportedLocales...)
+	sort.Strings(locales)
+
+	return &clientConfigService{
+		clientConfig: &dto.ClientConfigResponse{
+			Pagination: dto.ClientPaginationConfig{
+				DefaultPageSize: dto.DefaultPageSize,
+				MaxPageSize:     dto.MaxPageSize,
+			},
+			Limits: dto.ClientLimitsConfig{
+				MaxEventNameLength:         maxEventNameLength,
+				MaxDraftsPerCreator:        cfg.Event.MaxDraftsPerCreator,
+				MaxInvitationsPerEvent:     cfg.Event.MaxInvitationsPerEvent,
+				MaxInvitationsPerRequest:   maxInvitationsPerRequest,
+				MaxTicketsPerBulkRequest:   maxBulkTickets,
+				MaxCartItems:               maxTicketAvailabilityItems,
+				MaxBulkStatusEvents:        maxBulkStatusEvents,
+				SearchMinQueryLength:       cfg.Event.SearchMinQueryLength,
+				CartHoldSeconds:            int(cfg.Event.CartHoldDuration.Seconds()),
+				InvitationLinkTTLSeconds:   int(cfg.Event.InvitationLinkTTL.Seconds()),
+				InvitationLateGraceSeconds: int(cfg.Event.InvitationLateResponseGrace.Seconds()),
+			},
+			Payments: dto.ClientPaymentsConfig{
+				Currency:       strings.ToLower(cfg.Stripe.Currency),
+				MinTicketPrice: cfg.Event.MinTicketPrice(cfg.Stripe.Currency),
+				MaxTicketPrice: domain.MaxTicketPrice,
+				PublishableKey: cfg.Stripe.PublishableKey,
+				Environment:    cfg.Stripe.Environment,
+			},
+			Features: dto.ClientFeaturesConfig{
+				RequireEventImage: cfg.Event.RequireEventImage,
+				DescriptionFormat: cfg.Event.DescriptionFormat,
+				VenueTimezones:    cfg.Maps.GoogleAPIKey != "",
+			},
+			Enums: dto.ClientEnumsConfig{
+				EventTypes:         allowedValues(cfg.Event.AllowedTypes, domain.EventTypes),
+				EventLocationTypes: allowedValues(cfg.Event.AllowedLocationTypes, domain.EventLocationTypes),
+				EventStatuses:      domain.EventStatuses,
+				CategoryTypes:      domain.CategoryTypes,
+			},
+			SupportedLocales: locales,
+			DefaultLocale:    "en",
+		},
+	}
+}
+
+func (s *clientConfigService) GetClientConfig() *dto.ClientConfigResponse {
+	return s.clientConfig
+}
+
+// allowedValues narrows an enum to the values isAllowedValue accepts for the configured list
+func allowedValues[T ~string](allowed []string, values []T) []T {
+	result := make([]T, 0, len(values))
+	for _, value := range values {
+		if isAllowedValue(allowed, string(value)) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
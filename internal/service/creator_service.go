@@ -3,13 +3,21 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/louco-event/internal/domain"
 	"github.com/louco-event/internal/dto"
 	"github.com/louco-event/internal/repository"
+	"github.com/louco-event/pkg/cache"
 	"github.com/louco-event/pkg/logger"
 )
 
+const (
+	CreatorRatingCacheKey = "creator_rating_%d"
+	// Kept short so a new rating shows up on the profile soon after it is submitted
+	CreatorRatingCacheExpiration = 2 * time.Minute
+)
+
 type CreatorService interface {
 	CreateCreator(ctx context.Context, userID int, req *dto.CreateCreatorRequest) (*dto.CreatorResponse, error)
 	GetCreatorByID(ctx context.Context, id int) (*dto.CreatorResponse, error)
@@ -18,6 +26,9 @@ type CreatorService interface {
 	SetWeeztixToken(ctx context.Context, userID int, req *dto.SetWeeztixTokenRequest) error
 	GetCreatorProfile(ctx context.Context, userID int) (*dto.CreatorProfileResponse, error)
 	GetCreatorList(ctx context.Context, req *dto.CreatorListRequest) (*dto.CreatorListResponse, error)
+	// GetCreatorRatingSummary averages the ratings of all of the creator's events, each rating
+	// counting once. Served from a short-lived cache.
+	GetCreatorRatingSummary(ctx context.Context, creatorID int) (*dto.RatingSummaryResponse, error)
 	DeleteCreator(ctx context.Context, userID int) error
 }
 
@@ -26,6 +37,8 @@ type creatorService struct {
 	userRepo     repository.UserRepository
 	industryRepo repository.IndustryRepository
 	mediaRepo    repository.MediaRepository
+	ratingRepo   repository.EventRatingRepository
+	cache        *cache.RedisCache
 	logger       *logger.Logger
 }
 
@@ -34,6 +47,8 @@ func NewCreatorService(
 	userRepo repository.UserRepository,
 	industryRepo repository.IndustryRepository,
 	mediaRepo repository.MediaRepository,
+	ratingRepo repository.EventRatingRepository,
+	cache *cache.RedisCache,
 	logger *logger.Logger,
 ) CreatorService {
 	return &creatorService{
@@ -41,6 +56,8 @@ func NewCreatorService(
 		userRepo:     userRepo,
 		industryRepo: industryRepo,
 		mediaRepo:    mediaRepo,
+		ratingRepo:   ratingRepo,
+		cache:        cache,
 		logger:       logger,
 	}
 }
@@ -79,6 +96,10 @@ func (s *creatorService) CreateCreator(ctx context.Context, userID int, req *dto
 		}
 	}
 
+	if _, err := domain.LoadEventTimezone(req.Timezone); err != nil {
+		return nil, err
+	}
+
 	// Create creator
 	creator := domain.NewCreator(
 		userID,
@@ -87,6 +108,7 @@ func (s *creatorService) CreateCreator(ctx context.Context, userID int, req *dto
 		req.EstimatedTickets,
 		req.EstimatedEvents,
 	)
+	creator.Timezone = req.Timezone
 
 	// Validate industry IDs are provided
 	if len(req.IndustryIDs) == 0 {
@@ -128,7 +150,9 @@ func (s *creatorService) GetCreatorByID(ctx context.Context, id int) (*dto.Creat
 		return nil, fmt.Errorf("creator not found")
 	}
 
-	return s.mapCreatorToResponse(creator), nil
+	response := s.mapCreatorToResponse(creator)
+	response.Rating = s.getRatingSummary(ctx, creator.ID)
+	return response, nil
 }
 
 func (s *creatorService) GetCreatorByUserID(ctx context.Context, userID int) (*dto.CreatorResponse, error) {
@@ -138,7 +162,7 @@ func (s *creatorService) GetCreatorByUserID(ctx context.Context, userID int) (*d
 		return nil, fmt.Errorf("failed to get creator")
 	}
 	if creator == nil {
-		return nil, fmt.Errorf("creator profile not found")
+		return nil, domain.ErrCreatorProfileNotFound
 	}
 
 	return s.mapCreatorToResponse(creator), nil
@@ -151,7 +175,7 @@ func (s *creatorService) UpdateCreator(ctx context.Context, userID int, req *dto
 		return fmt.Errorf("creator not found")
 	}
 	if creator == nil {
-		return fmt.Errorf("creator profile not found")
+		return domain.ErrCreatorProfileNotFound
 	}
 
 	// Validate industries if provided
@@ -170,6 +194,12 @@ func (s *creatorService) UpdateCreator(ctx context.Context, userID int, req *dto
 
 	// Update creator fields
 	creator.UpdateProfile(req.CompanyName, req.Address, req.EstimatedTickets, req.EstimatedEvents)
+	if req.Timezone != nil {
+		if _, err := domain.LoadEventTimezone(*req.Timezone); err != nil {
+			return err
+		}
+		creator.Timezone = *req.Timezone
+	}
 
 	if err := creator.ValidateRequiredFields(); err != nil {
 		return err
@@ -198,7 +228,7 @@ func (s *creatorService) SetWeeztixToken(ctx context.Context, userID int, req *d
 		return fmt.Errorf("creator not found")
 	}
 	if creator == nil {
-		return fmt.Errorf("creator profile not found")
+		return domain.ErrCreatorProfileNotFound
 	}
 
 	creator.SetWeeztixToken(req.WeeztixToken)
@@ -226,7 +256,7 @@ func (s *creatorService) GetCreatorProfile(ctx context.Context, userID int) (*dt
 		return nil, fmt.Errorf("failed to get creator")
 	}
 	if creator == nil {
-		return nil, fmt.Errorf("creator profile not found")
+		return nil, domain.ErrCreatorProfileNotFound
 	}
 
 	userResponse := s.mapUserToResponse(user)
@@ -249,9 +279,12 @@ func (s *creatorService) GetCreatorProfile(ctx context.Context, userID int) (*dt
 		}
 	}
 
+	creatorResponse := s.mapCreatorToResponse(creator)
+	creatorResponse.Rating = s.getRatingSummary(ctx, creator.ID)
+
 	return &dto.CreatorProfileResponse{
 		User:    userResponse,
-		Creator: *s.mapCreatorToResponse(creator),
+		Creator: *creatorResponse,
 	}, nil
 }
 
@@ -271,7 +304,18 @@ func (s *creatorService) GetCreatorList(ctx context.Context, req *dto.CreatorLis
 	var total int
 	var err error
 
-	if req.IndustryID > 0 {
+	if req.SortBy == "rating" {
+		creators, err = s.creatorRepo.ListByRating(ctx, req.IndustryID, pageSize, offset)
+		if err != nil {
+			s.logger.Error().Err(err).Msg("Failed to get creators by rating")
+			return nil, fmt.Errorf("failed to get creators")
+		}
+		if req.IndustryID > 0 {
+			total, err = s.creatorRepo.CountByIndustryID(ctx, req.IndustryID)
+		} else {
+			total, err = s.creatorRepo.Count(ctx)
+		}
+	} else if req.IndustryID > 0 {
 		creators, err = s.creatorRepo.ListByIndustryID(ctx, req.IndustryID, pageSize, offset)
 		if err != nil {
 			s.logger.Error().Err(err).Msg("Failed to get creators by industry")
@@ -292,9 +336,23 @@ func (s *creatorService) GetCreatorList(ctx context.Context, req *dto.CreatorLis
 		return nil, fmt.Errorf("failed to get creator count")
 	}
 
+	// One aggregate query for the whole page; the list still works without ratings
+	creatorIDs := make([]int, len(creators))
+	for i, creator := range creators {
+		creatorIDs[i] = creator.ID
+	}
+	ratings, err := s.ratingRepo.GetSummariesByCreatorIDs(ctx, creatorIDs)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("Failed to get creator rating summaries")
+	}
+
 	creatorResponses := make([]dto.CreatorResponse, len(creators))
 	for i, creator := range creators {
 		creatorResponses[i] = *s.mapCreatorToResponse(creator)
+		if err == nil {
+			rating := ratings[creator.ID]
+			creatorResponses[i].Rating = dto.NewRatingSummaryResponse(rating.Average, rating.Count)
+		}
 	}
 
 	totalPages := (total + pageSize - 1) / pageSize
@@ -315,7 +373,7 @@ func (s *creatorService) DeleteCreator(ctx context.Context, userID int) error {
 		return fmt.Errorf("creator not found")
 	}
 	if creator == nil {
-		return fmt.Errorf("creator profile not found")
+		return domain.ErrCreatorProfileNotFound
 	}
 
 	if err := s.creatorRepo.Delete(ctx, creator.ID); err != nil {
@@ -326,6 +384,36 @@ func (s *creatorService) DeleteCreator(ctx context.Context, userID int) error {
 	return nil
 }
 
+func (s *creatorService) GetCreatorRatingSummary(ctx context.Context, creatorID int) (*dto.RatingSummaryResponse, error) {
+	cacheKey := fmt.Sprintf(CreatorRatingCacheKey, creatorID)
+	var cached dto.RatingSummaryResponse
+	if err := s.cache.Get(ctx, cacheKey, &cached); err == nil {
+		return &cached, nil
+	}
+
+	average, count, err := s.ratingRepo.GetSummaryByCreatorID(ctx, creatorID)
+	if err != nil {
+		s.logger.Error().Err(err).Int("creator_id", creatorID).Msg("Failed to get creator rating summary")
+		return nil, fmt.Errorf("failed to get creator rating summary")
+	}
+
+	summary := dto.NewRatingSummaryResponse(average, count)
+	if err := s.cache.Set(ctx, cacheKey, summary, CreatorRatingCacheExpiration); err != nil {
+		s.logger.Warn().Err(err).Int("creator_id", creatorID).Msg("Failed to cache creator rating summary")
+	}
+	return summary, nil
+}
+
+// getRatingSummary returns the creator's rating for a profile. A profile is still useful
+// without it, so failures leave it out.
+func (s *creatorService) getRatingSummary(ctx context.Context, creatorID int) *dto.RatingSummaryResponse {
+	summary, err := s.GetCreatorRatingSummary(ctx, creatorID)
+	if err != nil {
+		return nil
+	}
+	return summary
+}
+
 func (s *creatorService) mapCreatorToResponse(creator *domain.Creator) *dto.CreatorResponse {
 	industries := make([]dto.IndustryResponse, len(creator.Industries))
 	for i, industry := range creator.Industries {
@@ -344,6 +432,7 @@ func (s *creatorService) mapCreatorToResponse(creator *domain.Creator) *dto.Crea
 		Address:          creator.Address,
 		EstimatedTickets: creator.EstimatedTickets,
 		EstimatedEvents:  creator.EstimatedEvents,
+		Timezone:         creator.Timezone,
 		Industries:       industries,
 		CreatedAt:        creator.CreatedAt,
 		UpdatedAt:        creator.UpdatedAt,
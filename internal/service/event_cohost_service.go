@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/repository"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+type EventCoHostService interface {
+	InviteCoHost(ctx context.Context, eventID, ownerUserID int, req dto.InviteEventCoHostRequest) (*dto.EventCoHostResponse, error)
+	AcceptCoHostInvitation(ctx context.Context, eventID, userID int) (*dto.EventCoHostResponse, error)
+	GetCoHosts(ctx context.Context, eventID, userID int) ([]*dto.EventCoHostResponse, error)
+}
+
+type eventCoHostService struct {
+	coHostRepo   repository.EventCoHostRepository
+	eventRepo    repository.EventRepository
+	creatorRepo  repository.CreatorRepository
+	eventService EventService
+	logger       zerolog.Logger
+}
+
+// NewEventCoHostService creates the co-host service. Accepted editor co-hosts are granted edit
+// access through the event policy.
+func NewEventCoHostService(
+	coHostRepo repository.EventCoHostRepository,
+	eventRepo repository.EventRepository,
+	creatorRepo repository.CreatorRepository,
+	eventService EventService,
+	logger zerolog.Logger,
+) EventCoHostService {
+	return &eventCoHostService{
+		coHostRepo:   coHostRepo,
+		eventRepo:    eventRepo,
+		creatorRepo:  creatorRepo,
+		eventService: eventService,
+		logger:       logger.With().Str("service", "event_cohost").Logger(),
+	}
+}
+
+// InviteCoHost lets the event owner invite another creator. The invitation grants nothing
+// until the creator accepts it.
+func (s *eventCoHostService) InviteCoHost(ctx context.Context, eventID, ownerUserID int, req dto.InviteEventCoHostRequest) (*dto.EventCoHostResponse, error) {
+	if err := s.eventService.AuthorizeEvent(ctx, eventID, &ownerUserID, domain.EventActionManage); err != nil {
+		return nil, err
+	}
+
+	role := domain.EventCoHostRoleEditor
+	if req.Role != nil {
+		role = *req.Role
+	}
+	if !role.IsValid() {
+		return nil, domain.ErrEventCoHostInvalidRole
+	}
+
+	// The creator repository reports a missing creator as nil, not as an error
+	creator, err := s.creatorRepo.GetByID(ctx, req.CreatorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get creator: %w", err)
+	}
+	if creator == nil {
+		return nil, domain.ErrCreatorProfileNotFound
+	}
+	if creator.UserID == ownerUserID {
+		return nil, domain.ErrEventCoHostIsOwner
+	}
+
+	if _, err := s.coHostRepo.GetByEventAndCreator(ctx, eventID, creator.ID); err == nil {
+		return nil, domain.ErrEventCoHostAlreadyInvited
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("failed to check existing co-host: %w", err)
+	}
+
+	coHost := domain.NewEventCoHost(eventID, creator.ID, role)
+	if err := s.coHostRepo.Create(ctx, coHost); err != nil {
+		s.logger.Error().Err(err).Int("event_id", eventID).Int("creator_id", creator.ID).Msg("Failed to create co-host invitation")
+		return nil, fmt.Errorf("failed to create co-host invitation: %w", err)
+	}
+	coHost.Creator = creator
+
+	s.logger.Info().Int("event_id", eventID).Int("creator_id", creator.ID).Str("role", string(role)).Msg("Co-host invited")
+	return dto.EventCoHostToResponse(coHost), nil
+}
+
+// AcceptCoHostInvitation confirms the invitation of the user's creator profile
+func (s *eventCoHostService) AcceptCoHostInvitation(ctx context.Context, eventID, userID int) (*dto.EventCoHostResponse, error) {
+	creator, err := s.creatorRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, domain.ErrCreatorProfileNotFound
+	}
+
+	coHost, err := s.coHostRepo.GetByEventAndCreator(ctx, eventID, creator.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrEventCoHostNotFound
+		}
+		return nil, fmt.Errorf("failed to get co-host invitation: %w", err)
+	}
+	if err := coHost.Accept(); err != nil {
+		return nil, err
+	}
+
+	if err := s.coHostRepo.Update(ctx, coHost); err != nil {
+		s.logger.Error().Err(err).Int("event_id", eventID).Int("creator_id", creator.ID).Msg("Failed to accept co-host invitation")
+		return nil, fmt.Errorf("failed to update co-host invitation: %w", err)
+	}
+
+	s.logger.Info().Int("event_id", eventID).Int("creator_id", creator.ID).Msg("Co-host invitation accepted")
+	return dto.EventCoHostToResponse(coHost), nil
+}
+
+// GetCoHosts lists every co-host, pending ones included. Visible to the owner and to creators
+// invited as co-hosts.
+func (s *eventCoHostService) GetCoHosts(ctx context.Context, eventID, userID int) ([]*dto.EventCoHostResponse, error) {
+	creator, err := s.creatorRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, domain.ErrCreatorProfileNotFound
+	}
+
+	isOwner, err := s.eventRepo.IsEventOwner(ctx, eventID, creator.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check event ownership: %w", err)
+	}
+
+	coHosts, err := s.coHostRepo.GetByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get co-hosts: %w", err)
+	}
+
+	if !isOwner && !containsCoHost(coHosts, creator.ID) {
+		return nil, domain.ErrEventUnauthorized
+	}
+
+	responses := make([]*dto.EventCoHostResponse, 0, len(coHosts))
+	for _, coHost := range coHosts {
+		responses = append(responses, dto.EventCoHostToResponse(coHost))
+	}
+	return responses, nil
+}
+
+func containsCoHost(coHosts []*domain.EventCoHost, creatorID int) bool {
+	for _, coHost := range coHosts {
+		if coHost.CreatorID == creatorID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/repository/postgres"
+	"github.com/louco-event/internal/testutil"
+	"github.com/rs/zerolog"
+)
+
+func TestCoHostUpdatesEventOnceAccepted(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	owner := testutil.CreateCreator(t, db)
+	accepted := testutil.CreateCreator(t, db)
+	pending := testutil.CreateCreator(t, db)
+	event := testutil.CreateEvent(t, db, owner.ID, nil)
+
+	events := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+	service := NewEventCoHostService(
+		postgres.NewEventCoHostRepository(db),
+		postgres.NewEventRepository(db),
+		postgres.NewCreatorRepository(db),
+		events,
+		zerolog.Nop(),
+	)
+
+	for _, creator := range []*domain.Creator{accepted, pending} {
+		if _, err := service.InviteCoHost(ctx, event.ID, owner.UserID, dto.InviteEventCoHostRequest{CreatorID: creator.ID}); err != nil {
+			t.Fatalf("InviteCoHost: %v", err)
+		}
+	}
+	if _, err := service.InviteCoHost(ctx, event.ID, owner.UserID, dto.InviteEventCoHostRequest{CreatorID: pending.ID + 1000}); !errors.Is(err, domain.ErrCreatorProfileNotFound) {
+		t.Errorf("InviteCoHost for an unknown creator: err = %v, want ErrCreatorProfileNotFound", err)
+	}
+	if _, err := service.AcceptCoHostInvitation(ctx, event.ID, accepted.UserID); err != nil {
+		t.Fatalf("AcceptCoHostInvitation: %v", err)
+	}
+
+	name := "Renamed by the co-host"
+	updated, err := events.UpdateEvent(ctx, event.ID, accepted.UserID, dto.UpdateEventRequest{Name: &name})
+	if err != nil {
+		t.Fatalf("UpdateEvent by the accepted co-host: %v", err)
+	}
+	if updated.Name != name {
+		t.Errorf("name = %q, want %q", updated.Name, name)
+	}
+
+	// The pending invitation grants nothing yet
+	other := "Renamed by the pending co-host"
+	if _, err := events.UpdateEvent(ctx, event.ID, pending.UserID, dto.UpdateEventRequest{Name: &other}); !errors.Is(err, domain.ErrEventUnauthorized) {
+		t.Errorf("UpdateEvent by the pending co-host: err = %v, want ErrEventUnauthorized", err)
+	}
+	var stored domain.Event
+	if err := db.First(&stored, event.ID).Error; err != nil {
+		t.Fatalf("failed to load event: %v", err)
+	}
+	if stored.Name != name {
+		t.Errorf("stored name = %q, want the accepted co-host's %q", stored.Name, name)
+	}
+}
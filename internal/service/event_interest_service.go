@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/repository"
+	"github.com/rs/zerolog"
+)
+
+type EventInterestService interface {
+	// RegisterInterest records that the user would like to attend. Sold-out and finished
+	// events accept interest too.
+	RegisterInterest(ctx context.Context, eventID, userID int) (*dto.EventInterestResponse, error)
+	// GetInterestCount returns how many users registered interest; owner only
+	GetInterestCount(ctx context.Context, eventID, ownerUserID int) (*dto.EventInterestCountResponse, error)
+}
+
+type eventInterestService struct {
+	interestRepo     repository.EventInterestRepository
+	eventRepo        repository.EventRepository
+	eventHistoryRepo repository.EventHistoryRepository
+	userRepo         repository.UserRepository
+	eventService     EventService
+	milestones       []int
+	logger           zerolog.Logger
+}
+
+// NewEventInterestService creates the event interest service. milestones are the interest
+// counts at which an event.interest_milestone is recorded for the organizer.
+func NewEventInterestService(
+	interestRepo repository.EventInterestRepository,
+	eventRepo repository.EventRepository,
+	eventHistoryRepo repository.EventHistoryRepository,
+	userRepo repository.UserRepository,
+	eventService EventService,
+	milestones []int,
+	logger zerolog.Logger,
+) EventInterestService {
+	return &eventInterestService{
+		interestRepo:     interestRepo,
+		eventRepo:        eventRepo,
+		eventHistoryRepo: eventHistoryRepo,
+		userRepo:         userRepo,
+		eventService:     eventService,
+		milestones:       milestones,
+		logger:           logger.With().Str("service", "event_interest").Logger(),
+	}
+}
+
+func (s *eventInterestService) RegisterInterest(ctx context.Context, eventID, userID int) (*dto.EventInterestResponse, error) {
+	if err := s.eventService.AuthorizeEvent(ctx, eventID, &userID, domain.EventActionView); err != nil {
+		return nil, err
+	}
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	if event.Creator.UserID == userID {
+		return nil, domain.ErrEventInterestOwnEvent
+	}
+	if !event.IsPublished() {
+		return nil, domain.ErrEventInterestNotAllowed
+	}
+
+	exists, err := s.interestRepo.ExistsByEventAndUser(ctx, eventID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check existing interest: %w", err)
+	}
+	if exists {
+		return nil, domain.ErrEventInterestAlreadyRegistered
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	email := ""
+	if user.Email != nil {
+		email = strings.ToLower(strings.TrimSpace(*user.Email))
+	}
+
+	interest := domain.NewEventInterest(eventID, userID, email)
+	if err := s.interestRepo.Create(ctx, interest); err != nil {
+		s.logger.Error().Err(err).Int("event_id", eventID).Int("user_id", userID).Msg("Failed to register interest")
+		return nil, fmt.Errorf("failed to register interest: %w", err)
+	}
+
+	s.logger.Info().Int("event_id", eventID).Int("user_id", userID).Msg("Event interest registered")
+	s.checkInterestMilestone(ctx, event)
+
+	return &dto.EventInterestResponse{EventID: eventID, CreatedAt: interest.CreatedAt}, nil
+}
+
+func (s *eventInterestService) GetInterestCount(ctx context.Context, eventID, ownerUserID int) (*dto.EventInterestCountResponse, error) {
+	if err := s.eventService.AuthorizeEvent(ctx, eventID, &ownerUserID, domain.EventActionManage); err != nil {
+		return nil, err
+	}
+
+	count, err := s.interestRepo.CountByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count interest: %w", err)
+	}
+	return &dto.EventInterestCountResponse{EventID: eventID, Count: count}, nil
+}
+
+// checkInterestMilestone records an event.interest_milestone once the interest count crosses a
+// configured milestone. The interest is already stored, so failures are only logged.
+func (s *eventInterestService) checkInterestMilestone(ctx context.Context, event *domain.Event) {
+	if len(s.milestones) == 0 {
+		return
+	}
+
+	count, err := s.interestRepo.CountByEventID(ctx, event.ID)
+	if err != nil {
+		s.logger.Error().Err(err).Int("event_id", event.ID).Msg("Failed to count interest for milestone")
+		return
+	}
+
+	milestone, reached := event.InterestMilestone(s.milestones, count)
+	if !reached {
+		return
+	}
+
+	raised, err := s.eventRepo.RaiseInterestMilestoneLevel(ctx, event.ID, milestone)
+	if err != nil {
+		s.logger.Error().Err(err).Int("event_id", event.ID).Int("milestone", milestone).Msg("Failed to update interest milestone level")
+		return
+	}
+	// Another registration already reported this milestone
+	if !raised {
+		return
+	}
+
+	if err := s.eventHistoryRepo.Create(ctx, domain.NewEventInterestMilestoneHistory(event.ID, milestone)); err != nil {
+		s.logger.Error().Err(err).Int("event_id", event.ID).Int("milestone", milestone).Msg("Failed to record interest milestone")
+		return
+	}
+
+	s.logger.Info().Int("event_id", event.ID).Int("milestone", milestone).Int64("count", count).Msg("event.interest_milestone")
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/repository/postgres"
+	"github.com/louco-event/internal/testutil"
+	"github.com/rs/zerolog"
+)
+
+func TestRegisterInterestOnFinishedEvent(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	fans := []*domain.User{testutil.CreateUser(t, db, domain.UserTypeUser), testutil.CreateUser(t, db, domain.UserTypeUser)}
+	service := NewEventInterestService(
+		postgres.NewEventInterestRepository(db),
+		postgres.NewEventRepository(db),
+		postgres.NewEventHistoryRepository(db),
+		postgres.NewUserRepository(db),
+		newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{}),
+		[]int{2, 10},
+		zerolog.Nop(),
+	)
+
+	event := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+		start := time.Now().UTC().AddDate(0, 0, -10)
+		event.StartDate = &start
+	})
+	milestones := func() string {
+		var thresholds []int
+		if err := db.Model(&domain.EventHistory{}).
+			Where("event_id = ? AND action = ?", event.ID, domain.EventHistoryActionInterestMilestone).
+			Order("id").Pluck("threshold", &thresholds).Error; err != nil {
+			t.Fatalf("failed to load interest milestones: %v", err)
+		}
+		return fmt.Sprint(thresholds)
+	}
+
+	for i, fan := range fans {
+		if _, err := service.RegisterInterest(ctx, event.ID, fan.ID); err != nil {
+			t.Fatalf("RegisterInterest by fan %d: %v", i, err)
+		}
+	}
+	if _, err := service.RegisterInterest(ctx, event.ID, fans[0].ID); !errors.Is(err, domain.ErrEventInterestAlreadyRegistered) {
+		t.Errorf("repeated interest: err = %v, want ErrEventInterestAlreadyRegistered", err)
+	}
+	if _, err := service.RegisterInterest(ctx, event.ID, creator.UserID); !errors.Is(err, domain.ErrEventInterestOwnEvent) {
+		t.Errorf("interest in own event: err = %v, want ErrEventInterestOwnEvent", err)
+	}
+
+	// The second fan reached the first milestone; the organizer is told once
+	if got := milestones(); got != "[2]" {
+		t.Errorf("interest milestones = %s, want [2]", got)
+	}
+
+	// Only the owner sees the count
+	count, err := service.GetInterestCount(ctx, event.ID, creator.UserID)
+	if err != nil {
+		t.Fatalf("GetInterestCount: %v", err)
+	}
+	if count.Count != 2 {
+		t.Errorf("interest count = %d, want 2", count.Count)
+	}
+	if _, err := service.GetInterestCount(ctx, event.ID, fans[0].ID); !errors.Is(err, domain.ErrEventUnauthorized) {
+		t.Errorf("count by a fan: err = %v, want ErrEventUnauthorized", err)
+	}
+}
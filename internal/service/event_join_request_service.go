@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/repository"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+type EventJoinRequestService interface {
+	RequestToJoinEvent(ctx context.Context, eventID, userID int, req dto.CreateEventJoinRequestRequest) (*dto.EventJoinRequestResponse, error)
+	GetJoinRequests(ctx context.Context, eventID, ownerUserID int, filters dto.EventJoinRequestFilterRequest, pagination dto.PaginationRequest) ([]*dto.EventJoinRequestResponse, *dto.PaginationResponse, error)
+	ApproveJoinRequest(ctx context.Context, eventID, requestID, ownerUserID int) (*dto.EventJoinRequestResponse, error)
+	RejectJoinRequest(ctx context.Context, eventID, requestID, ownerUserID int) (*dto.EventJoinRequestResponse, error)
+}
+
+type eventJoinRequestService struct {
+	joinRequestRepo   repository.EventJoinRequestRepository
+	eventRepo         repository.EventRepository
+	userRepo          repository.UserRepository
+	invitationRepo    repository.InvitationRepository
+	eventService      EventService
+	invitationService InvitationService
+	logger            zerolog.Logger
+}
+
+// NewEventJoinRequestService creates the join request service. Approved requests become
+// invitations through the invitation service, so the per-event invitation cap applies.
+func NewEventJoinRequestService(
+	joinRequestRepo repository.EventJoinRequestRepository,
+	eventRepo repository.EventRepository,
+	userRepo repository.UserRepository,
+	invitationRepo repository.InvitationRepository,
+	eventService EventService,
+	invitationService InvitationService,
+	logger zerolog.Logger,
+) EventJoinRequestService {
+	return &eventJoinRequestService{
+		joinRequestRepo:   joinRequestRepo,
+		eventRepo:         eventRepo,
+		userRepo:          userRepo,
+		invitationRepo:    invitationRepo,
+		eventService:      eventService,
+		invitationService: invitationService,
+		logger:            logger.With().Str("service", "event_join_request").Logger(),
+	}
+}
+
+func (s *eventJoinRequestService) RequestToJoinEvent(ctx context.Context, eventID, userID int, req dto.CreateEventJoinRequestRequest) (*dto.EventJoinRequestResponse, error) {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrEventNotFound
+		}
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	if !event.CanAcceptJoinRequests() || event.Creator.UserID == userID {
+		return nil, domain.ErrEventJoinRequestsNotAllowed
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Email == nil || strings.TrimSpace(*user.Email) == "" {
+		return nil, domain.ErrEventJoinRequestEmailRequired
+	}
+	email := strings.ToLower(strings.TrimSpace(*user.Email))
+
+	invited, err := s.invitationRepo.ExistsByEventAndUser(ctx, eventID, userID)
+	if err == nil && !invited {
+		invited, err = s.invitationRepo.ExistsByEventAndEmail(ctx, eventID, email)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to check existing invitation: %w", err)
+	}
+	if invited {
+		return nil, domain.ErrInvitationUserAlreadyInvited
+	}
+
+	exists, err := s.joinRequestRepo.ExistsByEventAndUser(ctx, eventID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check existing join request: %w", err)
+	}
+	if exists {
+		return nil, domain.ErrEventJoinRequestDuplicate
+	}
+
+	if req.Message != nil {
+		message := strings.TrimSpace(*req.Message)
+		req.Message = &message
+		if message == "" {
+			req.Message = nil
+		}
+	}
+
+	request := domain.NewEventJoinRequest(eventID, userID, email, req.Message)
+	if err := s.joinRequestRepo.Create(ctx, request); err != nil {
+		s.logger.Error().Err(err).Int("event_id", eventID).Int("user_id", userID).Msg("Failed to create join request")
+		return nil, fmt.Errorf("failed to create join request: %w", err)
+	}
+
+	s.logger.Info().Int("event_id", eventID).Int("user_id", userID).Int("request_id", request.ID).Msg("Join request created")
+	return dto.EventJoinRequestToResponse(request), nil
+}
+
+func (s *eventJoinRequestService) GetJoinRequests(ctx context.Context, eventID, ownerUserID int, filters dto.EventJoinRequestFilterRequest, pagination dto.PaginationRequest) ([]*dto.EventJoinRequestResponse, *dto.PaginationResponse, error) {
+	if err := s.eventService.AuthorizeEvent(ctx, eventID, &ownerUserID, domain.EventActionManage); err != nil {
+		return nil, nil, err
+	}
+
+	requests, paginationResp, err := s.joinRequestRepo.GetByEventID(ctx, eventID, filters.Status, pagination)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get join requests: %w", err)
+	}
+
+	responses := make([]*dto.EventJoinRequestResponse, 0, len(requests))
+	for _, request := range requests {
+		responses = append(responses, dto.EventJoinRequestToResponse(request))
+	}
+
+	return responses, paginationResp, nil
+}
+
+// ApproveJoinRequest invites the requesting user. The invitation is created first so a full
+// guest list leaves the request pending instead of approving it without an invitation.
+func (s *eventJoinRequestService) ApproveJoinRequest(ctx context.Context, eventID, requestID, ownerUserID int) (*dto.EventJoinRequestResponse, error) {
+	request, err := s.getOwnedRequest(ctx, eventID, requestID, ownerUserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := request.Approve(); err != nil {
+		return nil, err
+	}
+
+	invitation, err := s.invitationService.CreateInvitation(ctx, eventID, dto.CreateInvitationRequest{
+		InvitedEmail:  request.Email,
+		InvitedUserID: &request.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.joinRequestRepo.Update(ctx, request); err != nil {
+		s.logger.Error().Err(err).Int("request_id", requestID).Int("invitation_id", invitation.ID).Msg("Failed to mark join request approved")
+		return nil, fmt.Errorf("failed to update join request: %w", err)
+	}
+
+	s.logger.Info().Int("event_id", eventID).Int("request_id", requestID).Int("invitation_id", invitation.ID).Msg("Join request approved")
+	response := dto.EventJoinRequestToResponse(request)
+	response.InvitationID = &invitation.ID
+	return response, nil
+}
+
+func (s *eventJoinRequestService) RejectJoinRequest(ctx context.Context, eventID, requestID, ownerUserID int) (*dto.EventJoinRequestResponse, error) {
+	request, err := s.getOwnedRequest(ctx, eventID, requestID, ownerUserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := request.Reject(); err != nil {
+		return nil, err
+	}
+
+	if err := s.joinRequestRepo.Update(ctx, request); err != nil {
+		return nil, fmt.Errorf("failed to update join request: %w", err)
+	}
+
+	s.logger.Info().Int("event_id", eventID).Int("request_id", requestID).Msg("Join request rejected")
+	return dto.EventJoinRequestToResponse(request), nil
+}
+
+// getOwnedRequest loads a join request of an event owned by the user
+func (s *eventJoinRequestService) getOwnedRequest(ctx context.Context, eventID, requestID, ownerUserID int) (*domain.EventJoinRequest, error) {
+	if err := s.eventService.AuthorizeEvent(ctx, eventID, &ownerUserID, domain.EventActionManage); err != nil {
+		return nil, err
+	}
+
+	request, err := s.joinRequestRepo.GetByID(ctx, requestID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrEventJoinRequestNotFound
+		}
+		return nil, fmt.Errorf("failed to get join request: %w", err)
+	}
+	if request.EventID != eventID {
+		return nil, domain.ErrEventJoinRequestNotFound
+	}
+	return request, nil
+}
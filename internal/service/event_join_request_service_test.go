@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/repository/postgres"
+	"github.com/louco-event/internal/testutil"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+func newTestEventJoinRequestService(t *testing.T, db *gorm.DB, maxInvitationsPerEvent int) EventJoinRequestService {
+	t.Helper()
+	return NewEventJoinRequestService(
+		postgres.NewEventJoinRequestRepository(db),
+		postgres.NewEventRepository(db),
+		postgres.NewUserRepository(db),
+		postgres.NewInvitationRepository(db),
+		newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{}),
+		newTestInvitationService(db, maxInvitationsPerEvent),
+		zerolog.Nop(),
+	)
+}
+
+func joinRequestStatus(t *testing.T, db *gorm.DB, requestID int) domain.EventJoinRequestStatus {
+	t.Helper()
+	var request domain.EventJoinRequest
+	if err := db.First(&request, requestID).Error; err != nil {
+		t.Fatalf("failed to load join request: %v", err)
+	}
+	return request.Status
+}
+
+func TestEventJoinRequestLifecycle(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	first := testutil.CreateUser(t, db, domain.UserTypeUser)
+	second := testutil.CreateUser(t, db, domain.UserTypeUser)
+	service := newTestEventJoinRequestService(t, db, 2)
+
+	event := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+		event.Type = domain.EventTypePrivate
+		event.AllowJoinRequests = true
+	})
+	createInvitation(t, db, event.ID, "guest@example.com")
+
+	// Only private events that opted in accept requests
+	closed := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) { event.Type = domain.EventTypePrivate })
+	if _, err := service.RequestToJoinEvent(ctx, closed.ID, first.ID, dto.CreateEventJoinRequestRequest{}); !errors.Is(err, domain.ErrEventJoinRequestsNotAllowed) {
+		t.Errorf("request to a closed event: err = %v, want ErrEventJoinRequestsNotAllowed", err)
+	}
+
+	request, err := service.RequestToJoinEvent(ctx, event.ID, first.ID, dto.CreateEventJoinRequestRequest{})
+	if err != nil {
+		t.Fatalf("RequestToJoinEvent: %v", err)
+	}
+	if _, err := service.RequestToJoinEvent(ctx, event.ID, first.ID, dto.CreateEventJoinRequestRequest{}); !errors.Is(err, domain.ErrEventJoinRequestDuplicate) {
+		t.Errorf("repeated request: err = %v, want ErrEventJoinRequestDuplicate", err)
+	}
+
+	approved, err := service.ApproveJoinRequest(ctx, event.ID, request.ID, creator.UserID)
+	if err != nil {
+		t.Fatalf("ApproveJoinRequest: %v", err)
+	}
+	if approved.Status != domain.EventJoinRequestStatusApproved || approved.InvitationID == nil {
+		t.Fatalf("approved request = %+v, want approved with an invitation", approved)
+	}
+	var invitation domain.Invitation
+	if err := db.First(&invitation, *approved.InvitationID).Error; err != nil {
+		t.Fatalf("failed to load invitation: %v", err)
+	}
+	if invitation.EventID != event.ID || invitation.InvitedUserID == nil || *invitation.InvitedUserID != first.ID {
+		t.Errorf("invitation = event %d, user %v; want event %d, user %d", invitation.EventID, invitation.InvitedUserID, event.ID, first.ID)
+	}
+
+	// The guest list is now full, so the next approval is refused and the request waits
+	pending, err := service.RequestToJoinEvent(ctx, event.ID, second.ID, dto.CreateEventJoinRequestRequest{})
+	if err != nil {
+		t.Fatalf("RequestToJoinEvent: %v", err)
+	}
+	if _, err := service.ApproveJoinRequest(ctx, event.ID, pending.ID, creator.UserID); !errors.Is(err, domain.ErrInvitationEventLimitReached) {
+		t.Errorf("approval over the cap: err = %v, want ErrInvitationEventLimitReached", err)
+	}
+	if status := joinRequestStatus(t, db, pending.ID); status != domain.EventJoinRequestStatusPending {
+		t.Errorf("request over the cap is %s, want pending", status)
+	}
+
+	rejected, err := service.RejectJoinRequest(ctx, event.ID, pending.ID, creator.UserID)
+	if err != nil {
+		t.Fatalf("RejectJoinRequest: %v", err)
+	}
+	if rejected.Status != domain.EventJoinRequestStatusRejected {
+		t.Errorf("rejected request is %s, want rejected", rejected.Status)
+	}
+	if _, err := service.ApproveJoinRequest(ctx, event.ID, pending.ID, creator.UserID); !errors.Is(err, domain.ErrEventJoinRequestAlreadyHandled) {
+		t.Errorf("approving a rejected request: err = %v, want ErrEventJoinRequestAlreadyHandled", err)
+	}
+
+	// Only the owner handles requests
+	if _, err := service.RejectJoinRequest(ctx, event.ID, request.ID, second.ID); !errors.Is(err, domain.ErrEventUnauthorized) {
+		t.Errorf("rejection by a guest: err = %v, want ErrEventUnauthorized", err)
+	}
+}
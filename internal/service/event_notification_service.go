@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/i18n"
+	"github.com/louco-event/internal/repository"
+	"github.com/louco-event/pkg/email"
+	"github.com/rs/zerolog"
+)
+
+// detailsChangedBatchSize is how many details_changed entries one notifier run sends
+const detailsChangedBatchSize = 20
+
+// eventDetailLabelKeys are the translation keys naming the changed details in attendee emails
+var eventDetailLabelKeys = map[string]string{
+	"start":            "email.event_details_changed.field.start",
+	"end":              "email.event_details_changed.field.end",
+	"address_id":       "email.event_details_changed.field.venue",
+	"online_event_url": "email.event_details_changed.field.online_link",
+}
+
+type EventNotificationService interface {
+	// ProcessDetailsChanged emails the attendees of published events whose details changed;
+	// run by the scheduler
+	ProcessDetailsChanged(ctx context.Context) error
+}
+
+type eventNotificationService struct {
+	eventRepo        repository.EventRepository
+	eventHistoryRepo repository.EventHistoryRepository
+	addressRepo      repository.AddressRepository
+	emailService     email.EmailService
+	translator       *i18n.I18n
+	logger           zerolog.Logger
+}
+
+func NewEventNotificationService(
+	eventRepo repository.EventRepository,
+	eventHistoryRepo repository.EventHistoryRepository,
+	addressRepo repository.AddressRepository,
+	emailService email.EmailService,
+	translator *i18n.I18n,
+	logger zerolog.Logger,
+) EventNotificationService {
+	return &eventNotificationService{
+		eventRepo:        eventRepo,
+		eventHistoryRepo: eventHistoryRepo,
+		addressRepo:      addressRepo,
+		emailService:     emailService,
+		translator:       translator,
+		logger:           logger.With().Str("service", "event_notification").Logger(),
+	}
+}
+
+func (s *eventNotificationService) ProcessDetailsChanged(ctx context.Context) error {
+	histories, err := s.eventHistoryRepo.GetUnnotified(ctx, domain.EventHistoryActionDetailsChanged, detailsChangedBatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to get details changes: %w", err)
+	}
+
+	for _, history := range histories {
+		s.notifyDetailsChanged(ctx, history)
+
+		// Failed deliveries are logged, not retried, so attendees never get the same change twice
+		if err := s.eventHistoryRepo.MarkNotified(ctx, history.ID); err != nil {
+			return fmt.Errorf("failed to mark details change %d as notified: %w", history.ID, err)
+		}
+	}
+	return nil
+}
+
+func (s *eventNotificationService) notifyDetailsChanged(ctx context.Context, history *domain.EventHistory) {
+	logger := s.logger.With().Int("history_id", history.ID).Int("event_id", history.EventID).Logger()
+
+	changes, err := history.DetailChanges()
+	if err != nil {
+		logger.Error().Err(err).Msg("Invalid details change")
+		return
+	}
+	event, err := s.eventRepo.GetByID(ctx, history.EventID)
+	if err != nil {
+		logger.Warn().Err(err).Msg("Event of details change not found")
+		return
+	}
+	// The event may have been cancelled or unpublished before the notifier ran
+	if !event.IsPublished() {
+		return
+	}
+
+	recipients, err := s.eventRepo.GetAttendeeEmails(ctx, event.ID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get attendee emails")
+		return
+	}
+
+	// Attendees have no stored language and the notifier runs outside any request, so the
+	// emails use the default language
+	lang := ""
+	subject := s.translator.Format(lang, "email.event_details_changed.subject", map[string]string{"event": event.Name})
+	message := s.detailsChangedMessage(ctx, lang, event, changes)
+	sent := 0
+	for _, recipient := range recipients {
+		if err := s.emailService.SendNotification(ctx, recipient, subject, message); err != nil {
+			logger.Error().Err(err).Msg("Failed to send details change notification")
+			continue
+		}
+		sent++
+	}
+	logger.Info().Int("recipients", len(recipients)).Int("sent", sent).Msg("Details change notifications sent")
+}
+
+func (s *eventNotificationService) detailsChangedMessage(ctx context.Context, lang string, event *domain.Event, changes []domain.EventDetailChange) string {
+	var message strings.Builder
+	message.WriteString(s.translator.Format(lang, "email.event_details_changed.body", map[string]string{"event": event.Name}))
+	message.WriteString("\n\n")
+	for _, change := range changes {
+		label := change.Field
+		if key, ok := eventDetailLabelKeys[change.Field]; ok {
+			label = s.translator.Translate(lang, key)
+		}
+		value := s.translator.Translate(lang, "email.event_details_changed.not_set")
+		if change.To != nil {
+			value = *change.To
+		}
+		// Show the venue itself rather than its id
+		if change.Field == "address_id" && event.AddressID != nil {
+			if address, err := s.addressRepo.GetByID(ctx, *event.AddressID); err == nil {
+				value = address.GetFormattedAddress()
+			}
+		}
+		fmt.Fprintf(&message, "%s: %s\n", label, value)
+	}
+	return message.String()
+}
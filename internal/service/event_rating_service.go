@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/repository"
+	"github.com/louco-event/pkg/sanitizer"
+	"github.com/rs/zerolog"
+)
+
+type EventRatingService interface {
+	// RateEvent stores the user's rating of a completed event they attended. Rating the same
+	// event again replaces the earlier rating.
+	RateEvent(ctx context.Context, eventID, userID int, req dto.RateEventRequest) (*dto.EventRatingResponse, error)
+	// GetEventRatingSummary returns the average stars and rating count of an event the user may view
+	GetEventRatingSummary(ctx context.Context, eventID int, userID *int) (*dto.RatingSummaryResponse, error)
+}
+
+type eventRatingService struct {
+	ratingRepo         repository.EventRatingRepository
+	eventRepo          repository.EventRepository
+	ticketPurchaseRepo repository.TicketPurchaseRepository
+	invitationRepo     repository.InvitationRepository
+	eventService       EventService
+	sanitizer          *sanitizer.Sanitizer
+	logger             zerolog.Logger
+}
+
+func NewEventRatingService(
+	ratingRepo repository.EventRatingRepository,
+	eventRepo repository.EventRepository,
+	ticketPurchaseRepo repository.TicketPurchaseRepository,
+	invitationRepo repository.InvitationRepository,
+	eventService EventService,
+	logger zerolog.Logger,
+) EventRatingService {
+	return &eventRatingService{
+		ratingRepo:         ratingRepo,
+		eventRepo:          eventRepo,
+		ticketPurchaseRepo: ticketPurchaseRepo,
+		invitationRepo:     invitationRepo,
+		eventService:       eventService,
+		sanitizer:          sanitizer.New(sanitizer.ModePlainText),
+		logger:             logger.With().Str("service", "event_rating").Logger(),
+	}
+}
+
+func (s *eventRatingService) RateEvent(ctx context.Context, eventID, userID int, req dto.RateEventRequest) (*dto.EventRatingResponse, error) {
+	if err := s.eventService.AuthorizeEvent(ctx, eventID, &userID, domain.EventActionView); err != nil {
+		return nil, err
+	}
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	if event.Creator.UserID == userID {
+		return nil, domain.ErrEventRatingOwnEvent
+	}
+	if !event.IsCompleted() {
+		return nil, domain.ErrEventRatingNotCompleted
+	}
+
+	attended, err := s.hasAttended(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !attended {
+		return nil, domain.ErrEventRatingNotAttended
+	}
+
+	comment := s.sanitizer.SanitizePtr(req.Comment)
+	if comment != nil && *comment == "" {
+		comment = nil
+	}
+
+	rating, err := domain.NewEventRating(eventID, userID, req.Stars, comment)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ratingRepo.Upsert(ctx, rating); err != nil {
+		s.logger.Error().Err(err).Int("event_id", eventID).Int("user_id", userID).Msg("Failed to save event rating")
+		return nil, fmt.Errorf("failed to save rating: %w", err)
+	}
+
+	// Reload so a replaced rating reports its original creation time
+	saved, err := s.ratingRepo.GetByEventAndUser(ctx, eventID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rating: %w", err)
+	}
+
+	s.logger.Info().Int("event_id", eventID).Int("user_id", userID).Int("stars", saved.Stars).Msg("Event rated")
+	return dto.EventRatingToResponse(saved), nil
+}
+
+func (s *eventRatingService) GetEventRatingSummary(ctx context.Context, eventID int, userID *int) (*dto.RatingSummaryResponse, error) {
+	if err := s.eventService.AuthorizeEvent(ctx, eventID, userID, domain.EventActionView); err != nil {
+		return nil, err
+	}
+
+	average, count, err := s.ratingRepo.GetSummaryByEventID(ctx, eventID)
+	if err != nil {
+		s.logger.Error().Err(err).Int("event_id", eventID).Msg("Failed to get event rating summary")
+		return nil, fmt.Errorf("failed to get rating summary: %w", err)
+	}
+	return dto.NewRatingSummaryResponse(average, count), nil
+}
+
+// hasAttended reports whether the user bought a ticket for the event or accepted an invitation
+func (s *eventRatingService) hasAttended(ctx context.Context, eventID, userID int) (bool, error) {
+	purchased, err := s.ticketPurchaseRepo.ExistsByEventAndUser(ctx, eventID, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check ticket purchases: %w", err)
+	}
+	if purchased {
+		return true, nil
+	}
+
+	invited, err := s.invitationRepo.ExistsApprovedByEventAndUser(ctx, eventID, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check invitation: %w", err)
+	}
+	return invited, nil
+}
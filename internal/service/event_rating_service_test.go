@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/repository/postgres"
+	"github.com/louco-event/internal/testutil"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+func newTestEventRatingService(t *testing.T, db *gorm.DB) EventRatingService {
+	t.Helper()
+	return NewEventRatingService(
+		postgres.NewEventRatingRepository(db),
+		postgres.NewEventRepository(db),
+		postgres.NewTicketPurchaseRepository(db),
+		postgres.NewInvitationRepository(db),
+		newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{}),
+		zerolog.Nop(),
+	)
+}
+
+// attendEvent gives the user a ticket purchase for the event
+func attendEvent(t *testing.T, db *gorm.DB, eventID, userID int) {
+	t.Helper()
+	ticket := testutil.CreateTicket(t, db, eventID, 10)
+	if err := db.Omit("Ticket", "User").Create(domain.NewTicketPurchase(ticket.ID, userID, 1)).Error; err != nil {
+		t.Fatalf("failed to create purchase: %v", err)
+	}
+}
+
+func finishedEvent(daysAgo int) func(*domain.Event) {
+	return func(event *domain.Event) {
+		start := time.Now().UTC().AddDate(0, 0, -daysAgo)
+		event.StartDate = &start
+	}
+}
+
+func TestRateEvent(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	buyer := testutil.CreateUser(t, db, domain.UserTypeUser)
+	guest := testutil.CreateUser(t, db, domain.UserTypeUser)
+	stranger := testutil.CreateUser(t, db, domain.UserTypeUser)
+	service := newTestEventRatingService(t, db)
+
+	// Not over yet
+	upcoming := testutil.CreateEvent(t, db, creator.ID, nil)
+	attendEvent(t, db, upcoming.ID, buyer.ID)
+	if _, err := service.RateEvent(ctx, upcoming.ID, buyer.ID, dto.RateEventRequest{Stars: 5}); !errors.Is(err, domain.ErrEventRatingNotCompleted) {
+		t.Errorf("rating an upcoming event: err = %v, want ErrEventRatingNotCompleted", err)
+	}
+
+	event := testutil.CreateEvent(t, db, creator.ID, finishedEvent(3))
+	attendEvent(t, db, event.ID, buyer.ID)
+	seedInvitations(t, db, event.ID, []domain.InvitationStatus{domain.InvitationStatusApproved}, func(invitation *domain.Invitation) {
+		invitation.InvitedUserID = &guest.ID
+	})
+	if _, err := service.RateEvent(ctx, event.ID, stranger.ID, dto.RateEventRequest{Stars: 1}); !errors.Is(err, domain.ErrEventRatingNotAttended) {
+		t.Errorf("rating by a non-attendee: err = %v, want ErrEventRatingNotAttended", err)
+	}
+
+	first, err := service.RateEvent(ctx, event.ID, buyer.ID, dto.RateEventRequest{Stars: 4})
+	if err != nil {
+		t.Fatalf("RateEvent: %v", err)
+	}
+	// Rating again replaces the earlier rating
+	comment := "Great music, long queues"
+	second, err := service.RateEvent(ctx, event.ID, buyer.ID, dto.RateEventRequest{Stars: 2, Comment: &comment})
+	if err != nil {
+		t.Fatalf("RateEvent again: %v", err)
+	}
+	if second.Stars != 2 || second.Comment == nil || *second.Comment != comment || !second.CreatedAt.Equal(first.CreatedAt) {
+		t.Errorf("second rating = %+v, want 2 stars with the comment and the first creation time %s", second, first.CreatedAt)
+	}
+	if count := countRows(t, db, "event_ratings", "event_id = ? AND user_id = ?", event.ID, buyer.ID); count != 1 {
+		t.Errorf("buyer has %d ratings for the event, want 1", count)
+	}
+
+	if _, err := service.RateEvent(ctx, event.ID, guest.ID, dto.RateEventRequest{Stars: 5}); err != nil {
+		t.Fatalf("RateEvent by an invited guest: %v", err)
+	}
+	summary, err := service.GetEventRatingSummary(ctx, event.ID, nil)
+	if err != nil {
+		t.Fatalf("GetEventRatingSummary: %v", err)
+	}
+	if summary.Average != 3.5 || summary.Count != 2 {
+		t.Errorf("summary = %.2f of %d ratings, want 3.50 of 2", summary.Average, summary.Count)
+	}
+}
+
+func TestCreatorRatingSummaryWeighsEachRating(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	other := testutil.CreateCreator(t, db)
+	fans := []*domain.User{testutil.CreateUser(t, db, domain.UserTypeUser), testutil.CreateUser(t, db, domain.UserTypeUser)}
+	service := newTestEventRatingService(t, db)
+	repo := postgres.NewEventRatingRepository(db)
+
+	rate := func(event *domain.Event, fan *domain.User, stars int) {
+		t.Helper()
+		attendEvent(t, db, event.ID, fan.ID)
+		if _, err := service.RateEvent(ctx, event.ID, fan.ID, dto.RateEventRequest{Stars: stars}); err != nil {
+			t.Fatalf("RateEvent: %v", err)
+		}
+	}
+	busy := testutil.CreateEvent(t, db, creator.ID, finishedEvent(10))
+	quiet := testutil.CreateEvent(t, db, creator.ID, finishedEvent(3))
+	elsewhere := testutil.CreateEvent(t, db, other.ID, finishedEvent(3))
+	rate(busy, fans[0], 5)
+	rate(busy, fans[1], 3)
+	rate(quiet, fans[0], 2)
+	rate(elsewhere, fans[1], 1)
+
+	// Every rating counts once, so the busier event weighs more than the quiet one
+	average, count, err := repo.GetSummaryByCreatorID(ctx, creator.ID)
+	if err != nil {
+		t.Fatalf("GetSummaryByCreatorID: %v", err)
+	}
+	if count != 3 || math.Abs(average-10.0/3) > 1e-9 {
+		t.Errorf("creator summary = %.4f of %d ratings, want 3.3333 of 3", average, count)
+	}
+
+	summaries, err := repo.GetSummariesByCreatorIDs(ctx, []int{creator.ID, other.ID})
+	if err != nil {
+		t.Fatalf("GetSummariesByCreatorIDs: %v", err)
+	}
+	if got := summaries[creator.ID]; got.Count != 3 || math.Abs(got.Average-10.0/3) > 1e-9 {
+		t.Errorf("batched creator summary = %+v, want 3.3333 of 3", got)
+	}
+	if got := summaries[other.ID]; got.Count != 1 || got.Average != 1 {
+		t.Errorf("other creator summary = %+v, want 1 of 1", got)
+	}
+}
@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/repository"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+type EventReportService interface {
+	ReportEvent(ctx context.Context, eventID, userID int, req dto.ReportEventRequest) (*dto.EventReportResponse, error)
+	GetReports(ctx context.Context, filters dto.EventReportFilterRequest, pagination dto.PaginationRequest) ([]*dto.EventReportResponse, *dto.PaginationResponse, error)
+	ResolveReport(ctx context.Context, reportID, adminUserID int, req dto.ResolveEventReportRequest) (*dto.EventReportResponse, error)
+}
+
+type eventReportService struct {
+	eventReportRepo  repository.EventReportRepository
+	eventRepo        repository.EventRepository
+	eventHistoryRepo repository.EventHistoryRepository
+	unpublishAfter   int
+	logger           zerolog.Logger
+}
+
+// NewEventReportService creates the report service. A published event is stopped once it has
+// unpublishAfter pending reports; zero disables automatic unpublishing.
+func NewEventReportService(
+	eventReportRepo repository.EventReportRepository,
+	eventRepo repository.EventRepository,
+	eventHistoryRepo repository.EventHistoryRepository,
+	unpublishAfter int,
+	logger zerolog.Logger,
+) EventReportService {
+	return &eventReportService{
+		eventReportRepo:  eventReportRepo,
+		eventRepo:        eventRepo,
+		eventHistoryRepo: eventHistoryRepo,
+		unpublishAfter:   unpublishAfter,
+		logger:           logger.With().Str("service", "event_report").Logger(),
+	}
+}
+
+func (s *eventReportService) ReportEvent(ctx context.Context, eventID, userID int, req dto.ReportEventRequest) (*dto.EventReportResponse, error) {
+	if !req.Reason.IsValid() {
+		return nil, domain.ErrEventReportInvalidReason
+	}
+	if req.Details != nil {
+		details := strings.TrimSpace(*req.Details)
+		req.Details = &details
+		if details == "" {
+			req.Details = nil
+		}
+	}
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrEventNotFound
+		}
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	// Only published events are visible to reporters
+	if !event.IsPublished() {
+		return nil, domain.ErrEventNotFound
+	}
+
+	exists, err := s.eventReportRepo.ExistsByEventAndReporter(ctx, eventID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check existing report: %w", err)
+	}
+	if exists {
+		return nil, domain.ErrEventReportDuplicate
+	}
+
+	report := domain.NewEventReport(eventID, userID, req.Reason, req.Details)
+	if err := s.eventReportRepo.Create(ctx, report); err != nil {
+		s.logger.Error().Err(err).Int("event_id", eventID).Int("user_id", userID).Msg("Failed to create event report")
+		return nil, fmt.Errorf("failed to report event: %w", err)
+	}
+
+	s.logger.Info().Int("event_id", eventID).Int("user_id", userID).Str("reason", string(req.Reason)).Msg("Event reported")
+
+	s.unpublishIfOverThreshold(ctx, event)
+
+	return dto.EventReportToResponse(report), nil
+}
+
+func (s *eventReportService) GetReports(ctx context.Context, filters dto.EventReportFilterRequest, pagination dto.PaginationRequest) ([]*dto.EventReportResponse, *dto.PaginationResponse, error) {
+	reports, paginationResp, err := s.eventReportRepo.GetReports(ctx, filters.Status, pagination)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("Failed to get event reports")
+		return nil, nil, fmt.Errorf("failed to get event reports: %w", err)
+	}
+
+	responses := make([]*dto.EventReportResponse, 0, len(reports))
+	for _, report := range reports {
+		responses = append(responses, dto.EventReportToResponse(report))
+	}
+
+	return responses, paginationResp, nil
+}
+
+func (s *eventReportService) ResolveReport(ctx context.Context, reportID, adminUserID int, req dto.ResolveEventReportRequest) (*dto.EventReportResponse, error) {
+	report, err := s.eventReportRepo.GetByID(ctx, reportID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrEventReportNotFound
+		}
+		return nil, fmt.Errorf("failed to get report: %w", err)
+	}
+
+	if err := report.Close(req.Status, adminUserID, req.Note); err != nil {
+		return nil, err
+	}
+
+	if err := s.eventReportRepo.Update(ctx, report); err != nil {
+		s.logger.Error().Err(err).Int("report_id", reportID).Msg("Failed to resolve event report")
+		return nil, fmt.Errorf("failed to resolve report: %w", err)
+	}
+
+	history := domain.NewEventModerationHistory(report.EventID, adminUserID, req.Status, req.Note)
+	if err := s.eventHistoryRepo.Create(ctx, history); err != nil {
+		s.logger.Error().Err(err).Int("event_id", report.EventID).Msg("Failed to record event history")
+	}
+
+	s.logger.Info().Int("report_id", reportID).Int("event_id", report.EventID).Int("admin_user_id", adminUserID).Str("status", string(req.Status)).Msg("Event report closed")
+	return dto.EventReportToResponse(report), nil
+}
+
+// unpublishIfOverThreshold stops a published event once it collects enough pending reports.
+// Failures are logged so they never fail the report itself.
+func (s *eventReportService) unpublishIfOverThreshold(ctx context.Context, event *domain.Event) {
+	if s.unpublishAfter <= 0 {
+		return
+	}
+
+	pending, err := s.eventReportRepo.CountPendingByEventID(ctx, event.ID)
+	if err != nil {
+		s.logger.Error().Err(err).Int("event_id", event.ID).Msg("Failed to count pending event reports")
+		return
+	}
+	if pending < int64(s.unpublishAfter) {
+		return
+	}
+
+	if err := event.Stop(); err != nil {
+		return
+	}
+	if err := s.eventRepo.UpdateStatus(ctx, event.ID, domain.EventStatusStopped); err != nil {
+		s.logger.Error().Err(err).Int("event_id", event.ID).Msg("Failed to stop reported event")
+		return
+	}
+
+	history := domain.NewEventStatusHistory(event.ID, nil, domain.EventStatusPublished, domain.EventStatusStopped)
+	if err := s.eventHistoryRepo.Create(ctx, history); err != nil {
+		s.logger.Error().Err(err).Int("event_id", event.ID).Msg("Failed to record event history")
+	}
+
+	s.logger.Warn().Int("event_id", event.ID).Int64("pending_reports", pending).Msg("Event stopped after reaching the report threshold")
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/repository/postgres"
+	"github.com/louco-event/internal/testutil"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+func newTestEventReportService(db *gorm.DB, unpublishAfter int) EventReportService {
+	return NewEventReportService(
+		postgres.NewEventReportRepository(db),
+		postgres.NewEventRepository(db),
+		postgres.NewEventHistoryRepository(db),
+		unpublishAfter,
+		zerolog.Nop(),
+	)
+}
+
+func eventStatus(t *testing.T, db *gorm.DB, eventID int) domain.EventStatus {
+	t.Helper()
+	var event domain.Event
+	if err := db.First(&event, eventID).Error; err != nil {
+		t.Fatalf("failed to get event: %v", err)
+	}
+	return event.Status
+}
+
+func TestReportEvent(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	reporter := testutil.CreateUser(t, db, domain.UserTypeUser)
+	event := testutil.CreateEvent(t, db, creator.ID, nil)
+	service := newTestEventReportService(db, 0)
+
+	details := "  looks like a scam  "
+	report, err := service.ReportEvent(ctx, event.ID, reporter.ID, dto.ReportEventRequest{Reason: domain.EventReportReasonFraud, Details: &details})
+	if err != nil {
+		t.Fatalf("ReportEvent: %v", err)
+	}
+	if report.Status != domain.EventReportStatusPending || report.Details == nil || *report.Details != "looks like a scam" {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+
+	if _, err := service.ReportEvent(ctx, event.ID, reporter.ID, dto.ReportEventRequest{Reason: domain.EventReportReasonSpam}); !errors.Is(err, domain.ErrEventReportDuplicate) {
+		t.Fatalf("duplicate report: got %v, want event already reported", err)
+	}
+	if count := countRows(t, db, "event_reports", "event_id = ?", event.ID); count != 1 {
+		t.Fatalf("%d reports stored, want 1", count)
+	}
+
+	draft := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) { event.Status = domain.EventStatusDraft })
+	if _, err := service.ReportEvent(ctx, draft.ID, reporter.ID, dto.ReportEventRequest{Reason: domain.EventReportReasonSpam}); !errors.Is(err, domain.ErrEventNotFound) {
+		t.Fatalf("report of unpublished event: got %v, want event not found", err)
+	}
+}
+
+func TestReportEventStopsEventAtThreshold(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	event := testutil.CreateEvent(t, db, creator.ID, nil)
+	const threshold = 3
+	service := newTestEventReportService(db, threshold)
+
+	for i := 1; i <= threshold; i++ {
+		reporter := testutil.CreateUser(t, db, domain.UserTypeUser)
+		if _, err := service.ReportEvent(ctx, event.ID, reporter.ID, dto.ReportEventRequest{Reason: domain.EventReportReasonInappropriate}); err != nil {
+			t.Fatalf("report %d: %v", i, err)
+		}
+
+		want := domain.EventStatusPublished
+		if i == threshold {
+			want = domain.EventStatusStopped
+		}
+		if status := eventStatus(t, db, event.ID); status != want {
+			t.Fatalf("after %d reports: status %q, want %q", i, status, want)
+		}
+	}
+
+	if count := countRows(t, db, "event_histories", "event_id = ? AND to_status = ?", event.ID, domain.EventStatusStopped); count != 1 {
+		t.Fatalf("%d stop history entries, want 1", count)
+	}
+}
@@ -2,90 +2,223 @@ package service
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
+	"strings"
 	"time"
+	"unicode/utf8"
 
+	"github.com/louco-event/internal/config"
 	"github.com/louco-event/internal/domain"
 	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/i18n"
 	"github.com/louco-event/internal/repository"
+	"github.com/louco-event/pkg/cache"
+	"github.com/louco-event/pkg/email"
+	"github.com/louco-event/pkg/ical"
 	"github.com/louco-event/pkg/logger"
+	"github.com/louco-event/pkg/pagination"
+	"github.com/louco-event/pkg/sanitizer"
+	"gorm.io/gorm"
+)
+
+const (
+	// maxInvitationsPerRequest mirrors the bulk invitation limit
+	maxInvitationsPerRequest = 100
+	// maxBulkStatusEvents caps how many events one bulk status update may touch
+	maxBulkStatusEvents = 100
+	// maxBulkCategoryEvents caps how many events one bulk category assignment may touch
+	maxBulkCategoryEvents = 100
+
+	// maxAttentionEvents caps the events checked for the needs-attention list
+	maxAttentionEvents = 200
+
+	defaultSimilarEventsLimit = 6
+	maxSimilarEventsLimit     = 20
+
+	defaultShowcaseEventsLimit = 10
+	maxShowcaseEventsLimit     = 50
+
+	// eventViewDedupeWindow is how long repeat views by the same visitor count once
+	eventViewDedupeWindow = time.Hour
+
+	// Look-ahead window of the starting-soon listing, in hours
+	defaultStartingSoonHours = 48
+	maxStartingSoonHours     = 168
+
+	eventSearchCacheKey = "events_search_%s"
 )
 
 type EventService interface {
 	// Basic CRUD operations
 	CreateEvent(ctx context.Context, userID int, req dto.CreateEventRequest) (*dto.EventResponse, error)
-	GetEventByID(ctx context.Context, id int, userID *int) (*dto.EventResponse, error)
+	GetEventByID(ctx context.Context, id int, userID *int, ipAddress string) (*dto.EventResponse, error)
 	GetEventByIDWithRelations(ctx context.Context, id int, userID *int) (*dto.EventResponse, error)
+	// RecordView counts a visit to a published public event towards trending; visits to other
+	// events are ignored
+	RecordView(ctx context.Context, eventID int, userID *int, ipAddress string) error
 	UpdateEvent(ctx context.Context, id, userID int, req dto.UpdateEventRequest) (*dto.EventResponse, error)
 	DeleteEvent(ctx context.Context, id, userID int) error
+	// RestoreEvent takes one of the user's deleted events out of the trash as a draft again
+	RestoreEvent(ctx context.Context, id, userID int) (*dto.EventResponse, error)
+	// GetCreatorTrashedEvents lists the user's deleted events that have not been purged yet
+	GetCreatorTrashedEvents(ctx context.Context, userID int, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error)
+	// CloneEvent copies an event of the user into a new draft without tickets or invitations
+	CloneEvent(ctx context.Context, id, userID int) (*dto.EventResponse, error)
 
 	// Creator-specific operations
 	GetCreatorEvents(ctx context.Context, userID int, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error)
 	GetCreatorEventsByStatus(ctx context.Context, userID int, status domain.EventStatus, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error)
+	GetCreatorEventsByStatuses(ctx context.Context, userID int, statuses []domain.EventStatus, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error)
+	// GetEventsNeedingAttention is the creator's to-do list: rejected events, drafts that cannot
+	// be published yet and events starting soon with low ticket sales
+	GetEventsNeedingAttention(ctx context.Context, userID int) ([]*dto.EventAttentionItem, error)
+	// SearchCreatorEvents matches name and description across the creator's own events in any
+	// status, optionally narrowed to one status
+	SearchCreatorEvents(ctx context.Context, userID int, query string, statusFilter *domain.EventStatus, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error)
+	// GetAllEventsByStatus lists events of all creators in one status; admin only
+	GetAllEventsByStatus(ctx context.Context, filters dto.AdminEventFilterRequest, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error)
+	// GetTrashedEvents lists deleted events of all creators that have not been purged; admin only
+	GetTrashedEvents(ctx context.Context, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error)
+	// PurgeEvent permanently deletes an event from the trash; admin only
+	PurgeEvent(ctx context.Context, id int) error
 	GetCreatorDraftEvents(ctx context.Context, userID int, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error)
 	GetCreatorPublishedEvents(ctx context.Context, userID int, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error)
+	GetCreatorPendingEvents(ctx context.Context, userID int, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error)
+	GetCreatorRejectedEvents(ctx context.Context, userID int, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error)
+	GetCreatorStoppedEvents(ctx context.Context, userID int, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error)
+	GetCreatorCancelledEvents(ctx context.Context, userID int, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error)
 
 	// Public event operations
 	GetPublicEvents(ctx context.Context, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error)
+	// GetPublicEventsAfter is the cursor paginated variant of GetPublicEvents
+	GetPublicEventsAfter(ctx context.Context, pagination dto.CursorPaginationRequest) ([]*dto.EventListResponse, *dto.CursorPaginationResponse, error)
 	GetPublicEventsByCategory(ctx context.Context, categoryID int, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error)
 	GetPublicEventsByLocation(ctx context.Context, city, country string, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error)
 	SearchPublicEvents(ctx context.Context, req dto.EventSearchRequest, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error)
+	// GetNearbyEvents lists published events around a point, each with its distance
+	GetNearbyEvents(ctx context.Context, req dto.EventNearbyRequest, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error)
 
 	// Status management
 	UpdateEventStatus(ctx context.Context, id, userID int, req dto.UpdateEventStatusRequest) (*dto.EventResponse, error)
+	// ExportICS renders the event as an iCalendar file for users allowed to view it
+	ExportICS(ctx context.Context, eventID int, userID *int) ([]byte, error)
+	// BulkUpdateStatus applies one status to several events. Events that are missing, that the
+	// user may not manage or that cannot make the transition are reported per id; the rest are
+	// updated together.
+	BulkUpdateStatus(ctx context.Context, userID int, ids []int, status domain.EventStatus) (*dto.BulkUpdateEventStatusResponse, error)
+	// BulkAddCategoryToEvents adds a category to several events at once. Events that are missing,
+	// that the user may not edit or whose status allows no edits are reported per id; events
+	// already in the category are skipped.
+	BulkAddCategoryToEvents(ctx context.Context, userID int, eventIDs []int, categoryID int) (*dto.BulkAddEventCategoryResponse, error)
 	SubmitEventForReview(ctx context.Context, id, userID int) (*dto.EventResponse, error)
 	PublishEvent(ctx context.Context, id, userID int) (*dto.EventResponse, error)
+	// PublishAndNotify spends a publishing credit, publishes a private event and then emails
+	// every pending invitee in the request language. Nothing is sent when the credit or the
+	// publish fails.
+	PublishAndNotify(ctx context.Context, id, userID int) (*dto.PublishAndNotifyResponse, error)
 	CancelEvent(ctx context.Context, id, userID int) (*dto.EventResponse, error)
 
 	// Advanced filtering and search
 	GetEventsWithFilters(ctx context.Context, filters dto.EventFilterRequest, pagination dto.PaginationRequest, userID *int) ([]*dto.EventListResponse, *dto.PaginationResponse, error)
-	GetEventsByDateRange(ctx context.Context, startDate, endDate time.Time, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error)
-	GetUpcomingEvents(ctx context.Context, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error)
+	CountEventsWithFilters(ctx context.Context, filters dto.EventFilterRequest, userID *int) (int64, error)
+	GetEventsByDateRange(ctx context.Context, startDate, endDate time.Time, includeAnnouncements bool, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error)
+	GetUpcomingEvents(ctx context.Context, includeAnnouncements bool, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error)
+	GetAnnouncements(ctx context.Context, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error)
+	GetCreatorUpcomingEvents(ctx context.Context, creatorID int, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error)
+	GetEventsStartingSoon(ctx context.Context, withinHours int, city *string, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error)
+	GetOngoingEvents(ctx context.Context, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error)
 	GetPastEvents(ctx context.Context, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error)
+	GetSimilarEvents(ctx context.Context, eventID int, limit int) ([]*dto.EventListResponse, error)
+	GetFeaturedEvents(ctx context.Context, req dto.EventShowcaseRequest) ([]*dto.EventListResponse, error)
+	GetTrendingEvents(ctx context.Context, req dto.EventShowcaseRequest) ([]*dto.EventListResponse, error)
+	// SetEventFeatured adds a published public event to the featured list or removes it; admin only
+	SetEventFeatured(ctx context.Context, id int, featured bool) (*dto.EventResponse, error)
+	GetEventPublicStats(ctx context.Context, eventID int) (*dto.EventPublicStatsResponse, error)
+	// RemainingCapacity returns the seats left at an event, or nil when its capacity is unlimited
+	RemainingCapacity(ctx context.Context, eventID int) (*int, error)
+	GetEventShareMeta(ctx context.Context, eventID int) (*dto.EventShareMetaResponse, error)
 
 	// Statistics operations
 	GetEventStats(ctx context.Context, userID int) (*dto.EventStatsResponse, error)
+	GetCreatorStatusCounts(ctx context.Context, userID int) (map[domain.EventStatus]int64, error)
 	GetSystemEventStats(ctx context.Context) (*dto.SystemEventStatsResponse, error)
 
 	// Validation operations
-	ValidateEventOwnership(ctx context.Context, eventID, userID int) error
 	ValidateEventAccess(ctx context.Context, eventID int, userID *int) error
 	CanUserAccessEvent(ctx context.Context, eventID int, userID *int) (bool, error)
+	AuthorizeEvent(ctx context.Context, eventID int, userID *int, action domain.EventAction) error
+
+	// History operations
+	// GetEventHistory returns the change history of an event, newest first, to its owner or to
+	// an admin
+	GetEventHistory(ctx context.Context, eventID, userID int, isAdmin bool, pagination dto.PaginationRequest) ([]*dto.EventHistoryResponse, *dto.PaginationResponse, error)
 }
 
 type eventService struct {
 	eventRepo           repository.EventRepository
+	eventHistoryRepo    repository.EventHistoryRepository
+	eventViewRepo       repository.EventViewRepository
 	addressRepo         repository.AddressRepository
 	ticketRepo          repository.TicketRepository
 	invitationRepo      repository.InvitationRepository
 	categoryRepo        repository.CategoryRepository
 	creatorRepo         repository.CreatorRepository
 	mediaRepo           repository.MediaRepository
+	mediaSigner         dto.MediaSigner
 	subscriptionService SubscriptionService
+	emailService        email.EmailService
+	translator          *i18n.I18n
+	cache               *cache.RedisCache
+	cursorCodec         *pagination.CursorCodec
+	eventConfig         config.EventConfig
+	policy              domain.EventPolicy
+	sanitizer           *sanitizer.Sanitizer
 	logger              *logger.Logger
 }
 
 func NewEventService(
 	eventRepo repository.EventRepository,
+	eventHistoryRepo repository.EventHistoryRepository,
+	eventViewRepo repository.EventViewRepository,
 	addressRepo repository.AddressRepository,
 	ticketRepo repository.TicketRepository,
 	invitationRepo repository.InvitationRepository,
 	categoryRepo repository.CategoryRepository,
 	creatorRepo repository.CreatorRepository,
 	mediaRepo repository.MediaRepository,
+	mediaSigner dto.MediaSigner,
 	subscriptionService SubscriptionService,
+	emailService email.EmailService,
+	translator *i18n.I18n,
+	cache *cache.RedisCache,
+	cursorCodec *pagination.CursorCodec,
+	eventConfig config.EventConfig,
 	logger *logger.Logger,
 ) EventService {
 	return &eventService{
 		eventRepo:           eventRepo,
+		eventHistoryRepo:    eventHistoryRepo,
+		eventViewRepo:       eventViewRepo,
 		addressRepo:         addressRepo,
 		ticketRepo:          ticketRepo,
 		invitationRepo:      invitationRepo,
 		categoryRepo:        categoryRepo,
 		creatorRepo:         creatorRepo,
 		mediaRepo:           mediaRepo,
+		mediaSigner:         mediaSigner,
 		subscriptionService: subscriptionService,
+		emailService:        emailService,
+		translator:          translator,
+		cache:               cache,
+		cursorCodec:         cursorCodec,
+		eventConfig:         eventConfig,
+		policy:              domain.NewEventPolicy(),
+		sanitizer:           sanitizer.New(sanitizer.Mode(eventConfig.DescriptionFormat)),
 		logger:              logger,
 	}
 }
@@ -98,12 +231,17 @@ func (s *eventService) CreateEvent(ctx context.Context, userID int, req dto.Crea
 	creator, err := s.creatorRepo.GetByUserID(ctx, userID)
 	if err != nil {
 		s.logger.Error().Err(err).Int("user_id", userID).Msg("Failed to get creator by user ID")
-		return nil, fmt.Errorf("creator profile not found")
+		return nil, domain.ErrCreatorProfileNotFound
 	}
 
 	creatorID := creator.ID
 	s.logger.Info().Int("user_id", userID).Int("creator_id", creatorID).Msg("Found creator for user")
 
+	// New events start as drafts, so they count against the draft limit
+	if err := s.checkDraftLimit(ctx, creatorID); err != nil {
+		return nil, err
+	}
+
 	// Validate address if provided
 	if req.AddressID != nil {
 		addressExists, err := s.addressRepo.ExistsByID(ctx, *req.AddressID)
@@ -112,7 +250,7 @@ func (s *eventService) CreateEvent(ctx context.Context, userID int, req dto.Crea
 			return nil, fmt.Errorf("failed to validate address: %w", err)
 		}
 		if !addressExists {
-			return nil, errors.New("event.address_not_found")
+			return nil, domain.ErrEventAddressNotFound
 		}
 	}
 
@@ -135,7 +273,7 @@ func (s *eventService) CreateEvent(ctx context.Context, userID int, req dto.Crea
 			return nil, fmt.Errorf("failed to validate image: %w", err)
 		}
 		if !mediaExists {
-			return nil, errors.New("event.image_not_found")
+			return nil, domain.ErrEventImageNotFound
 		}
 	}
 
@@ -147,10 +285,14 @@ func (s *eventService) CreateEvent(ctx context.Context, userID int, req dto.Crea
 			return nil, fmt.Errorf("failed to validate video: %w", err)
 		}
 		if !mediaExists {
-			return nil, errors.New("event.video_not_found")
+			return nil, domain.ErrEventVideoNotFound
 		}
 	}
 
+	if _, err := domain.LoadEventTimezone(req.Timezone); err != nil {
+		return nil, err
+	}
+
 	// Parse dates
 	var startDate, endDate *time.Time
 	var startTime, endTime *time.Time
@@ -189,46 +331,57 @@ func (s *eventService) CreateEvent(ctx context.Context, userID int, req dto.Crea
 
 	// Create event domain entity
 	event := &domain.Event{
-		CreatorID:        creatorID,
-		Name:             req.Name,
-		Description:      req.Description,
-		ImageID:          req.ImageID,
-		VideoID:          req.VideoID,
-		Type:             req.Type,
-		LocationType:     req.LocationType,
-		Status:           domain.EventStatusDraft, // Always start as draft
-		StartDate:        startDate,
-		StartTime:        startTime,
-		EndDate:          endDate,
-		EndTime:          endTime,
-		AddressID:        req.AddressID,
-		OnlineEventURL:   req.OnlineEventURL,
-		OnlineEventType:  req.OnlineEventType,
-		TicketURL:        req.TicketURL,
-		HasSystemTickets: req.HasSystemTickets,
-		AdditionalInfo:   req.AdditionalInfo,
-	}
+		CreatorID:         creatorID,
+		Name:              req.Name,
+		Description:       s.sanitizer.SanitizePtr(req.Description),
+		ImageID:           req.ImageID,
+		VideoID:           req.VideoID,
+		Type:              req.Type,
+		LocationType:      req.LocationType,
+		Status:            domain.EventStatusDraft, // Always start as draft
+		StartDate:         startDate,
+		StartTime:         startTime,
+		EndDate:           endDate,
+		EndTime:           endTime,
+		Timezone:          req.Timezone,
+		AddressID:         req.AddressID,
+		OnlineEventURL:    req.OnlineEventURL,
+		OnlineEventType:   req.OnlineEventType,
+		TicketURL:         req.TicketURL,
+		HasSystemTickets:  req.HasSystemTickets,
+		AllowJoinRequests: req.AllowJoinRequests,
+		HideGoingCount:    req.HideGoingCount,
+		Capacity:          req.Capacity,
+		AdditionalInfo:    s.sanitizer.SanitizePtr(req.AdditionalInfo),
+	}
+	s.applyDefaultTimezone(ctx, event)
 
 	// Validate business rules
 	if err := s.validateEventBusinessRules(event); err != nil {
+		if errors.Is(err, domain.ErrEventTypeNotAllowed) {
+			return nil, err
+		}
 		return nil, fmt.Errorf("validation failed: %w", err)
 	}
 
-	// Create event
-	if err := s.eventRepo.Create(ctx, event); err != nil {
-		s.logger.Error().Err(err).Int("creator_id", creatorID).Msg("Failed to create event")
-		return nil, fmt.Errorf("failed to create event: %w", err)
+	// Build the guest list for private events
+	invitations, err := s.buildEventInvitations(event, req.Invitations)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkInitialInvitationLimit(ctx, userID, len(invitations)); err != nil {
+		return nil, err
 	}
 
-	// Add categories if provided
-	if len(req.CategoryIDs) > 0 {
-		if err := s.eventRepo.AddCategories(ctx, event.ID, req.CategoryIDs); err != nil {
-			s.logger.Error().Err(err).Int("event_id", event.ID).Msg("Failed to add categories to event")
-			return nil, fmt.Errorf("failed to add categories: %w", err)
-		}
+	// Create event together with its categories and invitations, rolling back all of them if
+	// any fails
+	if err := s.eventRepo.CreateWithInvitations(ctx, event, req.CategoryIDs, invitations); err != nil {
+		s.logger.Error().Err(err).Int("creator_id", creatorID).Int("invitation_count", len(invitations)).Msg("Failed to create event")
+		return nil, fmt.Errorf("failed to create event: %w", err)
 	}
 
-	s.logger.Info().Int("event_id", event.ID).Int("creator_id", creatorID).Msg("Event created successfully")
+	s.logger.Info().Int("event_id", event.ID).Int("creator_id", creatorID).Int("invitation_count", len(invitations)).Msg("Event created successfully")
+	s.recordHistory(ctx, domain.NewEventFieldsHistory(event.ID, &userID, domain.EventHistoryActionCreated, nil))
 
 	// Get event with relations for response
 	createdEvent, err := s.eventRepo.GetByIDWithRelations(ctx, event.ID)
@@ -237,10 +390,91 @@ func (s *eventService) CreateEvent(ctx context.Context, userID int, req dto.Crea
 		return nil, fmt.Errorf("failed to get created event: %w", err)
 	}
 
-	return dto.EventToResponse(createdEvent), nil
+	return dto.EventToResponse(createdEvent, s.mediaSigner), nil
+}
+
+// CloneEvent duplicates the details, location, media and categories of an event as a new draft
+// named "<name> (Copy)". Dates are left empty so the copy is not mistaken for the original.
+func (s *eventService) CloneEvent(ctx context.Context, id, userID int) (*dto.EventResponse, error) {
+	s.logger.Info().Int("event_id", id).Int("user_id", userID).Msg("Cloning event")
+
+	if err := s.AuthorizeEvent(ctx, id, &userID, domain.EventActionManage); err != nil {
+		return nil, err
+	}
+
+	source, err := s.eventRepo.GetByIDWithRelations(ctx, id)
+	if err != nil {
+		s.logger.Error().Err(err).Int("event_id", id).Msg("Failed to get event to clone")
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	// The clone is a draft, so it counts against the draft limit
+	if err := s.checkDraftLimit(ctx, source.CreatorID); err != nil {
+		return nil, err
+	}
+
+	event := &domain.Event{
+		CreatorID:         source.CreatorID,
+		Name:              cloneEventName(source.Name),
+		Description:       source.Description,
+		ImageID:           source.ImageID,
+		VideoID:           source.VideoID,
+		Type:              source.Type,
+		LocationType:      source.LocationType,
+		Status:            domain.EventStatusDraft,
+		Timezone:          source.Timezone,
+		AddressID:         source.AddressID,
+		OnlineEventURL:    source.OnlineEventURL,
+		OnlineEventType:   source.OnlineEventType,
+		HasSystemTickets:  source.HasSystemTickets,
+		AllowJoinRequests: source.AllowJoinRequests,
+		HideGoingCount:    source.HideGoingCount,
+		Capacity:          source.Capacity,
+		AdditionalInfo:    source.AdditionalInfo,
+	}
+
+	if err := s.eventRepo.Create(ctx, event); err != nil {
+		s.logger.Error().Err(err).Int("event_id", id).Msg("Failed to create cloned event")
+		return nil, fmt.Errorf("failed to clone event: %w", err)
+	}
+
+	if len(source.Categories) > 0 {
+		categoryIDs := make([]int, len(source.Categories))
+		for i, category := range source.Categories {
+			categoryIDs[i] = category.ID
+		}
+		if err := s.eventRepo.AddCategories(ctx, event.ID, categoryIDs); err != nil {
+			s.logger.Error().Err(err).Int("event_id", event.ID).Msg("Failed to add categories to cloned event")
+			return nil, fmt.Errorf("failed to add categories: %w", err)
+		}
+	}
+
+	s.logger.Info().Int("event_id", event.ID).Int("source_event_id", id).Msg("Event cloned successfully")
+	s.recordHistory(ctx, domain.NewEventFieldsHistory(event.ID, &userID, domain.EventHistoryActionCreated, nil))
+
+	clonedEvent, err := s.eventRepo.GetByIDWithRelations(ctx, event.ID)
+	if err != nil {
+		s.logger.Error().Err(err).Int("event_id", event.ID).Msg("Failed to get cloned event")
+		return nil, fmt.Errorf("failed to get cloned event: %w", err)
+	}
+
+	return dto.EventToResponse(clonedEvent, s.mediaSigner), nil
+}
+
+// maxEventNameLength matches the size of the events.name column
+const maxEventNameLength = 200
+
+// cloneEventName appends the copy suffix, shortening the name so it still fits the column
+func cloneEventName(name string) string {
+	const suffix = " (Copy)"
+	runes := []rune(name)
+	if limit := maxEventNameLength - utf8.RuneCountInString(suffix); len(runes) > limit {
+		runes = runes[:limit]
+	}
+	return string(runes) + suffix
 }
 
-func (s *eventService) GetEventByID(ctx context.Context, id int, userID *int) (*dto.EventResponse, error) {
+func (s *eventService) GetEventByID(ctx context.Context, id int, userID *int, ipAddress string) (*dto.EventResponse, error) {
 	s.logger.Info().Int("event_id", id).Interface("user_id", userID).Msg("Getting event by ID")
 
 	event, err := s.eventRepo.GetByID(ctx, id)
@@ -249,12 +483,46 @@ func (s *eventService) GetEventByID(ctx context.Context, id int, userID *int) (*
 		return nil, fmt.Errorf("failed to get event: %w", err)
 	}
 
-	// Check access permissions
-	if err := s.ValidateEventAccess(ctx, id, userID); err != nil {
+	// Check access permissions on the loaded event instead of fetching it again
+	if err := s.authorizeLoadedEvent(ctx, event, userID, domain.EventActionView); err != nil {
 		return nil, err
 	}
 
-	return dto.EventToResponse(event), nil
+	// A failed view count must never break the page
+	if err := s.recordView(ctx, event, userID, ipAddress); err != nil {
+		s.logger.Warn().Err(err).Int("event_id", id).Msg("Failed to record event view")
+	}
+
+	return dto.EventToResponse(event, s.mediaSigner), nil
+}
+
+func (s *eventService) RecordView(ctx context.Context, eventID int, userID *int, ipAddress string) error {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.ErrEventNotFound
+		}
+		return fmt.Errorf("failed to get event: %w", err)
+	}
+	return s.recordView(ctx, event, userID, ipAddress)
+}
+
+// recordView stores a view of a published public event. Repeat views by the same user, or by
+// the same address when signed out, within eventViewDedupeWindow and the owner's own views are
+// not counted.
+func (s *eventService) recordView(ctx context.Context, event *domain.Event, userID *int, ipAddress string) error {
+	if !event.IsPublished() || !event.IsPublic() {
+		return nil
+	}
+	if userID != nil && event.Creator.UserID == *userID {
+		return nil
+	}
+
+	view := domain.NewEventView(event.ID, userID, ipAddress)
+	if _, err := s.eventViewRepo.Record(ctx, view, view.ViewedAt.Add(-eventViewDedupeWindow)); err != nil {
+		return fmt.Errorf("failed to record event view: %w", err)
+	}
+	return nil
 }
 
 func (s *eventService) GetEventByIDWithRelations(ctx context.Context, id int, userID *int) (*dto.EventResponse, error) {
@@ -266,12 +534,12 @@ func (s *eventService) GetEventByIDWithRelations(ctx context.Context, id int, us
 		return nil, fmt.Errorf("failed to get event: %w", err)
 	}
 
-	// Check access permissions
-	if err := s.ValidateEventAccess(ctx, id, userID); err != nil {
+	// Check access permissions on the loaded event instead of fetching it again
+	if err := s.authorizeLoadedEvent(ctx, event, userID, domain.EventActionView); err != nil {
 		return nil, err
 	}
 
-	return dto.EventToResponse(event), nil
+	return dto.EventToResponse(event, s.mediaSigner), nil
 }
 
 func (s *eventService) UpdateEvent(ctx context.Context, id, userID int, req dto.UpdateEventRequest) (*dto.EventResponse, error) {
@@ -281,13 +549,13 @@ func (s *eventService) UpdateEvent(ctx context.Context, id, userID int, req dto.
 	creator, err := s.creatorRepo.GetByUserID(ctx, userID)
 	if err != nil {
 		s.logger.Error().Err(err).Int("user_id", userID).Msg("Failed to get creator by user ID")
-		return nil, fmt.Errorf("creator profile not found")
+		return nil, domain.ErrCreatorProfileNotFound
 	}
 
 	creatorID := creator.ID
 
-	// Validate ownership
-	if err := s.ValidateEventOwnership(ctx, id, userID); err != nil {
+	// Owners and accepted editor co-hosts may edit
+	if err := s.AuthorizeEvent(ctx, id, &userID, domain.EventActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -298,17 +566,19 @@ func (s *eventService) UpdateEvent(ctx context.Context, id, userID int, req dto.
 		return nil, fmt.Errorf("failed to get event: %w", err)
 	}
 
-	// Check if event can be updated (only draft and pending events can be updated)
-	if event.Status != domain.EventStatusDraft && event.Status != domain.EventStatusPending {
-		return nil, errors.New("only draft and pending events can be updated")
+	// Draft and pending events can be updated freely; published events keep their visibility and
+	// ticketing, and attendees are only told about changes to the logistics
+	if err := checkEventEditable(event, req); err != nil {
+		return nil, err
 	}
+	before := *event
 
 	// Update fields if provided
 	if req.Name != nil {
 		event.Name = *req.Name
 	}
 	if req.Description != nil {
-		event.Description = req.Description
+		event.Description = s.sanitizer.SanitizePtr(req.Description)
 	}
 	if req.ImageID != nil {
 		event.ImageID = req.ImageID
@@ -319,8 +589,10 @@ func (s *eventService) UpdateEvent(ctx context.Context, id, userID int, req dto.
 	if req.Type != nil {
 		event.Type = *req.Type
 	}
+	// Location fields are applied after the type so the business rules below always judge the
+	// merged event: an address sent for an online event is rejected rather than stored
 	if req.LocationType != nil {
-		event.LocationType = *req.LocationType
+		event.ChangeLocationType(*req.LocationType)
 	}
 	if req.AddressID != nil {
 		// Validate address exists
@@ -329,9 +601,9 @@ func (s *eventService) UpdateEvent(ctx context.Context, id, userID int, req dto.
 			return nil, fmt.Errorf("failed to validate address: %w", err)
 		}
 		if !addressExists {
-			return nil, errors.New("address not found")
+			return nil, domain.ErrEventAddressNotFound
 		}
-		event.AddressID = req.AddressID
+		event.SetAddress(*req.AddressID)
 	}
 	if req.OnlineEventURL != nil {
 		event.OnlineEventURL = req.OnlineEventURL
@@ -345,8 +617,20 @@ func (s *eventService) UpdateEvent(ctx context.Context, id, userID int, req dto.
 	if req.HasSystemTickets != nil {
 		event.HasSystemTickets = *req.HasSystemTickets
 	}
+	if req.AllowJoinRequests != nil {
+		event.AllowJoinRequests = *req.AllowJoinRequests
+	}
+	if req.HideGoingCount != nil {
+		event.HideGoingCount = *req.HideGoingCount
+	}
+	if req.Capacity != nil {
+		event.Capacity = req.Capacity
+		if *req.Capacity == 0 {
+			event.Capacity = nil
+		}
+	}
 	if req.AdditionalInfo != nil {
-		event.AdditionalInfo = req.AdditionalInfo
+		event.AdditionalInfo = s.sanitizer.SanitizePtr(req.AdditionalInfo)
 	}
 
 	// Parse and update dates if provided
@@ -378,9 +662,19 @@ func (s *eventService) UpdateEvent(ctx context.Context, id, userID int, req dto.
 		}
 		event.EndTime = &parsed
 	}
+	if req.Timezone != nil {
+		if _, err := domain.LoadEventTimezone(*req.Timezone); err != nil {
+			return nil, err
+		}
+		event.Timezone = *req.Timezone
+	}
+	s.applyDefaultTimezone(ctx, event)
 
 	// Validate business rules
 	if err := s.validateEventBusinessRules(event); err != nil {
+		if errors.Is(err, domain.ErrEventTypeNotAllowed) {
+			return nil, err
+		}
 		return nil, fmt.Errorf("validation failed: %w", err)
 	}
 
@@ -416,6 +710,13 @@ func (s *eventService) UpdateEvent(ctx context.Context, id, userID int, req dto.
 	}
 
 	s.logger.Info().Int("event_id", id).Int("creator_id", creatorID).Msg("Event updated successfully")
+	s.recordHistory(ctx, domain.NewEventFieldsHistory(id, &userID, domain.EventHistoryActionUpdated, updatedEventFields(req)))
+	if event.IsPublished() {
+		if changes := event.DetailChanges(&before); len(changes) > 0 {
+			s.recordHistory(ctx, domain.NewEventDetailsChangedHistory(id, &userID, changes))
+			s.logger.Info().Int("event_id", id).Int("changes", len(changes)).Msg("event.details_changed")
+		}
+	}
 
 	// Get updated event with relations
 	updatedEvent, err := s.eventRepo.GetByIDWithRelations(ctx, id)
@@ -424,7 +725,7 @@ func (s *eventService) UpdateEvent(ctx context.Context, id, userID int, req dto.
 		return nil, fmt.Errorf("failed to get updated event: %w", err)
 	}
 
-	return dto.EventToResponse(updatedEvent), nil
+	return dto.EventToResponse(updatedEvent, s.mediaSigner), nil
 }
 
 func (s *eventService) DeleteEvent(ctx context.Context, id, userID int) error {
@@ -434,42 +735,22 @@ func (s *eventService) DeleteEvent(ctx context.Context, id, userID int) error {
 	creator, err := s.creatorRepo.GetByUserID(ctx, userID)
 	if err != nil {
 		s.logger.Error().Err(err).Int("user_id", userID).Msg("Failed to get creator by user ID")
-		return fmt.Errorf("creator profile not found")
+		return domain.ErrCreatorProfileNotFound
 	}
 
 	creatorID := creator.ID
 
-	// Validate ownership
-	if err := s.ValidateEventOwnership(ctx, id, userID); err != nil {
-		return err
-	}
-
-	// Get event to check status
-	event, err := s.eventRepo.GetByID(ctx, id)
+	event, err := s.loadAuthorizedEvent(ctx, id, &userID, domain.EventActionDelete)
 	if err != nil {
-		s.logger.Error().Err(err).Int("event_id", id).Msg("Failed to get event for deletion")
-		return fmt.Errorf("failed to get event: %w", err)
+		return err
 	}
 
 	// Check if event can be deleted (only draft events can be deleted)
 	if event.Status != domain.EventStatusDraft {
-		return errors.New("only draft events can be deleted")
-	}
-
-	// Delete related data first
-	// Delete invitations
-	if err := s.invitationRepo.DeleteByEventID(ctx, id); err != nil {
-		s.logger.Error().Err(err).Int("event_id", id).Msg("Failed to delete event invitations")
-		return fmt.Errorf("failed to delete invitations: %w", err)
-	}
-
-	// Delete tickets
-	if err := s.ticketRepo.DeleteByEventID(ctx, id); err != nil {
-		s.logger.Error().Err(err).Int("event_id", id).Msg("Failed to delete event tickets")
-		return fmt.Errorf("failed to delete tickets: %w", err)
+		return domain.ErrEventCannotBeDeleted
 	}
 
-	// Delete event
+	// Move the event to the trash; its tickets and invitations stay until the event is purged
 	if err := s.eventRepo.Delete(ctx, id); err != nil {
 		s.logger.Error().Err(err).Int("event_id", id).Msg("Failed to delete event")
 		return fmt.Errorf("failed to delete event: %w", err)
@@ -479,13 +760,65 @@ func (s *eventService) DeleteEvent(ctx context.Context, id, userID int) error {
 	return nil
 }
 
+func (s *eventService) RestoreEvent(ctx context.Context, id, userID int) (*dto.EventResponse, error) {
+	creator, err := s.creatorRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		s.logger.Error().Err(err).Int("user_id", userID).Msg("Failed to get creator by user ID")
+		return nil, domain.ErrCreatorProfileNotFound
+	}
+
+	// Only drafts can be deleted, so the restored event is a draft again
+	if err := s.checkDraftLimit(ctx, creator.ID); err != nil {
+		return nil, err
+	}
+
+	if err := s.eventRepo.Restore(ctx, id, creator.ID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrEventNotFound
+		}
+		s.logger.Error().Err(err).Int("event_id", id).Msg("Failed to restore event")
+		return nil, fmt.Errorf("failed to restore event: %w", err)
+	}
+
+	s.logger.Info().Int("event_id", id).Int("creator_id", creator.ID).Msg("Event restored")
+
+	event, err := s.eventRepo.GetByIDWithRelations(ctx, id)
+	if err != nil {
+		s.logger.Error().Err(err).Int("event_id", id).Msg("Failed to get restored event")
+		return nil, fmt.Errorf("failed to get restored event: %w", err)
+	}
+
+	return dto.EventToResponse(event, s.mediaSigner), nil
+}
+
+func (s *eventService) GetCreatorTrashedEvents(ctx context.Context, userID int, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
+	creator, err := s.creatorRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		s.logger.Error().Err(err).Int("user_id", userID).Msg("Failed to get creator by user ID")
+		return nil, nil, domain.ErrCreatorProfileNotFound
+	}
+
+	events, paginationResp, err := s.eventRepo.GetTrashed(ctx, creator.ID, pagination)
+	if err != nil {
+		s.logger.Error().Err(err).Int("creator_id", creator.ID).Msg("Failed to get trashed events")
+		return nil, nil, fmt.Errorf("failed to get trashed events: %w", err)
+	}
+
+	responses := make([]*dto.EventListResponse, 0, len(events))
+	for _, event := range events {
+		responses = append(responses, dto.EventToListResponse(event))
+	}
+
+	return responses, paginationResp, nil
+}
+
 // Creator-specific operations
 func (s *eventService) GetCreatorEvents(ctx context.Context, userID int, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
 	// Get creator by user ID
 	creator, err := s.creatorRepo.GetByUserID(ctx, userID)
 	if err != nil {
 		s.logger.Error().Err(err).Int("user_id", userID).Msg("Failed to get creator by user ID")
-		return nil, nil, fmt.Errorf("creator profile not found")
+		return nil, nil, domain.ErrCreatorProfileNotFound
 	}
 
 	creatorID := creator.ID
@@ -507,7 +840,7 @@ func (s *eventService) GetCreatorEventsByStatus(ctx context.Context, userID int,
 	creator, err := s.creatorRepo.GetByUserID(ctx, userID)
 	if err != nil {
 		s.logger.Error().Err(err).Int("user_id", userID).Msg("Failed to get creator by user ID")
-		return nil, nil, fmt.Errorf("creator profile not found")
+		return nil, nil, domain.ErrCreatorProfileNotFound
 	}
 
 	creatorID := creator.ID
@@ -524,22 +857,18 @@ func (s *eventService) GetCreatorEventsByStatus(ctx context.Context, userID int,
 	return responses, paginationResp, nil
 }
 
-func (s *eventService) GetCreatorDraftEvents(ctx context.Context, userID int, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
-	return s.GetCreatorEventsByStatus(ctx, userID, domain.EventStatusDraft, pagination)
-}
-
-func (s *eventService) GetCreatorPublishedEvents(ctx context.Context, userID int, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
-	return s.GetCreatorEventsByStatus(ctx, userID, domain.EventStatusPublished, pagination)
-}
+func (s *eventService) GetAllEventsByStatus(ctx context.Context, filters dto.AdminEventFilterRequest, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
+	if filters.StartDate != nil && filters.EndDate != nil && *filters.StartDate > *filters.EndDate {
+		return nil, nil, domain.ErrEventInvalidDateRange
+	}
 
-// Public event operations
-func (s *eventService) GetPublicEvents(ctx context.Context, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
-	events, paginationResp, err := s.eventRepo.GetPublicEvents(ctx, pagination)
+	events, paginationResp, err := s.eventRepo.GetByStatusWithFilters(ctx, filters, pagination)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to get public events: %w", err)
+		s.logger.Error().Err(err).Str("status", string(filters.Status)).Msg("Failed to get events by status")
+		return nil, nil, fmt.Errorf("failed to get events by status: %w", err)
 	}
 
-	var responses []*dto.EventListResponse
+	responses := make([]*dto.EventListResponse, 0, len(events))
 	for _, event := range events {
 		responses = append(responses, dto.EventToListResponse(event))
 	}
@@ -547,13 +876,14 @@ func (s *eventService) GetPublicEvents(ctx context.Context, pagination dto.Pagin
 	return responses, paginationResp, nil
 }
 
-func (s *eventService) GetPublicEventsByCategory(ctx context.Context, categoryID int, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
-	events, paginationResp, err := s.eventRepo.GetPublicEventsByCategory(ctx, categoryID, pagination)
+func (s *eventService) GetTrashedEvents(ctx context.Context, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
+	events, paginationResp, err := s.eventRepo.GetTrashed(ctx, 0, pagination)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to get public events by category: %w", err)
+		s.logger.Error().Err(err).Msg("Failed to get trashed events")
+		return nil, nil, fmt.Errorf("failed to get trashed events: %w", err)
 	}
 
-	var responses []*dto.EventListResponse
+	responses := make([]*dto.EventListResponse, 0, len(events))
 	for _, event := range events {
 		responses = append(responses, dto.EventToListResponse(event))
 	}
@@ -561,11 +891,43 @@ func (s *eventService) GetPublicEventsByCategory(ctx context.Context, categoryID
 	return responses, paginationResp, nil
 }
 
-func (s *eventService) GetPublicEventsByLocation(ctx context.Context, city, country string, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
-	events, paginationResp, err := s.eventRepo.GetPublicEventsByLocation(ctx, city, pagination)
+func (s *eventService) PurgeEvent(ctx context.Context, id int) error {
+	if err := s.eventRepo.Purge(ctx, id); err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return domain.ErrEventNotFound
+		case errors.Is(err, domain.ErrEventHasPurchases):
+			return err
+		}
+		s.logger.Error().Err(err).Int("event_id", id).Msg("Failed to purge event")
+		return fmt.Errorf("failed to purge event: %w", err)
+	}
+
+	s.logger.Info().Int("event_id", id).Msg("Event purged")
+	return nil
+}
+
+// GetCreatorEventsByStatuses returns the creator's events in any of the given statuses
+func (s *eventService) GetCreatorEventsByStatuses(ctx context.Context, userID int, statuses []domain.EventStatus, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
+	if len(statuses) == 0 {
+		return nil, nil, domain.ErrEventInvalidStatus
+	}
+	for _, status := range statuses {
+		if !status.IsValid() {
+			return nil, nil, domain.ErrEventInvalidStatus
+		}
+	}
+
+	// Get creator by user ID
+	creator, err := s.creatorRepo.GetByUserID(ctx, userID)
 	if err != nil {
-		s.logger.Error().Err(err).Str("city", city).Msg("Failed to get public events by location")
-		return nil, nil, fmt.Errorf("failed to get public events by location: %w", err)
+		s.logger.Error().Err(err).Int("user_id", userID).Msg("Failed to get creator by user ID")
+		return nil, nil, domain.ErrCreatorProfileNotFound
+	}
+
+	events, paginationResp, err := s.eventRepo.GetByCreatorIDAndStatuses(ctx, creator.ID, statuses, pagination)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get creator events by statuses: %w", err)
 	}
 
 	var responses []*dto.EventListResponse
@@ -576,27 +938,59 @@ func (s *eventService) GetPublicEventsByLocation(ctx context.Context, city, coun
 	return responses, paginationResp, nil
 }
 
-func (s *eventService) SearchPublicEvents(ctx context.Context, req dto.EventSearchRequest, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
-	// Search public events - use advanced filtering instead
-	publishedStatus := domain.EventStatusPublished
-	publicType := domain.EventTypePublic
+func (s *eventService) GetCreatorDraftEvents(ctx context.Context, userID int, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
+	return s.GetCreatorEventsByStatus(ctx, userID, domain.EventStatusDraft, pagination)
+}
 
-	filters := dto.EventFilterRequest{
-		Query:        &req.Query,
-		Type:         &publicType,
-		LocationType: req.LocationType,
-		CategoryIDs:  req.CategoryIDs,
-		City:         req.City,
-		Status:       &publishedStatus,
+func (s *eventService) GetCreatorPublishedEvents(ctx context.Context, userID int, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
+	return s.GetCreatorEventsByStatus(ctx, userID, domain.EventStatusPublished, pagination)
+}
+
+func (s *eventService) GetCreatorPendingEvents(ctx context.Context, userID int, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
+	return s.GetCreatorEventsByStatus(ctx, userID, domain.EventStatusPending, pagination)
+}
+
+func (s *eventService) GetCreatorRejectedEvents(ctx context.Context, userID int, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
+	return s.GetCreatorEventsByStatus(ctx, userID, domain.EventStatusRejected, pagination)
+}
+
+func (s *eventService) GetCreatorStoppedEvents(ctx context.Context, userID int, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
+	return s.GetCreatorEventsByStatus(ctx, userID, domain.EventStatusStopped, pagination)
+}
+
+func (s *eventService) GetCreatorCancelledEvents(ctx context.Context, userID int, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
+	return s.GetCreatorEventsByStatus(ctx, userID, domain.EventStatusCancelled, pagination)
+}
+
+func (s *eventService) SearchCreatorEvents(ctx context.Context, userID int, query string, statusFilter *domain.EventStatus, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
+	query = strings.TrimSpace(query)
+	if utf8.RuneCountInString(query) < s.eventConfig.SearchMinQueryLength {
+		return nil, nil, domain.ErrEventSearchQueryTooShort
+	}
+	if statusFilter != nil && !statusFilter.IsValid() {
+		return nil, nil, domain.ErrEventInvalidStatus
 	}
 
-	events, paginationResp, err := s.eventRepo.GetEventsWithFilters(ctx, filters, pagination)
+	creator, err := s.creatorRepo.GetByUserID(ctx, userID)
 	if err != nil {
-		s.logger.Error().Err(err).Interface("request", req).Msg("Failed to search public events")
+		s.logger.Error().Err(err).Int("user_id", userID).Msg("Failed to get creator by user ID")
+		return nil, nil, domain.ErrCreatorProfileNotFound
+	}
+
+	// Same matching as the public search; the owner as viewer lifts the published public
+	// restriction for the creator's own events
+	filters := dto.EventFilterRequest{
+		Query:     &query,
+		CreatorID: &creator.ID,
+		Status:    statusFilter,
+	}
+	events, paginationResp, err := s.eventRepo.GetEventsWithFilters(ctx, filters, &userID, pagination)
+	if err != nil {
+		s.logger.Error().Err(err).Int("creator_id", creator.ID).Msg("Failed to search creator events")
 		return nil, nil, fmt.Errorf("failed to search events: %w", err)
 	}
 
-	var responses []*dto.EventListResponse
+	responses := make([]*dto.EventListResponse, 0, len(events))
 	for _, event := range events {
 		responses = append(responses, dto.EventToListResponse(event))
 	}
@@ -604,61 +998,460 @@ func (s *eventService) SearchPublicEvents(ctx context.Context, req dto.EventSear
 	return responses, paginationResp, nil
 }
 
-// Status management
-func (s *eventService) UpdateEventStatus(ctx context.Context, id, userID int, req dto.UpdateEventStatusRequest) (*dto.EventResponse, error) {
-	// Validate ownership
-	if err := s.ValidateEventOwnership(ctx, id, userID); err != nil {
-		return nil, err
+func (s *eventService) GetEventsNeedingAttention(ctx context.Context, userID int) ([]*dto.EventAttentionItem, error) {
+	creator, err := s.creatorRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		s.logger.Error().Err(err).Int("user_id", userID).Msg("Failed to get creator by user ID")
+		return nil, domain.ErrCreatorProfileNotFound
 	}
 
-	// Get current event
-	event, err := s.eventRepo.GetByID(ctx, id)
+	events, err := s.eventRepo.GetAttentionCandidates(ctx, creator.ID, time.Now().Add(s.eventConfig.AttentionWindow), maxAttentionEvents)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get event: %w", err)
+		return nil, fmt.Errorf("failed to get events needing attention: %w", err)
 	}
 
-	// Validate status transition
-	if err := s.validateStatusTransition(event.Status, req.Status); err != nil {
-		return nil, err
+	items := make([]*dto.EventAttentionItem, 0, len(events))
+	for _, event := range events {
+		item := &dto.EventAttentionItem{}
+		switch event.Status {
+		case domain.EventStatusRejected:
+			item.Reason = dto.EventAttentionRejected
+		case domain.EventStatusDraft:
+			item.Err = s.validatePublishReadiness(event, domain.EventStatusPublished)
+			if item.Err == nil {
+				item.Err = event.ValidateReadiness()
+			}
+			if item.Err == nil {
+				continue
+			}
+			item.Reason = dto.EventAttentionIncomplete
+		case domain.EventStatusPublished:
+			sold, onSale := ticketSoldPercentage(event.Tickets)
+			if !onSale || sold >= float64(s.eventConfig.LowSalesPercent) {
+				continue
+			}
+			item.Reason = dto.EventAttentionLowSales
+			item.SoldPercentage = &sold
+		default:
+			continue
+		}
+		item.Event = dto.EventToListResponse(event)
+		items = append(items, item)
 	}
+	return items, nil
+}
 
-	// Update status
-	if err := s.eventRepo.UpdateStatus(ctx, id, req.Status); err != nil {
-		return nil, fmt.Errorf("failed to update event status: %w", err)
+// ticketSoldPercentage is the sold share of all active ticket tiers; false when none is on sale
+func ticketSoldPercentage(tickets []domain.Ticket) (float64, bool) {
+	var sold, total int
+	for _, ticket := range tickets {
+		if !ticket.IsActive {
+			continue
+		}
+		sold += ticket.SoldQuantity
+		total += ticket.TotalQuantity
+	}
+	if total == 0 {
+		return 0, false
 	}
+	return float64(sold) / float64(total) * 100, true
+}
 
-	// Get updated event
-	updatedEvent, err := s.eventRepo.GetByIDWithRelations(ctx, id)
+// Public event operations
+func (s *eventService) GetPublicEvents(ctx context.Context, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
+	events, paginationResp, err := s.eventRepo.GetPublicEvents(ctx, pagination)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get updated event: %w", err)
+		return nil, nil, fmt.Errorf("failed to get public events: %w", err)
 	}
 
-	return dto.EventToResponse(updatedEvent), nil
-}
+	var responses []*dto.EventListResponse
+	for _, event := range events {
+		responses = append(responses, dto.EventToListResponse(event))
+	}
 
-func (s *eventService) SubmitEventForReview(ctx context.Context, id, userID int) (*dto.EventResponse, error) {
-	s.logger.Info().Int("event_id", id).Int("user_id", userID).Msg("Submitting event for review with subscription validation")
+	return responses, paginationResp, nil
+}
 
-	// Validate ownership first
-	if err := s.ValidateEventOwnership(ctx, id, userID); err != nil {
-		return nil, err
+func (s *eventService) GetPublicEventsAfter(ctx context.Context, req dto.CursorPaginationRequest) ([]*dto.EventListResponse, *dto.CursorPaginationResponse, error) {
+	var cursor *pagination.Cursor
+	if req.After != "" {
+		var err error
+		if cursor, err = s.cursorCodec.Decode(req.After); err != nil {
+			return nil, nil, err
+		}
 	}
 
-	// Check publishing rights before allowing draft->pending transition
-	canPublish, err := s.subscriptionService.CanPublishEvent(ctx, uint(userID))
+	// One extra row tells whether another page follows
+	limit := req.GetLimitWithDefault()
+	events, err := s.eventRepo.GetPublicEventsAfter(ctx, cursor, limit+1)
 	if err != nil {
-		s.logger.Error().Err(err).Int("user_id", userID).Msg("Failed to check publishing rights")
-		return nil, fmt.Errorf("failed to check publishing rights: %w", err)
+		return nil, nil, fmt.Errorf("failed to get public events: %w", err)
 	}
 
-	if !canPublish {
-		s.logger.Warn().Int("user_id", userID).Msg("User does not have publishing rights")
-		return nil, errors.New("subscription.insufficient_publishing_rights")
+	paginationResp := &dto.CursorPaginationResponse{Limit: limit}
+	if len(events) > limit {
+		events = events[:limit]
+		last := events[limit-1]
+		next, err := s.cursorCodec.Encode(pagination.Cursor{CreatedAt: last.CreatedAt, ID: last.ID})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to encode cursor: %w", err)
+		}
+		paginationResp.HasMore = true
+		paginationResp.NextCursor = next
 	}
 
-	// If user has rights, proceed with status update and consume usage
-	response, err := s.UpdateEventStatus(ctx, id, userID, dto.UpdateEventStatusRequest{
-		Status: domain.EventStatusPending,
+	responses := make([]*dto.EventListResponse, 0, len(events))
+	for _, event := range events {
+		responses = append(responses, dto.EventToListResponse(event))
+	}
+
+	return responses, paginationResp, nil
+}
+
+func (s *eventService) GetPublicEventsByCategory(ctx context.Context, categoryID int, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
+	events, paginationResp, err := s.eventRepo.GetPublicEventsByCategory(ctx, categoryID, pagination)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get public events by category: %w", err)
+	}
+
+	var responses []*dto.EventListResponse
+	for _, event := range events {
+		responses = append(responses, dto.EventToListResponse(event))
+	}
+
+	return responses, paginationResp, nil
+}
+
+func (s *eventService) GetNearbyEvents(ctx context.Context, req dto.EventNearbyRequest, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
+	radiusKm := req.GetRadiusKmWithDefault()
+	events, paginationResp, err := s.eventRepo.GetEventsByCoordinates(ctx, *req.Latitude, *req.Longitude, radiusKm, req.SortsByDistance(), pagination)
+	if err != nil {
+		s.logger.Error().Err(err).Float64("latitude", *req.Latitude).Float64("longitude", *req.Longitude).Int("radius", radiusKm).Msg("Failed to get nearby events")
+		return nil, nil, fmt.Errorf("failed to get nearby events: %w", err)
+	}
+
+	responses := make([]*dto.EventListResponse, 0, len(events))
+	for _, event := range events {
+		responses = append(responses, dto.EventToListResponse(event))
+	}
+
+	return responses, paginationResp, nil
+}
+
+func (s *eventService) GetPublicEventsByLocation(ctx context.Context, city, country string, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
+	events, paginationResp, err := s.eventRepo.GetPublicEventsByLocation(ctx, city, country, pagination)
+	if err != nil {
+		s.logger.Error().Err(err).Str("city", city).Msg("Failed to get public events by location")
+		return nil, nil, fmt.Errorf("failed to get public events by location: %w", err)
+	}
+
+	var responses []*dto.EventListResponse
+	for _, event := range events {
+		responses = append(responses, dto.EventToListResponse(event))
+	}
+
+	return responses, paginationResp, nil
+}
+
+// searchResultCacheEntry is what SearchPublicEvents keeps in the cache for one query page
+type searchResultCacheEntry struct {
+	Events     []*dto.EventListResponse `json:"events"`
+	Pagination *dto.PaginationResponse  `json:"pagination"`
+}
+
+func (s *eventService) SearchPublicEvents(ctx context.Context, req dto.EventSearchRequest, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
+	req.Query = strings.TrimSpace(req.Query)
+	if utf8.RuneCountInString(req.Query) < s.eventConfig.SearchMinQueryLength {
+		return nil, nil, domain.ErrEventSearchQueryTooShort
+	}
+
+	// Popular queries are hit repeatedly by anonymous clients, so identical requests are
+	// answered from a short-lived cache
+	cacheKey := s.searchCacheKey(req, pagination)
+	if cacheKey != "" {
+		var cached searchResultCacheEntry
+		if err := s.cache.Get(ctx, cacheKey, &cached); err == nil {
+			s.logger.Debug().Str("query", req.Query).Msg("Event search retrieved from cache")
+			return cached.Events, cached.Pagination, nil
+		}
+	}
+
+	// Search public events - use advanced filtering instead
+	publishedStatus := domain.EventStatusPublished
+	publicType := domain.EventTypePublic
+
+	filters := dto.EventFilterRequest{
+		Query:        &req.Query,
+		Type:         &publicType,
+		LocationType: req.LocationType,
+		CategoryIDs:  req.CategoryIDs,
+		City:         req.City,
+		Status:       &publishedStatus,
+	}
+
+	events, paginationResp, err := s.eventRepo.GetEventsWithFilters(ctx, filters, nil, pagination)
+	if err != nil {
+		s.logger.Error().Err(err).Interface("request", req).Msg("Failed to search public events")
+		return nil, nil, fmt.Errorf("failed to search events: %w", err)
+	}
+
+	var responses []*dto.EventListResponse
+	for _, event := range events {
+		responses = append(responses, dto.EventToListResponse(event))
+	}
+
+	if cacheKey != "" {
+		entry := searchResultCacheEntry{Events: responses, Pagination: paginationResp}
+		if err := s.cache.Set(ctx, cacheKey, entry, s.eventConfig.SearchCacheTTL); err != nil {
+			s.logger.Warn().Err(err).Msg("Failed to cache event search results")
+		}
+	}
+
+	return responses, paginationResp, nil
+}
+
+// searchCacheKey derives a cache key from the normalized search request, or returns "" when
+// caching is disabled
+func (s *eventService) searchCacheKey(req dto.EventSearchRequest, pagination dto.PaginationRequest) string {
+	if s.cache == nil || s.eventConfig.SearchCacheTTL <= 0 {
+		return ""
+	}
+
+	req.Query = strings.ToLower(req.Query)
+	payload, err := json.Marshal(struct {
+		Request    dto.EventSearchRequest `json:"request"`
+		Pagination dto.PaginationRequest  `json:"pagination"`
+	}{req, pagination})
+	if err != nil {
+		return ""
+	}
+
+	sum := sha256.Sum256(payload)
+	return fmt.Sprintf(eventSearchCacheKey, hex.EncodeToString(sum[:]))
+}
+
+// Status management
+func (s *eventService) UpdateEventStatus(ctx context.Context, id, userID int, req dto.UpdateEventStatusRequest) (*dto.EventResponse, error) {
+	event, err := s.loadAuthorizedEvent(ctx, id, &userID, domain.EventActionManage)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate status transition
+	if err := s.validateStatusTransition(event.Status, req.Status); err != nil {
+		return nil, err
+	}
+	if err := s.validatePublishReadiness(event, req.Status); err != nil {
+		return nil, err
+	}
+
+	// Update status
+	if err := s.eventRepo.UpdateStatus(ctx, id, req.Status); err != nil {
+		return nil, fmt.Errorf("failed to update event status: %w", err)
+	}
+	s.recordHistory(ctx, domain.NewEventStatusHistory(id, &userID, event.Status, req.Status))
+
+	// Get updated event
+	updatedEvent, err := s.eventRepo.GetByIDWithRelations(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get updated event: %w", err)
+	}
+
+	return dto.EventToResponse(updatedEvent, s.mediaSigner), nil
+}
+
+func (s *eventService) BulkUpdateStatus(ctx context.Context, userID int, ids []int, status domain.EventStatus) (*dto.BulkUpdateEventStatusResponse, error) {
+	ids = uniqueInts(ids)
+	if len(ids) == 0 {
+		return nil, domain.ErrEventNoneToUpdate
+	}
+	if len(ids) > maxBulkStatusEvents {
+		return nil, domain.ErrEventTooManyToUpdate
+	}
+	if !status.IsValid() {
+		return nil, domain.ErrEventInvalidStatus
+	}
+
+	events, err := s.eventRepo.GetMultipleByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events: %w", err)
+	}
+	eventsByID := make(map[int]*domain.Event, len(events))
+	for _, event := range events {
+		eventsByID[event.ID] = event
+	}
+
+	response := &dto.BulkUpdateEventStatusResponse{Results: make([]dto.BulkEventStatusResult, len(ids))}
+	var updateIDs []int
+	for i, id := range ids {
+		response.Results[i] = dto.BulkEventStatusResult{EventID: id}
+
+		event, ok := eventsByID[id]
+		if !ok {
+			response.Results[i].Result = dto.BulkEventStatusResultNotFound
+			continue
+		}
+		// Each event answers as it would to UpdateEventStatus
+		if err := s.authorizeLoadedEvent(ctx, event, &userID, domain.EventActionManage); err != nil {
+			switch {
+			case errors.Is(err, domain.ErrEventNotFound):
+				response.Results[i].Result = dto.BulkEventStatusResultNotFound
+			case errors.Is(err, domain.ErrEventUnauthorized):
+				response.Results[i].Result = dto.BulkEventStatusResultAccessDenied
+			default:
+				return nil, err
+			}
+			continue
+		}
+		response.Results[i].PreviousStatus = event.Status
+
+		if err := s.validateStatusTransition(event.Status, status); err != nil {
+			response.Results[i].Result = dto.BulkEventStatusResultInvalidTransition
+			continue
+		}
+		if err := s.validatePublishReadiness(event, status); err != nil {
+			response.Results[i].Result = dto.BulkEventStatusResultNotReady
+			continue
+		}
+		updateIDs = append(updateIDs, id)
+	}
+
+	if len(updateIDs) > 0 {
+		// One statement, so either every valid event changes or none does
+		if err := s.eventRepo.UpdateMultipleStatus(ctx, updateIDs, status); err != nil {
+			s.logger.Error().Err(err).Int("user_id", userID).Int("count", len(updateIDs)).Msg("Failed to bulk update event status")
+			return nil, fmt.Errorf("failed to update event status: %w", err)
+		}
+	}
+
+	for i := range response.Results {
+		result := &response.Results[i]
+		if result.Result != "" {
+			continue
+		}
+		result.Result = dto.BulkEventStatusResultUpdated
+		s.recordHistory(ctx, domain.NewEventStatusHistory(result.EventID, &userID, result.PreviousStatus, status))
+	}
+	response.Updated = len(updateIDs)
+	response.Rejected = len(ids) - len(updateIDs)
+
+	s.logger.Info().Int("user_id", userID).Str("status", string(status)).Int("updated", response.Updated).Int("rejected", response.Rejected).Msg("Event statuses bulk updated")
+	return response, nil
+}
+
+func (s *eventService) BulkAddCategoryToEvents(ctx context.Context, userID int, eventIDs []int, categoryID int) (*dto.BulkAddEventCategoryResponse, error) {
+	eventIDs = uniqueInts(eventIDs)
+	if len(eventIDs) == 0 {
+		return nil, domain.ErrEventNoneToUpdate
+	}
+	if len(eventIDs) > maxBulkCategoryEvents {
+		return nil, domain.ErrEventTooManyToUpdate
+	}
+
+	category, err := s.categoryRepo.GetByID(ctx, categoryID)
+	if err != nil {
+		s.logger.Error().Err(err).Int("category_id", categoryID).Msg("Failed to check category existence")
+		return nil, fmt.Errorf("failed to validate category: %w", err)
+	}
+	if category == nil {
+		return nil, domain.ErrEventCategoryNotFound
+	}
+
+	events, err := s.eventRepo.GetMultipleByIDs(ctx, eventIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events: %w", err)
+	}
+	eventsByID := make(map[int]*domain.Event, len(events))
+	for _, event := range events {
+		eventsByID[event.ID] = event
+	}
+
+	response := &dto.BulkAddEventCategoryResponse{
+		CategoryID: categoryID,
+		Results:    make([]dto.BulkEventCategoryResult, len(eventIDs)),
+	}
+	// Each event answers as it would to an UpdateEvent that sets its categories
+	edit := dto.UpdateEventRequest{CategoryIDs: []int{categoryID}}
+	var editableIDs []int
+	for i, id := range eventIDs {
+		response.Results[i] = dto.BulkEventCategoryResult{EventID: id}
+
+		event, ok := eventsByID[id]
+		if !ok {
+			response.Results[i].Result = dto.BulkEventCategoryResultNotFound
+			continue
+		}
+		if err := s.authorizeLoadedEvent(ctx, event, &userID, domain.EventActionEdit); err != nil {
+			switch {
+			case errors.Is(err, domain.ErrEventNotFound):
+				response.Results[i].Result = dto.BulkEventCategoryResultNotFound
+			case errors.Is(err, domain.ErrEventUnauthorized):
+				response.Results[i].Result = dto.BulkEventCategoryResultAccessDenied
+			default:
+				return nil, err
+			}
+			continue
+		}
+		if err := checkEventEditable(event, edit); err != nil {
+			response.Results[i].Result = dto.BulkEventCategoryResultNotEditable
+			continue
+		}
+		editableIDs = append(editableIDs, id)
+	}
+
+	// Events already in the category are skipped by the insert itself, so a concurrent
+	// assignment cannot create a duplicate either
+	added, err := s.eventRepo.AddCategoryToEvents(ctx, editableIDs, categoryID)
+	if err != nil {
+		s.logger.Error().Err(err).Int("user_id", userID).Int("category_id", categoryID).Msg("Failed to bulk add category to events")
+		return nil, fmt.Errorf("failed to add category to events: %w", err)
+	}
+	addedIDs := make(map[int]bool, len(added))
+	for _, id := range added {
+		addedIDs[id] = true
+	}
+
+	for i := range response.Results {
+		result := &response.Results[i]
+		switch {
+		case result.Result != "":
+			response.Rejected++
+		case addedIDs[result.EventID]:
+			result.Result = dto.BulkEventCategoryResultAdded
+			response.Added++
+		default:
+			result.Result = dto.BulkEventCategoryResultSkipped
+			response.Skipped++
+		}
+	}
+
+	s.logger.Info().Int("user_id", userID).Int("category_id", categoryID).Int("added", response.Added).Int("skipped", response.Skipped).Int("rejected", response.Rejected).Msg("Category bulk added to events")
+	return response, nil
+}
+
+func (s *eventService) SubmitEventForReview(ctx context.Context, id, userID int) (*dto.EventResponse, error) {
+	s.logger.Info().Int("event_id", id).Int("user_id", userID).Msg("Submitting event for review with subscription validation")
+
+	// Validate ownership first
+	if err := s.AuthorizeEvent(ctx, id, &userID, domain.EventActionPublish); err != nil {
+		return nil, err
+	}
+
+	// Check publishing rights before allowing draft->pending transition
+	canPublish, err := s.subscriptionService.CanPublishEvent(ctx, uint(userID))
+	if err != nil {
+		s.logger.Error().Err(err).Int("user_id", userID).Msg("Failed to check publishing rights")
+		return nil, fmt.Errorf("failed to check publishing rights: %w", err)
+	}
+
+	if !canPublish {
+		s.logger.Warn().Int("user_id", userID).Msg("User does not have publishing rights")
+		return nil, domain.ErrEventPublishingRights
+	}
+
+	// If user has rights, proceed with status update and consume usage
+	response, err := s.UpdateEventStatus(ctx, id, userID, dto.UpdateEventStatusRequest{
+		Status: domain.EventStatusPending,
 	})
 	if err != nil {
 		return nil, err
@@ -682,6 +1475,77 @@ func (s *eventService) PublishEvent(ctx context.Context, id, userID int) (*dto.E
 	})
 }
 
+func (s *eventService) PublishAndNotify(ctx context.Context, id, userID int) (*dto.PublishAndNotifyResponse, error) {
+	event, err := s.loadAuthorizedEvent(ctx, id, &userID, domain.EventActionPublish)
+	if err != nil {
+		return nil, err
+	}
+	if !event.IsPrivate() {
+		return nil, domain.ErrEventNotPrivate
+	}
+	// Checked up front so a credit is never spent on an event that cannot be published
+	if err := s.validateStatusTransition(event.Status, domain.EventStatusPublished); err != nil {
+		return nil, err
+	}
+	if err := s.validatePublishReadiness(event, domain.EventStatusPublished); err != nil {
+		return nil, err
+	}
+
+	canPublish, err := s.subscriptionService.CanPublishEvent(ctx, uint(userID))
+	if err != nil {
+		s.logger.Error().Err(err).Int("user_id", userID).Msg("Failed to check publishing rights")
+		return nil, fmt.Errorf("failed to check publishing rights: %w", err)
+	}
+	if !canPublish {
+		return nil, domain.ErrEventPublishingRights
+	}
+
+	// The credit is spent only once the event is published; if that fails the publish is
+	// undone, so the creator never pays for an unpublished event or publishes for free
+	published, err := s.PublishEvent(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.subscriptionService.ConsumeEventCredit(ctx, uint(userID)); err != nil {
+		s.logger.Error().Err(err).Int("user_id", userID).Msg("Failed to consume event publishing usage")
+		if revertErr := s.eventRepo.UpdateStatus(ctx, id, event.Status); revertErr != nil {
+			s.logger.Error().Err(revertErr).Int("event_id", id).Msg("Failed to unpublish event after credit failure")
+		} else {
+			s.recordHistory(ctx, domain.NewEventStatusHistory(id, &userID, domain.EventStatusPublished, event.Status))
+		}
+		return nil, fmt.Errorf("failed to consume publishing credit: %w", err)
+	}
+	response := &dto.PublishAndNotifyResponse{Event: published}
+
+	// The event is already published, so notification problems are logged rather than returned
+	invitations, err := s.invitationRepo.GetPendingByEventID(ctx, id)
+	if err != nil {
+		s.logger.Error().Err(err).Int("event_id", id).Msg("Failed to get pending invitations to notify")
+		return response, nil
+	}
+
+	// Invitees are addressed in the organizer's language; most invitees have no account to
+	// store a preference on
+	lang := i18n.LanguageFromContext(ctx)
+	params := map[string]string{
+		"event": published.Name,
+		"url":   fmt.Sprintf("%s/events/%d", strings.TrimRight(s.eventConfig.ShareBaseURL, "/"), id),
+	}
+	subject := s.translator.Format(lang, "email.event_published_invitation.subject", params)
+	message := s.translator.Format(lang, "email.event_published_invitation.body", params)
+	for _, invitation := range invitations {
+		if err := s.emailService.SendNotification(ctx, invitation.InvitedEmail, subject, message); err != nil {
+			s.logger.Error().Err(err).Int("event_id", id).Int("invitation_id", invitation.ID).Msg("Failed to send invitation notification")
+			response.Failed++
+			continue
+		}
+		response.Notified++
+	}
+
+	s.logger.Info().Int("event_id", id).Int("notified", response.Notified).Int("failed", response.Failed).Msg("Event published and invitees notified")
+	return response, nil
+}
+
 func (s *eventService) CancelEvent(ctx context.Context, id, userID int) (*dto.EventResponse, error) {
 	return s.UpdateEventStatus(ctx, id, userID, dto.UpdateEventStatusRequest{
 		Status: domain.EventStatusCancelled,
@@ -690,34 +1554,147 @@ func (s *eventService) CancelEvent(ctx context.Context, id, userID int) (*dto.Ev
 
 // Advanced filtering and search
 func (s *eventService) GetEventsWithFilters(ctx context.Context, filters dto.EventFilterRequest, pagination dto.PaginationRequest, userID *int) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
-	events, paginationResp, err := s.eventRepo.GetEventsWithFilters(ctx, filters, pagination)
+	if err := validateEventFilterLocation(filters); err != nil {
+		return nil, nil, err
+	}
+
+	// Access rules are applied in the query so pagination totals only count visible events
+	events, paginationResp, err := s.eventRepo.GetEventsWithFilters(ctx, filters, userID, pagination)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get events with filters: %w", err)
+	}
+
+	var responses []*dto.EventListResponse
+	for _, event := range events {
+		responses = append(responses, dto.EventToListResponse(event))
+	}
+
+	return responses, paginationResp, nil
+}
+
+func (s *eventService) CountEventsWithFilters(ctx context.Context, filters dto.EventFilterRequest, userID *int) (int64, error) {
+	if err := validateEventFilterLocation(filters); err != nil {
+		return 0, err
+	}
+
+	count, err := s.eventRepo.CountEventsWithFilters(ctx, filters, userID)
+	if err != nil {
+		s.logger.Error().Err(err).Interface("filters", filters).Msg("Failed to count events with filters")
+		return 0, fmt.Errorf("failed to count events with filters: %w", err)
+	}
+
+	return count, nil
+}
+
+// validateEventFilterLocation requires a full coordinate pair whenever a radius or distance sort is used
+func validateEventFilterLocation(filters dto.EventFilterRequest) error {
+	if (filters.Latitude != nil) != (filters.Longitude != nil) {
+		return domain.ErrEventCoordinatesRequired
+	}
+	if (filters.RadiusKm != nil || filters.SortsByDistance()) && !filters.HasCoordinates() {
+		return domain.ErrEventCoordinatesRequired
+	}
+	return nil
+}
+
+func (s *eventService) GetEventsByDateRange(ctx context.Context, startDate, endDate time.Time, includeAnnouncements bool, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
+	// Convert dates to string format for repository
+	startDateStr := startDate.Format("2006-01-02")
+	endDateStr := endDate.Format("2006-01-02")
+
+	events, paginationResp, err := s.eventRepo.GetEventsByDateRange(ctx, startDateStr, endDateStr, includeAnnouncements, pagination)
+	if err != nil {
+		s.logger.Error().Err(err).Time("start_date", startDate).Time("end_date", endDate).Msg("Failed to get events by date range")
+		return nil, nil, fmt.Errorf("failed to get events by date range: %w", err)
+	}
+
+	var responses []*dto.EventListResponse
+	for _, event := range events {
+		responses = append(responses, dto.EventToListResponse(event))
+	}
+
+	return responses, paginationResp, nil
+}
+
+func (s *eventService) GetUpcomingEvents(ctx context.Context, includeAnnouncements bool, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
+	today := time.Now().Format("2006-01-02")
+	events, paginationResp, err := s.eventRepo.GetEventsStartingFrom(ctx, today, includeAnnouncements, pagination)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get upcoming events: %w", err)
+	}
+
+	var responses []*dto.EventListResponse
+	for _, event := range events {
+		responses = append(responses, dto.EventToListResponse(event))
+	}
+
+	return responses, paginationResp, nil
+}
+
+// GetAnnouncements lists public announcements; undated ones never show up in date based listings
+func (s *eventService) GetAnnouncements(ctx context.Context, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
+	events, paginationResp, err := s.eventRepo.GetAnnouncements(ctx, pagination)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("Failed to get announcements")
+		return nil, nil, fmt.Errorf("failed to get announcements: %w", err)
+	}
+
+	responses := make([]*dto.EventListResponse, 0, len(events))
+	for _, event := range events {
+		responses = append(responses, dto.EventToListResponse(event))
+	}
+
+	return responses, paginationResp, nil
+}
+
+// GetEventsStartingSoon lists last-minute events that start within the next withinHours and can
+// still be attended. The window defaults to 48 hours and is capped at a week.
+func (s *eventService) GetEventsStartingSoon(ctx context.Context, withinHours int, city *string, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
+	if withinHours <= 0 {
+		withinHours = defaultStartingSoonHours
+	}
+	withinHours = min(withinHours, maxStartingSoonHours)
+	if city != nil {
+		trimmed := strings.TrimSpace(*city)
+		city = &trimmed
+		if trimmed == "" {
+			city = nil
+		}
+	}
+
+	before := time.Now().Add(time.Duration(withinHours) * time.Hour)
+	events, paginationResp, err := s.eventRepo.GetEventsStartingSoon(ctx, before, city, pagination)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to get events with filters: %w", err)
+		s.logger.Error().Err(err).Int("within_hours", withinHours).Msg("Failed to get events starting soon")
+		return nil, nil, fmt.Errorf("failed to get events starting soon: %w", err)
 	}
 
-	var responses []*dto.EventListResponse
+	responses := make([]*dto.EventListResponse, 0, len(events))
 	for _, event := range events {
-		// Check access for each event
-		if canAccess, _ := s.CanUserAccessEvent(ctx, event.ID, userID); canAccess {
-			responses = append(responses, dto.EventToListResponse(event))
-		}
+		responses = append(responses, dto.EventToListResponse(event))
 	}
 
 	return responses, paginationResp, nil
 }
 
-func (s *eventService) GetEventsByDateRange(ctx context.Context, startDate, endDate time.Time, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
-	// Convert dates to string format for repository
-	startDateStr := startDate.Format("2006-01-02")
-	endDateStr := endDate.Format("2006-01-02")
+// GetCreatorUpcomingEvents lists the upcoming events shown on a public creator profile. Unlike
+// GetCreatorEvents it is keyed by creator id and only returns published public events.
+func (s *eventService) GetCreatorUpcomingEvents(ctx context.Context, creatorID int, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
+	creator, err := s.creatorRepo.GetByID(ctx, creatorID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get creator: %w", err)
+	}
+	if creator == nil {
+		return nil, nil, domain.ErrCreatorProfileNotFound
+	}
 
-	events, paginationResp, err := s.eventRepo.GetEventsByDateRange(ctx, startDateStr, endDateStr, pagination)
+	events, paginationResp, err := s.eventRepo.GetCreatorUpcomingEvents(ctx, creatorID, pagination)
 	if err != nil {
-		s.logger.Error().Err(err).Time("start_date", startDate).Time("end_date", endDate).Msg("Failed to get events by date range")
-		return nil, nil, fmt.Errorf("failed to get events by date range: %w", err)
+		s.logger.Error().Err(err).Int("creator_id", creatorID).Msg("Failed to get creator upcoming events")
+		return nil, nil, fmt.Errorf("failed to get creator upcoming events: %w", err)
 	}
 
-	var responses []*dto.EventListResponse
+	responses := make([]*dto.EventListResponse, 0, len(events))
 	for _, event := range events {
 		responses = append(responses, dto.EventToListResponse(event))
 	}
@@ -725,10 +1702,10 @@ func (s *eventService) GetEventsByDateRange(ctx context.Context, startDate, endD
 	return responses, paginationResp, nil
 }
 
-func (s *eventService) GetUpcomingEvents(ctx context.Context, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
-	events, paginationResp, err := s.eventRepo.GetUpcomingEvents(ctx, pagination)
+func (s *eventService) GetOngoingEvents(ctx context.Context, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
+	events, paginationResp, err := s.eventRepo.GetOngoingEvents(ctx, pagination)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to get upcoming events: %w", err)
+		return nil, nil, fmt.Errorf("failed to get ongoing events: %w", err)
 	}
 
 	var responses []*dto.EventListResponse
@@ -753,13 +1730,243 @@ func (s *eventService) GetPastEvents(ctx context.Context, pagination dto.Paginat
 	return responses, paginationResp, nil
 }
 
+func (s *eventService) GetSimilarEvents(ctx context.Context, eventID int, limit int) ([]*dto.EventListResponse, error) {
+	exists, err := s.eventRepo.ExistsByID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check event existence: %w", err)
+	}
+	if !exists {
+		return nil, domain.ErrEventNotFound
+	}
+
+	if limit <= 0 {
+		limit = defaultSimilarEventsLimit
+	}
+	if limit > maxSimilarEventsLimit {
+		limit = maxSimilarEventsLimit
+	}
+
+	events, err := s.eventRepo.GetSimilarEvents(ctx, eventID, limit)
+	if err != nil {
+		s.logger.Error().Err(err).Int("event_id", eventID).Msg("Failed to get similar events")
+		return nil, fmt.Errorf("failed to get similar events: %w", err)
+	}
+
+	responses := make([]*dto.EventListResponse, 0, len(events))
+	for _, event := range events {
+		responses = append(responses, dto.EventToListResponse(event))
+	}
+
+	return responses, nil
+}
+
+func (s *eventService) GetFeaturedEvents(ctx context.Context, req dto.EventShowcaseRequest) ([]*dto.EventListResponse, error) {
+	events, err := s.eventRepo.GetFeaturedEvents(ctx, normalizeShowcaseRequest(req))
+	if err != nil {
+		s.logger.Error().Err(err).Msg("Failed to get featured events")
+		return nil, fmt.Errorf("failed to get featured events: %w", err)
+	}
+	responses := make([]*dto.EventListResponse, 0, len(events))
+	for _, event := range events {
+		responses = append(responses, dto.EventToListResponse(event))
+	}
+	return responses, nil
+}
+
+func (s *eventService) GetTrendingEvents(ctx context.Context, req dto.EventShowcaseRequest) ([]*dto.EventListResponse, error) {
+	since := time.Now().Add(-s.eventConfig.TrendingWindow)
+	events, err := s.eventRepo.GetTrendingEvents(ctx, normalizeShowcaseRequest(req), since, s.eventConfig.TrendingHalfLife)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("Failed to get trending events")
+		return nil, fmt.Errorf("failed to get trending events: %w", err)
+	}
+	responses := make([]*dto.EventListResponse, 0, len(events))
+	for _, event := range events {
+		responses = append(responses, dto.EventToListResponse(event))
+	}
+	return responses, nil
+}
+
+func (s *eventService) SetEventFeatured(ctx context.Context, id int, featured bool) (*dto.EventResponse, error) {
+	event, err := s.eventRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrEventNotFound
+		}
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	// Unfeaturing is always allowed so events that were unpublished can be taken off the list
+	if featured && (!event.IsPublished() || !event.IsPublic()) {
+		return nil, domain.ErrEventNotFeaturable
+	}
+
+	if err := s.eventRepo.SetFeatured(ctx, id, featured); err != nil {
+		s.logger.Error().Err(err).Int("event_id", id).Msg("Failed to update featured flag")
+		return nil, fmt.Errorf("failed to update featured flag: %w", err)
+	}
+	s.logger.Info().Int("event_id", id).Bool("featured", featured).Msg("Event featured flag updated")
+
+	event.Featured = featured
+	return dto.EventToResponse(event, s.mediaSigner), nil
+}
+
+func normalizeShowcaseRequest(req dto.EventShowcaseRequest) dto.EventShowcaseRequest {
+	if req.Limit <= 0 {
+		req.Limit = defaultShowcaseEventsLimit
+	}
+	if req.Limit > maxShowcaseEventsLimit {
+		req.Limit = maxShowcaseEventsLimit
+	}
+	return req
+}
+
+// GetEventPublicStats returns the public counters of a published public event. Private and
+// unpublished events are reported as not found so their existence is not revealed; the going
+// count is left out when the organizer hides it.
+func (s *eventService) GetEventPublicStats(ctx context.Context, eventID int) (*dto.EventPublicStatsResponse, error) {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrEventNotFound
+		}
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	if !event.IsPublic() || !event.IsPublished() {
+		return nil, domain.ErrEventNotFound
+	}
+
+	stats, err := s.eventRepo.GetEventPublicStats(ctx, eventID)
+	if err != nil {
+		s.logger.Error().Err(err).Int("event_id", eventID).Msg("Failed to get event public stats")
+		return nil, fmt.Errorf("failed to get event stats: %w", err)
+	}
+	if event.HideGoingCount {
+		stats.GoingCount = nil
+	}
+
+	return stats, nil
+}
+
+func (s *eventService) RemainingCapacity(ctx context.Context, eventID int) (*int, error) {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrEventNotFound
+		}
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	if event.Capacity == nil {
+		return nil, nil
+	}
+
+	attendees, err := s.eventRepo.CountAttendees(ctx, eventID)
+	if err != nil {
+		s.logger.Error().Err(err).Int("event_id", eventID).Msg("Failed to count event attendees")
+		return nil, fmt.Errorf("failed to count attendees: %w", err)
+	}
+	return event.RemainingCapacity(attendees), nil
+}
+
+// GetEventShareMeta returns the link preview of a published public event. Like the public
+// stats, other events are reported as not found.
+func (s *eventService) GetEventShareMeta(ctx context.Context, eventID int) (*dto.EventShareMetaResponse, error) {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrEventNotFound
+		}
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	if !event.IsPublic() || !event.IsPublished() {
+		return nil, domain.ErrEventNotFound
+	}
+
+	if event.ImageID != nil {
+		image, err := s.mediaRepo.GetByID(ctx, *event.ImageID)
+		if err != nil {
+			// A missing image only costs the preview its picture
+			s.logger.Warn().Err(err).Int("event_id", eventID).Msg("Failed to load event image for share preview")
+		} else {
+			event.Image = image
+		}
+	}
+
+	meta := dto.EventToShareMeta(event, s.eventConfig.ShareBaseURL)
+	if meta.ImageURL == nil && s.eventConfig.ShareFallbackImageURL != "" {
+		fallback := s.eventConfig.ShareFallbackImageURL
+		meta.ImageURL = &fallback
+	}
+	return meta, nil
+}
+
+func (s *eventService) ExportICS(ctx context.Context, eventID int, userID *int) ([]byte, error) {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrEventNotFound
+		}
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	if err := s.authorizeLoadedEvent(ctx, event, userID, domain.EventActionView); err != nil {
+		return nil, err
+	}
+	if event.StartDate == nil {
+		return nil, domain.ErrEventNotScheduled
+	}
+
+	baseURL := strings.TrimRight(s.eventConfig.ShareBaseURL, "/")
+	calendarEvent := ical.Event{
+		UID:     fmt.Sprintf("event-%d@%s", event.ID, calendarUIDDomain(baseURL)),
+		Summary: event.Name,
+		URL:     fmt.Sprintf("%s/events/%d", baseURL, event.ID),
+		Updated: event.UpdatedAt,
+	}
+	if event.Description != nil {
+		// Calendar apps show descriptions as plain text, whatever format the event uses
+		calendarEvent.Description = sanitizer.PlainText(*event.Description)
+	}
+	switch {
+	case event.Address != nil:
+		calendarEvent.Location = event.Address.GetFormattedAddress()
+	case event.OnlineEventURL != nil:
+		calendarEvent.Location = *event.OnlineEventURL
+	}
+
+	start := event.GetFullStartDateTime()
+	if start == nil {
+		// Without a start time, e.g. announcements, the event takes whole days
+		calendarEvent.AllDay = true
+		calendarEvent.Start = *event.StartDate
+		calendarEvent.End = *event.StartDate
+		if event.EndDate != nil && !event.EndDate.Before(*event.StartDate) {
+			calendarEvent.End = *event.EndDate
+		}
+	} else {
+		calendarEvent.Start = *start
+		calendarEvent.End = start.Add(domain.DefaultEventDuration)
+		if end := event.GetFullEndDateTime(); end != nil && end.After(*start) {
+			calendarEvent.End = *end
+		}
+	}
+
+	return ical.Calendar(calendarEvent), nil
+}
+
+// calendarUIDDomain is the host part of calendar UIDs, taken from the public event address
+func calendarUIDDomain(baseURL string) string {
+	if parsed, err := url.Parse(baseURL); err == nil && parsed.Hostname() != "" {
+		return parsed.Hostname()
+	}
+	return "louco-event"
+}
+
 // Statistics operations
 func (s *eventService) GetEventStats(ctx context.Context, userID int) (*dto.EventStatsResponse, error) {
 	// Get creator by user ID
 	creator, err := s.creatorRepo.GetByUserID(ctx, userID)
 	if err != nil {
 		s.logger.Error().Err(err).Int("user_id", userID).Msg("Failed to get creator by user ID")
-		return nil, fmt.Errorf("creator profile not found")
+		return nil, domain.ErrCreatorProfileNotFound
 	}
 
 	creatorID := creator.ID
@@ -771,6 +1978,33 @@ func (s *eventService) GetEventStats(ctx context.Context, userID int) (*dto.Even
 	return stats, nil
 }
 
+// GetCreatorStatusCounts returns how many of the user's events are in each status, with every
+// status present. Cheaper than GetEventStats when only the per-status counts are needed.
+func (s *eventService) GetCreatorStatusCounts(ctx context.Context, userID int) (map[domain.EventStatus]int64, error) {
+	creator, err := s.creatorRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		s.logger.Error().Err(err).Int("user_id", userID).Msg("Failed to get creator by user ID")
+		return nil, domain.ErrCreatorProfileNotFound
+	}
+
+	counts, err := s.eventRepo.CountByCreatorIDGroupedByStatus(ctx, creator.ID)
+	if err != nil {
+		s.logger.Error().Err(err).Int("creator_id", creator.ID).Msg("Failed to count creator events by status")
+		return nil, fmt.Errorf("failed to get event status counts: %w", err)
+	}
+
+	statuses := []domain.EventStatus{
+		domain.EventStatusDraft, domain.EventStatusPending, domain.EventStatusRejected,
+		domain.EventStatusStopped, domain.EventStatusCancelled, domain.EventStatusPublished,
+	}
+	for _, status := range statuses {
+		if _, ok := counts[status]; !ok {
+			counts[status] = 0
+		}
+	}
+	return counts, nil
+}
+
 func (s *eventService) GetSystemEventStats(ctx context.Context) (*dto.SystemEventStatsResponse, error) {
 	stats, err := s.eventRepo.GetSystemEventStats(ctx)
 	if err != nil {
@@ -779,23 +2013,94 @@ func (s *eventService) GetSystemEventStats(ctx context.Context) (*dto.SystemEven
 	return stats, nil
 }
 
+// checkInitialInvitationLimit applies the per-event invitation cap to the guest list of a new event
+func (s *eventService) checkInitialInvitationLimit(ctx context.Context, userID int, count int) error {
+	if count == 0 {
+		return nil
+	}
+
+	subscription, err := s.subscriptionService.GetActiveSubscription(ctx, uint(userID))
+	if err != nil {
+		return fmt.Errorf("failed to get creator subscription: %w", err)
+	}
+
+	if limit := domain.InvitationLimit(s.eventConfig.MaxInvitationsPerEvent, subscription); limit > 0 && count > limit {
+		return domain.ErrInvitationEventLimitReached
+	}
+	return nil
+}
+
+// buildEventInvitations validates the invitations sent along with a new event
+func (s *eventService) buildEventInvitations(event *domain.Event, reqs []dto.CreateInvitationRequest) ([]*domain.Invitation, error) {
+	if len(reqs) == 0 {
+		return nil, nil
+	}
+	if event.Type != domain.EventTypePrivate {
+		return nil, domain.ErrEventInvitationsPrivateOnly
+	}
+	if len(reqs) > maxInvitationsPerRequest {
+		return nil, domain.ErrEventInvitationsLimitExceeded
+	}
+
+	seen := make(map[string]bool, len(reqs))
+	invitations := make([]*domain.Invitation, 0, len(reqs))
+
+	for _, req := range reqs {
+		email := strings.ToLower(strings.TrimSpace(req.InvitedEmail))
+		if len(email) < 5 || !strings.Contains(email, "@") {
+			return nil, domain.ErrEventInvitationInvalidEmail
+		}
+		if seen[email] {
+			return nil, domain.ErrEventInvitationDuplicateEmail
+		}
+		seen[email] = true
+
+		// The event ID is filled in once the event is created
+		invitations = append(invitations, domain.NewInvitation(0, email, req.InvitedUserID))
+	}
+
+	return invitations, nil
+}
+
+// checkDraftLimit rejects a new draft once the creator already has the configured number
+func (s *eventService) checkDraftLimit(ctx context.Context, creatorID int) error {
+	if s.eventConfig.MaxDraftsPerCreator <= 0 {
+		return nil
+	}
+
+	drafts, err := s.eventRepo.CountByCreatorIDAndStatus(ctx, creatorID, domain.EventStatusDraft)
+	if err != nil {
+		return fmt.Errorf("failed to count draft events: %w", err)
+	}
+	if drafts >= int64(s.eventConfig.MaxDraftsPerCreator) {
+		return domain.ErrEventDraftLimitReached
+	}
+	return nil
+}
+
 // Business rule validation
 func (s *eventService) validateEventBusinessRules(event *domain.Event) error {
+	// Deployment restrictions on top of the enum validation
+	if !isAllowedValue(s.eventConfig.AllowedTypes, string(event.Type)) ||
+		!isAllowedValue(s.eventConfig.AllowedLocationTypes, string(event.LocationType)) {
+		return domain.ErrEventTypeNotAllowed
+	}
+
 	// Location type specific validations
 	switch event.LocationType {
 	case domain.EventLocationTypeLocation:
 		if event.AddressID == nil {
-			return errors.New("address is required for location-based events")
+			return domain.ErrEventAddressRequired
 		}
 		if event.OnlineEventURL != nil {
-			return errors.New("online event URL should not be provided for location-based events")
+			return domain.ErrEventOnlineURLNotAllowed
 		}
 	case domain.EventLocationTypeOnline:
 		if event.OnlineEventURL == nil || *event.OnlineEventURL == "" {
-			return errors.New("online event URL is required for online events")
+			return domain.ErrEventOnlineURLRequired
 		}
 		if event.AddressID != nil {
-			return errors.New("address should not be provided for online events")
+			return domain.ErrEventAddressNotAllowed
 		}
 	case domain.EventLocationTypeAnnouncement:
 		// Announcements don't require address or URL
@@ -805,7 +2110,7 @@ func (s *eventService) validateEventBusinessRules(event *domain.Event) error {
 	// Date validations
 	if event.StartDate != nil && event.EndDate != nil {
 		if event.EndDate.Before(*event.StartDate) {
-			return errors.New("end date cannot be before start date")
+			return domain.ErrEventEndBeforeStart
 		}
 	}
 
@@ -813,132 +2118,276 @@ func (s *eventService) validateEventBusinessRules(event *domain.Event) error {
 	if event.StartDate != nil && event.EndDate != nil &&
 		event.StartTime != nil && event.EndTime != nil {
 		if event.StartDate.Equal(*event.EndDate) && event.EndTime.Before(*event.StartTime) {
-			return errors.New("end time cannot be before start time on the same date")
+			return domain.ErrEventEndTimeBeforeStartTime
 		}
 	}
 
 	// System tickets validation
 	if event.HasSystemTickets && event.TicketURL != nil {
-		return errors.New("cannot have both system tickets and external ticket URL")
+		return domain.ErrEventTicketSourceConflict
 	}
 
 	return nil
 }
 
 // Status transition validation
-func (s *eventService) validateStatusTransition(currentStatus, newStatus domain.EventStatus) error {
-	validTransitions := map[domain.EventStatus][]domain.EventStatus{
-		domain.EventStatusDraft: {
-			domain.EventStatusPending,
-			domain.EventStatusPublished, // Direct publish for creators
-		},
-		domain.EventStatusPending: {
-			domain.EventStatusDraft,     // Back to draft
-			domain.EventStatusPublished, // Approved
-			domain.EventStatusRejected,  // Rejected
-		},
-		domain.EventStatusPublished: {
-			domain.EventStatusStopped,   // Temporarily stop
-			domain.EventStatusCancelled, // Cancel permanently
-		},
-		domain.EventStatusRejected: {
-			domain.EventStatusDraft,   // Back to draft for fixes
-			domain.EventStatusPending, // Resubmit
-		},
-		domain.EventStatusStopped: {
-			domain.EventStatusPublished, // Resume
-			domain.EventStatusCancelled, // Cancel permanently
-		},
-		// Cancelled is final - no transitions allowed
-	}
-
-	allowedStatuses, exists := validTransitions[currentStatus]
-	if !exists {
-		return fmt.Errorf("no transitions allowed from status %s", currentStatus)
-	}
+var eventStatusTransitions = map[domain.EventStatus][]domain.EventStatus{
+	domain.EventStatusDraft: {
+		domain.EventStatusPending,
+		domain.EventStatusPublished, // Direct publish for creators
+	},
+	domain.EventStatusPending: {
+		domain.EventStatusDraft,     // Back to draft
+		domain.EventStatusPublished, // Approved
+		domain.EventStatusRejected,  // Rejected
+	},
+	domain.EventStatusPublished: {
+		domain.EventStatusStopped,   // Temporarily stop
+		domain.EventStatusCancelled, // Cancel permanently
+	},
+	domain.EventStatusRejected: {
+		domain.EventStatusDraft,   // Back to draft for fixes
+		domain.EventStatusPending, // Resubmit
+	},
+	domain.EventStatusStopped: {
+		domain.EventStatusPublished, // Resume
+		domain.EventStatusCancelled, // Cancel permanently
+	},
+	// Cancelled is final - no transitions allowed
+}
 
+// validateStatusTransition returns a *domain.EventStatusTransitionError when the event cannot
+// move from currentStatus to newStatus
+func (s *eventService) validateStatusTransition(currentStatus, newStatus domain.EventStatus) error {
+	allowedStatuses := eventStatusTransitions[currentStatus]
 	for _, allowedStatus := range allowedStatuses {
 		if newStatus == allowedStatus {
 			return nil
 		}
 	}
 
-	return fmt.Errorf("invalid status transition from %s to %s", currentStatus, newStatus)
+	return domain.NewEventStatusTransitionError(currentStatus, newStatus, allowedStatuses)
 }
 
-// Validation operations
-func (s *eventService) ValidateEventOwnership(ctx context.Context, eventID, userID int) error {
-	// Get creator by user ID
-	creator, err := s.creatorRepo.GetByUserID(ctx, userID)
-	if err != nil {
-		s.logger.Error().Err(err).Int("user_id", userID).Msg("Failed to get creator by user ID")
-		return fmt.Errorf("creator profile not found")
+// validatePublishReadiness applies the operator's publishing rules to events heading for review
+// or publication
+func (s *eventService) validatePublishReadiness(event *domain.Event, newStatus domain.EventStatus) error {
+	if newStatus != domain.EventStatusPending && newStatus != domain.EventStatusPublished {
+		return nil
+	}
+	if s.eventConfig.RequireEventImage && event.ImageID == nil {
+		return domain.ErrEventImageRequired
 	}
+	return nil
+}
 
-	creatorID := creator.ID
-	isOwner, err := s.eventRepo.IsEventOwner(ctx, eventID, creatorID)
+func (s *eventService) GetEventHistory(ctx context.Context, eventID, userID int, isAdmin bool, pagination dto.PaginationRequest) ([]*dto.EventHistoryResponse, *dto.PaginationResponse, error) {
+	if isAdmin {
+		if _, err := s.eventRepo.GetByID(ctx, eventID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, nil, domain.ErrEventNotFound
+			}
+			return nil, nil, fmt.Errorf("failed to get event: %w", err)
+		}
+	} else if err := s.AuthorizeEvent(ctx, eventID, &userID, domain.EventActionManage); err != nil {
+		return nil, nil, err
+	}
+
+	histories, paginationResp, err := s.eventHistoryRepo.GetByEventID(ctx, eventID, pagination)
 	if err != nil {
-		s.logger.Error().Err(err).Int("event_id", eventID).Int("creator_id", creatorID).Msg("Failed to validate event ownership")
-		return fmt.Errorf("failed to validate ownership: %w", err)
+		s.logger.Error().Err(err).Int("event_id", eventID).Msg("Failed to get event history")
+		return nil, nil, fmt.Errorf("failed to get event history: %w", err)
 	}
-	if !isOwner {
-		return errors.New("access denied: you don't own this event")
+
+	responses := make([]*dto.EventHistoryResponse, 0, len(histories))
+	for _, history := range histories {
+		responses = append(responses, dto.EventHistoryToResponse(history))
+	}
+
+	return responses, paginationResp, nil
+}
+
+// applyDefaultTimezone gives location events the timezone of their venue, and online events
+// created without a timezone the timezone of their creator. Venues whose timezone is unknown
+// keep the timezone the creator entered.
+func (s *eventService) applyDefaultTimezone(ctx context.Context, event *domain.Event) {
+	switch {
+	case event.LocationType == domain.EventLocationTypeLocation && event.AddressID != nil:
+		address, err := s.addressRepo.GetByID(ctx, *event.AddressID)
+		if err != nil {
+			s.logger.Warn().Err(err).Int("address_id", *event.AddressID).Msg("Failed to get venue timezone")
+			return
+		}
+		if address.Timezone != "" {
+			event.Timezone = address.Timezone
+		}
+	case event.LocationType == domain.EventLocationTypeOnline && event.Timezone == "":
+		creator, err := s.creatorRepo.GetByID(ctx, event.CreatorID)
+		if err != nil || creator == nil {
+			s.logger.Warn().Err(err).Int("creator_id", event.CreatorID).Msg("Failed to get creator timezone")
+			return
+		}
+		event.Timezone = creator.Timezone
+	}
+}
+
+// recordHistory stores a history entry; failures are logged so they never block the change itself
+func (s *eventService) recordHistory(ctx context.Context, history *domain.EventHistory) {
+	if err := s.eventHistoryRepo.Create(ctx, history); err != nil {
+		s.logger.Error().Err(err).Int("event_id", history.EventID).Str("action", string(history.Action)).Msg("Failed to record event history")
 	}
-	return nil
 }
 
 func (s *eventService) ValidateEventAccess(ctx context.Context, eventID int, userID *int) error {
+	return s.AuthorizeEvent(ctx, eventID, userID, domain.EventActionView)
+}
+
+func (s *eventService) CanUserAccessEvent(ctx context.Context, eventID int, userID *int) (bool, error) {
+	err := s.ValidateEventAccess(ctx, eventID, userID)
+	return err == nil, nil
+}
+
+// AuthorizeEvent checks an action against the event policy for the given user. When private
+// existence is hidden, private events the user cannot see are reported as not found.
+func (s *eventService) AuthorizeEvent(ctx context.Context, eventID int, userID *int, action domain.EventAction) error {
+	_, err := s.loadAuthorizedEvent(ctx, eventID, userID, action)
+	return err
+}
+
+// loadAuthorizedEvent is AuthorizeEvent for callers that go on to use the event
+func (s *eventService) loadAuthorizedEvent(ctx context.Context, eventID int, userID *int, action domain.EventAction) (*domain.Event, error) {
 	event, err := s.eventRepo.GetByID(ctx, eventID)
 	if err != nil {
-		return fmt.Errorf("failed to get event: %w", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrEventNotFound
+		}
+		return nil, fmt.Errorf("failed to get event: %w", err)
 	}
 
-	// Public events are accessible to everyone if published
-	if event.Type == domain.EventTypePublic && event.Status == domain.EventStatusPublished {
-		return nil
+	if err := s.authorizeLoadedEvent(ctx, event, userID, action); err != nil {
+		return nil, err
 	}
+	return event, nil
+}
 
-	// Private events require user to be logged in
-	if event.Type == domain.EventTypePrivate {
-		if userID == nil {
-			return errors.New("authentication required for private events")
-		}
+// authorizeLoadedEvent is AuthorizeEvent for an event loaded with its Creator and co-hosts. Only
+// the invitation check for a signed-in non-owner needs a query.
+func (s *eventService) authorizeLoadedEvent(ctx context.Context, event *domain.Event, userID *int, action domain.EventAction) error {
+	role, err := s.eventRole(ctx, event, userID)
+	if err != nil {
+		return err
+	}
 
-		// Check if user is the creator
-		creator, err := s.creatorRepo.GetByUserID(ctx, *userID)
-		if err == nil && creator.ID == event.CreatorID {
-			return nil
+	if !s.policy.Allows(action, event, role) {
+		if s.eventConfig.HidePrivateExistence && event.IsPrivate() && !s.policy.CanView(event, role) {
+			return domain.ErrEventNotFound
 		}
+		return domain.ErrEventUnauthorized
+	}
+	return nil
+}
 
-		// Check if user is invited
-		hasInvitation, err := s.invitationRepo.ExistsByEventAndUser(ctx, eventID, *userID)
-		if err != nil {
-			return fmt.Errorf("failed to check invitation: %w", err)
-		}
-		if !hasInvitation {
-			return errors.New("access denied: you are not invited to this private event")
-		}
+// eventRole resolves how the user relates to the event. Relations that grant more come first,
+// so an admin who owns an event still edits it as its owner; the admin role is read from the
+// context set by the auth middleware.
+func (s *eventService) eventRole(ctx context.Context, event *domain.Event, userID *int) (domain.EventRole, error) {
+	if userID == nil {
+		return domain.EventRoleAnonymous, nil
 	}
 
-	// For non-published events, only creator can access
-	if event.Status != domain.EventStatusPublished {
-		if userID == nil {
-			return errors.New("authentication required")
-		}
+	if event.Creator.UserID == *userID {
+		return domain.EventRoleOwner, nil
+	}
+	if event.IsCoHostEditor(*userID) {
+		return domain.EventRoleCoHost, nil
+	}
+	if domain.UserRoleFromContext(ctx) == domain.UserRoleAdmin {
+		return domain.EventRoleAdmin, nil
+	}
+	if event.IsCoHostMember(*userID) {
+		return domain.EventRoleMember, nil
+	}
 
-		creator, err := s.creatorRepo.GetByUserID(ctx, *userID)
-		if err != nil {
-			return errors.New("creator profile required")
-		}
-		if creator.ID != event.CreatorID {
-			return errors.New("access denied: event not published")
+	hasInvitation, err := s.invitationRepo.ExistsForInvitee(ctx, event.ID, *userID)
+	if err != nil {
+		return "", fmt.Errorf("failed to check invitation: %w", err)
+	}
+	if hasInvitation {
+		return domain.EventRoleInvitee, nil
+	}
+
+	return domain.EventRoleUser, nil
+}
+
+// isAllowedValue reports whether value is in the allowed set; an empty set allows everything
+func isAllowedValue(allowed []string, value string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, item := range allowed {
+		if item == value {
+			return true
 		}
 	}
+	return false
+}
 
-	return nil
+// publishedLockedFields are the update fields a published event no longer accepts: they change
+// who may see the event or how its tickets are sold
+var publishedLockedFields = map[string]bool{
+	"type":               true,
+	"ticket_url":         true,
+	"has_system_tickets": true,
 }
 
-func (s *eventService) CanUserAccessEvent(ctx context.Context, eventID int, userID *int) (bool, error) {
-	err := s.ValidateEventAccess(ctx, eventID, userID)
-	return err == nil, nil
+// checkEventEditable reports whether req may be applied to the event in its current status.
+// Draft and pending events accept every field; published events accept everything but
+// publishedLockedFields; other statuses are read-only.
+func checkEventEditable(event *domain.Event, req dto.UpdateEventRequest) error {
+	switch event.Status {
+	case domain.EventStatusDraft, domain.EventStatusPending:
+		return nil
+	case domain.EventStatusPublished:
+		for _, field := range updatedEventFields(req) {
+			if publishedLockedFields[field] {
+				return domain.ErrEventCannotBeEdited
+			}
+		}
+		return nil
+	}
+	return domain.ErrEventCannotBeEdited
+}
+
+// updatedEventFields lists the fields set in an update request
+func updatedEventFields(req dto.UpdateEventRequest) []string {
+	var fields []string
+	add := func(set bool, name string) {
+		if set {
+			fields = append(fields, name)
+		}
+	}
+
+	add(req.Name != nil, "name")
+	add(req.Description != nil, "description")
+	add(req.ImageID != nil, "image_id")
+	add(req.VideoID != nil, "video_id")
+	add(req.Type != nil, "type")
+	add(req.LocationType != nil, "location_type")
+	add(req.StartDate != nil, "start_date")
+	add(req.StartTime != nil, "start_time")
+	add(req.EndDate != nil, "end_date")
+	add(req.EndTime != nil, "end_time")
+	add(req.Timezone != nil, "timezone")
+	add(req.AddressID != nil, "address_id")
+	add(req.OnlineEventURL != nil, "online_event_url")
+	add(req.OnlineEventType != nil, "online_event_type")
+	add(req.TicketURL != nil, "ticket_url")
+	add(req.HasSystemTickets != nil, "has_system_tickets")
+	add(req.AllowJoinRequests != nil, "allow_join_requests")
+	add(req.HideGoingCount != nil, "hide_going_count")
+	add(req.Capacity != nil, "capacity")
+	add(req.AdditionalInfo != nil, "additional_info")
+	add(req.CategoryIDs != nil, "category_ids")
+
+	return fields
 }
@@ -0,0 +1,2500 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/louco-event/internal/config"
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/i18n"
+	"github.com/louco-event/internal/repository"
+	"github.com/louco-event/internal/repository/postgres"
+	"github.com/louco-event/internal/testutil"
+	"github.com/louco-event/pkg/logger"
+	"github.com/louco-event/pkg/pagination"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+// fakePublishingRights grants or refuses publishing credits without a subscription
+type fakePublishingRights struct {
+	SubscriptionService
+	canPublish bool
+	consumeErr error
+	consumed   int
+}
+
+func (f *fakePublishingRights) CanPublishEvent(ctx context.Context, userID uint) (bool, error) {
+	return f.canPublish, nil
+}
+
+func (f *fakePublishingRights) ConsumeEventCredit(ctx context.Context, userID uint) error {
+	if f.consumeErr != nil {
+		return f.consumeErr
+	}
+	f.consumed++
+	return nil
+}
+
+// GetActiveSubscription reports no subscription, leaving the platform invitation limit in force
+func (f *fakePublishingRights) GetActiveSubscription(ctx context.Context, userID uint) (*domain.UserSubscription, error) {
+	return nil, nil
+}
+
+type sentEmail struct {
+	to, subject, message string
+}
+
+// fakeEmailService records notifications instead of sending them
+type fakeEmailService struct {
+	mu   sync.Mutex
+	sent []sentEmail
+}
+
+func (f *fakeEmailService) SendVerificationCode(ctx context.Context, email, code, language string) error {
+	return nil
+}
+
+func (f *fakeEmailService) SendNotification(ctx context.Context, email, subject, message string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.sent = append(f.sent, sentEmail{to: email, subject: subject, message: message})
+	return nil
+}
+
+func newTestTranslator(t testing.TB) *i18n.I18n {
+	t.Helper()
+	translator, err := i18n.New("../i18n/locales", "en")
+	if err != nil {
+		t.Fatalf("failed to load translations: %v", err)
+	}
+	return translator
+}
+
+func newTestEventService(t testing.TB, db *gorm.DB, rights SubscriptionService, emails *fakeEmailService) *eventService {
+	t.Helper()
+	nop := zerolog.Nop()
+	return NewEventService(
+		postgres.NewEventRepository(db),
+		postgres.NewEventHistoryRepository(db),
+		postgres.NewEventViewRepository(db),
+		postgres.NewAddressRepository(db),
+		postgres.NewTicketRepository(db),
+		postgres.NewInvitationRepository(db),
+		postgres.NewCategoryRepository(db),
+		postgres.NewCreatorRepository(db),
+		postgres.NewMediaRepository(db),
+		nil,
+		rights,
+		emails,
+		newTestTranslator(t),
+		nil,
+		pagination.NewCursorCodec("test-secret"),
+		config.EventConfig{ShareBaseURL: "https://louco.test"},
+		&logger.Logger{Logger: &nop},
+	).(*eventService)
+}
+
+func createInvitation(t *testing.T, db *gorm.DB, eventID int, email string) {
+	t.Helper()
+	if err := db.Omit("Event", "InvitedUser").Create(domain.NewInvitation(eventID, email, nil)).Error; err != nil {
+		t.Fatalf("failed to create invitation: %v", err)
+	}
+}
+
+func TestPublishAndNotify(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	creator := testutil.CreateCreator(t, db)
+	privateDraft := func(event *domain.Event) {
+		event.Type = domain.EventTypePrivate
+		event.Status = domain.EventStatusDraft
+		event.Name = "Garden Party"
+	}
+
+	t.Run("publishes and emails invitees in the request language", func(t *testing.T) {
+		event := testutil.CreateEvent(t, db, creator.ID, privateDraft)
+		createInvitation(t, db, event.ID, "guest@example.com")
+		rights := &fakePublishingRights{canPublish: true}
+		emails := &fakeEmailService{}
+		service := newTestEventService(t, db, rights, emails)
+
+		ctx := i18n.WithLanguage(context.Background(), "tr")
+		result, err := service.PublishAndNotify(ctx, event.ID, creator.UserID)
+		if err != nil {
+			t.Fatalf("PublishAndNotify: %v", err)
+		}
+		if result.Notified != 1 || rights.consumed != 1 {
+			t.Fatalf("notified %d, consumed %d credits; want 1 and 1", result.Notified, rights.consumed)
+		}
+		if status := eventStatus(t, db, event.ID); status != domain.EventStatusPublished {
+			t.Errorf("status = %s, want published", status)
+		}
+		sent := emails.sent[0]
+		if sent.subject != "Garden Party etkinliğine davetlisiniz" {
+			t.Errorf("subject = %q, want the Turkish invitation subject", sent.subject)
+		}
+		if !strings.Contains(sent.message, "https://louco.test/events/") {
+			t.Errorf("message %q has no event link", sent.message)
+		}
+	})
+
+	t.Run("without publishing rights nothing changes", func(t *testing.T) {
+		event := testutil.CreateEvent(t, db, creator.ID, privateDraft)
+		createInvitation(t, db, event.ID, "guest@example.com")
+		emails := &fakeEmailService{}
+		service := newTestEventService(t, db, &fakePublishingRights{canPublish: false}, emails)
+
+		_, err := service.PublishAndNotify(context.Background(), event.ID, creator.UserID)
+		if !errors.Is(err, domain.ErrEventPublishingRights) {
+			t.Fatalf("err = %v, want ErrEventPublishingRights", err)
+		}
+		if status := eventStatus(t, db, event.ID); status != domain.EventStatusDraft {
+			t.Errorf("status = %s, want draft", status)
+		}
+		if len(emails.sent) != 0 {
+			t.Errorf("sent %d emails, want none", len(emails.sent))
+		}
+	})
+
+	t.Run("a failed credit undoes the publish", func(t *testing.T) {
+		event := testutil.CreateEvent(t, db, creator.ID, privateDraft)
+		createInvitation(t, db, event.ID, "guest@example.com")
+		emails := &fakeEmailService{}
+		rights := &fakePublishingRights{canPublish: true, consumeErr: errors.New("no credits left")}
+		service := newTestEventService(t, db, rights, emails)
+
+		if _, err := service.PublishAndNotify(context.Background(), event.ID, creator.UserID); err == nil {
+			t.Fatal("PublishAndNotify succeeded without a credit")
+		}
+		if status := eventStatus(t, db, event.ID); status != domain.EventStatusDraft {
+			t.Errorf("status = %s, want draft", status)
+		}
+		if len(emails.sent) != 0 {
+			t.Errorf("sent %d emails, want none", len(emails.sent))
+		}
+	})
+
+	t.Run("a failed publish spends no credit", func(t *testing.T) {
+		event := testutil.CreateEvent(t, db, creator.ID, privateDraft)
+		createInvitation(t, db, event.ID, "guest@example.com")
+		emails := &fakeEmailService{}
+		rights := &fakePublishingRights{canPublish: true}
+		service := newTestEventService(t, db, rights, emails)
+		service.eventRepo = &failingStatusEventRepository{EventRepository: service.eventRepo}
+
+		if _, err := service.PublishAndNotify(context.Background(), event.ID, creator.UserID); err == nil {
+			t.Fatal("PublishAndNotify succeeded although the status update failed")
+		}
+		if rights.consumed != 0 {
+			t.Errorf("consumed %d credits, want none", rights.consumed)
+		}
+		if status := eventStatus(t, db, event.ID); status != domain.EventStatusDraft {
+			t.Errorf("status = %s, want draft", status)
+		}
+		if len(emails.sent) != 0 {
+			t.Errorf("sent %d emails, want none", len(emails.sent))
+		}
+	})
+}
+
+// failingStatusEventRepository fails every status update, as a concurrent change or a lost
+// connection would
+type failingStatusEventRepository struct {
+	repository.EventRepository
+}
+
+func (r *failingStatusEventRepository) UpdateStatus(ctx context.Context, id int, status domain.EventStatus) error {
+	return errors.New("connection reset")
+}
+
+func TestUpdatePublishedEventDetailChanges(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+	withLink := func(event *domain.Event) {
+		link := "https://meet.example.com/launch"
+		event.OnlineEventURL = &link
+	}
+	detailsChanged := func(eventID int) int64 {
+		return countRows(t, db, "event_histories", "event_id = ? AND action = ?", eventID, domain.EventHistoryActionDetailsChanged)
+	}
+
+	t.Run("a new start date is recorded for attendees", func(t *testing.T) {
+		event := testutil.CreateEvent(t, db, creator.ID, withLink)
+		startDate := event.StartDate.AddDate(0, 0, 7).Format("2006-01-02")
+
+		if _, err := service.UpdateEvent(ctx, event.ID, creator.UserID, dto.UpdateEventRequest{StartDate: &startDate}); err != nil {
+			t.Fatalf("UpdateEvent: %v", err)
+		}
+		if count := detailsChanged(event.ID); count != 1 {
+			t.Errorf("%d details changes recorded, want 1", count)
+		}
+	})
+
+	t.Run("a new description is saved without a notification", func(t *testing.T) {
+		event := testutil.CreateEvent(t, db, creator.ID, withLink)
+		description := "Now with a keynote"
+
+		if _, err := service.UpdateEvent(ctx, event.ID, creator.UserID, dto.UpdateEventRequest{Description: &description}); err != nil {
+			t.Fatalf("UpdateEvent: %v", err)
+		}
+		if count := detailsChanged(event.ID); count != 0 {
+			t.Errorf("%d details changes recorded, want none", count)
+		}
+	})
+
+	t.Run("ticketing stays locked", func(t *testing.T) {
+		event := testutil.CreateEvent(t, db, creator.ID, withLink)
+		ticketURL := "https://tickets.example.com"
+
+		_, err := service.UpdateEvent(ctx, event.ID, creator.UserID, dto.UpdateEventRequest{TicketURL: &ticketURL})
+		if !errors.Is(err, domain.ErrEventCannotBeEdited) {
+			t.Errorf("err = %v, want ErrEventCannotBeEdited", err)
+		}
+	})
+}
+
+func TestUpdateEventValidatesMergedLocation(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+	address := testutil.CreateAddress(t, db, "Germany", "Berlin", 52.52, 13.40)
+	online := func(event *domain.Event) {
+		link := "https://meet.example.com/launch"
+		event.OnlineEventURL = &link
+	}
+	stored := func(eventID int) domain.Event {
+		var event domain.Event
+		if err := db.First(&event, eventID).Error; err != nil {
+			t.Fatalf("failed to load event: %v", err)
+		}
+		return event
+	}
+
+	t.Run("an address on an online event is rejected", func(t *testing.T) {
+		event := testutil.CreateEvent(t, db, creator.ID, online)
+		_, err := service.UpdateEvent(ctx, event.ID, creator.UserID, dto.UpdateEventRequest{AddressID: &address.ID})
+		if !errors.Is(err, domain.ErrEventAddressNotAllowed) {
+			t.Fatalf("err = %v, want ErrEventAddressNotAllowed", err)
+		}
+		if after := stored(event.ID); after.AddressID != nil || after.OnlineEventURL == nil {
+			t.Errorf("stored address %v, URL %v; want the online event unchanged", after.AddressID, after.OnlineEventURL)
+		}
+	})
+
+	t.Run("a URL on a location event is rejected", func(t *testing.T) {
+		event := testutil.CreateEvent(t, db, creator.ID, testutil.AtAddress(address))
+		link := "https://meet.example.com/other"
+		_, err := service.UpdateEvent(ctx, event.ID, creator.UserID, dto.UpdateEventRequest{OnlineEventURL: &link})
+		if !errors.Is(err, domain.ErrEventOnlineURLNotAllowed) {
+			t.Fatalf("err = %v, want ErrEventOnlineURLNotAllowed", err)
+		}
+	})
+
+	t.Run("changing the location type drops the other field", func(t *testing.T) {
+		event := testutil.CreateEvent(t, db, creator.ID, online)
+		locationType := domain.EventLocationTypeLocation
+		if _, err := service.UpdateEvent(ctx, event.ID, creator.UserID, dto.UpdateEventRequest{LocationType: &locationType, AddressID: &address.ID}); err != nil {
+			t.Fatalf("UpdateEvent: %v", err)
+		}
+		if after := stored(event.ID); after.AddressID == nil || *after.AddressID != address.ID || after.OnlineEventURL != nil {
+			t.Errorf("stored address %v, URL %v; want the address and no URL", after.AddressID, after.OnlineEventURL)
+		}
+	})
+}
+
+func TestCheckEventEditable(t *testing.T) {
+	description := "New description"
+	ticketURL := "https://tickets.example.com"
+	tests := []struct {
+		name    string
+		status  domain.EventStatus
+		req     dto.UpdateEventRequest
+		wantErr bool
+	}{
+		{"draft accepts ticketing", domain.EventStatusDraft, dto.UpdateEventRequest{TicketURL: &ticketURL}, false},
+		{"published accepts a description", domain.EventStatusPublished, dto.UpdateEventRequest{Description: &description}, false},
+		{"published rejects ticketing", domain.EventStatusPublished, dto.UpdateEventRequest{TicketURL: &ticketURL}, true},
+		{"cancelled is read-only", domain.EventStatusCancelled, dto.UpdateEventRequest{Description: &description}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkEventEditable(&domain.Event{Status: tt.status}, tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkEventEditable() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRequireEventImageBlocksPublishing(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	draft := func(event *domain.Event) { event.Status = domain.EventStatusDraft }
+
+	for _, required := range []bool{true, false} {
+		t.Run(fmt.Sprintf("required=%v", required), func(t *testing.T) {
+			service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+			service.eventConfig.RequireEventImage = required
+			event := testutil.CreateEvent(t, db, creator.ID, draft)
+
+			_, err := service.UpdateEventStatus(ctx, event.ID, creator.UserID, dto.UpdateEventStatusRequest{Status: domain.EventStatusPending})
+			if required {
+				if !errors.Is(err, domain.ErrEventImageRequired) {
+					t.Fatalf("err = %v, want ErrEventImageRequired", err)
+				}
+				if status := eventStatus(t, db, event.ID); status != domain.EventStatusDraft {
+					t.Errorf("status = %s, want the imageless event left a draft", status)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UpdateEventStatus: %v", err)
+			}
+			if status := eventStatus(t, db, event.ID); status != domain.EventStatusPending {
+				t.Errorf("status = %s, want pending", status)
+			}
+		})
+	}
+}
+
+func TestGetEventHistory(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	admin := testutil.CreateUser(t, db, domain.UserTypeUser)
+	other := testutil.CreateCreator(t, db)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+	event := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+		event.Status = domain.EventStatusDraft
+	})
+
+	for _, status := range []domain.EventStatus{domain.EventStatusPending, domain.EventStatusRejected, domain.EventStatusDraft} {
+		if _, err := service.UpdateEventStatus(ctx, event.ID, creator.UserID, dto.UpdateEventStatusRequest{Status: status}); err != nil {
+			t.Fatalf("UpdateEventStatus(%s): %v", status, err)
+		}
+	}
+
+	report := domain.NewEventReport(event.ID, admin.ID, domain.EventReportReasonSpam, nil)
+	if err := db.Omit("Event", "Reporter").Create(report).Error; err != nil {
+		t.Fatalf("failed to create report: %v", err)
+	}
+	note := "Checked with the organizer"
+	reports := newTestEventReportService(db, 0)
+	if _, err := reports.ResolveReport(ctx, report.ID, admin.ID, dto.ResolveEventReportRequest{Status: domain.EventReportStatusDismissed, Note: &note}); err != nil {
+		t.Fatalf("ResolveReport: %v", err)
+	}
+
+	type entry struct {
+		action   domain.EventHistoryAction
+		toStatus domain.EventStatus
+	}
+	want := []entry{
+		{domain.EventHistoryActionReportDismissed, ""},
+		{domain.EventHistoryActionStatusChanged, domain.EventStatusDraft},
+		{domain.EventHistoryActionStatusChanged, domain.EventStatusRejected},
+		{domain.EventHistoryActionStatusChanged, domain.EventStatusPending},
+	}
+	check := func(t *testing.T, userID int, isAdmin bool) {
+		history, _, err := service.GetEventHistory(ctx, event.ID, userID, isAdmin, dto.PaginationRequest{})
+		if err != nil {
+			t.Fatalf("GetEventHistory: %v", err)
+		}
+		if len(history) != len(want) {
+			t.Fatalf("got %d entries, want %d", len(history), len(want))
+		}
+		for i, w := range want {
+			got := entry{action: history[i].Action}
+			if history[i].ToStatus != nil {
+				got.toStatus = *history[i].ToStatus
+			}
+			if got != w {
+				t.Errorf("entry %d = %+v, want %+v", i, got, w)
+			}
+		}
+		if moderation := history[0]; moderation.Note == nil || *moderation.Note != note || moderation.Actor == nil || moderation.Actor.ID != admin.ID {
+			t.Errorf("moderation entry does not record the admin and note: %+v", moderation)
+		}
+	}
+
+	t.Run("owner", func(t *testing.T) { check(t, creator.UserID, false) })
+	t.Run("admin", func(t *testing.T) { check(t, admin.ID, true) })
+	t.Run("other creator", func(t *testing.T) {
+		if _, _, err := service.GetEventHistory(ctx, event.ID, other.UserID, false, dto.PaginationRequest{}); err == nil {
+			t.Error("another creator could read the history")
+		}
+	})
+}
+
+func TestEventRole(t *testing.T) {
+	accepted := time.Now()
+	event := &domain.Event{
+		Creator: domain.Creator{UserID: 1},
+		CoHosts: []domain.EventCoHost{
+			{Role: domain.EventCoHostRoleEditor, AcceptedAt: &accepted, Creator: &domain.Creator{UserID: 2}},
+			{Role: domain.EventCoHostRoleHost, AcceptedAt: &accepted, Creator: &domain.Creator{UserID: 3}},
+		},
+	}
+	adminCtx := domain.ContextWithUserRole(context.Background(), domain.UserRoleAdmin)
+	userID := func(id int) *int { return &id }
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		userID *int
+		want   domain.EventRole
+	}{
+		{"anonymous", context.Background(), nil, domain.EventRoleAnonymous},
+		{"owner", context.Background(), userID(1), domain.EventRoleOwner},
+		{"owner who is an admin", adminCtx, userID(1), domain.EventRoleOwner},
+		{"editor co-host", context.Background(), userID(2), domain.EventRoleCoHost},
+		{"host co-host", context.Background(), userID(3), domain.EventRoleMember},
+		{"admin", adminCtx, userID(4), domain.EventRoleAdmin},
+	}
+
+	service := &eventService{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			role, err := service.eventRole(tt.ctx, event, tt.userID)
+			if err != nil {
+				t.Fatalf("eventRole: %v", err)
+			}
+			if role != tt.want {
+				t.Errorf("eventRole() = %s, want %s", role, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetEventsWithFiltersShowsEventsToAcceptedCoHosts(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	owner := testutil.CreateCreator(t, db)
+	accepted := testutil.CreateCreator(t, db)
+	invited := testutil.CreateCreator(t, db)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+	event := testutil.CreateEvent(t, db, owner.ID, func(event *domain.Event) {
+		event.Type = domain.EventTypePrivate
+		event.Status = domain.EventStatusDraft
+	})
+
+	acceptedAt := time.Now()
+	for _, coHost := range []*domain.EventCoHost{
+		{EventID: event.ID, CreatorID: accepted.ID, Role: domain.EventCoHostRoleHost, AcceptedAt: &acceptedAt},
+		{EventID: event.ID, CreatorID: invited.ID, Role: domain.EventCoHostRoleEditor},
+	} {
+		if err := db.Omit("Creator").Create(coHost).Error; err != nil {
+			t.Fatalf("failed to create co-host: %v", err)
+		}
+	}
+
+	visible := func(userID int) bool {
+		t.Helper()
+		events, _, err := service.GetEventsWithFilters(ctx, dto.EventFilterRequest{}, dto.PaginationRequest{}, &userID)
+		if err != nil {
+			t.Fatalf("GetEventsWithFilters: %v", err)
+		}
+		for _, listed := range events {
+			if listed.ID == event.ID {
+				return true
+			}
+		}
+		return false
+	}
+
+	if !visible(accepted.UserID) {
+		t.Error("accepted co-host does not see the event")
+	}
+	if visible(invited.UserID) {
+		t.Error("co-host who has not accepted sees the event")
+	}
+}
+
+func TestGetEventPublicStats(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	attendee := testutil.CreateUser(t, db, domain.UserTypeUser)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+
+	seed := func(event *domain.Event) {
+		t.Helper()
+		ticket := testutil.CreateTicket(t, db, event.ID, 10)
+		if err := db.Omit("Ticket", "User").Create(domain.NewTicketPurchase(ticket.ID, attendee.ID, 1)).Error; err != nil {
+			t.Fatalf("failed to create purchase: %v", err)
+		}
+		for _, viewer := range []*int{&attendee.ID, nil} {
+			if err := db.Create(domain.NewEventView(event.ID, viewer, "203.0.113.7")).Error; err != nil {
+				t.Fatalf("failed to create view: %v", err)
+			}
+		}
+	}
+
+	t.Run("public event", func(t *testing.T) {
+		event := testutil.CreateEvent(t, db, creator.ID, nil)
+		seed(event)
+
+		stats, err := service.GetEventPublicStats(ctx, event.ID)
+		if err != nil {
+			t.Fatalf("GetEventPublicStats: %v", err)
+		}
+		if stats.ViewCount != 2 {
+			t.Errorf("view count = %d, want 2", stats.ViewCount)
+		}
+		if stats.GoingCount == nil || *stats.GoingCount != 1 {
+			t.Errorf("going count = %v, want 1", stats.GoingCount)
+		}
+	})
+
+	t.Run("hidden going count", func(t *testing.T) {
+		event := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+			event.HideGoingCount = true
+		})
+		seed(event)
+
+		stats, err := service.GetEventPublicStats(ctx, event.ID)
+		if err != nil {
+			t.Fatalf("GetEventPublicStats: %v", err)
+		}
+		if stats.GoingCount != nil {
+			t.Errorf("going count = %d, want it hidden", *stats.GoingCount)
+		}
+	})
+
+	t.Run("private event", func(t *testing.T) {
+		event := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+			event.Type = domain.EventTypePrivate
+		})
+		if _, err := service.GetEventPublicStats(ctx, event.ID); !errors.Is(err, domain.ErrEventNotFound) {
+			t.Errorf("err = %v, want ErrEventNotFound", err)
+		}
+	})
+
+	t.Run("missing event", func(t *testing.T) {
+		if _, err := service.GetEventPublicStats(ctx, 999999); !errors.Is(err, domain.ErrEventNotFound) {
+			t.Errorf("err = %v, want ErrEventNotFound", err)
+		}
+	})
+}
+
+func TestGetTrendingEventsRanksByDedupedViews(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+	service.eventConfig.TrendingWindow = 7 * 24 * time.Hour
+	service.eventConfig.TrendingHalfLife = 24 * time.Hour
+
+	older := testutil.CreateEvent(t, db, creator.ID, nil)
+	if err := db.Model(older).Update("created_at", time.Now().Add(-3*24*time.Hour)).Error; err != nil {
+		t.Fatalf("failed to age event: %v", err)
+	}
+	newer := testutil.CreateEvent(t, db, creator.ID, nil)
+
+	for i := range 5 {
+		if err := service.RecordView(ctx, older.ID, nil, fmt.Sprintf("203.0.113.%d", i+1)); err != nil {
+			t.Fatalf("RecordView: %v", err)
+		}
+	}
+	// Reloading the page from one address counts once
+	for range 10 {
+		if err := service.RecordView(ctx, newer.ID, nil, "198.51.100.1"); err != nil {
+			t.Fatalf("RecordView: %v", err)
+		}
+	}
+	if count := countRows(t, db, "event_views", "event_id = ?", newer.ID); count != 1 {
+		t.Errorf("repeat anonymous views stored %d rows, want 1", count)
+	}
+
+	trending, err := service.GetTrendingEvents(ctx, dto.EventShowcaseRequest{})
+	if err != nil {
+		t.Fatalf("GetTrendingEvents: %v", err)
+	}
+	if len(trending) != 2 || trending[0].ID != older.ID || trending[1].ID != newer.ID {
+		t.Fatalf("trending = %v, want the viewed older event before the newer one", eventIDs(trending))
+	}
+}
+
+func TestGetTrendingEventsWindow(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+	service.eventConfig.TrendingWindow = 7 * 24 * time.Hour
+	service.eventConfig.TrendingHalfLife = 24 * time.Hour
+
+	createdDaysAgo := func(days int) *domain.Event {
+		event := testutil.CreateEvent(t, db, creator.ID, nil)
+		if err := db.Model(event).Update("created_at", time.Now().AddDate(0, 0, -days)).Error; err != nil {
+			t.Fatalf("failed to age event: %v", err)
+		}
+		return event
+	}
+	viewedDaysAgo := func(event *domain.Event, days int) {
+		view := domain.NewEventView(event.ID, nil, "203.0.113.1")
+		view.ViewedAt = time.Now().AddDate(0, 0, -days)
+		if err := db.Create(view).Error; err != nil {
+			t.Fatalf("failed to create view: %v", err)
+		}
+	}
+
+	fresh := createdDaysAgo(0)
+	revived := createdDaysAgo(10)
+	viewedDaysAgo(revived, 1)
+	forgotten := createdDaysAgo(10)
+	viewedDaysAgo(forgotten, 9)
+	createdDaysAgo(10)
+
+	// Old events trend only through views inside the window; new ones are listed without views
+	trending, err := service.GetTrendingEvents(ctx, dto.EventShowcaseRequest{})
+	if err != nil {
+		t.Fatalf("GetTrendingEvents: %v", err)
+	}
+	if got, want := eventIDs(trending), []int{revived.ID, fresh.ID}; !slices.Equal(got, want) {
+		t.Errorf("trending = %v, want %v", got, want)
+	}
+
+	category := testutil.CreateCategory(t, db, "Jazz", fresh.ID, forgotten.ID)
+	trending, err = service.GetTrendingEvents(ctx, dto.EventShowcaseRequest{CategoryID: &category.ID})
+	if err != nil {
+		t.Fatalf("GetTrendingEvents: %v", err)
+	}
+	if got, want := eventIDs(trending), []int{fresh.ID}; !slices.Equal(got, want) {
+		t.Errorf("trending in category = %v, want %v", got, want)
+	}
+}
+
+func TestGetFeaturedEventsIsCurated(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+
+	berlin := testutil.CreateAddress(t, db, "Germany", "Berlin", 52.52, 13.40)
+	first := testutil.CreateEvent(t, db, creator.ID, testutil.AtAddress(berlin))
+	dropped := testutil.CreateEvent(t, db, creator.ID, nil)
+	latest := testutil.CreateEvent(t, db, creator.ID, nil)
+	private := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) { event.Type = domain.EventTypePrivate })
+	// The newest event is not picked, so it stays off the list
+	testutil.CreateEvent(t, db, creator.ID, nil)
+
+	for _, event := range []*domain.Event{first, dropped, latest} {
+		if _, err := service.SetEventFeatured(ctx, event.ID, true); err != nil {
+			t.Fatalf("SetEventFeatured: %v", err)
+		}
+	}
+	if _, err := service.SetEventFeatured(ctx, dropped.ID, false); err != nil {
+		t.Fatalf("SetEventFeatured(false): %v", err)
+	}
+	if _, err := service.SetEventFeatured(ctx, private.ID, true); !errors.Is(err, domain.ErrEventNotFeaturable) {
+		t.Errorf("featuring a private event: err = %v, want ErrEventNotFeaturable", err)
+	}
+
+	// Most recently featured first
+	list := func(req dto.EventShowcaseRequest) []int {
+		t.Helper()
+		featured, err := service.GetFeaturedEvents(ctx, req)
+		if err != nil {
+			t.Fatalf("GetFeaturedEvents: %v", err)
+		}
+		return eventIDs(featured)
+	}
+	if got, want := list(dto.EventShowcaseRequest{}), []int{latest.ID, first.ID}; !slices.Equal(got, want) {
+		t.Errorf("featured = %v, want %v", got, want)
+	}
+	if got, want := list(dto.EventShowcaseRequest{Limit: 1}), []int{latest.ID}; !slices.Equal(got, want) {
+		t.Errorf("featured with limit 1 = %v, want %v", got, want)
+	}
+	city := "berlin"
+	if got, want := list(dto.EventShowcaseRequest{City: &city}), []int{first.ID}; !slices.Equal(got, want) {
+		t.Errorf("featured in Berlin = %v, want %v", got, want)
+	}
+}
+
+func eventIDs(events []*dto.EventListResponse) []int {
+	ids := make([]int, len(events))
+	for i, event := range events {
+		ids[i] = event.ID
+	}
+	return ids
+}
+
+func TestValidateEventBusinessRulesAllowedTypes(t *testing.T) {
+	onlineURL := "https://meet.test/launch"
+	online := func(eventType domain.EventType) *domain.Event {
+		return &domain.Event{Type: eventType, LocationType: domain.EventLocationTypeOnline, OnlineEventURL: &onlineURL}
+	}
+	announcement := &domain.Event{Type: domain.EventTypePublic, LocationType: domain.EventLocationTypeAnnouncement}
+
+	tests := []struct {
+		name   string
+		config config.EventConfig
+		event  *domain.Event
+		want   error
+	}{
+		{"nothing configured", config.EventConfig{}, online(domain.EventTypePrivate), nil},
+		{"allowed type", config.EventConfig{AllowedTypes: []string{"public", "private"}}, online(domain.EventTypePrivate), nil},
+		{"type not allowed", config.EventConfig{AllowedTypes: []string{"public"}}, online(domain.EventTypePrivate), domain.ErrEventTypeNotAllowed},
+		{"location type not allowed", config.EventConfig{AllowedLocationTypes: []string{"location", "online"}}, announcement, domain.ErrEventTypeNotAllowed},
+		{"allowed location type", config.EventConfig{AllowedLocationTypes: []string{"announcement"}}, announcement, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &eventService{eventConfig: tt.config}
+			if err := service.validateEventBusinessRules(tt.event); !errors.Is(err, tt.want) {
+				t.Errorf("validateEventBusinessRules() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+// fakeDraftCounter reports a fixed number of drafts per creator
+// fakeEventLookup fails every event lookup with a fixed error
+type fakeEventLookup struct {
+	repository.EventRepository
+	err error
+}
+
+func (r *fakeEventLookup) GetByID(ctx context.Context, id int) (*domain.Event, error) {
+	return nil, r.err
+}
+
+func TestEventLookupsKeepLookupFailures(t *testing.T) {
+	connErr := errors.New("connection reset")
+	lookups := map[string]func(service *eventService) error{
+		"RemainingCapacity": func(service *eventService) error {
+			_, err := service.RemainingCapacity(context.Background(), 1)
+			return err
+		},
+		"GetEventShareMeta": func(service *eventService) error {
+			_, err := service.GetEventShareMeta(context.Background(), 1)
+			return err
+		},
+	}
+	for name, lookup := range lookups {
+		t.Run(name, func(t *testing.T) {
+			service := &eventService{eventRepo: &fakeEventLookup{err: gorm.ErrRecordNotFound}}
+			if err := lookup(service); !errors.Is(err, domain.ErrEventNotFound) {
+				t.Errorf("missing event: err = %v, want ErrEventNotFound", err)
+			}
+
+			service.eventRepo = &fakeEventLookup{err: connErr}
+			if err := lookup(service); !errors.Is(err, connErr) || errors.Is(err, domain.ErrEventNotFound) {
+				t.Errorf("failed lookup: err = %v, want the wrapped connection error", err)
+			}
+		})
+	}
+}
+
+type fakeDraftCounter struct {
+	repository.EventRepository
+	drafts int64
+}
+
+func (r *fakeDraftCounter) CountByCreatorIDAndStatus(ctx context.Context, creatorID int, status domain.EventStatus) (int64, error) {
+	if status != domain.EventStatusDraft {
+		return 0, nil
+	}
+	return r.drafts, nil
+}
+
+func TestCheckDraftLimit(t *testing.T) {
+	tests := []struct {
+		name   string
+		limit  int
+		drafts int64
+		want   error
+	}{
+		{"no limit", 0, 100, nil},
+		{"below the limit", 3, 2, nil},
+		{"at the limit", 3, 3, domain.ErrEventDraftLimitReached},
+		{"over the limit", 3, 5, domain.ErrEventDraftLimitReached},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &eventService{
+				eventRepo:   &fakeDraftCounter{drafts: tt.drafts},
+				eventConfig: config.EventConfig{MaxDraftsPerCreator: tt.limit},
+			}
+			if err := service.checkDraftLimit(context.Background(), 1); !errors.Is(err, tt.want) {
+				t.Errorf("checkDraftLimit() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestBulkUpdateStatus(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	other := testutil.CreateCreator(t, db)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+
+	published := testutil.CreateEvent(t, db, creator.ID, nil)
+	draft := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+		event.Status = domain.EventStatusDraft
+	})
+	othersEvent := testutil.CreateEvent(t, db, other.ID, nil)
+	missingID := othersEvent.ID + 1000
+
+	result, err := service.BulkUpdateStatus(ctx, creator.UserID, []int{published.ID, draft.ID, othersEvent.ID, missingID, published.ID}, domain.EventStatusCancelled)
+	if err != nil {
+		t.Fatalf("BulkUpdateStatus: %v", err)
+	}
+	if result.Updated != 1 || result.Rejected != 3 {
+		t.Errorf("updated %d and rejected %d, want 1 and 3", result.Updated, result.Rejected)
+	}
+	want := map[int]string{
+		published.ID:   dto.BulkEventStatusResultUpdated,
+		draft.ID:       dto.BulkEventStatusResultInvalidTransition,
+		othersEvent.ID: dto.BulkEventStatusResultAccessDenied,
+		missingID:      dto.BulkEventStatusResultNotFound,
+	}
+	if len(result.Results) != len(want) {
+		t.Errorf("got %d results, want %d", len(result.Results), len(want))
+	}
+	for _, got := range result.Results {
+		if got.Result != want[got.EventID] {
+			t.Errorf("event %d: result %q, want %q", got.EventID, got.Result, want[got.EventID])
+		}
+	}
+
+	for eventID, status := range map[int]domain.EventStatus{
+		published.ID:   domain.EventStatusCancelled,
+		draft.ID:       domain.EventStatusDraft,
+		othersEvent.ID: domain.EventStatusPublished,
+	} {
+		if got := eventStatus(t, db, eventID); got != status {
+			t.Errorf("event %d is %s, want %s", eventID, got, status)
+		}
+	}
+
+	// An editor co-host gets the same answer in bulk as from UpdateEventStatus
+	acceptedAt := time.Now()
+	coHost := &domain.EventCoHost{EventID: othersEvent.ID, CreatorID: creator.ID, Role: domain.EventCoHostRoleEditor, AcceptedAt: &acceptedAt}
+	if err := db.Omit("Creator").Create(coHost).Error; err != nil {
+		t.Fatalf("failed to create co-host: %v", err)
+	}
+	_, singleErr := service.UpdateEventStatus(ctx, othersEvent.ID, creator.UserID, dto.UpdateEventStatusRequest{Status: domain.EventStatusStopped})
+	if !errors.Is(singleErr, domain.ErrEventUnauthorized) {
+		t.Errorf("UpdateEventStatus by an editor co-host: err = %v, want ErrEventUnauthorized", singleErr)
+	}
+	result, err = service.BulkUpdateStatus(ctx, creator.UserID, []int{othersEvent.ID}, domain.EventStatusStopped)
+	if err != nil {
+		t.Fatalf("BulkUpdateStatus by an editor co-host: %v", err)
+	}
+	if got := result.Results[0].Result; got != dto.BulkEventStatusResultAccessDenied {
+		t.Errorf("editor co-host: result %q, want %q", got, dto.BulkEventStatusResultAccessDenied)
+	}
+
+	tooMany := make([]int, maxBulkStatusEvents+1)
+	for i := range tooMany {
+		tooMany[i] = i + 1
+	}
+	for name, tt := range map[string]struct {
+		ids    []int
+		status domain.EventStatus
+		want   error
+	}{
+		"no events":      {nil, domain.EventStatusCancelled, domain.ErrEventNoneToUpdate},
+		"too many":       {tooMany, domain.EventStatusCancelled, domain.ErrEventTooManyToUpdate},
+		"unknown status": {[]int{published.ID}, domain.EventStatus("archived"), domain.ErrEventInvalidStatus},
+	} {
+		if _, err := service.BulkUpdateStatus(ctx, creator.UserID, tt.ids, tt.status); !errors.Is(err, tt.want) {
+			t.Errorf("%s: err = %v, want %v", name, err, tt.want)
+		}
+	}
+}
+
+func TestBulkAddCategoryToEvents(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	other := testutil.CreateCreator(t, db)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+
+	events := []*domain.Event{
+		testutil.CreateEvent(t, db, creator.ID, nil),
+		testutil.CreateEvent(t, db, creator.ID, nil),
+		testutil.CreateEvent(t, db, creator.ID, nil),
+	}
+	othersEvent := testutil.CreateEvent(t, db, other.ID, nil)
+	category := testutil.CreateCategory(t, db, "Jazz", events[2].ID)
+
+	result, err := service.BulkAddCategoryToEvents(ctx, creator.UserID, []int{events[0].ID, events[1].ID, events[2].ID, othersEvent.ID}, category.ID)
+	if err != nil {
+		t.Fatalf("BulkAddCategoryToEvents: %v", err)
+	}
+	if result.Added != 2 || result.Skipped != 1 || result.Rejected != 1 {
+		t.Errorf("added %d, skipped %d, rejected %d, want 2, 1 and 1", result.Added, result.Skipped, result.Rejected)
+	}
+	want := map[int]string{
+		events[0].ID:   dto.BulkEventCategoryResultAdded,
+		events[1].ID:   dto.BulkEventCategoryResultAdded,
+		events[2].ID:   dto.BulkEventCategoryResultSkipped,
+		othersEvent.ID: dto.BulkEventCategoryResultAccessDenied,
+	}
+	for _, got := range result.Results {
+		if got.Result != want[got.EventID] {
+			t.Errorf("event %d: result %q, want %q", got.EventID, got.Result, want[got.EventID])
+		}
+	}
+
+	for _, event := range append(events, othersEvent) {
+		var links int64
+		if err := db.Model(&domain.EventCategory{}).Where("event_id = ? AND category_id = ?", event.ID, category.ID).Count(&links).Error; err != nil {
+			t.Fatalf("failed to count categories: %v", err)
+		}
+		wantLinks := int64(1)
+		if event.ID == othersEvent.ID {
+			wantLinks = 0
+		}
+		if links != wantLinks {
+			t.Errorf("event %d is in the category %d times, want %d", event.ID, links, wantLinks)
+		}
+	}
+
+	// An editor co-host may add the category, as UpdateEvent lets them; a cancelled event is
+	// read-only for everyone
+	editor := testutil.CreateCreator(t, db)
+	cancelled := testutil.CreateEvent(t, db, other.ID, func(event *domain.Event) {
+		event.Status = domain.EventStatusCancelled
+	})
+	acceptedAt := time.Now()
+	for _, eventID := range []int{othersEvent.ID, cancelled.ID} {
+		coHost := &domain.EventCoHost{EventID: eventID, CreatorID: editor.ID, Role: domain.EventCoHostRoleEditor, AcceptedAt: &acceptedAt}
+		if err := db.Omit("Creator").Create(coHost).Error; err != nil {
+			t.Fatalf("failed to create co-host: %v", err)
+		}
+	}
+	result, err = service.BulkAddCategoryToEvents(ctx, editor.UserID, []int{othersEvent.ID, cancelled.ID}, category.ID)
+	if err != nil {
+		t.Fatalf("BulkAddCategoryToEvents by an editor co-host: %v", err)
+	}
+	want = map[int]string{
+		othersEvent.ID: dto.BulkEventCategoryResultAdded,
+		cancelled.ID:   dto.BulkEventCategoryResultNotEditable,
+	}
+	for _, got := range result.Results {
+		if got.Result != want[got.EventID] {
+			t.Errorf("editor co-host, event %d: result %q, want %q", got.EventID, got.Result, want[got.EventID])
+		}
+	}
+
+	if _, err := service.BulkAddCategoryToEvents(ctx, creator.UserID, []int{events[0].ID}, category.ID+1000); !errors.Is(err, domain.ErrEventCategoryNotFound) {
+		t.Errorf("unknown category: err = %v, want ErrEventCategoryNotFound", err)
+	}
+	if _, err := service.BulkAddCategoryToEvents(ctx, creator.UserID, nil, category.ID); !errors.Is(err, domain.ErrEventNoneToUpdate) {
+		t.Errorf("no events: err = %v, want ErrEventNoneToUpdate", err)
+	}
+}
+
+func TestSearchPublicEventsMinimumQueryLength(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+	service.eventConfig.SearchMinQueryLength = 3
+	event := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+		event.Name = "Jazz Night"
+	})
+
+	for _, query := range []string{"", "ja", "  ja  ", "çğ"} {
+		if _, _, err := service.SearchPublicEvents(ctx, dto.EventSearchRequest{Query: query}, dto.PaginationRequest{}); !errors.Is(err, domain.ErrEventSearchQueryTooShort) {
+			t.Errorf("query %q: err = %v, want ErrEventSearchQueryTooShort", query, err)
+		}
+	}
+
+	events, _, err := service.SearchPublicEvents(ctx, dto.EventSearchRequest{Query: " jazz "}, dto.PaginationRequest{})
+	if err != nil {
+		t.Fatalf("SearchPublicEvents: %v", err)
+	}
+	if got := eventIDs(events); len(got) != 1 || got[0] != event.ID {
+		t.Errorf("found events %v, want [%d]", got, event.ID)
+	}
+}
+
+func TestSearchCreatorEventsFindsDrafts(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	other := testutil.CreateCreator(t, db)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+	service.eventConfig.SearchMinQueryLength = 2
+	named := func(name string, status domain.EventStatus) func(event *domain.Event) {
+		return func(event *domain.Event) {
+			event.Name = name
+			event.Status = status
+		}
+	}
+	draft := testutil.CreateEvent(t, db, creator.ID, named("Summer Rooftop Draft", domain.EventStatusDraft))
+	published := testutil.CreateEvent(t, db, creator.ID, named("Summer Festival", domain.EventStatusPublished))
+	testutil.CreateEvent(t, db, creator.ID, named("Winter Gala", domain.EventStatusDraft))
+	testutil.CreateEvent(t, db, other.ID, named("Summer Rooftop Draft", domain.EventStatusDraft))
+
+	events, paginationResp, err := service.SearchCreatorEvents(ctx, creator.UserID, "rooftop", nil, dto.PaginationRequest{})
+	if err != nil {
+		t.Fatalf("SearchCreatorEvents: %v", err)
+	}
+	if got := eventIDs(events); len(got) != 1 || got[0] != draft.ID {
+		t.Errorf("found events %v, want only the draft %d", got, draft.ID)
+	}
+	if paginationResp.Total != 1 {
+		t.Errorf("total = %d, want 1", paginationResp.Total)
+	}
+
+	drafts := domain.EventStatusDraft
+	events, _, err = service.SearchCreatorEvents(ctx, creator.UserID, "summer", &drafts, dto.PaginationRequest{})
+	if err != nil {
+		t.Fatalf("SearchCreatorEvents with a status: %v", err)
+	}
+	if got := eventIDs(events); len(got) != 1 || got[0] != draft.ID {
+		t.Errorf("found drafts %v, want only %d and not the published %d", got, draft.ID, published.ID)
+	}
+
+	if _, _, err := service.SearchCreatorEvents(ctx, creator.UserID, " s ", nil, dto.PaginationRequest{}); !errors.Is(err, domain.ErrEventSearchQueryTooShort) {
+		t.Errorf("short query: err = %v, want ErrEventSearchQueryTooShort", err)
+	}
+	unknown := domain.EventStatus("archived")
+	if _, _, err := service.SearchCreatorEvents(ctx, creator.UserID, "summer", &unknown, dto.PaginationRequest{}); !errors.Is(err, domain.ErrEventInvalidStatus) {
+		t.Errorf("unknown status: err = %v, want ErrEventInvalidStatus", err)
+	}
+}
+
+func TestGetEventsWithFiltersRadiusCategoryAndDates(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+
+	// Taksim, Kadıköy (about 5 km away) and Ankara (about 350 km away)
+	taksim := testutil.CreateAddress(t, db, "Türkiye", "Istanbul", 41.0370, 28.9850)
+	kadikoy := testutil.CreateAddress(t, db, "Türkiye", "Istanbul", 40.9900, 29.0290)
+	ankara := testutil.CreateAddress(t, db, "Türkiye", "Ankara", 39.9208, 32.8541)
+
+	inWindow := time.Now().UTC().AddDate(0, 0, 10)
+	outOfWindow := time.Now().UTC().AddDate(0, 0, 40)
+	event := func(address *domain.Address, start time.Time) *domain.Event {
+		return testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+			testutil.AtAddress(address)(event)
+			event.StartDate = &start
+		})
+	}
+	match := event(kadikoy, inWindow)
+	tooFar := event(ankara, inWindow)
+	tooLate := event(taksim, outOfWindow)
+	otherCategory := event(taksim, inWindow)
+	concerts := testutil.CreateCategory(t, db, "Concerts", match.ID, tooFar.ID, tooLate.ID)
+	testutil.CreateCategory(t, db, "Talks", otherCategory.ID)
+
+	latitude, longitude, radius := taksim.Latitude, taksim.Longitude, 25.0
+	startDate := time.Now().UTC().Format("2006-01-02")
+	endDate := time.Now().UTC().AddDate(0, 0, 30).Format("2006-01-02")
+	filters := dto.EventFilterRequest{
+		Latitude:    &latitude,
+		Longitude:   &longitude,
+		RadiusKm:    &radius,
+		CategoryIDs: []int{concerts.ID},
+		StartDate:   &startDate,
+		EndDate:     &endDate,
+	}
+
+	events, paginationResp, err := service.GetEventsWithFilters(ctx, filters, dto.PaginationRequest{}, nil)
+	if err != nil {
+		t.Fatalf("GetEventsWithFilters: %v", err)
+	}
+	if got := eventIDs(events); len(got) != 1 || got[0] != match.ID {
+		t.Errorf("found events %v, want only %d", got, match.ID)
+	}
+	if paginationResp.Total != 1 {
+		t.Errorf("total = %d, want 1", paginationResp.Total)
+	}
+
+	filters.Longitude = nil
+	if _, _, err := service.GetEventsWithFilters(ctx, filters, dto.PaginationRequest{}, nil); !errors.Is(err, domain.ErrEventCoordinatesRequired) {
+		t.Errorf("radius without a longitude: err = %v, want ErrEventCoordinatesRequired", err)
+	}
+}
+
+func TestHidePrivateExistence(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	invitee := testutil.CreateUser(t, db, domain.UserTypeUser)
+	stranger := testutil.CreateUser(t, db, domain.UserTypeUser)
+
+	private := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+		event.Type = domain.EventTypePrivate
+	})
+	if err := db.Omit("Event", "InvitedUser").Create(domain.NewInvitation(private.ID, *invitee.Email, &invitee.ID)).Error; err != nil {
+		t.Fatalf("failed to create invitation: %v", err)
+	}
+	public := testutil.CreateEvent(t, db, creator.ID, nil)
+
+	tests := []struct {
+		name    string
+		eventID int
+		userID  int
+		action  domain.EventAction
+		hidden  error
+		shown   error
+	}{
+		{"stranger views a private event", private.ID, stranger.ID, domain.EventActionView, domain.ErrEventNotFound, domain.ErrEventUnauthorized},
+		{"stranger manages a private event", private.ID, stranger.ID, domain.EventActionManage, domain.ErrEventNotFound, domain.ErrEventUnauthorized},
+		{"invitee manages a private event", private.ID, invitee.ID, domain.EventActionManage, domain.ErrEventUnauthorized, domain.ErrEventUnauthorized},
+		{"stranger manages a public event", public.ID, stranger.ID, domain.EventActionManage, domain.ErrEventUnauthorized, domain.ErrEventUnauthorized},
+	}
+	for _, hide := range []bool{true, false} {
+		events := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+		events.eventConfig.HidePrivateExistence = hide
+		shares := NewEventShareService(events, postgres.NewEventRepository(db), postgres.NewShareTokenAccessRepository(db), nil, zerolog.Nop())
+
+		for _, tt := range tests {
+			t.Run(fmt.Sprintf("%s/hidden=%v", tt.name, hide), func(t *testing.T) {
+				want := tt.shown
+				if hide {
+					want = tt.hidden
+				}
+				if err := events.AuthorizeEvent(ctx, tt.eventID, &tt.userID, tt.action); !errors.Is(err, want) {
+					t.Errorf("AuthorizeEvent: err = %v, want %v", err, want)
+				}
+				// Owner-only operations in other services report the same error
+				if tt.action == domain.EventActionManage {
+					if _, err := shares.GetShareToken(ctx, tt.eventID, tt.userID); !errors.Is(err, want) {
+						t.Errorf("GetShareToken: err = %v, want %v", err, want)
+					}
+				}
+			})
+		}
+	}
+}
+
+// runningNow makes a CreateEvent event start an hour ago and end in an hour
+func runningNow(event *domain.Event) {
+	start := time.Now().UTC().Add(-time.Hour)
+	end := start.Add(2 * time.Hour)
+	event.StartDate, event.StartTime = &start, &start
+	event.EndDate, event.EndTime = &end, &end
+}
+
+func TestGetOngoingEventsListsOnlyPublicEvents(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+
+	public := testutil.CreateEvent(t, db, creator.ID, runningNow)
+	testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+		runningNow(event)
+		event.Type = domain.EventTypePrivate
+	})
+	testutil.CreateEvent(t, db, creator.ID, nil) // starts tomorrow
+
+	events, paginationResp, err := service.GetOngoingEvents(ctx, dto.PaginationRequest{})
+	if err != nil {
+		t.Fatalf("GetOngoingEvents: %v", err)
+	}
+	if len(events) != 1 || events[0].ID != public.ID {
+		t.Errorf("ongoing = %v, want only the public event %d", eventIDs(events), public.ID)
+	}
+	if paginationResp.Total != 1 {
+		t.Errorf("total = %d, want 1", paginationResp.Total)
+	}
+}
+
+func TestGetOngoingEventsUsesEventTimezone(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+
+	// Wall-clock times fourteen hours ahead of UTC; read as UTC the event would start tomorrow
+	kiritimati, err := time.LoadLocation("Pacific/Kiritimati")
+	if err != nil {
+		t.Fatalf("failed to load timezone: %v", err)
+	}
+	local := func(at time.Time) *time.Time {
+		wall := at.In(kiritimati)
+		naive := time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), 0, time.UTC)
+		return &naive
+	}
+	ongoing := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+		event.Timezone = "Pacific/Kiritimati"
+		event.StartDate = local(time.Now().Add(-time.Hour))
+		event.StartTime = event.StartDate
+		event.EndDate = local(time.Now().Add(time.Hour))
+		event.EndTime = event.EndDate
+	})
+
+	events, _, err := service.GetOngoingEvents(ctx, dto.PaginationRequest{})
+	if err != nil {
+		t.Fatalf("GetOngoingEvents: %v", err)
+	}
+	if len(events) != 1 || events[0].ID != ongoing.ID {
+		t.Errorf("ongoing = %v, want the event %d running in its own timezone", eventIDs(events), ongoing.ID)
+	}
+}
+
+func TestEventTimezoneDefaults(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	if err := db.Model(creator).Update("timezone", "Europe/Istanbul").Error; err != nil {
+		t.Fatalf("failed to set creator timezone: %v", err)
+	}
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+
+	venue := func(timezone string) *domain.Address {
+		t.Helper()
+		address := testutil.CreateAddress(t, db, "Germany", "Berlin", 52.52, 13.405)
+		if err := db.Model(address).Update("timezone", timezone).Error; err != nil {
+			t.Fatalf("failed to set address timezone: %v", err)
+		}
+		return address
+	}
+	berlin := venue("Europe/Berlin")
+	newYork := venue("America/New_York")
+	onlineURL := "https://meet.example.com/event"
+
+	located, err := service.CreateEvent(ctx, creator.UserID, dto.CreateEventRequest{
+		Name: "Venue Event", Type: domain.EventTypePublic, LocationType: domain.EventLocationTypeLocation,
+		AddressID: &berlin.ID, Timezone: "Asia/Tokyo",
+	})
+	if err != nil {
+		t.Fatalf("CreateEvent at a venue: %v", err)
+	}
+	if located.Timezone != "Europe/Berlin" {
+		t.Errorf("location event timezone = %q, want the venue's Europe/Berlin", located.Timezone)
+	}
+
+	moved, err := service.UpdateEvent(ctx, located.ID, creator.UserID, dto.UpdateEventRequest{AddressID: &newYork.ID})
+	if err != nil {
+		t.Fatalf("UpdateEvent to another venue: %v", err)
+	}
+	if moved.Timezone != "America/New_York" {
+		t.Errorf("moved event timezone = %q, want America/New_York", moved.Timezone)
+	}
+
+	online, err := service.CreateEvent(ctx, creator.UserID, dto.CreateEventRequest{
+		Name: "Online Event", Type: domain.EventTypePublic, LocationType: domain.EventLocationTypeOnline,
+		OnlineEventURL: &onlineURL,
+	})
+	if err != nil {
+		t.Fatalf("CreateEvent online: %v", err)
+	}
+	if online.Timezone != "Europe/Istanbul" {
+		t.Errorf("online event timezone = %q, want the creator's Europe/Istanbul", online.Timezone)
+	}
+
+	explicit, err := service.CreateEvent(ctx, creator.UserID, dto.CreateEventRequest{
+		Name: "Online Tokyo Event", Type: domain.EventTypePublic, LocationType: domain.EventLocationTypeOnline,
+		OnlineEventURL: &onlineURL, Timezone: "Asia/Tokyo",
+	})
+	if err != nil {
+		t.Fatalf("CreateEvent online with a timezone: %v", err)
+	}
+	if explicit.Timezone != "Asia/Tokyo" {
+		t.Errorf("online event with a timezone = %q, want Asia/Tokyo", explicit.Timezone)
+	}
+}
+
+func TestCreatePrivateEventWithInvitations(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	category := testutil.CreateCategory(t, db, "Dinner")
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+
+	newRequest := func(name string, invitedUserID *int) dto.CreateEventRequest {
+		req := dto.CreateEventRequest{
+			Name:         name,
+			Type:         domain.EventTypePrivate,
+			LocationType: domain.EventLocationTypeAnnouncement,
+			CategoryIDs:  []int{category.ID},
+		}
+		for i := range 5 {
+			req.Invitations = append(req.Invitations, dto.CreateInvitationRequest{InvitedEmail: fmt.Sprintf("guest%d@example.com", i)})
+		}
+		req.Invitations[4].InvitedUserID = invitedUserID
+		return req
+	}
+
+	created, err := service.CreateEvent(ctx, creator.UserID, newRequest("Supper club", nil))
+	if err != nil {
+		t.Fatalf("CreateEvent: %v", err)
+	}
+	var invitations []domain.Invitation
+	if err := db.Where("event_id = ?", created.ID).Find(&invitations).Error; err != nil {
+		t.Fatalf("failed to load invitations: %v", err)
+	}
+	if len(invitations) != 5 {
+		t.Fatalf("%d invitations created, want 5", len(invitations))
+	}
+	for _, invitation := range invitations {
+		if invitation.Status != domain.InvitationStatusPending || invitation.Token == nil {
+			t.Errorf("invitation %+v, want pending with an RSVP token", invitation)
+		}
+	}
+
+	// An invitee that cannot be stored takes the event and its categories down with it
+	missingUser := 999999
+	if _, err := service.CreateEvent(ctx, creator.UserID, newRequest("Broken supper club", &missingUser)); err == nil {
+		t.Fatal("CreateEvent with an unknown invited user succeeded")
+	}
+	var events, links int64
+	if err := db.Model(&domain.Event{}).Where("name = ?", "Broken supper club").Count(&events).Error; err != nil {
+		t.Fatalf("failed to count events: %v", err)
+	}
+	if err := db.Model(&domain.EventCategory{}).Where("category_id = ?", category.ID).Count(&links).Error; err != nil {
+		t.Fatalf("failed to count categories: %v", err)
+	}
+	if events != 0 || links != 1 {
+		t.Errorf("%d events and %d category links after the failed create, want 0 and 1", events, links)
+	}
+}
+
+func TestGetCreatorEventsByStatus(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	other := testutil.CreateCreator(t, db)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+
+	withStatus := func(status domain.EventStatus) func(*domain.Event) {
+		return func(event *domain.Event) { event.Status = status }
+	}
+	for _, status := range []domain.EventStatus{domain.EventStatusDraft, domain.EventStatusDraft, domain.EventStatusPublished, domain.EventStatusCancelled} {
+		testutil.CreateEvent(t, db, creator.ID, withStatus(status))
+	}
+	testutil.CreateEvent(t, db, other.ID, withStatus(domain.EventStatusDraft))
+
+	drafts, page, err := service.GetCreatorEventsByStatus(ctx, creator.UserID, domain.EventStatusDraft, dto.PaginationRequest{Page: 1, PageSize: 1})
+	if err != nil {
+		t.Fatalf("GetCreatorEventsByStatus: %v", err)
+	}
+	if len(drafts) != 1 || page.Total != 2 || page.TotalPages != 2 {
+		t.Errorf("got %d drafts of %d on %d pages, want 1 of 2 on 2 pages", len(drafts), page.Total, page.TotalPages)
+	}
+
+	// A single status matches the multi-status listing for that status
+	single, _, err := service.GetCreatorEventsByStatuses(ctx, creator.UserID, []domain.EventStatus{domain.EventStatusDraft}, dto.PaginationRequest{Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("GetCreatorEventsByStatuses: %v", err)
+	}
+	all, _, err := service.GetCreatorEventsByStatus(ctx, creator.UserID, domain.EventStatusDraft, dto.PaginationRequest{Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("GetCreatorEventsByStatus: %v", err)
+	}
+	if len(single) != 2 || len(all) != 2 || single[0].ID != all[0].ID || single[1].ID != all[1].ID {
+		t.Errorf("by status %d events, by statuses %d events; want the same 2 drafts", len(all), len(single))
+	}
+}
+
+func TestCreatorStatusListsReturnOnlyTheirStatus(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+
+	lists := map[domain.EventStatus]func(context.Context, int, dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error){
+		domain.EventStatusDraft:     service.GetCreatorDraftEvents,
+		domain.EventStatusPublished: service.GetCreatorPublishedEvents,
+		domain.EventStatusPending:   service.GetCreatorPendingEvents,
+		domain.EventStatusRejected:  service.GetCreatorRejectedEvents,
+		domain.EventStatusStopped:   service.GetCreatorStoppedEvents,
+		domain.EventStatusCancelled: service.GetCreatorCancelledEvents,
+	}
+	created := make(map[domain.EventStatus]int, len(lists))
+	for status := range lists {
+		created[status] = testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) { event.Status = status }).ID
+	}
+
+	for status, list := range lists {
+		events, _, err := list(ctx, creator.UserID, dto.PaginationRequest{})
+		if err != nil {
+			t.Fatalf("listing %s events: %v", status, err)
+		}
+		if got := eventIDs(events); !slices.Equal(got, []int{created[status]}) {
+			t.Errorf("%s events = %v, want [%d]", status, got, created[status])
+		}
+	}
+}
+
+func TestCountEventsWithFiltersMatchesFetch(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	viewer := testutil.CreateUser(t, db, domain.UserTypeUser)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+	private := func(event *domain.Event) { event.Type = domain.EventTypePrivate }
+
+	testutil.CreateEvent(t, db, creator.ID, nil)
+	testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) { event.Status = domain.EventStatusDraft })
+	testutil.CreateEvent(t, db, creator.ID, private)
+	invitedByID := testutil.CreateEvent(t, db, creator.ID, private)
+	if err := db.Omit("Event", "InvitedUser").Create(domain.NewInvitation(invitedByID.ID, *viewer.Email, &viewer.ID)).Error; err != nil {
+		t.Fatalf("failed to create invitation: %v", err)
+	}
+	// Invited before the email was linked to the account
+	invitedByEmail := testutil.CreateEvent(t, db, creator.ID, private)
+	createInvitation(t, db, invitedByEmail.ID, strings.ToUpper(*viewer.Email))
+
+	tests := []struct {
+		name   string
+		userID *int
+		want   int64
+	}{
+		{"anonymous", nil, 1},
+		{"invitee", &viewer.ID, 3},
+		{"owner", &creator.UserID, 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			count, err := service.CountEventsWithFilters(ctx, dto.EventFilterRequest{}, tt.userID)
+			if err != nil {
+				t.Fatalf("CountEventsWithFilters: %v", err)
+			}
+			events, page, err := service.GetEventsWithFilters(ctx, dto.EventFilterRequest{}, dto.PaginationRequest{Page: 1, PageSize: 10}, tt.userID)
+			if err != nil {
+				t.Fatalf("GetEventsWithFilters: %v", err)
+			}
+			if count != tt.want || int64(len(events)) != count || int64(page.Total) != count {
+				t.Errorf("count %d, fetched %d of %d; want %d everywhere", count, len(events), page.Total, tt.want)
+			}
+		})
+	}
+
+	// The policy check agrees with the listing for the email-only invitation
+	if ok, _ := service.CanUserAccessEvent(ctx, invitedByEmail.ID, &viewer.ID); !ok {
+		t.Error("CanUserAccessEvent denies an event the user was invited to by email")
+	}
+}
+
+func TestGetAllEventsByStatusListsEveryCreator(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	first := testutil.CreateCreator(t, db)
+	second := testutil.CreateCreator(t, db)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+	cancelled := func(event *domain.Event) { event.Status = domain.EventStatusCancelled }
+
+	want := map[int]bool{
+		testutil.CreateEvent(t, db, first.ID, cancelled).ID:  true,
+		testutil.CreateEvent(t, db, second.ID, cancelled).ID: true,
+	}
+	testutil.CreateEvent(t, db, first.ID, nil)
+
+	events, page, err := service.GetAllEventsByStatus(ctx, dto.AdminEventFilterRequest{Status: domain.EventStatusCancelled}, dto.PaginationRequest{Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("GetAllEventsByStatus: %v", err)
+	}
+	if len(events) != 2 || page.Total != 2 {
+		t.Fatalf("got %d of %d events, want the 2 cancelled ones", len(events), page.Total)
+	}
+	for _, event := range events {
+		if !want[event.ID] || event.Status != domain.EventStatusCancelled {
+			t.Errorf("listed event %d (%s), want only the cancelled events", event.ID, event.Status)
+		}
+	}
+
+	// The creator filter narrows the listing to one creator
+	events, _, err = service.GetAllEventsByStatus(ctx, dto.AdminEventFilterRequest{Status: domain.EventStatusCancelled, CreatorID: &second.ID}, dto.PaginationRequest{Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("GetAllEventsByStatus by creator: %v", err)
+	}
+	if len(events) != 1 || events[0].CreatorID != second.ID {
+		t.Errorf("got %d events, want the second creator's cancelled event", len(events))
+	}
+}
+
+func TestGetSimilarEventsRanksCategoryOverlap(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	other := testutil.CreateCreator(t, db)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+	berlin := testutil.CreateAddress(t, db, "Germany", "Berlin", 52.52, 13.40)
+	lisbon := testutil.CreateAddress(t, db, "Portugal", "Lisbon", 38.72, -9.14)
+	named := func(name string, mutate func(*domain.Event)) func(*domain.Event) {
+		return func(event *domain.Event) {
+			event.Name = name
+			if mutate != nil {
+				mutate(event)
+			}
+		}
+	}
+
+	source := testutil.CreateEvent(t, db, creator.ID, named("Jazz Night", testutil.AtAddress(berlin)))
+	bothCategories := testutil.CreateEvent(t, db, other.ID, named("Blues Evening", testutil.AtAddress(lisbon)))
+	oneCategory := testutil.CreateEvent(t, db, other.ID, named("Swing Workshop", testutil.AtAddress(lisbon)))
+	sameCity := testutil.CreateEvent(t, db, other.ID, named("Berlin Meetup", testutil.AtAddress(berlin)))
+	unrelated := testutil.CreateEvent(t, db, other.ID, named("Chess Club", testutil.AtAddress(lisbon)))
+	private := testutil.CreateEvent(t, db, other.ID, named("Private Jam", func(event *domain.Event) { event.Type = domain.EventTypePrivate }))
+	duplicate := testutil.CreateEvent(t, db, creator.ID, named("Jazz Night", nil))
+
+	testutil.CreateCategory(t, db, "Jazz", source.ID, bothCategories.ID, oneCategory.ID, private.ID, duplicate.ID)
+	testutil.CreateCategory(t, db, "Live Music", source.ID, bothCategories.ID, private.ID, duplicate.ID)
+	testutil.CreateCategory(t, db, "Games", unrelated.ID)
+
+	similar, err := service.GetSimilarEvents(ctx, source.ID, 10)
+	if err != nil {
+		t.Fatalf("GetSimilarEvents: %v", err)
+	}
+	var got []int
+	for _, event := range similar {
+		got = append(got, event.ID)
+	}
+	want := []int{bothCategories.ID, oneCategory.ID, sameCity.ID}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("similar events = %v, want %v ranked by shared categories, then same city", got, want)
+	}
+
+	// Without any category the same city is the only signal
+	uncategorized := testutil.CreateEvent(t, db, creator.ID, named("Open Air", testutil.AtAddress(berlin)))
+	similar, err = service.GetSimilarEvents(ctx, uncategorized.ID, 10)
+	if err != nil {
+		t.Fatalf("GetSimilarEvents without categories: %v", err)
+	}
+	for _, event := range similar {
+		if event.ID != source.ID && event.ID != sameCity.ID {
+			t.Errorf("similar to an uncategorized event: %d, want only Berlin events", event.ID)
+		}
+	}
+	if len(similar) != 2 {
+		t.Errorf("got %d similar events, want the 2 other Berlin events", len(similar))
+	}
+
+	if _, err := service.GetSimilarEvents(ctx, source.ID+1000, 10); !errors.Is(err, domain.ErrEventNotFound) {
+		t.Errorf("GetSimilarEvents of a missing event: err = %v, want ErrEventNotFound", err)
+	}
+}
+
+// countQueries counts the queries run against a table from now on
+func countQueries(t *testing.T, db *gorm.DB, table string) *int {
+	t.Helper()
+	count := new(int)
+	err := db.Callback().Query().After("gorm:query").Register("test:count_"+table, func(tx *gorm.DB) {
+		if tx.Statement.Table == table {
+			*count++
+		}
+	})
+	if err != nil {
+		t.Fatalf("failed to register query counter: %v", err)
+	}
+	return count
+}
+
+func TestGetMultipleEventsByIDsChunksLongLists(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	repo := postgres.NewEventRepository(db)
+
+	want := make(map[int]bool)
+	ids := make([]int, 0, 1201)
+	for range 1201 {
+		event := testutil.CreateEvent(t, db, creator.ID, nil)
+		want[event.ID] = true
+		ids = append(ids, event.ID)
+	}
+	queries := countQueries(t, db, "events")
+
+	events, err := repo.GetMultipleByIDs(ctx, ids)
+	if err != nil {
+		t.Fatalf("GetMultipleByIDs: %v", err)
+	}
+	if *queries != 3 {
+		t.Errorf("ran %d event queries, want 3 chunks of at most 500 ids", *queries)
+	}
+	if len(events) != len(ids) {
+		t.Fatalf("got %d events, want %d", len(events), len(ids))
+	}
+	for _, event := range events {
+		if !want[event.ID] || event.Creator.ID != creator.ID {
+			t.Fatalf("event %d is unexpected or missing its creator", event.ID)
+		}
+		delete(want, event.ID)
+	}
+
+	// Past the limit the lookup is refused without querying
+	*queries = 0
+	tooMany := make([]int, 5001)
+	for i := range tooMany {
+		tooMany[i] = i + 1
+	}
+	if _, err := repo.GetMultipleByIDs(ctx, tooMany); err == nil {
+		t.Error("GetMultipleByIDs with 5001 ids succeeded, want the limit error")
+	}
+	if *queries != 0 {
+		t.Errorf("ran %d event queries for a refused lookup", *queries)
+	}
+}
+
+func TestGetCreatorUpcomingEventsListsFuturePublicEvents(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	other := testutil.CreateCreator(t, db)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+
+	startingIn := func(days int, mutate func(*domain.Event)) func(*domain.Event) {
+		return func(event *domain.Event) {
+			start := time.Now().UTC().AddDate(0, 0, days)
+			event.StartDate = &start
+			if mutate != nil {
+				mutate(event)
+			}
+		}
+	}
+	later := testutil.CreateEvent(t, db, creator.ID, startingIn(10, nil))
+	soonest := testutil.CreateEvent(t, db, creator.ID, startingIn(2, nil))
+	next := testutil.CreateEvent(t, db, creator.ID, startingIn(5, nil))
+	testutil.CreateEvent(t, db, creator.ID, startingIn(-3, nil))
+	testutil.CreateEvent(t, db, creator.ID, startingIn(3, func(event *domain.Event) { event.Type = domain.EventTypePrivate }))
+	testutil.CreateEvent(t, db, creator.ID, startingIn(3, func(event *domain.Event) { event.Status = domain.EventStatusDraft }))
+	testutil.CreateEvent(t, db, other.ID, startingIn(1, nil))
+
+	events, page, err := service.GetCreatorUpcomingEvents(ctx, creator.ID, dto.PaginationRequest{Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("GetCreatorUpcomingEvents: %v", err)
+	}
+	want := []int{soonest.ID, next.ID, later.ID}
+	got := make([]int, 0, len(events))
+	for _, event := range events {
+		got = append(got, event.ID)
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) || page.Total != 3 {
+		t.Errorf("got events %v of %d, want %v soonest first", got, page.Total, want)
+	}
+}
+
+func TestGetEventsStartingSoonWithinWindow(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+
+	startingIn := func(hours int, mutate func(*domain.Event)) func(*domain.Event) {
+		return func(event *domain.Event) {
+			start := time.Now().UTC().Add(time.Duration(hours) * time.Hour)
+			event.StartDate, event.StartTime = &start, &start
+			if mutate != nil {
+				mutate(event)
+			}
+		}
+	}
+	tomorrow := testutil.CreateEvent(t, db, creator.ID, startingIn(24, nil))
+	soon := testutil.CreateEvent(t, db, creator.ID, startingIn(2, nil))
+	testutil.CreateEvent(t, db, creator.ID, startingIn(100, nil))
+	testutil.CreateEvent(t, db, creator.ID, startingIn(-2, nil))
+	testutil.CreateEvent(t, db, creator.ID, startingIn(3, func(event *domain.Event) { event.Type = domain.EventTypePrivate }))
+
+	// Nobody can attend an event whose tickets are gone
+	soldOut := testutil.CreateEvent(t, db, creator.ID, startingIn(5, func(event *domain.Event) { event.HasSystemTickets = true }))
+	ticket := testutil.CreateTicket(t, db, soldOut.ID, 2)
+	if err := db.Model(ticket).Update("sold_quantity", 2).Error; err != nil {
+		t.Fatalf("failed to sell tickets: %v", err)
+	}
+
+	events, page, err := service.GetEventsStartingSoon(ctx, 48, nil, dto.PaginationRequest{Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("GetEventsStartingSoon: %v", err)
+	}
+	want := []int{soon.ID, tomorrow.ID}
+	got := make([]int, 0, len(events))
+	for _, event := range events {
+		got = append(got, event.ID)
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) || page.Total != 2 {
+		t.Errorf("got events %v of %d, want %v soonest first", got, page.Total, want)
+	}
+}
+
+func TestUpcomingEventsIncludeUndatedAnnouncements(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+
+	dated := testutil.CreateEvent(t, db, creator.ID, nil)
+	announcement := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+		event.LocationType = domain.EventLocationTypeAnnouncement
+		event.StartDate = nil
+	})
+	page := dto.PaginationRequest{Page: 1, PageSize: 10}
+	ids := func(events []*dto.EventListResponse) string {
+		got := make([]int, 0, len(events))
+		for _, event := range events {
+			got = append(got, event.ID)
+		}
+		return fmt.Sprint(got)
+	}
+
+	upcoming, _, err := service.GetUpcomingEvents(ctx, false, page)
+	if err != nil {
+		t.Fatalf("GetUpcomingEvents: %v", err)
+	}
+	if got, want := ids(upcoming), fmt.Sprint([]int{dated.ID}); got != want {
+		t.Errorf("upcoming events = %s, want %s", got, want)
+	}
+
+	// Asked for, the announcement follows the dated events
+	upcoming, _, err = service.GetUpcomingEvents(ctx, true, page)
+	if err != nil {
+		t.Fatalf("GetUpcomingEvents with announcements: %v", err)
+	}
+	if got, want := ids(upcoming), fmt.Sprint([]int{dated.ID, announcement.ID}); got != want {
+		t.Errorf("upcoming events with announcements = %s, want %s", got, want)
+	}
+
+	announcements, _, err := service.GetAnnouncements(ctx, page)
+	if err != nil {
+		t.Fatalf("GetAnnouncements: %v", err)
+	}
+	if got, want := ids(announcements), fmt.Sprint([]int{announcement.ID}); got != want {
+		t.Errorf("announcements = %s, want %s", got, want)
+	}
+}
+
+func TestGetEventShareMeta(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+	service.eventConfig.ShareBaseURL = "https://louco.test/"
+	service.eventConfig.ShareFallbackImageURL = "https://louco.test/card.png"
+
+	image := domain.NewMedia(creator.UserID, "cover.jpg", "cover.jpg", "uploads/cover.jpg", "https://cdn.louco.test/cover.jpg", domain.MediaTypeImage, "image/jpeg", 10)
+	image.SetDimensions(1200, 630)
+	if err := postgres.NewMediaRepository(db).Create(ctx, image); err != nil {
+		t.Fatalf("failed to create image: %v", err)
+	}
+	description := "<p>Live <b>jazz</b>\n\n on the roof.</p> " + strings.Repeat("Bring friends. ", 30)
+	event := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+		event.Name = "Rooftop Jazz"
+		event.Description = &description
+		event.ImageID = &image.ID
+	})
+
+	meta, err := service.GetEventShareMeta(ctx, event.ID)
+	if err != nil {
+		t.Fatalf("GetEventShareMeta: %v", err)
+	}
+	if meta.Title != "Rooftop Jazz" || meta.CanonicalURL != fmt.Sprintf("https://louco.test/events/%d", event.ID) {
+		t.Errorf("title %q, canonical url %q", meta.Title, meta.CanonicalURL)
+	}
+	if !strings.HasPrefix(meta.Description, "Live jazz on the roof. Bring friends.") || !strings.HasSuffix(meta.Description, "…") || len([]rune(meta.Description)) > 200 {
+		t.Errorf("description = %q, want plain text truncated to 200 runes", meta.Description)
+	}
+	if meta.ImageURL == nil || *meta.ImageURL != image.FileURL || meta.ImageWidth == nil || *meta.ImageWidth != 1200 {
+		t.Errorf("image = %v (%v wide), want the event image", meta.ImageURL, meta.ImageWidth)
+	}
+
+	// Events without an image fall back to the generated card
+	plain := testutil.CreateEvent(t, db, creator.ID, nil)
+	meta, err = service.GetEventShareMeta(ctx, plain.ID)
+	if err != nil {
+		t.Fatalf("GetEventShareMeta: %v", err)
+	}
+	if meta.ImageURL == nil || *meta.ImageURL != "https://louco.test/card.png" {
+		t.Errorf("image = %v, want the fallback card", meta.ImageURL)
+	}
+
+	hidden := map[string]*domain.Event{
+		"private": testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) { event.Type = domain.EventTypePrivate }),
+		"draft":   testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) { event.Status = domain.EventStatusDraft }),
+	}
+	for name, event := range hidden {
+		if _, err := service.GetEventShareMeta(ctx, event.ID); err == nil || err.Error() != "event not found" {
+			t.Errorf("%s event: err = %v, want event not found", name, err)
+		}
+	}
+}
+
+func TestGetCreatorStatusCountsMatchesPerStatusCounts(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	other := testutil.CreateCreator(t, db)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+	repo := postgres.NewEventRepository(db)
+
+	for _, status := range []domain.EventStatus{domain.EventStatusDraft, domain.EventStatusDraft, domain.EventStatusDraft, domain.EventStatusPublished, domain.EventStatusCancelled} {
+		testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) { event.Status = status })
+	}
+	testutil.CreateEvent(t, db, other.ID, func(event *domain.Event) { event.Status = domain.EventStatusPending })
+
+	counts, err := service.GetCreatorStatusCounts(ctx, creator.UserID)
+	if err != nil {
+		t.Fatalf("GetCreatorStatusCounts: %v", err)
+	}
+	statuses := []domain.EventStatus{
+		domain.EventStatusDraft, domain.EventStatusPending, domain.EventStatusRejected,
+		domain.EventStatusStopped, domain.EventStatusCancelled, domain.EventStatusPublished,
+	}
+	if len(counts) != len(statuses) {
+		t.Errorf("counts = %v, want every status", counts)
+	}
+	for _, status := range statuses {
+		want, err := repo.CountByCreatorIDAndStatus(ctx, creator.ID, status)
+		if err != nil {
+			t.Fatalf("CountByCreatorIDAndStatus(%s): %v", status, err)
+		}
+		if got, ok := counts[status]; !ok || got != want {
+			t.Errorf("%s = %d (present %v), want %d", status, got, ok, want)
+		}
+	}
+	if counts[domain.EventStatusDraft] != 3 {
+		t.Errorf("drafts = %d, want 3", counts[domain.EventStatusDraft])
+	}
+}
+
+func TestGetPublicEventsByLocationNarrowsByCountry(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+
+	france := testutil.CreateEvent(t, db, creator.ID, testutil.AtAddress(testutil.CreateAddress(t, db, "France", "Paris", 48.8566, 2.3522)))
+	texas := testutil.CreateEvent(t, db, creator.ID, testutil.AtAddress(testutil.CreateAddress(t, db, "United States", "Paris", 33.6609, -95.5555)))
+	page := dto.PaginationRequest{Page: 1, PageSize: 10}
+
+	tests := []struct {
+		country string
+		want    []int
+	}{
+		{"France", []int{france.ID}},
+		{"united states", []int{texas.ID}},
+		{"", []int{texas.ID, france.ID}},
+	}
+	for _, tt := range tests {
+		events, _, err := service.GetPublicEventsByLocation(ctx, "Paris", tt.country, page)
+		if err != nil {
+			t.Fatalf("GetPublicEventsByLocation(%q): %v", tt.country, err)
+		}
+		got := make([]int, 0, len(events))
+		for _, event := range events {
+			got = append(got, event.ID)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("country %q: got events %v, want %v", tt.country, got, tt.want)
+		}
+	}
+}
+
+func TestGetEventsWithFiltersByCityAndCountry(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+
+	france := testutil.CreateEvent(t, db, creator.ID, testutil.AtAddress(testutil.CreateAddress(t, db, "France", "Paris", 48.8566, 2.3522)))
+	testutil.CreateEvent(t, db, creator.ID, testutil.AtAddress(testutil.CreateAddress(t, db, "United States", "Paris", 33.6609, -95.5555)))
+	testutil.CreateEvent(t, db, creator.ID, testutil.AtAddress(testutil.CreateAddress(t, db, "France", "Lyon", 45.764, 4.8357)))
+
+	city, country := "Paris", "France"
+	latitude, longitude, radius := 48.85, 2.35, 50.0
+	tests := []struct {
+		name    string
+		filters dto.EventFilterRequest
+	}{
+		{"city and country", dto.EventFilterRequest{City: &city, Country: &country}},
+		{"city, country and radius", dto.EventFilterRequest{City: &city, Country: &country, Latitude: &latitude, Longitude: &longitude, RadiusKm: &radius}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, page, err := service.GetEventsWithFilters(ctx, tt.filters, dto.PaginationRequest{Page: 1, PageSize: 10}, nil)
+			if err != nil {
+				t.Fatalf("GetEventsWithFilters: %v", err)
+			}
+			if len(events) != 1 || events[0].ID != france.ID || page.Total != 1 {
+				t.Errorf("got %d events of %d, want only the Paris, France event %d", len(events), page.Total, france.ID)
+			}
+			count, err := service.CountEventsWithFilters(ctx, tt.filters, nil)
+			if err != nil {
+				t.Fatalf("CountEventsWithFilters: %v", err)
+			}
+			if count != 1 {
+				t.Errorf("count = %d, want 1", count)
+			}
+		})
+	}
+}
+
+func TestGetUpcomingEventsHasNoEndDate(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+
+	startingOn := func(start time.Time) func(*domain.Event) {
+		return func(event *domain.Event) { event.StartDate = &start }
+	}
+	soon := testutil.CreateEvent(t, db, creator.ID, nil)
+	farOff := testutil.CreateEvent(t, db, creator.ID, startingOn(time.Date(2100, time.January, 1, 0, 0, 0, 0, time.UTC)))
+	testutil.CreateEvent(t, db, creator.ID, startingOn(time.Now().UTC().AddDate(0, 0, -3)))
+
+	events, page, err := service.GetUpcomingEvents(ctx, false, dto.PaginationRequest{Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("GetUpcomingEvents: %v", err)
+	}
+	got := make([]int, 0, len(events))
+	for _, event := range events {
+		got = append(got, event.ID)
+	}
+	if want := []int{soon.ID, farOff.ID}; fmt.Sprint(got) != fmt.Sprint(want) || page.Total != 2 {
+		t.Errorf("got events %v of %d, want %v including the one in 2100", got, page.Total, want)
+	}
+}
+
+func TestPaginationIsStableForEqualCreatedAt(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	stamp := time.Now().UTC().Truncate(time.Second)
+	const rows, pageSize = 7, 3
+
+	// Pages through a listing and checks every row shows up once, in the wanted order
+	pageThrough := func(t *testing.T, want []int, fetch func(page dto.PaginationRequest) ([]int, error)) {
+		t.Helper()
+		var got []int
+		for page := 1; page <= (rows+pageSize-1)/pageSize; page++ {
+			ids, err := fetch(dto.PaginationRequest{Page: page, PageSize: pageSize})
+			if err != nil {
+				t.Fatalf("page %d: %v", page, err)
+			}
+			got = append(got, ids...)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("paged ids = %v, want %v", got, want)
+		}
+	}
+
+	event := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+		event.Type = domain.EventTypePrivate
+	})
+
+	t.Run("events", func(t *testing.T) {
+		var want []int
+		for range rows {
+			want = append(want, testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) { event.CreatedAt = stamp }).ID)
+		}
+		slices.Reverse(want)
+		repo := postgres.NewEventRepository(db)
+		pageThrough(t, want, func(page dto.PaginationRequest) ([]int, error) {
+			events, _, err := repo.GetPublicEvents(ctx, page)
+			ids := make([]int, 0, len(events))
+			for _, event := range events {
+				ids = append(ids, event.ID)
+			}
+			return ids, err
+		})
+	})
+
+	t.Run("invitations", func(t *testing.T) {
+		statuses := make([]domain.InvitationStatus, rows)
+		for i := range statuses {
+			statuses[i] = domain.InvitationStatusPending
+		}
+		var want []int
+		for _, invitation := range seedInvitations(t, db, event.ID, statuses, func(invitation *domain.Invitation) { invitation.CreatedAt = stamp }) {
+			want = append(want, invitation.ID)
+		}
+		slices.Reverse(want)
+		repo := postgres.NewInvitationRepository(db)
+		pageThrough(t, want, func(page dto.PaginationRequest) ([]int, error) {
+			invitations, _, err := repo.GetByEventID(ctx, event.ID, page)
+			ids := make([]int, 0, len(invitations))
+			for _, invitation := range invitations {
+				ids = append(ids, invitation.ID)
+			}
+			return ids, err
+		})
+	})
+
+	// Tickets list cheapest first, oldest first within a price
+	t.Run("tickets", func(t *testing.T) {
+		var want []int
+		for i := range rows {
+			ticket := domain.NewTicket(event.ID, fmt.Sprintf("Tier %d", i), 10, 10)
+			ticket.CreatedAt = stamp
+			if err := db.Omit("Event").Create(ticket).Error; err != nil {
+				t.Fatalf("failed to create ticket: %v", err)
+			}
+			want = append(want, ticket.ID)
+		}
+		repo := postgres.NewTicketRepository(db)
+		pageThrough(t, want, func(page dto.PaginationRequest) ([]int, error) {
+			tickets, _, err := repo.GetTicketsWithFilters(ctx, dto.TicketFilterRequest{EventID: &event.ID}, page)
+			ids := make([]int, 0, len(tickets))
+			for _, ticket := range tickets {
+				ids = append(ids, ticket.ID)
+			}
+			return ids, err
+		})
+	})
+}
+
+func TestDeletedEventIsHiddenButRestorable(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	other := testutil.CreateCreator(t, db)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+	repo := postgres.NewEventRepository(db)
+	draft := func(event *domain.Event) { event.Status = domain.EventStatusDraft }
+
+	event := testutil.CreateEvent(t, db, creator.ID, draft)
+	if err := service.DeleteEvent(ctx, event.ID, creator.UserID); err != nil {
+		t.Fatalf("DeleteEvent: %v", err)
+	}
+	if _, err := repo.GetByID(ctx, event.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("GetByID of a deleted event: err = %v, want ErrRecordNotFound", err)
+	}
+	trashed, _, err := service.GetCreatorTrashedEvents(ctx, creator.UserID, dto.PaginationRequest{})
+	if err != nil {
+		t.Fatalf("GetCreatorTrashedEvents: %v", err)
+	}
+	if got := eventIDs(trashed); !slices.Equal(got, []int{event.ID}) {
+		t.Errorf("trash = %v, want [%d]", got, event.ID)
+	}
+
+	// Only the owner restores, and only events that are in the trash
+	if _, err := service.RestoreEvent(ctx, event.ID, other.UserID); !errors.Is(err, domain.ErrEventNotFound) {
+		t.Errorf("restore by another creator: err = %v, want ErrEventNotFound", err)
+	}
+	restored, err := service.RestoreEvent(ctx, event.ID, creator.UserID)
+	if err != nil {
+		t.Fatalf("RestoreEvent: %v", err)
+	}
+	if restored.ID != event.ID || restored.Status != domain.EventStatusDraft {
+		t.Errorf("restored event = %d (%s), want %d as a draft", restored.ID, restored.Status, event.ID)
+	}
+	if _, err := repo.GetByID(ctx, event.ID); err != nil {
+		t.Errorf("GetByID of a restored event: %v", err)
+	}
+	if _, err := service.RestoreEvent(ctx, event.ID, creator.UserID); !errors.Is(err, domain.ErrEventNotFound) {
+		t.Errorf("restoring a live event: err = %v, want ErrEventNotFound", err)
+	}
+
+	// Purging removes the row for good, and only from the trash
+	if err := service.PurgeEvent(ctx, event.ID); !errors.Is(err, domain.ErrEventNotFound) {
+		t.Errorf("purging a live event: err = %v, want ErrEventNotFound", err)
+	}
+	if err := service.DeleteEvent(ctx, event.ID, creator.UserID); err != nil {
+		t.Fatalf("DeleteEvent: %v", err)
+	}
+	if err := service.PurgeEvent(ctx, event.ID); err != nil {
+		t.Fatalf("PurgeEvent: %v", err)
+	}
+	if count := countRows(t, db, "events", "id = ?", event.ID); count != 0 {
+		t.Errorf("purged event still has %d rows", count)
+	}
+}
+
+func TestGetEventsNeedingAttentionGivesReasons(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	other := testutil.CreateCreator(t, db)
+	// The default config does not require an image, so drafts are judged on their details
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+	service.eventConfig.AttentionWindow = 72 * time.Hour
+	service.eventConfig.LowSalesPercent = 25
+
+	withStatus := func(status domain.EventStatus) func(*domain.Event) {
+		return func(event *domain.Event) { event.Status = status }
+	}
+	rejected := testutil.CreateEvent(t, db, creator.ID, withStatus(domain.EventStatusRejected))
+	// CreateEvent leaves out the start time, the online URL, categories and tickets
+	incomplete := testutil.CreateEvent(t, db, creator.ID, withStatus(domain.EventStatusDraft))
+	url := "https://meet.louco.test/jazz"
+	noCategory := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+		event.Status = domain.EventStatusDraft
+		event.StartTime = event.StartDate
+		event.OnlineEventURL, event.TicketURL = &url, &url
+	})
+	ready := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+		event.Status = domain.EventStatusDraft
+		event.StartTime = event.StartDate
+		event.OnlineEventURL = &url
+		event.HasSystemTickets = true
+	})
+	testutil.CreateTicket(t, db, ready.ID, 50)
+	testutil.CreateCategory(t, db, "Jazz", ready.ID)
+	testutil.CreateEvent(t, db, other.ID, withStatus(domain.EventStatusRejected))
+
+	// Published events count only when they start soon and sell poorly
+	onSale := func(hours, sold int) *domain.Event {
+		event := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+			start := time.Now().UTC().Add(time.Duration(hours) * time.Hour)
+			event.StartDate, event.StartTime = &start, &start
+			event.HasSystemTickets = true
+		})
+		ticket := testutil.CreateTicket(t, db, event.ID, 10)
+		if err := db.Model(ticket).Update("sold_quantity", sold).Error; err != nil {
+			t.Fatalf("failed to sell tickets: %v", err)
+		}
+		return event
+	}
+	lowSales := onSale(24, 1)
+	onSale(24, 5)
+	onSale(200, 0)
+
+	items, err := service.GetEventsNeedingAttention(ctx, creator.UserID)
+	if err != nil {
+		t.Fatalf("GetEventsNeedingAttention: %v", err)
+	}
+	got := make(map[int]*dto.EventAttentionItem, len(items))
+	for _, item := range items {
+		got[item.Event.ID] = item
+	}
+	want := map[int]string{
+		rejected.ID:   dto.EventAttentionRejected,
+		incomplete.ID: dto.EventAttentionIncomplete,
+		noCategory.ID: dto.EventAttentionIncomplete,
+		lowSales.ID:   dto.EventAttentionLowSales,
+	}
+	if len(got) != len(want) {
+		t.Errorf("got %d events needing attention, want %d", len(got), len(want))
+	}
+	for id, reason := range want {
+		if item, ok := got[id]; !ok || item.Reason != reason {
+			t.Errorf("event %d: item = %+v, want reason %s", id, item, reason)
+		}
+	}
+	for id, wantErr := range map[int]error{incomplete.ID: domain.ErrEventDateTimeRequired, noCategory.ID: domain.ErrEventCategoriesRequired} {
+		if item := got[id]; item != nil && !errors.Is(item.Err, wantErr) {
+			t.Errorf("draft %d err = %v, want %v", id, item.Err, wantErr)
+		}
+	}
+	if item := got[lowSales.ID]; item != nil && (item.SoldPercentage == nil || *item.SoldPercentage != 10) {
+		t.Errorf("low sales sold percentage = %v, want 10", item.SoldPercentage)
+	}
+
+	// A deployment that requires images also flags the otherwise ready draft
+	service.eventConfig.RequireEventImage = true
+	items, err = service.GetEventsNeedingAttention(ctx, creator.UserID)
+	if err != nil {
+		t.Fatalf("GetEventsNeedingAttention with images required: %v", err)
+	}
+	var flagged bool
+	for _, item := range items {
+		if item.Event.ID == ready.ID {
+			flagged = errors.Is(item.Err, domain.ErrEventImageRequired)
+		}
+	}
+	if !flagged {
+		t.Error("ready draft without an image is not flagged when images are required")
+	}
+}
+
+// parseICS checks the calendar is well formed and returns the properties of its single VEVENT,
+// keyed by name with any parameters
+func parseICS(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+	text := string(data)
+	if !strings.HasSuffix(text, "\r\n") {
+		t.Fatalf("calendar does not end with CRLF: %q", text)
+	}
+	for _, line := range strings.Split(strings.TrimSuffix(text, "\r\n"), "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("line of %d octets is not folded: %q", len(line), line)
+		}
+	}
+
+	var blocks []string
+	props := make(map[string]string)
+	for _, line := range strings.Split(strings.TrimSuffix(strings.ReplaceAll(text, "\r\n ", ""), "\r\n"), "\r\n") {
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			t.Fatalf("content line without a value: %q", line)
+		}
+		switch name {
+		case "BEGIN":
+			blocks = append(blocks, value)
+		case "END":
+			if len(blocks) == 0 || blocks[len(blocks)-1] != value {
+				t.Fatalf("END:%s does not close %v", value, blocks)
+			}
+			blocks = blocks[:len(blocks)-1]
+		default:
+			if slices.Equal(blocks, []string{"VCALENDAR", "VEVENT"}) {
+				props[name] = value
+			}
+		}
+	}
+	if len(blocks) != 0 {
+		t.Fatalf("unclosed blocks %v", blocks)
+	}
+	return props
+}
+
+func TestExportICS(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	invitee := testutil.CreateUser(t, db, domain.UserTypeUser)
+	stranger := testutil.CreateUser(t, db, domain.UserTypeUser)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+	date := func(year int, month time.Month, day, hour, minute int) *time.Time {
+		value := time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+		return &value
+	}
+
+	// Istanbul is UTC+3 all year, so 20:00 there is 17:00 UTC
+	berlin := testutil.CreateAddress(t, db, "Germany", "Berlin", 52.52, 13.40)
+	description := "<p>Live <b>jazz</b> on the roof</p> " + strings.Repeat("Bring friends. ", 10)
+	concert := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+		testutil.AtAddress(berlin)(event)
+		event.Name = "Rooftop Jazz; Vol. 2"
+		event.Description = &description
+		event.Timezone = "Europe/Istanbul"
+		event.StartDate, event.StartTime = date(2030, time.June, 1, 0, 0), date(2030, time.June, 1, 20, 0)
+		event.EndDate, event.EndTime = date(2030, time.June, 1, 0, 0), date(2030, time.June, 1, 23, 30)
+	})
+	data, err := service.ExportICS(ctx, concert.ID, nil)
+	if err != nil {
+		t.Fatalf("ExportICS: %v", err)
+	}
+	props := parseICS(t, data)
+	want := map[string]string{
+		"UID":      fmt.Sprintf("event-%d@louco.test", concert.ID),
+		"DTSTART":  "20300601T170000Z",
+		"DTEND":    "20300601T203000Z",
+		"SUMMARY":  `Rooftop Jazz\; Vol. 2`,
+		"LOCATION": `Berlin\, Germany`,
+		"URL":      fmt.Sprintf("https://louco.test/events/%d", concert.ID),
+	}
+	for name, value := range want {
+		if props[name] != value {
+			t.Errorf("%s = %q, want %q", name, props[name], value)
+		}
+	}
+	if got := props["DESCRIPTION"]; !strings.HasPrefix(got, "Live jazz on the roof Bring friends.") || strings.Contains(got, "<") {
+		t.Errorf("DESCRIPTION = %q, want the description as plain text", got)
+	}
+
+	// An announcement without times takes whole days; the end date is exclusive
+	meetupURL := "https://meet.louco.test/launch"
+	announcement := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+		event.Type = domain.EventTypePrivate
+		event.OnlineEventURL = &meetupURL
+		event.StartDate, event.EndDate = date(2030, time.July, 10, 0, 0), date(2030, time.July, 12, 0, 0)
+	})
+	if err := db.Omit("Event", "InvitedUser").Create(domain.NewInvitation(announcement.ID, *invitee.Email, &invitee.ID)).Error; err != nil {
+		t.Fatalf("failed to create invitation: %v", err)
+	}
+	data, err = service.ExportICS(ctx, announcement.ID, &invitee.ID)
+	if err != nil {
+		t.Fatalf("ExportICS of an announcement: %v", err)
+	}
+	props = parseICS(t, data)
+	want = map[string]string{
+		"DTSTART;VALUE=DATE": "20300710",
+		"DTEND;VALUE=DATE":   "20300713",
+		"LOCATION":           meetupURL,
+	}
+	for name, value := range want {
+		if props[name] != value {
+			t.Errorf("announcement %s = %q, want %q", name, props[name], value)
+		}
+	}
+
+	// The export follows the event's access rules
+	if _, err := service.ExportICS(ctx, announcement.ID, &stranger.ID); !errors.Is(err, domain.ErrEventUnauthorized) {
+		t.Errorf("export of a private event by a stranger: err = %v, want ErrEventUnauthorized", err)
+	}
+}
+
+func TestGetPublicEventsAfterIsStableWhileScrolling(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+
+	// Rows created in the same instant are ordered by id
+	createdAt := time.Now().UTC().Add(-time.Hour).Truncate(time.Microsecond)
+	var want []int
+	for range 5 {
+		event := testutil.CreateEvent(t, db, creator.ID, nil)
+		if err := db.Model(event).Update("created_at", createdAt).Error; err != nil {
+			t.Fatalf("failed to set created_at: %v", err)
+		}
+		want = append(want, event.ID)
+	}
+	slices.Reverse(want)
+
+	var got []int
+	req := dto.CursorPaginationRequest{Limit: 2}
+	for page := 0; ; page++ {
+		events, cursor, err := service.GetPublicEventsAfter(ctx, req)
+		if err != nil {
+			t.Fatalf("GetPublicEventsAfter: %v", err)
+		}
+		got = append(got, eventIDs(events)...)
+		if page == 0 {
+			// A newer event published mid-scroll belongs before the cursor and is not repeated
+			testutil.CreateEvent(t, db, creator.ID, nil)
+		}
+		if !cursor.HasMore {
+			if cursor.NextCursor != "" {
+				t.Errorf("last page has next cursor %q", cursor.NextCursor)
+			}
+			break
+		}
+		req.After = cursor.NextCursor
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("scrolled through %v, want %v", got, want)
+	}
+}
+
+func TestGetEventsWithFiltersMatchAllCategories(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+
+	all := testutil.CreateEvent(t, db, creator.ID, nil)
+	twoOfThree := testutil.CreateEvent(t, db, creator.ID, nil)
+	oneOfThree := testutil.CreateEvent(t, db, creator.ID, nil)
+	jazz := testutil.CreateCategory(t, db, "Jazz", all.ID, twoOfThree.ID)
+	outdoor := testutil.CreateCategory(t, db, "Outdoor", all.ID, twoOfThree.ID)
+	family := testutil.CreateCategory(t, db, "Family", all.ID, oneOfThree.ID)
+
+	find := func(categoryIDs []int, matchAll bool) []int {
+		t.Helper()
+		filters := dto.EventFilterRequest{CategoryIDs: categoryIDs, MatchAllCategories: &matchAll}
+		events, page, err := service.GetEventsWithFilters(ctx, filters, dto.PaginationRequest{}, nil)
+		if err != nil {
+			t.Fatalf("GetEventsWithFilters: %v", err)
+		}
+		count, err := service.CountEventsWithFilters(ctx, filters, nil)
+		if err != nil {
+			t.Fatalf("CountEventsWithFilters: %v", err)
+		}
+		if page.Total != len(events) || count != int64(page.Total) {
+			t.Errorf("total %d and count %d for %d events", page.Total, count, len(events))
+		}
+		ids := eventIDs(events)
+		slices.Sort(ids)
+		return ids
+	}
+
+	requested := []int{jazz.ID, outdoor.ID, family.ID}
+	if got, want := find(requested, false), []int{all.ID, twoOfThree.ID, oneOfThree.ID}; !slices.Equal(got, want) {
+		t.Errorf("any category = %v, want %v", got, want)
+	}
+	if got, want := find(requested, true), []int{all.ID}; !slices.Equal(got, want) {
+		t.Errorf("all categories = %v, want %v", got, want)
+	}
+	// A repeated id is one category, not an extra one to match
+	if got, want := find([]int{jazz.ID, jazz.ID, outdoor.ID}, true), []int{all.ID, twoOfThree.ID}; !slices.Equal(got, want) {
+		t.Errorf("all of a repeated list = %v, want %v", got, want)
+	}
+}
+
+func TestGetEventsWithFiltersSortsNamesByLocale(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+
+	for _, name := range []string{"Ödül Töreni", "İnci Plajı", "Dans Gecesi", "Opera Gecesi", "Irmak Turu", "Çay Bahçesi"} {
+		testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) { event.Name = name })
+	}
+	names := func(locale string) string {
+		t.Helper()
+		sortBy := "name"
+		events, _, err := service.GetEventsWithFilters(ctx, dto.EventFilterRequest{SortBy: &sortBy, Locale: locale}, dto.PaginationRequest{}, nil)
+		if err != nil {
+			t.Fatalf("GetEventsWithFilters(%s): %v", locale, err)
+		}
+		got := make([]string, len(events))
+		for i, event := range events {
+			got[i] = event.Name
+		}
+		return strings.Join(got, ", ")
+	}
+
+	// Turkish has its own letters: dotless I comes before İ, and Ö follows O
+	want := "Çay Bahçesi, Dans Gecesi, Irmak Turu, İnci Plajı, Opera Gecesi, Ödül Töreni"
+	if got := names("tr"); got != want {
+		t.Errorf("tr order = %s, want %s", got, want)
+	}
+	// English treats them as accented I and O
+	want = "Çay Bahçesi, Dans Gecesi, İnci Plajı, Irmak Turu, Ödül Töreni, Opera Gecesi"
+	if got := names("en"); got != want {
+		t.Errorf("en order = %s, want %s", got, want)
+	}
+}
+
+func TestGetNearbyEventsReturnsDistances(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{})
+
+	// From Taksim: Beşiktaş about 2 km, Kadıköy about 6.4 km, Sarıyer about 15.4 km and
+	// Ankara about 350 km away. Creation order differs from distance order.
+	besiktas := testutil.CreateAddress(t, db, "Türkiye", "Istanbul", 41.0430, 29.0070)
+	sariyer := testutil.CreateAddress(t, db, "Türkiye", "Istanbul", 41.1670, 29.0500)
+	kadikoy := testutil.CreateAddress(t, db, "Türkiye", "Istanbul", 40.9900, 29.0290)
+	ankara := testutil.CreateAddress(t, db, "Türkiye", "Ankara", 39.9208, 32.8541)
+	near := testutil.CreateEvent(t, db, creator.ID, testutil.AtAddress(besiktas))
+	far := testutil.CreateEvent(t, db, creator.ID, testutil.AtAddress(sariyer))
+	middle := testutil.CreateEvent(t, db, creator.ID, testutil.AtAddress(kadikoy))
+	testutil.CreateEvent(t, db, creator.ID, testutil.AtAddress(ankara))
+	testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+		testutil.AtAddress(besiktas)(event)
+		event.Type = domain.EventTypePrivate
+	})
+
+	latitude, longitude := 41.0370, 28.9850
+	nearby := func(sort string) []*dto.EventListResponse {
+		t.Helper()
+		req := dto.EventNearbyRequest{Latitude: &latitude, Longitude: &longitude, Sort: sort}
+		events, page, err := service.GetNearbyEvents(ctx, req, dto.PaginationRequest{})
+		if err != nil {
+			t.Fatalf("GetNearbyEvents(%q): %v", sort, err)
+		}
+		if page.Total != 3 {
+			t.Errorf("total = %d, want 3", page.Total)
+		}
+		return events
+	}
+
+	events := nearby("")
+	if got, want := eventIDs(events), []int{near.ID, middle.ID, far.ID}; !slices.Equal(got, want) {
+		t.Fatalf("nearest first = %v, want %v", got, want)
+	}
+	for i, want := range []float64{1.962, 6.399, 15.447} {
+		if got := events[i].DistanceKm; got == nil || math.Abs(*got-want) > 0.01 {
+			t.Errorf("event %d distance = %v, want %.3f km", events[i].ID, got, want)
+		}
+	}
+
+	// Sorting by date keeps the distances
+	events = nearby("date")
+	if got, want := eventIDs(events), []int{middle.ID, far.ID, near.ID}; !slices.Equal(got, want) {
+		t.Errorf("newest first = %v, want %v", got, want)
+	}
+	if events[0].DistanceKm == nil || math.Abs(*events[0].DistanceKm-6.399) > 0.01 {
+		t.Errorf("distance by date = %v, want 6.399 km", events[0].DistanceKm)
+	}
+}
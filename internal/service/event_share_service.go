@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/repository"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+// shareTokenBytes is the entropy of a share link token; it is hex encoded to twice this length
+const shareTokenBytes = 32
+
+type EventShareService interface {
+	// Owner operations
+	GetShareToken(ctx context.Context, eventID, userID int) (*dto.EventShareTokenResponse, error)
+	RegenerateShareToken(ctx context.Context, eventID, userID int) (*dto.EventShareTokenResponse, error)
+	RevokeShareToken(ctx context.Context, eventID, userID int) error
+	GetShareTokenStats(ctx context.Context, eventID, userID int) (*dto.EventShareTokenStatsResponse, error)
+
+	// GetEventByShareToken opens an event through its share link and records the access. The
+	// visitor sees the public view of the event, never its guest list.
+	GetEventByShareToken(ctx context.Context, token string, userID *int, ipAddress string) (*dto.SharedEventResponse, error)
+}
+
+type eventShareService struct {
+	eventService         EventService
+	eventRepo            repository.EventRepository
+	shareTokenAccessRepo repository.ShareTokenAccessRepository
+	mediaSigner          dto.MediaSigner
+	logger               zerolog.Logger
+}
+
+func NewEventShareService(
+	eventService EventService,
+	eventRepo repository.EventRepository,
+	shareTokenAccessRepo repository.ShareTokenAccessRepository,
+	mediaSigner dto.MediaSigner,
+	logger zerolog.Logger,
+) EventShareService {
+	return &eventShareService{
+		eventService:         eventService,
+		eventRepo:            eventRepo,
+		shareTokenAccessRepo: shareTokenAccessRepo,
+		mediaSigner:          mediaSigner,
+		logger:               logger.With().Str("service", "event_share").Logger(),
+	}
+}
+
+func (s *eventShareService) GetShareToken(ctx context.Context, eventID, userID int) (*dto.EventShareTokenResponse, error) {
+	event, err := s.getOwnedEvent(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return dto.EventToShareTokenResponse(event), nil
+}
+
+// RegenerateShareToken issues a fresh token; the previous link stops working at once
+func (s *eventShareService) RegenerateShareToken(ctx context.Context, eventID, userID int) (*dto.EventShareTokenResponse, error) {
+	event, err := s.getOwnedEvent(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := generateShareToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate share token: %w", err)
+	}
+
+	event.SetShareToken(token)
+	if err := s.eventRepo.UpdateShareToken(ctx, event); err != nil {
+		s.logger.Error().Err(err).Int("event_id", eventID).Msg("Failed to save share token")
+		return nil, fmt.Errorf("failed to update share token: %w", err)
+	}
+
+	s.logger.Info().Int("event_id", eventID).Int("user_id", userID).Msg("Event share token regenerated")
+	return dto.EventToShareTokenResponse(event), nil
+}
+
+func (s *eventShareService) RevokeShareToken(ctx context.Context, eventID, userID int) error {
+	event, err := s.getOwnedEvent(ctx, eventID, userID)
+	if err != nil {
+		return err
+	}
+
+	event.RevokeShareToken()
+	if err := s.eventRepo.UpdateShareToken(ctx, event); err != nil {
+		s.logger.Error().Err(err).Int("event_id", eventID).Msg("Failed to revoke share token")
+		return fmt.Errorf("failed to update share token: %w", err)
+	}
+
+	s.logger.Info().Int("event_id", eventID).Int("user_id", userID).Msg("Event share token revoked")
+	return nil
+}
+
+// GetShareTokenStats reports usage of the current link only; regenerating starts a new count
+func (s *eventShareService) GetShareTokenStats(ctx context.Context, eventID, userID int) (*dto.EventShareTokenStatsResponse, error) {
+	event, err := s.getOwnedEvent(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.EventShareTokenStatsResponse{
+		EventShareTokenResponse: *dto.EventToShareTokenResponse(event),
+	}
+	if event.ShareTokenCreatedAt == nil {
+		return response, nil
+	}
+
+	stats, err := s.shareTokenAccessRepo.GetStats(ctx, eventID, *event.ShareTokenCreatedAt)
+	if err != nil {
+		s.logger.Error().Err(err).Int("event_id", eventID).Msg("Failed to get share token stats")
+		return nil, fmt.Errorf("failed to get share token stats: %w", err)
+	}
+
+	response.TotalAccesses = stats.TotalAccesses
+	response.UniqueVisitors = stats.UniqueVisitors
+	response.LastAccessedAt = stats.LastAccessedAt
+	return response, nil
+}
+
+func (s *eventShareService) GetEventByShareToken(ctx context.Context, token string, userID *int, ipAddress string) (*dto.SharedEventResponse, error) {
+	if token == "" {
+		return nil, domain.ErrEventNotFound
+	}
+
+	event, err := s.eventRepo.GetByShareToken(ctx, token)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrEventNotFound
+		}
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	// A share link skips the invitation check on private events, but never exposes drafts
+	if !event.IsPublished() {
+		return nil, domain.ErrEventNotFound
+	}
+
+	event, err = s.eventRepo.GetByIDWithRelations(ctx, event.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	// The access log is informational, so a failed write does not block the visitor
+	if err := s.shareTokenAccessRepo.Create(ctx, domain.NewShareTokenAccess(event.ID, userID, ipAddress)); err != nil {
+		s.logger.Warn().Err(err).Int("event_id", event.ID).Msg("Failed to record share token access")
+	}
+
+	return dto.EventToSharedResponse(event, s.mediaSigner), nil
+}
+
+func (s *eventShareService) getOwnedEvent(ctx context.Context, eventID, userID int) (*domain.Event, error) {
+	if err := s.eventService.AuthorizeEvent(ctx, eventID, &userID, domain.EventActionManage); err != nil {
+		return nil, err
+	}
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrEventNotFound
+		}
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	return event, nil
+}
+
+func generateShareToken() (string, error) {
+	buf := make([]byte, shareTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
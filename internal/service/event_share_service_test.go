@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/repository/postgres"
+	"github.com/louco-event/internal/testutil"
+	"github.com/rs/zerolog"
+)
+
+func TestShareTokenRegenerationAndAccessLog(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := NewEventShareService(
+		newTestEventService(t, db, nil, nil),
+		postgres.NewEventRepository(db),
+		postgres.NewShareTokenAccessRepository(db),
+		nil,
+		zerolog.Nop(),
+	)
+
+	event := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+		event.Type = domain.EventTypePrivate
+	})
+	createInvitation(t, db, event.ID, "guest@example.com")
+
+	first, err := service.RegenerateShareToken(ctx, event.ID, creator.UserID)
+	if err != nil {
+		t.Fatalf("RegenerateShareToken: %v", err)
+	}
+	visitor := testutil.CreateUser(t, db, domain.UserTypeUser)
+	shared, err := service.GetEventByShareToken(ctx, *first.Token, &visitor.ID, "203.0.113.7")
+	if err != nil {
+		t.Fatalf("GetEventByShareToken: %v", err)
+	}
+	if shared.ID != event.ID {
+		t.Errorf("shared event id = %d, want %d", shared.ID, event.ID)
+	}
+	body, err := json.Marshal(shared)
+	if err != nil {
+		t.Fatalf("failed to encode shared event: %v", err)
+	}
+	if strings.Contains(string(body), "guest@example.com") || strings.Contains(string(body), "invitations") {
+		t.Errorf("shared event exposes the guest list: %s", body)
+	}
+
+	second, err := service.RegenerateShareToken(ctx, event.ID, creator.UserID)
+	if err != nil {
+		t.Fatalf("RegenerateShareToken again: %v", err)
+	}
+	if *second.Token == *first.Token {
+		t.Fatalf("regenerated token equals the previous one")
+	}
+	if _, err := service.GetEventByShareToken(ctx, *first.Token, nil, "203.0.113.8"); !errors.Is(err, domain.ErrEventNotFound) {
+		t.Errorf("old token: err = %v, want event not found", err)
+	}
+	if _, err := service.GetEventByShareToken(ctx, *second.Token, nil, "203.0.113.9"); err != nil {
+		t.Errorf("new token: %v", err)
+	}
+
+	var accesses []domain.ShareTokenAccess
+	if err := db.Where("event_id = ?", event.ID).Order("id").Find(&accesses).Error; err != nil {
+		t.Fatalf("failed to load access log: %v", err)
+	}
+	if len(accesses) != 2 {
+		t.Fatalf("access log has %d entries, want 2 for the two successful visits", len(accesses))
+	}
+	if accesses[0].UserID == nil || *accesses[0].UserID != visitor.ID || accesses[0].IPAddress != "203.0.113.7" {
+		t.Errorf("first access = %+v, want user %d from 203.0.113.7", accesses[0], visitor.ID)
+	}
+	if accesses[1].UserID != nil || accesses[1].IPAddress != "203.0.113.9" {
+		t.Errorf("second access = %+v, want an anonymous visit from 203.0.113.9", accesses[1])
+	}
+
+	// Stats only count the current link
+	stats, err := service.GetShareTokenStats(ctx, event.ID, creator.UserID)
+	if err != nil {
+		t.Fatalf("GetShareTokenStats: %v", err)
+	}
+	if stats.TotalAccesses != 1 {
+		t.Errorf("stats count %d accesses, want 1 since the regeneration", stats.TotalAccesses)
+	}
+}
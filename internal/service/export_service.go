@@ -0,0 +1,285 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/repository"
+	"github.com/louco-event/pkg/storage"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+// exportPageSize is how many rows are read per query while building an export
+const exportPageSize = 100
+
+type ExportService interface {
+	CreateExport(ctx context.Context, userID int, req dto.CreateExportRequest) (*dto.ExportJobResponse, error)
+	GetExport(ctx context.Context, userID, jobID int) (*dto.ExportJobResponse, error)
+
+	// ProcessQueuedExports builds up to one batch of queued exports; run by the scheduler
+	ProcessQueuedExports(ctx context.Context) error
+}
+
+type exportService struct {
+	exportJobRepo      repository.ExportJobRepository
+	invitationRepo     repository.InvitationRepository
+	ticketPurchaseRepo repository.TicketPurchaseRepository
+	eventService       EventService
+	storage            storage.ObjectStorage
+	batchSize          int
+	downloadURLTTL     time.Duration
+	processingTimeout  time.Duration
+	logger             zerolog.Logger
+}
+
+// NewExportService creates the export service. Jobs processing for longer than
+// processingTimeout are queued again on the next worker run; zero disables the requeue.
+func NewExportService(
+	exportJobRepo repository.ExportJobRepository,
+	invitationRepo repository.InvitationRepository,
+	ticketPurchaseRepo repository.TicketPurchaseRepository,
+	eventService EventService,
+	storage storage.ObjectStorage,
+	batchSize int,
+	downloadURLTTL time.Duration,
+	processingTimeout time.Duration,
+	logger zerolog.Logger,
+) ExportService {
+	return &exportService{
+		exportJobRepo:      exportJobRepo,
+		invitationRepo:     invitationRepo,
+		ticketPurchaseRepo: ticketPurchaseRepo,
+		eventService:       eventService,
+		storage:            storage,
+		batchSize:          batchSize,
+		downloadURLTTL:     downloadURLTTL,
+		processingTimeout:  processingTimeout,
+		logger:             logger.With().Str("service", "export").Logger(),
+	}
+}
+
+func (s *exportService) CreateExport(ctx context.Context, userID int, req dto.CreateExportRequest) (*dto.ExportJobResponse, error) {
+	if !req.Type.IsValid() {
+		return nil, domain.ErrExportJobInvalidType
+	}
+	if req.EventID <= 0 {
+		return nil, domain.ErrEventNotFound
+	}
+
+	// Both export types contain guest data, so only the event owner may request them
+	if err := s.eventService.AuthorizeEvent(ctx, req.EventID, &userID, domain.EventActionManage); err != nil {
+		return nil, err
+	}
+
+	job, err := domain.NewExportJob(userID, req.Type, domain.ExportJobParams{EventID: req.EventID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode export params: %w", err)
+	}
+	if err := s.exportJobRepo.Create(ctx, job); err != nil {
+		s.logger.Error().Err(err).Int("user_id", userID).Str("type", string(req.Type)).Msg("Failed to queue export")
+		return nil, fmt.Errorf("failed to queue export: %w", err)
+	}
+
+	s.logger.Info().Int("job_id", job.ID).Int("user_id", userID).Str("type", string(job.Type)).Msg("Export queued")
+	return dto.ExportJobToResponse(job), nil
+}
+
+func (s *exportService) GetExport(ctx context.Context, userID, jobID int) (*dto.ExportJobResponse, error) {
+	job, err := s.exportJobRepo.GetByID(ctx, jobID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrExportJobNotFound
+		}
+		return nil, fmt.Errorf("failed to get export: %w", err)
+	}
+	// Other users' exports are reported as missing so job ids cannot be probed
+	if job.UserID != userID {
+		return nil, domain.ErrExportJobNotFound
+	}
+
+	response := dto.ExportJobToResponse(job)
+	if job.IsDone() && job.ResultKey != nil {
+		url, err := s.storage.PresignGet(*job.ResultKey, s.downloadURLTTL)
+		if err != nil {
+			s.logger.Error().Err(err).Int("job_id", job.ID).Msg("Failed to sign export download URL")
+			return nil, fmt.Errorf("failed to sign download url: %w", err)
+		}
+		response.DownloadURL = &url
+	}
+
+	return response, nil
+}
+
+func (s *exportService) ProcessQueuedExports(ctx context.Context) error {
+	if s.processingTimeout > 0 {
+		requeued, err := s.exportJobRepo.RequeueStale(ctx, time.Now().Add(-s.processingTimeout))
+		if err != nil {
+			return fmt.Errorf("failed to requeue stale export jobs: %w", err)
+		}
+		if requeued > 0 {
+			s.logger.Warn().Int64("jobs", requeued).Msg("Requeued exports stuck in processing")
+		}
+	}
+
+	for i := 0; i < s.batchSize; i++ {
+		job, err := s.exportJobRepo.ClaimNextQueued(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to claim export job: %w", err)
+		}
+		if job == nil {
+			return nil
+		}
+
+		s.processJob(ctx, job)
+	}
+	return nil
+}
+
+// processJob builds and uploads one claimed export. Failures are stored on the job so the
+// user sees them when polling; they never stop the rest of the batch.
+func (s *exportService) processJob(ctx context.Context, job *domain.ExportJob) {
+	logger := s.logger.With().Int("job_id", job.ID).Str("type", string(job.Type)).Logger()
+
+	key, err := s.buildAndUpload(ctx, job)
+	if err == nil {
+		err = job.Complete(key)
+	}
+	if err != nil {
+		logger.Error().Err(err).Msg("Export failed")
+		job.Fail(err.Error())
+	}
+
+	if err := s.exportJobRepo.Update(ctx, job); err != nil {
+		logger.Error().Err(err).Msg("Failed to save export job")
+		return
+	}
+	logger.Info().Str("status", string(job.Status)).Msg("Export processed")
+}
+
+func (s *exportService) buildAndUpload(ctx context.Context, job *domain.ExportJob) (string, error) {
+	params, err := job.DecodeParams()
+	if err != nil {
+		return "", fmt.Errorf("invalid export params: %w", err)
+	}
+
+	var rows [][]string
+	switch job.Type {
+	case domain.ExportJobTypeInvitations:
+		rows, err = s.invitationRows(ctx, params.EventID)
+	case domain.ExportJobTypeAttendees:
+		rows, err = s.attendeeRows(ctx, params.EventID)
+	default:
+		err = domain.ErrExportJobInvalidType
+	}
+	if err != nil {
+		return "", err
+	}
+
+	for _, row := range rows {
+		for i, cell := range row {
+			row[i] = escapeCSVFormula(cell)
+		}
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.WriteAll(rows); err != nil {
+		return "", fmt.Errorf("failed to write csv: %w", err)
+	}
+
+	key := fmt.Sprintf("exports/%d/%d-%s-event-%d.csv", job.UserID, job.ID, job.Type, params.EventID)
+	if err := s.storage.Put(ctx, key, "text/csv", bytes.NewReader(buf.Bytes())); err != nil {
+		return "", fmt.Errorf("failed to upload export: %w", err)
+	}
+	return key, nil
+}
+
+func (s *exportService) invitationRows(ctx context.Context, eventID int) ([][]string, error) {
+	rows := [][]string{{"id", "email", "user_id", "full_name", "status", "invited_at", "responded_at"}}
+
+	pagination := dto.PaginationRequest{Page: 1, PageSize: exportPageSize}
+	for {
+		invitations, paginationResp, err := s.invitationRepo.GetByEventIDWithRelations(ctx, eventID, pagination)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get invitations: %w", err)
+		}
+
+		for _, invitation := range invitations {
+			var userID, fullName string
+			if invitation.InvitedUser != nil {
+				userID = strconv.Itoa(invitation.InvitedUser.ID)
+				fullName = invitation.InvitedUser.FullName
+			}
+			rows = append(rows, []string{
+				strconv.Itoa(invitation.ID),
+				invitation.InvitedEmail,
+				userID,
+				fullName,
+				string(invitation.Status),
+				invitation.InvitedAt.Format(time.RFC3339),
+				formatOptionalTime(invitation.RespondedAt),
+			})
+		}
+
+		if pagination.Page >= paginationResp.TotalPages {
+			return rows, nil
+		}
+		pagination.Page++
+	}
+}
+
+func (s *exportService) attendeeRows(ctx context.Context, eventID int) ([][]string, error) {
+	purchases, err := s.ticketPurchaseRepo.GetByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticket purchases: %w", err)
+	}
+
+	rows := [][]string{{"purchase_id", "user_id", "full_name", "email", "ticket", "quantity", "purchased_at"}}
+	for _, purchase := range purchases {
+		var fullName, email, ticket string
+		if purchase.User != nil {
+			fullName = purchase.User.FullName
+			if purchase.User.Email != nil {
+				email = *purchase.User.Email
+			}
+		}
+		if purchase.Ticket != nil {
+			ticket = purchase.Ticket.Title
+		}
+		rows = append(rows, []string{
+			strconv.Itoa(purchase.ID),
+			strconv.Itoa(purchase.UserID),
+			fullName,
+			email,
+			ticket,
+			strconv.Itoa(purchase.Quantity),
+			purchase.PurchasedAt.Format(time.RFC3339),
+		})
+	}
+	return rows, nil
+}
+
+// escapeCSVFormula prefixes cells a spreadsheet would run as a formula with a quote, so names
+// and emails typed by guests cannot inject formulas into the creator's spreadsheet
+func escapeCSVFormula(cell string) string {
+	if cell != "" && strings.ContainsRune("=+-@\t\r", rune(cell[0])) {
+		return "'" + cell
+	}
+	return cell
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
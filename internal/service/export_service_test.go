@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/repository/postgres"
+	"github.com/louco-event/internal/testutil"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+// fakeExportStorage keeps uploaded exports in memory. onPut runs before each upload is stored.
+type fakeExportStorage struct {
+	objects map[string]string
+	onPut   func(key string)
+}
+
+func (f *fakeExportStorage) Put(ctx context.Context, key, contentType string, body io.ReadSeeker) error {
+	if f.onPut != nil {
+		f.onPut(key)
+	}
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	f.objects[key] = string(content)
+	return nil
+}
+
+func (f *fakeExportStorage) PresignGet(key string, ttl time.Duration) (string, error) {
+	return "https://storage.test/" + key, nil
+}
+
+func newTestExportService(t *testing.T, db *gorm.DB, storage *fakeExportStorage) ExportService {
+	t.Helper()
+	return NewExportService(
+		postgres.NewExportJobRepository(db),
+		postgres.NewInvitationRepository(db),
+		postgres.NewTicketPurchaseRepository(db),
+		newTestEventService(t, db, &fakePublishingRights{}, &fakeEmailService{}),
+		storage,
+		5,
+		time.Hour,
+		30*time.Minute,
+		zerolog.Nop(),
+	)
+}
+
+func exportJobStatus(t *testing.T, db *gorm.DB, jobID int) domain.ExportJobStatus {
+	t.Helper()
+	var job domain.ExportJob
+	if err := db.First(&job, jobID).Error; err != nil {
+		t.Fatalf("failed to load export job: %v", err)
+	}
+	return job.Status
+}
+
+func TestExportJobLifecycle(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	event := testutil.CreateEvent(t, db, creator.ID, nil)
+	createInvitation(t, db, event.ID, "=HYPERLINK(\"https://evil.test\")@example.com")
+
+	storage := &fakeExportStorage{objects: make(map[string]string)}
+	service := newTestExportService(t, db, storage)
+
+	job, err := service.CreateExport(ctx, creator.UserID, dto.CreateExportRequest{Type: domain.ExportJobTypeInvitations, EventID: event.ID})
+	if err != nil {
+		t.Fatalf("CreateExport: %v", err)
+	}
+	if status := exportJobStatus(t, db, job.ID); status != domain.ExportJobStatusQueued {
+		t.Fatalf("new export is %q, want queued", status)
+	}
+
+	// The upload happens while the worker holds the job in processing
+	var uploadStatus domain.ExportJobStatus
+	storage.onPut = func(key string) { uploadStatus = exportJobStatus(t, db, job.ID) }
+	if err := service.ProcessQueuedExports(ctx); err != nil {
+		t.Fatalf("ProcessQueuedExports: %v", err)
+	}
+	if uploadStatus != domain.ExportJobStatusProcessing {
+		t.Errorf("export was %q during upload, want processing", uploadStatus)
+	}
+
+	done, err := service.GetExport(ctx, creator.UserID, job.ID)
+	if err != nil {
+		t.Fatalf("GetExport: %v", err)
+	}
+	if done.Status != domain.ExportJobStatusDone || done.DownloadURL == nil {
+		t.Fatalf("export = %+v, want done with a download URL", done)
+	}
+	for _, content := range storage.objects {
+		if !strings.Contains(content, "'=HYPERLINK") {
+			t.Errorf("export %q does not escape the formula in the guest email", content)
+		}
+	}
+
+	// Other users' exports are reported as missing
+	if _, err := service.GetExport(ctx, creator.UserID+1000, job.ID); !errors.Is(err, domain.ErrExportJobNotFound) {
+		t.Errorf("GetExport of another user: err = %v, want ErrExportJobNotFound", err)
+	}
+}
+
+func TestProcessQueuedExportsRequeuesStaleJobs(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	event := testutil.CreateEvent(t, db, creator.ID, nil)
+
+	// A worker died an hour ago while building this export
+	job, err := domain.NewExportJob(creator.UserID, domain.ExportJobTypeInvitations, domain.ExportJobParams{EventID: event.ID})
+	if err != nil {
+		t.Fatalf("NewExportJob: %v", err)
+	}
+	if err := job.Start(); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	startedAt := time.Now().Add(-time.Hour)
+	job.StartedAt = &startedAt
+	if err := db.Create(job).Error; err != nil {
+		t.Fatalf("failed to create export job: %v", err)
+	}
+
+	service := newTestExportService(t, db, &fakeExportStorage{objects: make(map[string]string)})
+	if err := service.ProcessQueuedExports(ctx); err != nil {
+		t.Fatalf("ProcessQueuedExports: %v", err)
+	}
+	if status := exportJobStatus(t, db, job.ID); status != domain.ExportJobStatusDone {
+		t.Errorf("stale export is %q, want done after being requeued", status)
+	}
+}
+
+func TestEscapeCSVFormula(t *testing.T) {
+	tests := map[string]string{
+		"":                  "",
+		"Ada Lovelace":      "Ada Lovelace",
+		"=1+1":              "'=1+1",
+		"+31 6 1234":        "'+31 6 1234",
+		"-2":                "'-2",
+		"@SUM(A1:A2)":       "'@SUM(A1:A2)",
+		"\tcmd":             "'\tcmd",
+		"\rcmd":             "'\rcmd",
+		"guest@example.com": "guest@example.com",
+		"2026-10-16T10:00Z": "2026-10-16T10:00Z",
+		"a=b":               "a=b",
+	}
+	for cell, want := range tests {
+		if got := escapeCSVFormula(cell); got != want {
+			t.Errorf("escapeCSVFormula(%q) = %q, want %q", cell, got, want)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/repository"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+// WebhookProcessor handles one raw webhook delivery; it is how a dead-lettered delivery is
+// replayed through the same code that first failed on it
+type WebhookProcessor func(ctx context.Context, payload []byte) error
+
+type FailedWebhookService interface {
+	// RecordFailure dead-letters a webhook delivery whose processing returned procErr
+	RecordFailure(ctx context.Context, stripeEventID, eventType string, payload []byte, procErr error) error
+	GetFailedWebhooks(ctx context.Context, includeResolved bool, pagination dto.PaginationRequest) ([]*domain.FailedWebhook, *dto.PaginationResponse, error)
+
+	// RetryFailedWebhook replays a dead-lettered delivery through process. The webhook is
+	// resolved when process succeeds; otherwise its error is updated and it stays listed.
+	RetryFailedWebhook(ctx context.Context, id int, process WebhookProcessor) (*domain.FailedWebhook, error)
+}
+
+// failedWebhookRetryClaimTTL is how long a retry holds a webhook; a claim older than this is
+// treated as abandoned by a crashed retry
+const failedWebhookRetryClaimTTL = 5 * time.Minute
+
+type failedWebhookService struct {
+	failedWebhookRepo repository.FailedWebhookRepository
+	logger            zerolog.Logger
+}
+
+func NewFailedWebhookService(failedWebhookRepo repository.FailedWebhookRepository, logger zerolog.Logger) FailedWebhookService {
+	return &failedWebhookService{
+		failedWebhookRepo: failedWebhookRepo,
+		logger:            logger.With().Str("service", "failed_webhook").Logger(),
+	}
+}
+
+func (s *failedWebhookService) RecordFailure(ctx context.Context, stripeEventID, eventType string, payload []byte, procErr error) error {
+	webhook := domain.NewFailedWebhook(stripeEventID, eventType, payload, procErr)
+	if err := s.failedWebhookRepo.Create(ctx, webhook); err != nil {
+		s.logger.Error().Err(err).Str("stripe_event_id", stripeEventID).Str("event_type", eventType).Msg("Failed to dead-letter webhook")
+		return fmt.Errorf("failed to record failed webhook: %w", err)
+	}
+
+	s.logger.Warn().Int("failed_webhook_id", webhook.ID).Str("stripe_event_id", stripeEventID).Str("event_type", eventType).Msg("Webhook dead-lettered")
+	return nil
+}
+
+func (s *failedWebhookService) GetFailedWebhooks(ctx context.Context, includeResolved bool, pagination dto.PaginationRequest) ([]*domain.FailedWebhook, *dto.PaginationResponse, error) {
+	webhooks, paginationResp, err := s.failedWebhookRepo.List(ctx, includeResolved, pagination)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get failed webhooks: %w", err)
+	}
+	return webhooks, paginationResp, nil
+}
+
+func (s *failedWebhookService) RetryFailedWebhook(ctx context.Context, id int, process WebhookProcessor) (*domain.FailedWebhook, error) {
+	// Claim the webhook first so concurrent retries cannot replay it twice
+	claimed, err := s.failedWebhookRepo.ClaimForRetry(ctx, id, time.Now().Add(-failedWebhookRetryClaimTTL))
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim failed webhook: %w", err)
+	}
+
+	webhook, err := s.failedWebhookRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrFailedWebhookNotFound
+		}
+		return nil, fmt.Errorf("failed to get failed webhook: %w", err)
+	}
+	if !claimed {
+		if webhook.IsResolved() {
+			return nil, domain.ErrFailedWebhookAlreadyResolved
+		}
+		return nil, domain.ErrFailedWebhookRetryInProgress
+	}
+
+	procErr := process(ctx, []byte(webhook.Payload))
+	webhook.RecordRetry(procErr)
+	if err := s.failedWebhookRepo.Update(ctx, webhook); err != nil {
+		return nil, fmt.Errorf("failed to update failed webhook: %w", err)
+	}
+
+	if procErr != nil {
+		s.logger.Warn().Err(procErr).Int("failed_webhook_id", id).Int("attempts", webhook.Attempts).Msg("Webhook retry failed")
+	} else {
+		s.logger.Info().Int("failed_webhook_id", id).Int("attempts", webhook.Attempts).Msg("Webhook retry succeeded")
+	}
+	return webhook, nil
+}
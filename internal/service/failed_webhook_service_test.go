@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/repository/postgres"
+	"github.com/louco-event/internal/testutil"
+	"github.com/rs/zerolog"
+)
+
+func TestRetryFailedWebhookReplaysOnceUnderConcurrentRetries(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	repo := postgres.NewFailedWebhookRepository(db)
+	service := NewFailedWebhookService(repo, zerolog.Nop())
+
+	webhook := domain.NewFailedWebhook("evt_test", "checkout.session.completed", []byte(`{}`), errors.New("boom"))
+	if err := repo.Create(ctx, webhook); err != nil {
+		t.Fatalf("failed to create webhook: %v", err)
+	}
+
+	var calls atomic.Int32
+	process := func(ctx context.Context, payload []byte) error {
+		calls.Add(1)
+		time.Sleep(100 * time.Millisecond)
+		return nil
+	}
+
+	const retries = 5
+	var wg sync.WaitGroup
+	errs := make(chan error, retries)
+	for i := 0; i < retries; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := service.RetryFailedWebhook(ctx, webhook.ID, process)
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	if got := calls.Load(); got != 1 {
+		t.Fatalf("payload processed %d times, want 1", got)
+	}
+	var succeeded int
+	for err := range errs {
+		switch {
+		case err == nil:
+			succeeded++
+		case errors.Is(err, domain.ErrFailedWebhookRetryInProgress), errors.Is(err, domain.ErrFailedWebhookAlreadyResolved):
+		default:
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if succeeded != 1 {
+		t.Fatalf("%d retries succeeded, want 1", succeeded)
+	}
+
+	if _, err := service.RetryFailedWebhook(ctx, webhook.ID, process); !errors.Is(err, domain.ErrFailedWebhookAlreadyResolved) {
+		t.Fatalf("retry of resolved webhook: got %v, want ErrFailedWebhookAlreadyResolved", err)
+	}
+}
@@ -2,15 +2,21 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/louco-event/internal/domain"
 	"github.com/louco-event/internal/dto"
 	"github.com/louco-event/internal/repository"
 	"github.com/rs/zerolog"
+	"gorm.io/gorm"
 )
 
+// maxInvitationStatsEvents caps how many events one batched stats request may cover
+const maxInvitationStatsEvents = 100
+
 type InvitationService interface {
 	// Basic CRUD operations
 	CreateInvitation(ctx context.Context, eventID int, req dto.CreateInvitationRequest) (*dto.InvitationResponse, error)
@@ -23,6 +29,7 @@ type InvitationService interface {
 	GetInvitationsByEventID(ctx context.Context, eventID int, pagination dto.PaginationRequest) ([]*dto.InvitationResponse, *dto.PaginationResponse, error)
 	GetInvitationsByEventIDWithRelations(ctx context.Context, eventID int, pagination dto.PaginationRequest) ([]*dto.InvitationResponse, *dto.PaginationResponse, error)
 	GetEventInvitationStats(ctx context.Context, eventID int) (*dto.InvitationStatsResponse, error)
+	GetInvitationStatsForEvents(ctx context.Context, creatorID int, eventIDs []int) (map[int]*dto.InvitationStatsResponse, error)
 
 	// User-specific operations
 	GetInvitationsByUserID(ctx context.Context, userID int, pagination dto.PaginationRequest) ([]*dto.InvitationResponse, *dto.PaginationResponse, error)
@@ -41,13 +48,16 @@ type InvitationService interface {
 	ResetInvitationToPending(ctx context.Context, id int) (*dto.InvitationResponse, error)
 
 	// Bulk operations
-	CreateMultipleInvitations(ctx context.Context, eventID int, req dto.BulkCreateInvitationRequest) ([]*dto.InvitationResponse, error)
+	CreateMultipleInvitations(ctx context.Context, eventID int, req dto.BulkCreateInvitationRequest) (*dto.BulkCreateInvitationResponse, error)
+	InviteUsersByIDs(ctx context.Context, eventID, creatorUserID int, userIDs []int) (*dto.InviteUsersByIDsResponse, error)
 	DeleteAllEventInvitations(ctx context.Context, eventID int) error
 
 	// Email-based operations for external users
 	GetPendingInvitationsByEmail(ctx context.Context, email string) ([]*dto.InvitationResponse, error)
 	GetEventInvitationByEmail(ctx context.Context, eventID int, email string) (*dto.InvitationResponse, error)
+	GetInvitationByToken(ctx context.Context, eventID int, token string) (*dto.InvitationLookupResponse, error)
 	RespondToInvitationByEmail(ctx context.Context, eventID int, email string, status domain.InvitationStatus) (*dto.InvitationResponse, error)
+	RespondToInvitationByToken(ctx context.Context, eventID int, token string, status domain.InvitationStatus) (*dto.InvitationResponse, error)
 
 	// Expiration operations
 	GetExpiredInvitations(ctx context.Context, expirationHours int, pagination dto.PaginationRequest) ([]*dto.InvitationResponse, *dto.PaginationResponse, error)
@@ -68,35 +78,46 @@ type InvitationService interface {
 }
 
 type invitationService struct {
-	invitationRepo repository.InvitationRepository
-	eventRepo      repository.EventRepository
-	userRepo       repository.UserRepository
-	logger         zerolog.Logger
+	invitationRepo         repository.InvitationRepository
+	eventRepo              repository.EventRepository
+	userRepo               repository.UserRepository
+	userSubscriptionRepo   repository.UserSubscriptionRepository
+	waitlistService        WaitlistService
+	maxInvitationsPerEvent int
+	linkTTL                time.Duration
+	lateResponseGrace      time.Duration
+	logger                 zerolog.Logger
 }
 
 func NewInvitationService(
 	invitationRepo repository.InvitationRepository,
 	eventRepo repository.EventRepository,
 	userRepo repository.UserRepository,
+	userSubscriptionRepo repository.UserSubscriptionRepository,
+	waitlistService WaitlistService,
+	maxInvitationsPerEvent int,
+	linkTTL time.Duration,
+	lateResponseGrace time.Duration,
 	logger zerolog.Logger,
 ) InvitationService {
 	return &invitationService{
-		invitationRepo: invitationRepo,
-		eventRepo:      eventRepo,
-		userRepo:       userRepo,
-		logger:         logger.With().Str("service", "invitation").Logger(),
+		invitationRepo:         invitationRepo,
+		eventRepo:              eventRepo,
+		userRepo:               userRepo,
+		userSubscriptionRepo:   userSubscriptionRepo,
+		waitlistService:        waitlistService,
+		maxInvitationsPerEvent: maxInvitationsPerEvent,
+		linkTTL:                linkTTL,
+		lateResponseGrace:      lateResponseGrace,
+		logger:                 logger.With().Str("service", "invitation").Logger(),
 	}
 }
 
 // Basic CRUD operations
 func (s *invitationService) CreateInvitation(ctx context.Context, eventID int, req dto.CreateInvitationRequest) (*dto.InvitationResponse, error) {
-	// Validate event exists
-	exists, err := s.eventRepo.ExistsByID(ctx, eventID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to check event existence: %w", err)
-	}
-	if !exists {
-		return nil, fmt.Errorf("event not found")
+	// Validate event exists and still has room on its guest list
+	if err := s.checkInvitationLimit(ctx, eventID, 1); err != nil {
+		return nil, err
 	}
 
 	// Validate request
@@ -112,16 +133,16 @@ func (s *invitationService) CreateInvitation(ctx context.Context, eventID int, r
 			return nil, fmt.Errorf("failed to check existing invitation: %w", err)
 		}
 		if exists {
-			return nil, fmt.Errorf("user is already invited to this event")
+			return nil, domain.ErrInvitationUserAlreadyInvited
 		}
 	}
 
-	exists, err = s.invitationRepo.ExistsByEventAndEmail(ctx, eventID, req.InvitedEmail)
+	exists, err := s.invitationRepo.ExistsByEventAndEmail(ctx, eventID, req.InvitedEmail)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check existing invitation: %w", err)
 	}
 	if exists {
-		return nil, fmt.Errorf("email is already invited to this event")
+		return nil, domain.ErrInvitationDuplicateEmail
 	}
 
 	// Create domain entity
@@ -130,6 +151,7 @@ func (s *invitationService) CreateInvitation(ctx context.Context, eventID int, r
 		InvitedUserID: req.InvitedUserID,
 		InvitedEmail:  req.InvitedEmail,
 		Status:        domain.InvitationStatusPending,
+		Token:         domain.NewInvitationToken(),
 		InvitedAt:     time.Now(),
 	}
 
@@ -173,6 +195,9 @@ func (s *invitationService) UpdateInvitationStatus(ctx context.Context, id int,
 	// Get existing invitation
 	existingInvitation, err := s.invitationRepo.GetByID(ctx, id)
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrInvitationNotFound
+		}
 		return nil, fmt.Errorf("failed to get existing invitation: %w", err)
 	}
 
@@ -180,6 +205,15 @@ func (s *invitationService) UpdateInvitationStatus(ctx context.Context, id int,
 	if err := s.validateStatusTransition(existingInvitation.Status, req.Status); err != nil {
 		return nil, err
 	}
+	if req.Status.IsApproved() {
+		event, err := s.getEvent(ctx, existingInvitation.EventID)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.checkSeatAvailable(ctx, event); err != nil {
+			return nil, err
+		}
+	}
 
 	// Update status
 	if err := s.invitationRepo.UpdateStatus(ctx, id, req.Status); err != nil {
@@ -197,6 +231,7 @@ func (s *invitationService) UpdateInvitationStatus(ctx context.Context, id int,
 	}
 
 	s.logger.Info().Int("invitation_id", id).Str("status", string(req.Status)).Msg("Invitation status updated successfully")
+	s.releaseSeat(ctx, existingInvitation.EventID, existingInvitation.Status, req.Status)
 
 	// Get updated invitation
 	updatedInvitation, err := s.invitationRepo.GetByID(ctx, id)
@@ -214,7 +249,7 @@ func (s *invitationService) DeleteInvitation(ctx context.Context, id int) error
 		return fmt.Errorf("failed to check invitation existence: %w", err)
 	}
 	if !exists {
-		return fmt.Errorf("invitation not found")
+		return domain.ErrInvitationNotFound
 	}
 
 	// Delete invitation
@@ -268,6 +303,39 @@ func (s *invitationService) GetEventInvitationStats(ctx context.Context, eventID
 	return stats, nil
 }
 
+// GetInvitationStatsForEvents returns invitation stats for several of the creator's events at
+// once. It fails if any of the events is missing or owned by someone else.
+func (s *invitationService) GetInvitationStatsForEvents(ctx context.Context, creatorID int, eventIDs []int) (map[int]*dto.InvitationStatsResponse, error) {
+	uniqueIDs := uniqueInts(eventIDs)
+	if len(uniqueIDs) == 0 {
+		return map[int]*dto.InvitationStatsResponse{}, nil
+	}
+	if len(uniqueIDs) > maxInvitationStatsEvents {
+		return nil, domain.ErrInvitationStatsTooManyEvents
+	}
+
+	events, err := s.eventRepo.GetMultipleByIDs(ctx, uniqueIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events: %w", err)
+	}
+	if len(events) != len(uniqueIDs) {
+		return nil, domain.ErrEventNotFound
+	}
+	for _, event := range events {
+		if event.CreatorID != creatorID {
+			return nil, domain.ErrEventNotFound
+		}
+	}
+
+	stats, err := s.invitationRepo.GetInvitationStatsForEvents(ctx, uniqueIDs)
+	if err != nil {
+		s.logger.Error().Err(err).Ints("event_ids", uniqueIDs).Msg("Failed to get invitation stats for events")
+		return nil, fmt.Errorf("failed to get invitation stats: %w", err)
+	}
+
+	return stats, nil
+}
+
 // User-specific operations
 func (s *invitationService) GetInvitationsByUserID(ctx context.Context, userID int, pagination dto.PaginationRequest) ([]*dto.InvitationResponse, *dto.PaginationResponse, error) {
 	invitations, paginationResp, err := s.invitationRepo.GetByUserID(ctx, userID, pagination)
@@ -390,18 +458,24 @@ func (s *invitationService) ResetInvitationToPending(ctx context.Context, id int
 }
 
 // Bulk operations
-func (s *invitationService) CreateMultipleInvitations(ctx context.Context, eventID int, req dto.BulkCreateInvitationRequest) ([]*dto.InvitationResponse, error) {
+
+// CreateMultipleInvitations creates the batch in request order up to the event's invitation cap;
+// the invitations past the cap are reported as rejected rather than failing the whole batch
+func (s *invitationService) CreateMultipleInvitations(ctx context.Context, eventID int, req dto.BulkCreateInvitationRequest) (*dto.BulkCreateInvitationResponse, error) {
 	if len(req.Invitations) == 0 {
-		return nil, fmt.Errorf("no invitations to create")
+		return nil, domain.ErrInvitationNoneToCreate
 	}
 
-	// Validate event exists
-	exists, err := s.eventRepo.ExistsByID(ctx, eventID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to check event existence: %w", err)
+	// Reject repeated emails before touching the database; they would otherwise pass the
+	// per-email existence check and collide on insert
+	if duplicates := duplicateInvitationEmails(req.Invitations); len(duplicates) > 0 {
+		s.logger.Warn().Int("event_id", eventID).Strs("emails", duplicates).Msg("Bulk invitation request contains duplicate emails")
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvitationDuplicateInBatch, strings.Join(duplicates, ", "))
 	}
-	if !exists {
-		return nil, fmt.Errorf("event not found")
+
+	event, err := s.getEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
 	}
 
 	var invitations []*domain.Invitation
@@ -435,6 +509,7 @@ func (s *invitationService) CreateMultipleInvitations(ctx context.Context, event
 			InvitedUserID: invReq.InvitedUserID,
 			InvitedEmail:  invReq.InvitedEmail,
 			Status:        domain.InvitationStatusPending,
+			Token:         domain.NewInvitationToken(),
 			InvitedAt:     time.Now(),
 		}
 
@@ -446,20 +521,146 @@ func (s *invitationService) CreateMultipleInvitations(ctx context.Context, event
 		invitations = append(invitations, invitation)
 	}
 
-	// Create all invitations
-	if err := s.invitationRepo.CreateMultiple(ctx, invitations); err != nil {
-		s.logger.Error().Err(err).Int("event_id", eventID).Int("count", len(invitations)).Msg("Failed to create multiple invitations")
-		return nil, fmt.Errorf("failed to create invitations: %w", err)
+	room, err := s.invitationRoom(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+	accepted := invitations
+	if room >= 0 && len(invitations) > room {
+		s.logger.Warn().Int("event_id", eventID).Int("requested", len(invitations)).Int("room", room).Msg("Bulk invitation request crosses the event invitation limit")
+		accepted = invitations[:room]
+	}
+
+	if len(accepted) > 0 {
+		if err := s.invitationRepo.CreateMultiple(ctx, accepted); err != nil {
+			s.logger.Error().Err(err).Int("event_id", eventID).Int("count", len(accepted)).Msg("Failed to create multiple invitations")
+			return nil, fmt.Errorf("failed to create invitations: %w", err)
+		}
 	}
 
-	s.logger.Info().Int("event_id", eventID).Int("count", len(invitations)).Msg("Multiple invitations created successfully")
+	response := &dto.BulkCreateInvitationResponse{
+		Created:     len(accepted),
+		Rejected:    len(invitations) - len(accepted),
+		Invitations: make([]*dto.InvitationResponse, 0, len(accepted)),
+		Results:     make([]dto.BulkInvitationResult, len(invitations)),
+	}
+	for i, invitation := range invitations {
+		response.Results[i] = dto.BulkInvitationResult{Index: i, Email: invitation.InvitedEmail, Result: dto.BulkInvitationResultLimitReached}
+		if i < len(accepted) {
+			id := invitation.ID
+			response.Results[i].Result = dto.BulkInvitationResultCreated
+			response.Results[i].InvitationID = &id
+			response.Invitations = append(response.Invitations, s.invitationToResponse(invitation))
+		}
+	}
+
+	s.logger.Info().Int("event_id", eventID).Int("created", response.Created).Int("rejected", response.Rejected).Msg("Multiple invitations created")
+	return response, nil
+}
+
+// InviteUsersByIDs invites the creator's followers and past attendees using the email on their
+// account. Every other id, and any user who cannot be invited, gets the same skipped result so
+// the response never tells whether an id belongs to a user.
+func (s *invitationService) InviteUsersByIDs(ctx context.Context, eventID, creatorUserID int, userIDs []int) (*dto.InviteUsersByIDsResponse, error) {
+	userIDs = uniqueInts(userIDs)
+	if len(userIDs) == 0 {
+		return nil, domain.ErrInvitationNoneToCreate
+	}
+	if len(userIDs) > maxInvitationsPerRequest {
+		return nil, domain.ErrInvitationTooManyUsers
+	}
+
+	event, err := s.getEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event.Creator.UserID != creatorUserID {
+		return nil, domain.ErrEventUnauthorized
+	}
+
+	users, err := s.userRepo.GetInvitableByIDs(ctx, creatorUserID, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+	usersByID := make(map[int]*domain.User, len(users))
+	candidateIDs := make([]int, 0, len(users))
+	candidateEmails := make([]string, 0, len(users))
+	for _, user := range users {
+		if user.Email == nil || strings.TrimSpace(*user.Email) == "" {
+			continue
+		}
+		usersByID[user.ID] = user
+		candidateIDs = append(candidateIDs, user.ID)
+		candidateEmails = append(candidateEmails, normalizeInvitationEmail(*user.Email))
+	}
+
+	existing, err := s.invitationRepo.GetByEventAndInvitees(ctx, eventID, candidateIDs, candidateEmails)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check existing invitations: %w", err)
+	}
+	invitedUsers := make(map[int]bool, len(existing))
+	invitedEmails := make(map[string]bool, len(existing))
+	for _, invitation := range existing {
+		if invitation.InvitedUserID != nil {
+			invitedUsers[*invitation.InvitedUserID] = true
+		}
+		invitedEmails[normalizeInvitationEmail(invitation.InvitedEmail)] = true
+	}
+
+	response := &dto.InviteUsersByIDsResponse{Results: make([]dto.InviteUserResult, len(userIDs))}
+	var invitations []*domain.Invitation
+	resultIndex := make(map[*domain.Invitation]int)
+
+	for i, userID := range userIDs {
+		response.Results[i] = dto.InviteUserResult{UserID: userID, Result: dto.InviteUserResultSkipped}
+
+		user, ok := usersByID[userID]
+		if !ok || invitedUsers[userID] {
+			continue
+		}
+		email := normalizeInvitationEmail(*user.Email)
+		if invitedEmails[email] {
+			continue
+		}
+		invitedEmails[email] = true
+
+		invitation := domain.NewInvitation(eventID, email, &user.ID)
+		invitations = append(invitations, invitation)
+		resultIndex[invitation] = i
+	}
+
+	// Users past the invitation cap are skipped like the others instead of failing the request
+	room, err := s.invitationRoom(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+	if room >= 0 && len(invitations) > room {
+		invitations = invitations[:room]
+	}
+
+	if len(invitations) > 0 {
+		if err := s.invitationRepo.CreateMultiple(ctx, invitations); err != nil {
+			s.logger.Error().Err(err).Int("event_id", eventID).Int("count", len(invitations)).Msg("Failed to invite users by id")
+			return nil, fmt.Errorf("failed to create invitations: %w", err)
+		}
+	}
 
-	var responses []*dto.InvitationResponse
 	for _, invitation := range invitations {
-		responses = append(responses, s.invitationToResponse(invitation))
+		i := resultIndex[invitation]
+		id := invitation.ID
+		response.Results[i].Result = dto.InviteUserResultInvited
+		response.Results[i].InvitationID = &id
 	}
+	response.Invited = len(invitations)
+	response.Skipped = len(userIDs) - len(invitations)
 
-	return responses, nil
+	s.logger.Info().Int("event_id", eventID).Int("invited", response.Invited).Int("skipped", response.Skipped).Msg("Users invited by id")
+	return response, nil
+}
+
+// normalizeInvitationEmail is the form invitation emails are stored and compared in
+func normalizeInvitationEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
 }
 
 func (s *invitationService) DeleteAllEventInvitations(ctx context.Context, eventID int) error {
@@ -499,6 +700,38 @@ func (s *invitationService) GetEventInvitationByEmail(ctx context.Context, event
 	return s.invitationToResponse(invitation), nil
 }
 
+// GetInvitationByToken resolves an RSVP link for the given event. A token issued for another
+// event is reported as not found, so a link cannot be used to probe other events.
+func (s *invitationService) GetInvitationByToken(ctx context.Context, eventID int, token string) (*dto.InvitationLookupResponse, error) {
+	invitation, err := s.invitationRepo.GetByToken(ctx, token)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrInvitationNotFound
+		}
+		s.logger.Error().Err(err).Int("event_id", eventID).Msg("Failed to get invitation by token")
+		return nil, fmt.Errorf("failed to get invitation: %w", err)
+	}
+	if invitation.EventID != eventID {
+		return nil, domain.ErrInvitationNotFound
+	}
+	if invitation.IsLinkExpired(s.linkTTL) {
+		return nil, domain.ErrInvitationLinkExpired
+	}
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrEventNotFound
+		}
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	return &dto.InvitationLookupResponse{
+		Invitation: s.invitationToResponse(invitation),
+		Event:      dto.EventToListResponse(event),
+	}, nil
+}
+
 func (s *invitationService) RespondToInvitationByEmail(ctx context.Context, eventID int, email string, status domain.InvitationStatus) (*dto.InvitationResponse, error) {
 	// Get invitation by event and email
 	invitation, err := s.invitationRepo.GetEventInvitationByEmail(ctx, eventID, email)
@@ -506,27 +739,72 @@ func (s *invitationService) RespondToInvitationByEmail(ctx context.Context, even
 		return nil, fmt.Errorf("failed to get invitation: %w", err)
 	}
 
+	response, err := s.respondToInvitation(ctx, invitation, status)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().Int("invitation_id", invitation.ID).Str("email", email).Str("status", string(response.Status)).Msg("Invitation responded by email successfully")
+	return response, nil
+}
+
+// RespondToInvitationByToken answers an invitation through its RSVP link. Like
+// GetInvitationByToken, a token issued for another event is reported as not found.
+func (s *invitationService) RespondToInvitationByToken(ctx context.Context, eventID int, token string, status domain.InvitationStatus) (*dto.InvitationResponse, error) {
+	invitation, err := s.invitationRepo.GetByToken(ctx, token)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrInvitationNotFound
+		}
+		s.logger.Error().Err(err).Int("event_id", eventID).Msg("Failed to get invitation by token")
+		return nil, fmt.Errorf("failed to get invitation: %w", err)
+	}
+	if invitation.EventID != eventID {
+		return nil, domain.ErrInvitationNotFound
+	}
+	if invitation.IsLinkExpired(s.linkTTL) {
+		return nil, domain.ErrInvitationLinkExpired
+	}
+
+	response, err := s.respondToInvitation(ctx, invitation, status)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().Int("invitation_id", invitation.ID).Str("status", string(response.Status)).Msg("Invitation responded by link successfully")
+	return response, nil
+}
+
+// respondToInvitation stores a guest's answer. Invitations expire when the event starts;
+// answers within the late response grace are accepted as late_approved.
+func (s *invitationService) respondToInvitation(ctx context.Context, invitation *domain.Invitation, status domain.InvitationStatus) (*dto.InvitationResponse, error) {
+	event, err := s.getEvent(ctx, invitation.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err = invitation.ResolveResponse(status, event.GetFullStartDateTime(), s.lateResponseGrace, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
 	// Validate status transition
 	if err := s.validateStatusTransition(invitation.Status, status); err != nil {
 		return nil, err
 	}
+	if status.IsApproved() {
+		if err := s.checkSeatAvailable(ctx, event); err != nil {
+			return nil, err
+		}
+	}
 
-	// Update status
+	// UpdateStatus also stamps responded_at; saving the loaded invitation afterwards would
+	// write the old status back
 	if err := s.invitationRepo.UpdateStatus(ctx, invitation.ID, status); err != nil {
 		s.logger.Error().Err(err).Int("invitation_id", invitation.ID).Str("status", string(status)).Msg("Failed to update invitation status")
 		return nil, fmt.Errorf("failed to update invitation status: %w", err)
 	}
-
-	// Set responded_at timestamp
-	if status != domain.InvitationStatusPending && invitation.RespondedAt == nil {
-		now := time.Now()
-		invitation.RespondedAt = &now
-		if err := s.invitationRepo.Update(ctx, invitation); err != nil {
-			s.logger.Warn().Err(err).Int("invitation_id", invitation.ID).Msg("Failed to update responded_at timestamp")
-		}
-	}
-
-	s.logger.Info().Int("invitation_id", invitation.ID).Str("email", email).Str("status", string(status)).Msg("Invitation responded by email successfully")
+	s.releaseSeat(ctx, invitation.EventID, invitation.Status, status)
 
 	// Get updated invitation
 	updatedInvitation, err := s.invitationRepo.GetByID(ctx, invitation.ID)
@@ -581,6 +859,10 @@ func (s *invitationService) GetInvitationsExpiringIn(ctx context.Context, hours
 
 // Advanced filtering
 func (s *invitationService) GetInvitationsWithFilters(ctx context.Context, filters dto.InvitationFilterRequest, pagination dto.PaginationRequest) ([]*dto.InvitationResponse, *dto.PaginationResponse, error) {
+	if !validTimeRange(filters.InvitedFrom, filters.InvitedTo) || !validTimeRange(filters.RespondedFrom, filters.RespondedTo) {
+		return nil, nil, domain.ErrInvitationInvalidDateRange
+	}
+
 	invitations, paginationResp, err := s.invitationRepo.GetInvitationsWithFilters(ctx, filters, pagination)
 	if err != nil {
 		s.logger.Error().Err(err).Interface("filters", filters).Msg("Failed to get invitations with filters")
@@ -614,7 +896,7 @@ func (s *invitationService) ValidateInvitationAccess(ctx context.Context, invita
 		return fmt.Errorf("failed to validate access: %w", err)
 	}
 	if !canAccess {
-		return fmt.Errorf("access denied: you cannot access this invitation")
+		return domain.ErrInvitationUnauthorized
 	}
 	return nil
 }
@@ -626,22 +908,22 @@ func (s *invitationService) ValidateInvitationOwnership(ctx context.Context, inv
 		return fmt.Errorf("failed to validate ownership: %w", err)
 	}
 	if !isOwner {
-		return fmt.Errorf("access denied: invitation does not belong to this event")
+		return domain.ErrInvitationUnauthorized
 	}
 	return nil
 }
 
 func (s *invitationService) ValidateInvitationData(ctx context.Context, invitation *domain.Invitation) error {
 	if invitation.EventID <= 0 {
-		return fmt.Errorf("event ID is required")
+		return domain.ErrInvitationEventRequired
 	}
 	if invitation.InvitedEmail == "" {
-		return fmt.Errorf("invited email is required")
+		return domain.ErrInvitationEmailRequired
 	}
 
 	// Validate email format (basic validation)
 	if len(invitation.InvitedEmail) < 5 || !contains(invitation.InvitedEmail, "@") {
-		return fmt.Errorf("invalid email format")
+		return domain.ErrInvitationInvalidEmail
 	}
 
 	return nil
@@ -658,25 +940,139 @@ func (s *invitationService) CanUserRespondToInvitation(ctx context.Context, invi
 }
 
 // Helper methods
+
+// uniqueInts drops repeated ids while keeping their first-seen order
+func uniqueInts(ids []int) []int {
+	seen := make(map[int]bool, len(ids))
+	unique := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+// duplicateInvitationEmails returns every email that appears more than once in the batch,
+// compared case-insensitively and ignoring surrounding whitespace
+func duplicateInvitationEmails(invitations []dto.CreateInvitationRequest) []string {
+	seen := make(map[string]bool, len(invitations))
+	reported := make(map[string]bool)
+	var duplicates []string
+	for _, invitation := range invitations {
+		email := strings.ToLower(strings.TrimSpace(invitation.InvitedEmail))
+		if email == "" {
+			continue
+		}
+		if seen[email] && !reported[email] {
+			reported[email] = true
+			duplicates = append(duplicates, email)
+		}
+		seen[email] = true
+	}
+	return duplicates
+}
+
+// getEvent loads an event, reporting a missing one as ErrEventNotFound
+func (s *invitationService) getEvent(ctx context.Context, eventID int) (*domain.Event, error) {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrEventNotFound
+		}
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	return event, nil
+}
+
+// checkInvitationLimit makes sure the event exists and that adding the given number of
+// invitations keeps it within the lower of the platform and creator subscription caps
+func (s *invitationService) checkInvitationLimit(ctx context.Context, eventID int, adding int) error {
+	event, err := s.getEvent(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	room, err := s.invitationRoom(ctx, event)
+	if err != nil {
+		return err
+	}
+	if room >= 0 && adding > room {
+		s.logger.Warn().Int("event_id", eventID).Int("room", room).Int("adding", adding).Msg("Event invitation limit reached")
+		return domain.ErrInvitationEventLimitReached
+	}
+	return nil
+}
+
+// invitationRoom returns how many more invitations an already loaded event can take under the
+// lower of the platform and creator subscription caps, or -1 when neither caps it
+func (s *invitationService) invitationRoom(ctx context.Context, event *domain.Event) (int, error) {
+	subscription, err := s.userSubscriptionRepo.GetActiveSubscriptionByUserID(ctx, uint(event.Creator.UserID))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get creator subscription: %w", err)
+	}
+
+	limit := domain.InvitationLimit(s.maxInvitationsPerEvent, subscription)
+	if limit <= 0 {
+		return -1, nil
+	}
+
+	count, err := s.invitationRepo.CountByEventID(ctx, event.ID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count event invitations: %w", err)
+	}
+	return max(limit-int(count), 0), nil
+}
+
 func (s *invitationService) validateCreateInvitationRequest(req *dto.CreateInvitationRequest) error {
 	if req.InvitedEmail == "" {
-		return fmt.Errorf("invited email is required")
+		return domain.ErrInvitationEmailRequired
 	}
 
 	// Basic email validation
 	if len(req.InvitedEmail) < 5 || !contains(req.InvitedEmail, "@") {
-		return fmt.Errorf("invalid email format")
+		return domain.ErrInvitationInvalidEmail
 	}
 
 	return nil
 }
 
+// checkSeatAvailable rejects approving one more invitation when the event is at capacity.
+// validateStatusTransition never moves an approved invitation to another approved status, so
+// every approval takes a new seat.
+func (s *invitationService) checkSeatAvailable(ctx context.Context, event *domain.Event) error {
+	if event.Capacity == nil {
+		return nil
+	}
+	attendees, err := s.eventRepo.CountAttendees(ctx, event.ID)
+	if err != nil {
+		s.logger.Error().Err(err).Int("event_id", event.ID).Msg("Failed to count event attendees")
+		return fmt.Errorf("failed to check capacity: %w", err)
+	}
+	return event.CheckCapacity(attendees, 1)
+}
+
+// releaseSeat hands the seat of an invitation that is no longer approved to the waitlist. The
+// status change is already stored, so a failed promotion is only logged.
+func (s *invitationService) releaseSeat(ctx context.Context, eventID int, from, to domain.InvitationStatus) {
+	if !from.IsApproved() || to.IsApproved() {
+		return
+	}
+	if _, err := s.waitlistService.Promote(ctx, eventID, 1); err != nil {
+		s.logger.Error().Err(err).Int("event_id", eventID).Msg("Failed to promote waitlist after invitation change")
+	}
+}
+
 func (s *invitationService) validateStatusTransition(currentStatus, newStatus domain.InvitationStatus) error {
 	validTransitions := map[domain.InvitationStatus][]domain.InvitationStatus{
 		domain.InvitationStatusPending: {
 			domain.InvitationStatusApproved,
+			domain.InvitationStatusLateApproved,
 			domain.InvitationStatusRejected,
 		},
+		domain.InvitationStatusLateApproved: {
+			domain.InvitationStatusPending, // Allow reset to pending
+		},
 		domain.InvitationStatusApproved: {
 			domain.InvitationStatusPending, // Allow reset to pending
 		},
@@ -725,6 +1121,12 @@ func (s *invitationService) invitationToResponse(invitation *domain.Invitation)
 }
 
 // Utility functions
+
+// validTimeRange reports whether an optional window is ordered; open ends always are
+func validTimeRange(from, to *time.Time) bool {
+	return from == nil || to == nil || !from.After(*to)
+}
+
 func getUserIDOrZero(userID *int) int {
 	if userID == nil {
 		return 0
@@ -0,0 +1,414 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/repository/postgres"
+	"github.com/louco-event/internal/testutil"
+	"github.com/louco-event/pkg/logger"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+func newTestInvitationService(db *gorm.DB, maxInvitationsPerEvent int) InvitationService {
+	nop := zerolog.Nop()
+	return NewInvitationService(
+		postgres.NewInvitationRepository(db),
+		postgres.NewEventRepository(db),
+		postgres.NewUserRepository(db),
+		postgres.NewUserSubscriptionRepository(db, &logger.Logger{Logger: &nop}),
+		nil,
+		maxInvitationsPerEvent,
+		24*time.Hour,
+		time.Hour,
+		zerolog.Nop(),
+	)
+}
+
+// seedInvitations inserts one invitation per status for an event; mutate may adjust each before
+// it is saved
+func seedInvitations(t *testing.T, db *gorm.DB, eventID int, statuses []domain.InvitationStatus, mutate func(invitation *domain.Invitation)) []*domain.Invitation {
+	t.Helper()
+	invitations := make([]*domain.Invitation, 0, len(statuses))
+	for _, status := range statuses {
+		invitation := domain.NewInvitation(eventID, uuid.NewString()+"@example.com", nil)
+		invitation.Status = status
+		if mutate != nil {
+			mutate(invitation)
+		}
+		if err := db.Omit("Event", "InvitedUser").Create(invitation).Error; err != nil {
+			t.Fatalf("failed to create invitation: %v", err)
+		}
+		invitations = append(invitations, invitation)
+	}
+	return invitations
+}
+
+func TestGetInvitationStatsForEvents(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	other := testutil.CreateCreator(t, db)
+	service := newTestInvitationService(db, 0)
+
+	private := func(event *domain.Event) {
+		event.Type = domain.EventTypePrivate
+	}
+	events := []*domain.Event{
+		testutil.CreateEvent(t, db, creator.ID, private),
+		testutil.CreateEvent(t, db, creator.ID, private),
+		testutil.CreateEvent(t, db, creator.ID, private),
+	}
+	seedInvitations(t, db, events[0].ID, []domain.InvitationStatus{domain.InvitationStatusPending, domain.InvitationStatusApproved, domain.InvitationStatusRejected}, nil)
+	seedInvitations(t, db, events[1].ID, []domain.InvitationStatus{domain.InvitationStatusApproved, domain.InvitationStatusApproved}, nil)
+
+	ids := []int{events[0].ID, events[1].ID, events[2].ID}
+	batched, err := service.GetInvitationStatsForEvents(ctx, creator.ID, ids)
+	if err != nil {
+		t.Fatalf("GetInvitationStatsForEvents: %v", err)
+	}
+	if len(batched) != len(ids) {
+		t.Errorf("got stats for %d events, want %d", len(batched), len(ids))
+	}
+	for _, id := range ids {
+		single, err := service.GetEventInvitationStats(ctx, id)
+		if err != nil {
+			t.Fatalf("GetEventInvitationStats: %v", err)
+		}
+		if got := batched[id]; got == nil || *got != *single {
+			t.Errorf("event %d: batched stats %+v, want %+v", id, got, single)
+		}
+	}
+
+	othersEvent := testutil.CreateEvent(t, db, other.ID, private)
+	if _, err := service.GetInvitationStatsForEvents(ctx, creator.ID, []int{events[0].ID, othersEvent.ID}); !errors.Is(err, domain.ErrEventNotFound) {
+		t.Errorf("another creator's event: err = %v, want event not found", err)
+	}
+	tooMany := make([]int, maxInvitationStatsEvents+1)
+	for i := range tooMany {
+		tooMany[i] = i + 1
+	}
+	if _, err := service.GetInvitationStatsForEvents(ctx, creator.ID, tooMany); !errors.Is(err, domain.ErrInvitationStatsTooManyEvents) {
+		t.Errorf("too many events: err = %v, want ErrInvitationStatsTooManyEvents", err)
+	}
+}
+
+func TestCreateMultipleInvitationsCrossesCap(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestInvitationService(db, 5)
+
+	event := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+		event.Type = domain.EventTypePrivate
+	})
+	seedInvitations(t, db, event.ID, []domain.InvitationStatus{domain.InvitationStatusPending, domain.InvitationStatusApproved, domain.InvitationStatusRejected}, nil)
+
+	req := dto.BulkCreateInvitationRequest{Invitations: []dto.CreateInvitationRequest{
+		{InvitedEmail: "first@example.com"},
+		{InvitedEmail: "second@example.com"},
+		{InvitedEmail: "third@example.com"},
+		{InvitedEmail: "fourth@example.com"},
+	}}
+	report, err := service.CreateMultipleInvitations(ctx, event.ID, req)
+	if err != nil {
+		t.Fatalf("CreateMultipleInvitations: %v", err)
+	}
+	if report.Created != 2 || report.Rejected != 2 || len(report.Invitations) != 2 {
+		t.Errorf("created %d (%d invitations), rejected %d; want 2 created and 2 rejected", report.Created, len(report.Invitations), report.Rejected)
+	}
+	want := []string{dto.BulkInvitationResultCreated, dto.BulkInvitationResultCreated, dto.BulkInvitationResultLimitReached, dto.BulkInvitationResultLimitReached}
+	for i, result := range report.Results {
+		if result.Index != i || result.Email != req.Invitations[i].InvitedEmail || result.Result != want[i] {
+			t.Errorf("result %d = %+v, want %s for %s", i, result, want[i], req.Invitations[i].InvitedEmail)
+		}
+		if (result.InvitationID != nil) != (want[i] == dto.BulkInvitationResultCreated) {
+			t.Errorf("result %d invitation id = %v", i, result.InvitationID)
+		}
+	}
+
+	var count int64
+	if err := db.Model(&domain.Invitation{}).Where("event_id = ?", event.ID).Count(&count).Error; err != nil {
+		t.Fatalf("failed to count invitations: %v", err)
+	}
+	if count != 5 {
+		t.Errorf("event has %d invitations, want the cap of 5", count)
+	}
+
+	// A full event rejects every entry of the next batch
+	report, err = service.CreateMultipleInvitations(ctx, event.ID, dto.BulkCreateInvitationRequest{Invitations: []dto.CreateInvitationRequest{{InvitedEmail: "late@example.com"}}})
+	if err != nil {
+		t.Fatalf("CreateMultipleInvitations on a full event: %v", err)
+	}
+	if report.Created != 0 || report.Rejected != 1 {
+		t.Errorf("full event: created %d, rejected %d; want 0 and 1", report.Created, report.Rejected)
+	}
+}
+
+func TestCreateMultipleInvitationsRejectsDuplicateEmailsInBatch(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestInvitationService(db, 10)
+
+	event := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+		event.Type = domain.EventTypePrivate
+	})
+
+	// Case and surrounding whitespace do not make an email distinct
+	req := dto.BulkCreateInvitationRequest{Invitations: []dto.CreateInvitationRequest{
+		{InvitedEmail: "guest@example.com"},
+		{InvitedEmail: "other@example.com"},
+		{InvitedEmail: " Guest@Example.com "},
+		{InvitedEmail: "GUEST@example.com"},
+	}}
+	_, err := service.CreateMultipleInvitations(ctx, event.ID, req)
+	if !errors.Is(err, domain.ErrInvitationDuplicateInBatch) {
+		t.Fatalf("err = %v, want ErrInvitationDuplicateInBatch", err)
+	}
+	if !strings.HasSuffix(err.Error(), ": guest@example.com") {
+		t.Errorf("err = %q, want the repeated email reported once", err)
+	}
+
+	var count int64
+	if err := db.Model(&domain.Invitation{}).Where("event_id = ?", event.ID).Count(&count).Error; err != nil {
+		t.Fatalf("failed to count invitations: %v", err)
+	}
+	if count != 0 {
+		t.Errorf("event has %d invitations, want none from the refused batch", count)
+	}
+}
+
+func TestInviteUsersByIDsMixesKnownAndUnknownUsers(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestInvitationService(db, 0)
+
+	event := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+		event.Type = domain.EventTypePrivate
+	})
+
+	follower := testutil.CreateUser(t, db, domain.UserTypeUser)
+	if err := db.Omit("Follower", "Following").Create(&domain.Follow{FollowerID: follower.ID, FollowingID: creator.UserID}).Error; err != nil {
+		t.Fatalf("failed to create follow: %v", err)
+	}
+	attendee := testutil.CreateUser(t, db, domain.UserTypeUser)
+	past := testutil.CreateEvent(t, db, creator.ID, nil)
+	ticket := testutil.CreateTicket(t, db, past.ID, 10)
+	if err := db.Omit("Ticket", "User").Create(domain.NewTicketPurchase(ticket.ID, attendee.ID, 1)).Error; err != nil {
+		t.Fatalf("failed to create purchase: %v", err)
+	}
+	alreadyInvited := testutil.CreateUser(t, db, domain.UserTypeUser)
+	if err := db.Omit("Follower", "Following").Create(&domain.Follow{FollowerID: alreadyInvited.ID, FollowingID: creator.UserID}).Error; err != nil {
+		t.Fatalf("failed to create follow: %v", err)
+	}
+	seedInvitations(t, db, event.ID, []domain.InvitationStatus{domain.InvitationStatusPending}, func(invitation *domain.Invitation) {
+		invitation.InvitedEmail = *alreadyInvited.Email
+	})
+	stranger := testutil.CreateUser(t, db, domain.UserTypeUser)
+	unknownID := stranger.ID + 1000
+
+	ids := []int{follower.ID, unknownID, attendee.ID, stranger.ID, alreadyInvited.ID}
+	report, err := service.InviteUsersByIDs(ctx, event.ID, creator.UserID, ids)
+	if err != nil {
+		t.Fatalf("InviteUsersByIDs: %v", err)
+	}
+	if report.Invited != 2 || report.Skipped != 3 {
+		t.Errorf("invited %d, skipped %d; want 2 and 3", report.Invited, report.Skipped)
+	}
+	want := []string{dto.InviteUserResultInvited, dto.InviteUserResultSkipped, dto.InviteUserResultInvited, dto.InviteUserResultSkipped, dto.InviteUserResultSkipped}
+	for i, result := range report.Results {
+		if result.UserID != ids[i] || result.Result != want[i] {
+			t.Errorf("result %d = %+v, want %s for user %d", i, result, want[i], ids[i])
+		}
+		if (result.InvitationID != nil) != (want[i] == dto.InviteUserResultInvited) {
+			t.Errorf("result %d invitation id = %v", i, result.InvitationID)
+		}
+	}
+
+	var invitation domain.Invitation
+	if err := db.Where("event_id = ? AND invited_user_id = ?", event.ID, follower.ID).First(&invitation).Error; err != nil {
+		t.Fatalf("follower invitation not stored: %v", err)
+	}
+	if invitation.InvitedEmail != *follower.Email {
+		t.Errorf("follower invitation email = %q, want %q", invitation.InvitedEmail, *follower.Email)
+	}
+	var strangers int64
+	if err := db.Model(&domain.Invitation{}).Where("invited_user_id = ?", stranger.ID).Count(&strangers).Error; err != nil {
+		t.Fatalf("failed to count invitations: %v", err)
+	}
+	if strangers != 0 {
+		t.Errorf("a user with no relationship to the creator was invited")
+	}
+
+	// Another creator cannot invite to the event
+	other := testutil.CreateCreator(t, db)
+	if _, err := service.InviteUsersByIDs(ctx, event.ID, other.UserID, ids); !errors.Is(err, domain.ErrEventUnauthorized) {
+		t.Errorf("other creator: err = %v, want ErrEventUnauthorized", err)
+	}
+}
+
+func TestGetMultipleInvitationsByIDsChunksLongLists(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	event := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+		event.Type = domain.EventTypePrivate
+	})
+
+	statuses := make([]domain.InvitationStatus, 1001)
+	for i := range statuses {
+		statuses[i] = domain.InvitationStatusPending
+	}
+	want := make(map[int]bool)
+	ids := make([]int, 0, len(statuses))
+	for _, invitation := range seedInvitations(t, db, event.ID, statuses, nil) {
+		want[invitation.ID] = true
+		ids = append(ids, invitation.ID)
+	}
+	queries := countQueries(t, db, "invitations")
+
+	invitations, err := postgres.NewInvitationRepository(db).GetMultipleByIDs(ctx, ids)
+	if err != nil {
+		t.Fatalf("GetMultipleByIDs: %v", err)
+	}
+	if *queries != 3 {
+		t.Errorf("ran %d invitation queries, want 3 chunks of at most 500 ids", *queries)
+	}
+	if len(invitations) != len(ids) {
+		t.Fatalf("got %d invitations, want %d", len(invitations), len(ids))
+	}
+	for _, invitation := range invitations {
+		if !want[invitation.ID] || invitation.Event.ID != event.ID {
+			t.Fatalf("invitation %d is unexpected or missing its event", invitation.ID)
+		}
+		delete(want, invitation.ID)
+	}
+}
+
+func TestGetInvitationByToken(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestInvitationService(db, 10)
+
+	private := func(event *domain.Event) { event.Type = domain.EventTypePrivate }
+	event := testutil.CreateEvent(t, db, creator.ID, private)
+	other := testutil.CreateEvent(t, db, creator.ID, private)
+
+	pending := domain.InvitationStatusPending
+	valid := seedInvitations(t, db, event.ID, []domain.InvitationStatus{pending}, nil)[0]
+	expired := seedInvitations(t, db, event.ID, []domain.InvitationStatus{pending}, func(invitation *domain.Invitation) {
+		invitation.InvitedAt = time.Now().Add(-48 * time.Hour)
+	})[0]
+	used := seedInvitations(t, db, event.ID, []domain.InvitationStatus{domain.InvitationStatusApproved}, nil)[0]
+
+	lookup, err := service.GetInvitationByToken(ctx, event.ID, *valid.Token)
+	if err != nil {
+		t.Fatalf("GetInvitationByToken: %v", err)
+	}
+	if lookup.Invitation.ID != valid.ID || lookup.Event.ID != event.ID {
+		t.Errorf("lookup = invitation %d, event %d; want invitation %d, event %d", lookup.Invitation.ID, lookup.Event.ID, valid.ID, event.ID)
+	}
+
+	for name, invitation := range map[string]*domain.Invitation{"expired": expired, "used": used} {
+		if _, err := service.GetInvitationByToken(ctx, event.ID, *invitation.Token); !errors.Is(err, domain.ErrInvitationLinkExpired) {
+			t.Errorf("%s link: err = %v, want ErrInvitationLinkExpired", name, err)
+		}
+	}
+
+	// A token only opens the event it was issued for
+	if _, err := service.GetInvitationByToken(ctx, other.ID, *valid.Token); !errors.Is(err, domain.ErrInvitationNotFound) {
+		t.Errorf("token for another event: err = %v, want invitation not found", err)
+	}
+}
+
+func TestGetInvitationsWithFiltersByInvitedWindow(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestInvitationService(db, 10)
+	event := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+		event.Type = domain.EventTypePrivate
+	})
+
+	now := time.Now()
+	invitedDaysAgo := func(days int) *domain.Invitation {
+		return seedInvitations(t, db, event.ID, []domain.InvitationStatus{domain.InvitationStatusPending}, func(invitation *domain.Invitation) {
+			invitation.InvitedAt = now.AddDate(0, 0, -days)
+		})[0]
+	}
+	invitedDaysAgo(12)
+	lastWeek := []*domain.Invitation{invitedDaysAgo(6), invitedDaysAgo(3)}
+	invitedDaysAgo(0)
+
+	from, to := now.AddDate(0, 0, -7), now.AddDate(0, 0, -1)
+	filters := dto.InvitationFilterRequest{EventID: &event.ID, InvitedFrom: &from, InvitedTo: &to}
+	invitations, page, err := service.GetInvitationsWithFilters(ctx, filters, dto.PaginationRequest{Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("GetInvitationsWithFilters: %v", err)
+	}
+	got := make(map[int]bool, len(invitations))
+	for _, invitation := range invitations {
+		got[invitation.ID] = true
+	}
+	if len(invitations) != 2 || page.Total != 2 || !got[lastWeek[0].ID] || !got[lastWeek[1].ID] {
+		t.Errorf("got %d invitations of %d, want the 2 sent last week", len(invitations), page.Total)
+	}
+
+	// A reversed window is refused
+	filters.InvitedFrom, filters.InvitedTo = &to, &from
+	if _, _, err := service.GetInvitationsWithFilters(ctx, filters, dto.PaginationRequest{Page: 1, PageSize: 10}); !errors.Is(err, domain.ErrInvitationInvalidDateRange) {
+		t.Errorf("reversed window: err = %v, want ErrInvitationInvalidDateRange", err)
+	}
+}
+
+func TestRespondToInvitationLateResponseGrace(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestInvitationService(db, 10) // an hour of grace
+
+	startingIn := func(offset time.Duration) *domain.Event {
+		return testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+			start := time.Now().UTC().Add(offset)
+			event.Type = domain.EventTypePrivate
+			event.StartDate, event.StartTime = &start, &start
+		})
+	}
+	tests := []struct {
+		name    string
+		offset  time.Duration
+		status  domain.InvitationStatus
+		want    domain.InvitationStatus
+		wantErr error
+	}{
+		{"on time", 24 * time.Hour, domain.InvitationStatusApproved, domain.InvitationStatusApproved, nil},
+		{"within grace", -30 * time.Minute, domain.InvitationStatusApproved, domain.InvitationStatusLateApproved, nil},
+		{"decline within grace", -30 * time.Minute, domain.InvitationStatusRejected, domain.InvitationStatusRejected, nil},
+		{"past grace", -2 * time.Hour, domain.InvitationStatusApproved, "", domain.ErrInvitationExpired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := startingIn(tt.offset)
+			invitations := seedInvitations(t, db, event.ID, []domain.InvitationStatus{domain.InvitationStatusPending, domain.InvitationStatusPending}, nil)
+
+			byToken, err := service.RespondToInvitationByToken(ctx, event.ID, *invitations[0].Token, tt.status)
+			if !errors.Is(err, tt.wantErr) || (err == nil && byToken.Status != tt.want) {
+				t.Errorf("by token: response %v, err %v; want %s, %v", byToken, err, tt.want, tt.wantErr)
+			}
+			byEmail, err := service.RespondToInvitationByEmail(ctx, event.ID, invitations[1].InvitedEmail, tt.status)
+			if !errors.Is(err, tt.wantErr) || (err == nil && byEmail.Status != tt.want) {
+				t.Errorf("by email: response %v, err %v; want %s, %v", byEmail, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
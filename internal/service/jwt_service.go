@@ -31,6 +31,7 @@ type CustomClaims struct {
 	Email    string `json:"email"`
 	Username string `json:"username"`
 	UserType string `json:"user_type"`
+	Role     string `json:"role,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -41,6 +42,7 @@ func (s *jwtService) GenerateToken(claims *dto.JWTClaims) (string, error) {
 		Email:    claims.Email,
 		Username: claims.Username,
 		UserType: claims.UserType,
+		Role:     claims.Role,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(now.Add(s.expiration)),
 			IssuedAt:  jwt.NewNumericDate(now),
@@ -85,6 +87,7 @@ func (s *jwtService) ValidateToken(tokenString string) (*dto.JWTClaims, error) {
 		Email:    claims.Email,
 		Username: claims.Username,
 		UserType: claims.UserType,
+		Role:     claims.Role,
 	}, nil
 }
 
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/louco-event/internal/config"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/repository"
+	"github.com/rs/zerolog"
+)
+
+type MaintenanceService interface {
+	// PurgeOldRecords deletes operational records older than their retention window. A dry run
+	// only counts them.
+	PurgeOldRecords(ctx context.Context, retention config.RetentionConfig, dryRun bool) (*dto.PurgeResultResponse, error)
+	// PurgeConfigured runs PurgeOldRecords with the configured retention windows
+	PurgeConfigured(ctx context.Context, dryRun bool) (*dto.PurgeResultResponse, error)
+
+	// PurgeSoftDeletedEvents permanently deletes events that have been in the trash longer than
+	// retention, skipping events with ticket purchases. Returns how many were purged.
+	PurgeSoftDeletedEvents(ctx context.Context, retention time.Duration) (int64, error)
+
+	// RunScheduledPurge purges with the configured retention; run by the scheduler
+	RunScheduledPurge(ctx context.Context) error
+}
+
+type maintenanceService struct {
+	maintenanceRepo repository.MaintenanceRepository
+	eventRepo       repository.EventRepository
+	retention       config.RetentionConfig
+	logger          zerolog.Logger
+}
+
+func NewMaintenanceService(maintenanceRepo repository.MaintenanceRepository, eventRepo repository.EventRepository, retention config.RetentionConfig, logger zerolog.Logger) MaintenanceService {
+	return &maintenanceService{
+		maintenanceRepo: maintenanceRepo,
+		eventRepo:       eventRepo,
+		retention:       retention,
+		logger:          logger.With().Str("service", "maintenance").Logger(),
+	}
+}
+
+func (s *maintenanceService) PurgeOldRecords(ctx context.Context, retention config.RetentionConfig, dryRun bool) (*dto.PurgeResultResponse, error) {
+	batchSize := retention.BatchSize
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+
+	windows := []struct {
+		target    repository.PurgeTarget
+		retention time.Duration
+	}{
+		{repository.PurgeTargetEventHistory, retention.EventHistory},
+		{repository.PurgeTargetShareTokenAccesses, retention.ShareTokenAccesses},
+		{repository.PurgeTargetExportJobs, retention.ExportJobs},
+		{repository.PurgeTargetTicketReservations, retention.TicketReservations},
+		{repository.PurgeTargetVerificationCodes, retention.VerificationCodes},
+		{repository.PurgeTargetEventViews, retention.EventViews},
+	}
+
+	result := &dto.PurgeResultResponse{DryRun: dryRun, Targets: []dto.PurgeTargetResult{}}
+	now := time.Now()
+	for _, window := range windows {
+		// A zero window keeps the table forever
+		if window.retention <= 0 {
+			continue
+		}
+		before := now.Add(-window.retention)
+
+		var deleted int64
+		var err error
+		if dryRun {
+			deleted, err = s.maintenanceRepo.CountPurgeable(ctx, window.target, before)
+		} else {
+			deleted, err = s.purgeTarget(ctx, window.target, before, batchSize)
+		}
+		if err != nil {
+			s.logger.Error().Err(err).Str("target", string(window.target)).Int64("deleted", deleted).Msg("Failed to purge old records")
+			return nil, fmt.Errorf("failed to purge %s: %w", window.target, err)
+		}
+
+		result.Total += deleted
+		result.Targets = append(result.Targets, dto.PurgeTargetResult{
+			Target:    string(window.target),
+			Retention: window.retention.String(),
+			Before:    before,
+			Deleted:   deleted,
+		})
+	}
+
+	s.logger.Info().Bool("dry_run", dryRun).Int64("total", result.Total).Msg("Old records purged")
+	return result, nil
+}
+
+func (s *maintenanceService) PurgeConfigured(ctx context.Context, dryRun bool) (*dto.PurgeResultResponse, error) {
+	return s.PurgeOldRecords(ctx, s.retention, dryRun)
+}
+
+func (s *maintenanceService) PurgeSoftDeletedEvents(ctx context.Context, retention time.Duration) (int64, error) {
+	// A zero window keeps deleted events forever
+	if retention <= 0 {
+		return 0, nil
+	}
+	batchSize := s.retention.BatchSize
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+	deletedBefore := time.Now().Add(-retention)
+
+	var purged int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return purged, err
+		}
+
+		ids, err := s.eventRepo.GetPurgeableIDs(ctx, deletedBefore, batchSize)
+		if err != nil {
+			s.logger.Error().Err(err).Int64("purged", purged).Msg("Failed to get deleted events to purge")
+			return purged, fmt.Errorf("failed to get deleted events: %w", err)
+		}
+
+		// Each event is purged in its own transaction, so one failure does not undo the rest
+		for _, id := range ids {
+			if err := s.eventRepo.Purge(ctx, id); err != nil {
+				s.logger.Error().Err(err).Int("event_id", id).Int64("purged", purged).Msg("Failed to purge deleted event")
+				return purged, fmt.Errorf("failed to purge event %d: %w", id, err)
+			}
+			purged++
+		}
+		if len(ids) < batchSize {
+			break
+		}
+	}
+
+	s.logger.Info().Int64("purged", purged).Dur("retention", retention).Msg("Deleted events purged")
+	return purged, nil
+}
+
+func (s *maintenanceService) RunScheduledPurge(ctx context.Context) error {
+	if _, err := s.PurgeConfigured(ctx, false); err != nil {
+		return err
+	}
+	_, err := s.PurgeSoftDeletedEvents(ctx, s.retention.DeletedEvents)
+	return err
+}
+
+// purgeTarget deletes in batches until a batch comes back short, so no single statement holds
+// locks on a large part of the table
+func (s *maintenanceService) purgeTarget(ctx context.Context, target repository.PurgeTarget, before time.Time, batchSize int) (int64, error) {
+	var total int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return total, err
+		}
+
+		deleted, err := s.maintenanceRepo.PurgeBatch(ctx, target, before, batchSize)
+		if err != nil {
+			return total, err
+		}
+		total += deleted
+		if deleted < int64(batchSize) {
+			return total, nil
+		}
+	}
+}
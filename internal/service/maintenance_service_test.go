@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/louco-event/internal/config"
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/repository/postgres"
+	"github.com/louco-event/internal/testutil"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+func newTestMaintenanceService(db *gorm.DB, retention config.RetentionConfig) MaintenanceService {
+	return NewMaintenanceService(postgres.NewMaintenanceRepository(db), postgres.NewEventRepository(db), retention, zerolog.Nop())
+}
+
+func trashEvent(t *testing.T, db *gorm.DB, eventID int, deletedAt time.Time) {
+	t.Helper()
+	if err := db.Exec("UPDATE events SET deleted_at = ? WHERE id = ?", deletedAt, eventID).Error; err != nil {
+		t.Fatalf("failed to trash event: %v", err)
+	}
+}
+
+func countRows(t *testing.T, db *gorm.DB, table, condition string, args ...interface{}) int64 {
+	t.Helper()
+	var count int64
+	if err := db.Table(table).Where(condition, args...).Count(&count).Error; err != nil {
+		t.Fatalf("failed to count %s: %v", table, err)
+	}
+	return count
+}
+
+func TestPurgeSoftDeletedEventsRemovesEventsPastRetention(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	attendee := testutil.CreateUser(t, db, domain.UserTypeUser)
+
+	expired := testutil.CreateEvent(t, db, creator.ID, nil)
+	testutil.CreateTicket(t, db, expired.ID, 10)
+	if err := db.Omit("Event", "InvitedUser").Create(domain.NewInvitation(expired.ID, "guest@example.com", nil)).Error; err != nil {
+		t.Fatalf("failed to create invitation: %v", err)
+	}
+	if err := db.Create(domain.NewEventView(expired.ID, &attendee.ID, "")).Error; err != nil {
+		t.Fatalf("failed to create view: %v", err)
+	}
+	if err := db.Omit("Actor").Create(&domain.EventHistory{EventID: expired.ID, Action: domain.EventHistoryActionUpdated}).Error; err != nil {
+		t.Fatalf("failed to create history: %v", err)
+	}
+	if err := db.Create(domain.NewWaitlist(expired.ID, attendee.ID, *attendee.Email)).Error; err != nil {
+		t.Fatalf("failed to create waitlist entry: %v", err)
+	}
+	trashEvent(t, db, expired.ID, time.Now().Add(-40*24*time.Hour))
+
+	recent := testutil.CreateEvent(t, db, creator.ID, nil)
+	testutil.CreateTicket(t, db, recent.ID, 10)
+	trashEvent(t, db, recent.ID, time.Now().Add(-time.Hour))
+
+	service := newTestMaintenanceService(db, config.RetentionConfig{BatchSize: 10})
+	purged, err := service.PurgeSoftDeletedEvents(ctx, 30*24*time.Hour)
+	if err != nil {
+		t.Fatalf("PurgeSoftDeletedEvents: %v", err)
+	}
+	if purged != 1 {
+		t.Fatalf("purged %d events, want 1", purged)
+	}
+
+	for _, table := range []string{"tickets", "invitations", "event_views", "event_histories", "waitlists"} {
+		if count := countRows(t, db, table, "event_id = ?", expired.ID); count != 0 {
+			t.Errorf("%s: %d rows left for the purged event", table, count)
+		}
+	}
+	if count := countRows(t, db, "events", "id = ?", expired.ID); count != 0 {
+		t.Errorf("purged event is still stored")
+	}
+	if count := countRows(t, db, "events", "id = ?", recent.ID); count != 1 {
+		t.Errorf("recently deleted event was purged")
+	}
+	if count := countRows(t, db, "tickets", "event_id = ?", recent.ID); count != 1 {
+		t.Errorf("recently deleted event lost its tickets")
+	}
+}
+
+func TestPurgeOldRecordsKeepsRecentAndProtectedRows(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	attendee := testutil.CreateUser(t, db, domain.UserTypeUser)
+	event := testutil.CreateEvent(t, db, creator.ID, nil)
+	ticket := testutil.CreateTicket(t, db, event.ID, 10)
+
+	longAgo := time.Now().Add(-90 * 24 * time.Hour)
+	oldView := domain.NewEventView(event.ID, &attendee.ID, "")
+	oldView.ViewedAt = longAgo
+	recentView := domain.NewEventView(event.ID, nil, "203.0.113.7")
+	for _, view := range []*domain.EventView{oldView, recentView} {
+		if err := db.Create(view).Error; err != nil {
+			t.Fatalf("failed to create view: %v", err)
+		}
+	}
+
+	// Financial records older than every window
+	purchase := domain.NewTicketPurchase(ticket.ID, attendee.ID, 1)
+	purchase.PurchasedAt = longAgo
+	if err := db.Omit("Ticket", "User").Create(purchase).Error; err != nil {
+		t.Fatalf("failed to create purchase: %v", err)
+	}
+	subscription := &domain.UserSubscription{
+		UserID:    attendee.ID,
+		Type:      domain.SubscriptionTypeSubscription,
+		Name:      domain.SubscriptionNameBasic,
+		Status:    domain.SubscriptionStatusExpired,
+		CreatedAt: longAgo,
+	}
+	if err := db.Omit("User").Create(subscription).Error; err != nil {
+		t.Fatalf("failed to create subscription: %v", err)
+	}
+
+	retention := config.RetentionConfig{EventViews: 30 * 24 * time.Hour, BatchSize: 1}
+	service := newTestMaintenanceService(db, retention)
+
+	dryRun, err := service.PurgeOldRecords(ctx, retention, true)
+	if err != nil {
+		t.Fatalf("PurgeOldRecords dry run: %v", err)
+	}
+	if dryRun.Total != 1 {
+		t.Fatalf("dry run counted %d rows, want 1", dryRun.Total)
+	}
+	if count := countRows(t, db, "event_views", "event_id = ?", event.ID); count != 2 {
+		t.Fatalf("dry run deleted views: %d left, want 2", count)
+	}
+
+	result, err := service.PurgeOldRecords(ctx, retention, false)
+	if err != nil {
+		t.Fatalf("PurgeOldRecords: %v", err)
+	}
+	if result.Total != 1 {
+		t.Fatalf("purged %d rows, want 1", result.Total)
+	}
+	if count := countRows(t, db, "event_views", "id = ?", oldView.ID); count != 0 {
+		t.Errorf("old view was not purged")
+	}
+	if count := countRows(t, db, "event_views", "id = ?", recentView.ID); count != 1 {
+		t.Errorf("recent view was purged")
+	}
+	if count := countRows(t, db, "ticket_purchases", "id = ?", purchase.ID); count != 1 {
+		t.Errorf("ticket purchase was purged")
+	}
+	if count := countRows(t, db, "user_subscriptions", "id = ?", subscription.ID); count != 1 {
+		t.Errorf("subscription was purged")
+	}
+}
@@ -2,10 +2,13 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"mime/multipart"
+	"net/url"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -18,21 +21,43 @@ import (
 	"github.com/louco-event/internal/dto"
 	"github.com/louco-event/internal/repository"
 	"github.com/louco-event/pkg/logger"
+	"github.com/louco-event/pkg/urlsign"
 )
 
+// signedMediaRedirectTTL is how long the storage link behind a verified signed URL stays
+// valid; it only has to outlive the redirect
+const signedMediaRedirectTTL = 5 * time.Minute
+
 type MediaService interface {
 	UploadFile(ctx context.Context, userID int, file *multipart.FileHeader, fileContent io.Reader) (*dto.UploadResponse, error)
-	GetMediaByID(ctx context.Context, mediaID int) (*dto.MediaResponse, error)
-	GetUserMedia(ctx context.Context, userID int, req *dto.MediaListRequest) (*dto.MediaListResponse, error)
+	// GetMediaByID returns a media record; the file URL of private event media is only handed to
+	// its owner, as a signed link
+	GetMediaByID(ctx context.Context, mediaID int, viewerID int) (*dto.MediaResponse, error)
+	GetUserMedia(ctx context.Context, userID int, viewerID int, req *dto.MediaListRequest) (*dto.MediaListResponse, error)
 	DeleteMedia(ctx context.Context, userID int, mediaID int) error
 	UpdateMedia(ctx context.Context, userID int, mediaID int, req *dto.MediaUpdateRequest) error
+
+	// SignedURL returns a link to the media through the signed media route that stops working
+	// after ttl
+	SignedURL(media *domain.Media, ttl time.Duration) string
+	// ResolveSignedURL verifies a signed link and returns a short-lived storage URL to the file
+	ResolveSignedURL(ctx context.Context, mediaID int, expires int64, signature string) (string, error)
+	// ResolveFileURL returns a short-lived storage URL for media that is not attached to a
+	// private event; private event media is only reachable through signed links
+	ResolveFileURL(ctx context.Context, fileName string) (string, error)
+	// PrivateMediaURL returns a signed link valid for the configured TTL, so the service can
+	// sign media embedded in event responses
+	PrivateMediaURL(media *domain.Media) string
 }
 
 type mediaService struct {
-	mediaRepo repository.MediaRepository
-	s3Client  *s3.S3
-	bucket    string
-	logger    *logger.Logger
+	mediaRepo    repository.MediaRepository
+	s3Client     *s3.S3
+	bucket       string
+	signer       *urlsign.Signer
+	urlBase      string
+	signedURLTTL time.Duration
+	logger       *logger.Logger
 }
 
 type AWSConfig struct {
@@ -44,7 +69,10 @@ type AWSConfig struct {
 	UsePathStyleEndpoint bool
 }
 
-func NewMediaService(mediaRepo repository.MediaRepository, awsConfig AWSConfig, logger *logger.Logger) MediaService {
+// NewMediaService creates the media service. urlBase is the public address of the media routes
+// that file and signed links point to; signedURLTTL is how long links to private event media
+// keep working.
+func NewMediaService(mediaRepo repository.MediaRepository, awsConfig AWSConfig, signer *urlsign.Signer, urlBase string, signedURLTTL time.Duration, logger *logger.Logger) MediaService {
 	// Create AWS session
 	sess := session.Must(session.NewSession(&aws.Config{
 		Endpoint:         aws.String(awsConfig.Endpoint),
@@ -56,10 +84,13 @@ func NewMediaService(mediaRepo repository.MediaRepository, awsConfig AWSConfig,
 	s3Client := s3.New(sess)
 
 	return &mediaService{
-		mediaRepo: mediaRepo,
-		s3Client:  s3Client,
-		bucket:    awsConfig.Bucket,
-		logger:    logger,
+		mediaRepo:    mediaRepo,
+		s3Client:     s3Client,
+		bucket:       awsConfig.Bucket,
+		signer:       signer,
+		urlBase:      strings.TrimRight(urlBase, "/"),
+		signedURLTTL: signedURLTTL,
+		logger:       logger,
 	}
 }
 
@@ -85,13 +116,13 @@ func (s *mediaService) UploadFile(ctx context.Context, userID int, file *multipa
 	fileName := fmt.Sprintf("%s%s", uuid.New().String(), ext)
 	filePath := fmt.Sprintf("uploads/%d/%s", userID, fileName)
 
-	// Upload to S3
+	// Upload to S3. Objects stay private; they are served through the file and signed
+	// media routes so media of private events never has a public address.
 	_, err := s.s3Client.PutObjectWithContext(ctx, &s3.PutObjectInput{
 		Bucket:      aws.String(s.bucket),
 		Key:         aws.String(filePath),
 		Body:        aws.ReadSeekCloser(fileContent),
 		ContentType: aws.String(mimeType),
-		ACL:         aws.String("public-read"),
 	})
 
 	if err != nil {
@@ -99,12 +130,7 @@ func (s *mediaService) UploadFile(ctx context.Context, userID int, file *multipa
 		return nil, fmt.Errorf("failed to upload file")
 	}
 
-	// Generate file URL
-	endpoint := ""
-	if s.s3Client.Config.Endpoint != nil {
-		endpoint = strings.TrimPrefix(*s.s3Client.Config.Endpoint, "https://")
-	}
-	fileURL := fmt.Sprintf("https://%s.%s/%s", s.bucket, endpoint, filePath)
+	fileURL := fmt.Sprintf("%s/files/%s", s.urlBase, url.PathEscape(fileName))
 
 	// Create media record
 	media := domain.NewMedia(userID, file.Filename, fileName, filePath, fileURL, mediaType, mimeType, file.Size)
@@ -160,16 +186,20 @@ func (s *mediaService) UploadFile(ctx context.Context, userID int, file *multipa
 	return response, nil
 }
 
-func (s *mediaService) GetMediaByID(ctx context.Context, mediaID int) (*dto.MediaResponse, error) {
+func (s *mediaService) GetMediaByID(ctx context.Context, mediaID int, viewerID int) (*dto.MediaResponse, error) {
 	media, err := s.mediaRepo.GetByID(ctx, mediaID)
 	if err != nil {
 		return nil, fmt.Errorf("media not found")
 	}
 
-	return s.mapMediaToResponse(media), nil
+	responses, err := s.mapMediaListToResponse(ctx, []*domain.Media{media}, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	return &responses[0], nil
 }
 
-func (s *mediaService) GetUserMedia(ctx context.Context, userID int, req *dto.MediaListRequest) (*dto.MediaListResponse, error) {
+func (s *mediaService) GetUserMedia(ctx context.Context, userID int, viewerID int, req *dto.MediaListRequest) (*dto.MediaListResponse, error) {
 	page := req.Page
 	if page < 1 {
 		page = 1
@@ -185,9 +215,9 @@ func (s *mediaService) GetUserMedia(ctx context.Context, userID int, req *dto.Me
 		return nil, fmt.Errorf("failed to get user media")
 	}
 
-	mediaResponses := make([]dto.MediaResponse, len(mediaList))
-	for i, media := range mediaList {
-		mediaResponses[i] = *s.mapMediaToResponse(media)
+	mediaResponses, err := s.mapMediaListToResponse(ctx, mediaList, viewerID)
+	if err != nil {
+		return nil, err
 	}
 
 	// Calculate total pages (simplified)
@@ -262,6 +292,72 @@ func (s *mediaService) UpdateMedia(ctx context.Context, userID int, mediaID int,
 	return s.mediaRepo.Update(ctx, media)
 }
 
+func (s *mediaService) SignedURL(media *domain.Media, ttl time.Duration) string {
+	expires := time.Now().Add(ttl).Unix()
+	signature := s.signer.Sign(signedMediaResource(media.ID), expires)
+
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expires, 10))
+	query.Set("signature", signature)
+	return fmt.Sprintf("%s/%d/signed?%s", s.urlBase, media.ID, query.Encode())
+}
+
+func (s *mediaService) PrivateMediaURL(media *domain.Media) string {
+	return s.SignedURL(media, s.signedURLTTL)
+}
+
+func (s *mediaService) ResolveSignedURL(ctx context.Context, mediaID int, expires int64, signature string) (string, error) {
+	if err := s.signer.Verify(signedMediaResource(mediaID), expires, signature, time.Now()); err != nil {
+		if errors.Is(err, urlsign.ErrExpired) {
+			return "", fmt.Errorf("signed url expired")
+		}
+		return "", fmt.Errorf("invalid signature")
+	}
+
+	media, err := s.mediaRepo.GetByID(ctx, mediaID)
+	if err != nil {
+		return "", fmt.Errorf("media not found")
+	}
+
+	return s.presignStorageURL(media)
+}
+
+func (s *mediaService) ResolveFileURL(ctx context.Context, fileName string) (string, error) {
+	media, err := s.mediaRepo.GetByFileName(ctx, fileName)
+	if err != nil {
+		return "", fmt.Errorf("media not found")
+	}
+
+	privateIDs, err := s.mediaRepo.GetPrivateEventMediaIDs(ctx, []int{media.ID})
+	if err != nil {
+		s.logger.Error().Err(err).Int("media_id", media.ID).Msg("Failed to check media privacy")
+		return "", fmt.Errorf("failed to resolve media")
+	}
+	// Reported as missing so the route does not reveal which files belong to private events
+	if len(privateIDs) > 0 {
+		return "", fmt.Errorf("media not found")
+	}
+
+	return s.presignStorageURL(media)
+}
+
+func (s *mediaService) presignStorageURL(media *domain.Media) (string, error) {
+	req, _ := s.s3Client.GetObjectRequest(&s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(media.FilePath),
+	})
+	storageURL, err := req.Presign(signedMediaRedirectTTL)
+	if err != nil {
+		s.logger.Error().Err(err).Int("media_id", media.ID).Msg("Failed to presign media URL")
+		return "", fmt.Errorf("failed to sign storage url: %w", err)
+	}
+	return storageURL, nil
+}
+
+func signedMediaResource(mediaID int) string {
+	return "media:" + strconv.Itoa(mediaID)
+}
+
 func (s *mediaService) deleteFromS3(ctx context.Context, filePath string) error {
 	_, err := s.s3Client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
 		Bucket: aws.String(s.bucket),
@@ -284,6 +380,38 @@ func (s *mediaService) validateFileSize(size int64, mediaType domain.MediaType)
 	return nil
 }
 
+// mapMediaListToResponse maps media records for a viewer. Media of private events gets a signed
+// link when the viewer owns it and no file URL otherwise.
+func (s *mediaService) mapMediaListToResponse(ctx context.Context, mediaList []*domain.Media, viewerID int) ([]dto.MediaResponse, error) {
+	ids := make([]int, len(mediaList))
+	for i, media := range mediaList {
+		ids[i] = media.ID
+	}
+	privateIDs, err := s.mediaRepo.GetPrivateEventMediaIDs(ctx, ids)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("Failed to check media privacy")
+		return nil, fmt.Errorf("failed to get media")
+	}
+	private := make(map[int]bool, len(privateIDs))
+	for _, id := range privateIDs {
+		private[id] = true
+	}
+
+	responses := make([]dto.MediaResponse, len(mediaList))
+	for i, media := range mediaList {
+		responses[i] = *s.mapMediaToResponse(media)
+		if !private[media.ID] {
+			continue
+		}
+		if media.UserID == viewerID {
+			responses[i].FileURL = s.PrivateMediaURL(media)
+		} else {
+			responses[i].FileURL = ""
+		}
+	}
+	return responses, nil
+}
+
 func (s *mediaService) mapMediaToResponse(media *domain.Media) *dto.MediaResponse {
 	return &dto.MediaResponse{
 		ID:           media.ID,
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/repository"
+	"github.com/louco-event/pkg/logger"
+	"github.com/louco-event/pkg/urlsign"
+	"github.com/rs/zerolog"
+)
+
+type fakeMediaRepo struct {
+	repository.MediaRepository
+	media     map[int]*domain.Media
+	privateID map[int]bool
+}
+
+func (r *fakeMediaRepo) GetByID(ctx context.Context, id int) (*domain.Media, error) {
+	media, ok := r.media[id]
+	if !ok {
+		return nil, errors.New("record not found")
+	}
+	return media, nil
+}
+
+func (r *fakeMediaRepo) GetPrivateEventMediaIDs(ctx context.Context, mediaIDs []int) ([]int, error) {
+	var ids []int
+	for _, id := range mediaIDs {
+		if r.privateID[id] {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func newTestMediaService(repo repository.MediaRepository) *mediaService {
+	nop := zerolog.Nop()
+	awsConfig := AWSConfig{
+		Endpoint:             "http://storage.test",
+		AccessKeyID:          "test",
+		SecretAccessKey:      "test",
+		DefaultRegion:        "us-east-1",
+		Bucket:               "media",
+		UsePathStyleEndpoint: true,
+	}
+	return NewMediaService(repo, awsConfig, urlsign.NewSigner("test-secret"), "https://api.test/api/v1/media", time.Hour, &logger.Logger{Logger: &nop}).(*mediaService)
+}
+
+// signedQuery extracts the expiry and signature of a signed media link
+func signedQuery(t *testing.T, signedURL string) (int64, string) {
+	t.Helper()
+	parsed, err := url.Parse(signedURL)
+	if err != nil {
+		t.Fatalf("invalid signed url %q: %v", signedURL, err)
+	}
+	expires, err := strconv.ParseInt(parsed.Query().Get("expires"), 10, 64)
+	if err != nil {
+		t.Fatalf("signed url %q has no expiry", signedURL)
+	}
+	return expires, parsed.Query().Get("signature")
+}
+
+func TestResolveSignedURL(t *testing.T) {
+	media := domain.NewMedia(1, "cover.jpg", "cover.jpg", "uploads/1/cover.jpg", "", domain.MediaTypeImage, "image/jpeg", 10)
+	media.ID = 7
+	service := newTestMediaService(&fakeMediaRepo{media: map[int]*domain.Media{7: media}})
+	ctx := context.Background()
+
+	t.Run("valid", func(t *testing.T) {
+		expires, signature := signedQuery(t, service.SignedURL(media, time.Hour))
+		storageURL, err := service.ResolveSignedURL(ctx, media.ID, expires, signature)
+		if err != nil {
+			t.Fatalf("ResolveSignedURL: %v", err)
+		}
+		if !strings.Contains(storageURL, media.FilePath) || !strings.Contains(storageURL, "X-Amz-Signature") {
+			t.Errorf("storage url %q is not a presigned link to the file", storageURL)
+		}
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		expires, signature := signedQuery(t, service.SignedURL(media, -time.Minute))
+		if _, err := service.ResolveSignedURL(ctx, media.ID, expires, signature); err == nil || err.Error() != "signed url expired" {
+			t.Errorf("err = %v, want signed url expired", err)
+		}
+	})
+
+	t.Run("signature for another media", func(t *testing.T) {
+		expires, signature := signedQuery(t, service.SignedURL(media, time.Hour))
+		if _, err := service.ResolveSignedURL(ctx, media.ID+1, expires, signature); err == nil || err.Error() != "invalid signature" {
+			t.Errorf("err = %v, want invalid signature", err)
+		}
+	})
+
+	t.Run("extended expiry", func(t *testing.T) {
+		expires, signature := signedQuery(t, service.SignedURL(media, time.Hour))
+		if _, err := service.ResolveSignedURL(ctx, media.ID, expires+3600, signature); err == nil || err.Error() != "invalid signature" {
+			t.Errorf("err = %v, want invalid signature", err)
+		}
+	})
+}
+
+func TestGetMediaByIDHidesPrivateEventMedia(t *testing.T) {
+	media := domain.NewMedia(1, "cover.jpg", "cover.jpg", "uploads/1/cover.jpg", "https://api.test/api/v1/media/files/cover.jpg", domain.MediaTypeImage, "image/jpeg", 10)
+	media.ID = 7
+	repo := &fakeMediaRepo{media: map[int]*domain.Media{7: media}, privateID: map[int]bool{7: true}}
+	service := newTestMediaService(repo)
+	ctx := context.Background()
+
+	owner, err := service.GetMediaByID(ctx, media.ID, media.UserID)
+	if err != nil {
+		t.Fatalf("GetMediaByID: %v", err)
+	}
+	if !strings.Contains(owner.FileURL, "/7/signed?") {
+		t.Errorf("owner got %q, want a signed link", owner.FileURL)
+	}
+
+	other, err := service.GetMediaByID(ctx, media.ID, media.UserID+1)
+	if err != nil {
+		t.Fatalf("GetMediaByID: %v", err)
+	}
+	if other.FileURL != "" {
+		t.Errorf("other user got file url %q for private event media", other.FileURL)
+	}
+}
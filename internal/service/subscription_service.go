@@ -2,12 +2,17 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/i18n"
 	"github.com/louco-event/internal/repository"
+	"github.com/louco-event/pkg/email"
 	"github.com/louco-event/pkg/logger"
+	"github.com/louco-event/pkg/stripe"
 )
 
 type SubscriptionService interface {
@@ -36,9 +41,19 @@ type SubscriptionService interface {
 	// Subscription lifecycle
 	CreateSubscription(ctx context.Context, userID uint, planID uint, stripeSubscriptionID string) (*domain.UserSubscription, error)
 	CreatePackage(ctx context.Context, userID uint, planID uint, stripePaymentIntentID string) (*domain.UserSubscription, error)
+	CreateTrialSubscription(ctx context.Context, userID uint, planID uint, stripeSubscriptionID string) (*domain.UserSubscription, error)
 	ActivateSubscription(ctx context.Context, subscriptionID uint) error
-	CancelSubscription(ctx context.Context, subscriptionID uint) error
+	CancelSubscription(ctx context.Context, userID uint, subscriptionID uint, atPeriodEnd bool, reason string) error
+	ReactivateSubscription(ctx context.Context, userID uint, subscriptionID uint) error
 	ExpireSubscription(ctx context.Context, subscriptionID uint) error
+	ResetUsageForNewPeriod(ctx context.Context, subscriptionID uint) error
+
+	// Free trials
+	GetEligibleTrialDays(ctx context.Context, userID uint, planID uint) (int, error)
+	HandleTrialWillEnd(ctx context.Context, stripeID string) error
+
+	// Plan changes
+	PreviewPlanChange(ctx context.Context, userID uint, subscriptionID uint, newPlanID uint) (*domain.PlanChangePreview, error)
 
 	// Payment integration
 	HandlePaymentSuccess(ctx context.Context, stripeID string) error
@@ -46,11 +61,14 @@ type SubscriptionService interface {
 	HandlePaymentRefunded(ctx context.Context, stripeID string) error
 	ActivateSubscriptionByStripeID(ctx context.Context, stripeID string) error
 	CancelSubscriptionByStripeID(ctx context.Context, stripeID string) error
+	HandleSubscriptionRenewal(ctx context.Context, stripeID string) error
 
 	// Administrative functions
 	ResetWeeklyLimits(ctx context.Context) error
 	ResetMonthlyLimits(ctx context.Context) error
 	ProcessExpiredSubscriptions(ctx context.Context) error
+	GetCancellationStats(ctx context.Context) ([]*domain.CancellationReasonStat, error)
+	ReconcileSubscriptions(ctx context.Context, dryRun bool) (*domain.SubscriptionReconciliation, error)
 
 	// Plan seeding (for initial setup)
 	SeedDefaultPlans(ctx context.Context) error
@@ -59,17 +77,32 @@ type SubscriptionService interface {
 type subscriptionService struct {
 	userSubscriptionRepo repository.UserSubscriptionRepository
 	subscriptionPlanRepo repository.SubscriptionPlanRepository
+	historyRepo          repository.SubscriptionHistoryRepository
+	userRepo             repository.UserRepository
+	stripeService        stripe.SubscriptionClient
+	emailService         email.EmailService
+	translator           *i18n.I18n
 	logger               *logger.Logger
 }
 
 func NewSubscriptionService(
 	userSubscriptionRepo repository.UserSubscriptionRepository,
 	subscriptionPlanRepo repository.SubscriptionPlanRepository,
+	historyRepo repository.SubscriptionHistoryRepository,
+	userRepo repository.UserRepository,
+	stripeService stripe.SubscriptionClient,
+	emailService email.EmailService,
+	translator *i18n.I18n,
 	logger *logger.Logger,
 ) SubscriptionService {
 	return &subscriptionService{
 		userSubscriptionRepo: userSubscriptionRepo,
 		subscriptionPlanRepo: subscriptionPlanRepo,
+		historyRepo:          historyRepo,
+		userRepo:             userRepo,
+		stripeService:        stripeService,
+		emailService:         emailService,
+		translator:           translator,
 		logger:               logger,
 	}
 }
@@ -239,6 +272,55 @@ func (s *subscriptionService) CreatePackage(ctx context.Context, userID uint, pl
 	return subscription, nil
 }
 
+func (s *subscriptionService) CreateTrialSubscription(ctx context.Context, userID uint, planID uint, stripeSubscriptionID string) (*domain.UserSubscription, error) {
+	// Get the plan
+	plan, err := s.GetPlanByID(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !plan.HasTrial() {
+		return nil, fmt.Errorf("plan does not offer a trial")
+	}
+
+	// A redelivered webhook finds the subscription the first delivery created
+	existing, err := s.userSubscriptionRepo.GetByStripeSubscriptionID(ctx, stripeSubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	// Create user subscription from plan, active for the trial and the first paid period
+	subscription := plan.CreateUserSubscription(int(userID))
+	subscription.StripeID = &stripeSubscriptionID
+	subscription.Status = domain.SubscriptionStatusActive
+
+	trialEndsAt := subscription.StartedAt.AddDate(0, 0, plan.TrialDays)
+	expiredAt := trialEndsAt.AddDate(0, 0, plan.GetDurationDays())
+	subscription.TrialEndsAt = &trialEndsAt
+	subscription.ExpiredAt = &expiredAt
+
+	// Validate the subscription
+	if err := subscription.Validate(); err != nil {
+		return nil, fmt.Errorf("subscription validation failed: %w", err)
+	}
+
+	// Save together with the user's one-time trial claim
+	if err := s.userSubscriptionRepo.CreateTrial(ctx, subscription); err != nil {
+		if errors.Is(err, domain.ErrTrialAlreadyUsed) {
+			s.logger.Warn().Err(err).Uint("user_id", userID).Uint("plan_id", planID).Msg("Trial refused")
+			return nil, err
+		}
+		s.logger.Error().Err(err).Uint("user_id", userID).Uint("plan_id", planID).Msg("Failed to create trial subscription")
+		return nil, fmt.Errorf("failed to create subscription: %w", err)
+	}
+
+	s.logger.Info().Uint("user_id", userID).Uint("plan_id", planID).Str("stripe_id", stripeSubscriptionID).Time("trial_ends_at", trialEndsAt).Msg("Trial subscription created successfully")
+	return subscription, nil
+}
+
 func (s *subscriptionService) ActivateSubscription(ctx context.Context, subscriptionID uint) error {
 	subscription, err := s.userSubscriptionRepo.GetByID(ctx, subscriptionID)
 	if err != nil {
@@ -261,30 +343,281 @@ func (s *subscriptionService) ActivateSubscription(ctx context.Context, subscrip
 	return nil
 }
 
-func (s *subscriptionService) CancelSubscription(ctx context.Context, subscriptionID uint) error {
+func (s *subscriptionService) CancelSubscription(ctx context.Context, userID uint, subscriptionID uint, atPeriodEnd bool, reason string) error {
 	subscription, err := s.userSubscriptionRepo.GetByID(ctx, subscriptionID)
 	if err != nil {
 		return err
 	}
-	if subscription == nil {
+	// Someone else's subscription is reported as missing, before anything reaches Stripe
+	if subscription == nil || subscription.UserID != int(userID) {
 		return fmt.Errorf("subscription not found")
 	}
 
+	isStripeSubscription := subscription.Type == domain.SubscriptionTypeSubscription && subscription.StripeID != nil
+
+	// Keep the reason for churn analysis; the history entry keeps it past a reactivation
+	if reason = strings.TrimSpace(reason); reason != "" {
+		subscription.CancellationReason = &reason
+	}
+
+	if atPeriodEnd {
+		if !isStripeSubscription {
+			return fmt.Errorf("only subscriptions can be cancelled at period end")
+		}
+		if !subscription.IsActive() {
+			return fmt.Errorf("subscription is not active")
+		}
+
+		if err := s.stripeService.CancelSubscription(ctx, *subscription.StripeID, true); err != nil {
+			return err
+		}
+
+		// Entitlements stay in place until Stripe deletes the subscription at period end
+		now := time.Now()
+		subscription.CancelAtPeriodEnd = true
+		subscription.CancelledAt = &now
+
+		if err := s.userSubscriptionRepo.Update(ctx, subscription); err != nil {
+			s.logger.Error().Err(err).Uint("subscription_id", subscriptionID).Msg("Failed to schedule subscription cancellation")
+			return fmt.Errorf("failed to cancel subscription: %w", err)
+		}
+		s.recordHistory(ctx, domain.NewSubscriptionCancellationHistory(subscription, int(userID), true))
+
+		s.logger.Info().Uint("subscription_id", subscriptionID).Int("user_id", subscription.UserID).Str("reason", reason).Msg("Subscription scheduled for cancellation at period end")
+		return nil
+	}
+
+	if isStripeSubscription && subscription.Status == domain.SubscriptionStatusActive {
+		if err := s.stripeService.CancelSubscription(ctx, *subscription.StripeID, false); err != nil {
+			return err
+		}
+	}
+
+	if err := s.markCancelled(ctx, subscription); err != nil {
+		return err
+	}
+	s.recordHistory(ctx, domain.NewSubscriptionCancellationHistory(subscription, int(userID), false))
+	return nil
+}
+
+func (s *subscriptionService) ReactivateSubscription(ctx context.Context, userID uint, subscriptionID uint) error {
+	subscription, err := s.userSubscriptionRepo.GetByID(ctx, subscriptionID)
+	if err != nil {
+		return err
+	}
+	if subscription == nil || subscription.UserID != int(userID) {
+		return fmt.Errorf("subscription not found")
+	}
+
+	if !subscription.IsCanceledButActive() || subscription.StripeID == nil {
+		return fmt.Errorf("subscription is not scheduled for cancellation")
+	}
+
+	if err := s.stripeService.ReactivateSubscription(ctx, *subscription.StripeID); err != nil {
+		return err
+	}
+
+	subscription.CancelAtPeriodEnd = false
+	subscription.CancellationReason = nil
+	subscription.CancelledAt = nil
+
+	if err := s.userSubscriptionRepo.Update(ctx, subscription); err != nil {
+		s.logger.Error().Err(err).Uint("subscription_id", subscriptionID).Msg("Failed to reactivate subscription")
+		return fmt.Errorf("failed to reactivate subscription: %w", err)
+	}
+
+	s.logger.Info().Uint("subscription_id", subscriptionID).Msg("Subscription reactivated successfully")
+	return nil
+}
+
+// markCancelled ends the subscription locally without touching Stripe
+func (s *subscriptionService) markCancelled(ctx context.Context, subscription *domain.UserSubscription) error {
 	subscription.Status = domain.SubscriptionStatusCancelled
+	subscription.CancelAtPeriodEnd = false
+	if subscription.CancelledAt == nil {
+		now := time.Now()
+		subscription.CancelledAt = &now
+	}
 
 	if err := s.userSubscriptionRepo.Update(ctx, subscription); err != nil {
-		s.logger.Error().Err(err).Uint("subscription_id", subscriptionID).Msg("Failed to cancel subscription")
+		s.logger.Error().Err(err).Int("subscription_id", subscription.ID).Msg("Failed to cancel subscription")
 		return fmt.Errorf("failed to cancel subscription: %w", err)
 	}
 
-	s.logger.Info().Uint("subscription_id", subscriptionID).Msg("Subscription cancelled successfully")
+	reason := ""
+	if subscription.CancellationReason != nil {
+		reason = *subscription.CancellationReason
+	}
+	s.logger.Info().Int("subscription_id", subscription.ID).Int("user_id", subscription.UserID).Str("reason", reason).Msg("Subscription cancelled successfully")
 	return nil
 }
 
+// recordHistory stores an audit entry; the change itself is already saved, so failures are logged
+func (s *subscriptionService) recordHistory(ctx context.Context, history *domain.SubscriptionHistory) {
+	if err := s.historyRepo.Create(ctx, history); err != nil {
+		s.logger.Error().Err(err).Int("subscription_id", history.SubscriptionID).Str("action", string(history.Action)).Msg("Failed to record subscription history")
+	}
+}
+
 func (s *subscriptionService) ExpireSubscription(ctx context.Context, subscriptionID uint) error {
 	return s.userSubscriptionRepo.MarkAsExpired(ctx, subscriptionID)
 }
 
+// ResetUsageForNewPeriod starts a new billing period for a recurring subscription, zeroing the
+// consumed publishing usage and recording the period window reported by Stripe. Nothing changes
+// unless Stripe's period starts after the one already recorded.
+func (s *subscriptionService) ResetUsageForNewPeriod(ctx context.Context, subscriptionID uint) error {
+	subscription, err := s.userSubscriptionRepo.GetByID(ctx, subscriptionID)
+	if err != nil {
+		return err
+	}
+	if subscription == nil {
+		return fmt.Errorf("subscription not found")
+	}
+	if subscription.Type != domain.SubscriptionTypeSubscription || subscription.StripeID == nil {
+		return fmt.Errorf("only subscriptions have billing periods")
+	}
+
+	stripeSubscription, err := s.stripeService.GetSubscription(ctx, *subscription.StripeID)
+	if err != nil {
+		return fmt.Errorf("failed to get billing period: %w", err)
+	}
+
+	// A redelivered or out-of-order invoice webhook must not wipe usage in the middle of a period
+	start := stripeSubscription.CurrentPeriodStart
+	if subscription.PeriodStart != nil && !start.After(*subscription.PeriodStart) {
+		s.logger.Info().Uint("subscription_id", subscriptionID).Time("period_start", start).Msg("Billing period has not moved forward, usage kept")
+		return nil
+	}
+
+	subscription.StartNewPeriod(start, stripeSubscription.CurrentPeriodEnd)
+
+	if err := s.userSubscriptionRepo.Update(ctx, subscription); err != nil {
+		s.logger.Error().Err(err).Uint("subscription_id", subscriptionID).Msg("Failed to reset subscription usage")
+		return fmt.Errorf("failed to reset subscription usage: %w", err)
+	}
+
+	s.logger.Info().
+		Uint("subscription_id", subscriptionID).
+		Time("period_start", stripeSubscription.CurrentPeriodStart).
+		Time("period_end", stripeSubscription.CurrentPeriodEnd).
+		Msg("Subscription usage reset for new billing period")
+	return nil
+}
+
+func (s *subscriptionService) GetEligibleTrialDays(ctx context.Context, userID uint, planID uint) (int, error) {
+	plan, err := s.GetPlanByID(ctx, planID)
+	if err != nil {
+		return 0, err
+	}
+
+	if !plan.HasTrial() {
+		return 0, nil
+	}
+
+	user, err := s.userRepo.GetByID(ctx, int(userID))
+	if err != nil {
+		return 0, err
+	}
+
+	if user.HasUsedTrial {
+		return 0, nil
+	}
+
+	return plan.TrialDays, nil
+}
+
+func (s *subscriptionService) HandleTrialWillEnd(ctx context.Context, stripeID string) error {
+	subscription, err := s.userSubscriptionRepo.GetByStripeSubscriptionID(ctx, stripeID)
+	if err != nil {
+		return err
+	}
+	if subscription == nil {
+		return fmt.Errorf("subscription not found for Stripe ID: %s", stripeID)
+	}
+
+	user, err := s.userRepo.GetByID(ctx, subscription.UserID)
+	if err != nil {
+		return err
+	}
+
+	if user.Email == nil || *user.Email == "" {
+		s.logger.Info().Int("user_id", user.ID).Str("stripe_id", stripeID).Msg("User has no email, skipping trial ending notification")
+		return nil
+	}
+
+	// Stripe sends this webhook, so the email goes out in the language stored on the user
+	message := s.translator.Translate(user.Language, "email.trial_will_end.body_soon")
+	if subscription.TrialEndsAt != nil {
+		message = s.translator.Format(user.Language, "email.trial_will_end.body", map[string]string{
+			"date": subscription.TrialEndsAt.Format("2006-01-02"),
+		})
+	}
+	subject := s.translator.Translate(user.Language, "email.trial_will_end.subject")
+
+	if err := s.emailService.SendNotification(ctx, *user.Email, subject, message); err != nil {
+		s.logger.Error().Err(err).Int("user_id", user.ID).Str("stripe_id", stripeID).Msg("Failed to send trial ending notification")
+		return fmt.Errorf("failed to send trial ending notification: %w", err)
+	}
+
+	s.logger.Info().Int("user_id", user.ID).Str("stripe_id", stripeID).Msg("Trial ending notification sent")
+	return nil
+}
+
+// Plan changes
+func (s *subscriptionService) PreviewPlanChange(ctx context.Context, userID uint, subscriptionID uint, newPlanID uint) (*domain.PlanChangePreview, error) {
+	plan, err := s.GetPlanByID(ctx, newPlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !plan.IsSubscription() {
+		return nil, fmt.Errorf("plan is not a subscription type")
+	}
+
+	subscription, err := s.userSubscriptionRepo.GetByID(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if subscription != nil && subscription.UserID != int(userID) {
+		return nil, fmt.Errorf("subscription not found")
+	}
+
+	// Without an active Stripe-backed subscription the change is a new purchase at full price
+	if subscription == nil || !subscription.IsActive() || subscription.Type != domain.SubscriptionTypeSubscription || subscription.StripeID == nil {
+		return &domain.PlanChangePreview{
+			NewPlanID:        plan.ID,
+			NewPlan:          plan.Name,
+			IsNewPurchase:    true,
+			ImmediateCharge:  plan.Price,
+			NextInvoiceTotal: plan.Price,
+			Currency:         plan.Currency,
+		}, nil
+	}
+
+	if subscription.Name == plan.Name {
+		return nil, fmt.Errorf("subscription is already on this plan")
+	}
+
+	invoicePreview, err := s.stripeService.PreviewSubscriptionChange(ctx, *subscription.StripeID, plan)
+	if err != nil {
+		s.logger.Error().Err(err).Uint("subscription_id", subscriptionID).Uint("plan_id", newPlanID).Msg("Failed to preview plan change")
+		return nil, fmt.Errorf("failed to preview plan change: %w", err)
+	}
+
+	return &domain.PlanChangePreview{
+		SubscriptionID:   subscription.ID,
+		CurrentPlan:      subscription.Name,
+		NewPlanID:        plan.ID,
+		NewPlan:          plan.Name,
+		IsNewPurchase:    false,
+		ImmediateCharge:  s.stripeService.ConvertCentsToDollars(invoicePreview.ProrationAmount),
+		NextInvoiceTotal: s.stripeService.ConvertCentsToDollars(invoicePreview.Total),
+		Currency:         subscription.Currency,
+		NextBillingDate:  invoicePreview.NextBillingDate,
+	}, nil
+}
+
 // Payment integration
 func (s *subscriptionService) HandlePaymentSuccess(ctx context.Context, stripeID string) error {
 	// Try to find by subscription ID first
@@ -429,6 +762,126 @@ func (s *subscriptionService) ProcessExpiredSubscriptions(ctx context.Context) e
 	return nil
 }
 
+func (s *subscriptionService) GetCancellationStats(ctx context.Context) ([]*domain.CancellationReasonStat, error) {
+	return s.userSubscriptionRepo.GetCancellationReasonStats(ctx)
+}
+
+// ReconcileSubscriptions compares every active Stripe-backed subscription with Stripe and
+// corrects the local record when a webhook was missed. With dryRun the drift is only reported.
+func (s *subscriptionService) ReconcileSubscriptions(ctx context.Context, dryRun bool) (*domain.SubscriptionReconciliation, error) {
+	subscriptions, err := s.userSubscriptionRepo.GetActiveStripeSubscriptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &domain.SubscriptionReconciliation{
+		DryRun: dryRun,
+		Drifts: []*domain.SubscriptionDrift{},
+	}
+	for _, subscription := range subscriptions {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		report.Checked++
+
+		stripeSubscription, err := s.stripeService.GetSubscription(ctx, *subscription.StripeID)
+		if err != nil && !errors.Is(err, stripe.ErrSubscriptionNotFound) {
+			s.logger.Error().Err(err).Int("subscription_id", subscription.ID).Msg("Failed to fetch subscription for reconciliation")
+			report.Failed++
+			continue
+		}
+
+		drift := subscriptionDrift(subscription, stripeSubscription)
+		if drift == nil {
+			continue
+		}
+		report.Drifts = append(report.Drifts, drift)
+		if dryRun || drift.Action == domain.SubscriptionReconcileReview {
+			continue
+		}
+
+		if err := s.applySubscriptionDrift(ctx, subscription, stripeSubscription, drift.Action); err != nil {
+			drift.Error = err.Error()
+			continue
+		}
+		drift.Applied = true
+		report.Corrected++
+	}
+
+	s.logger.Info().
+		Bool("dry_run", dryRun).
+		Int("checked", report.Checked).
+		Int("drifted", len(report.Drifts)).
+		Int("corrected", report.Corrected).
+		Int("failed", report.Failed).
+		Msg("Reconciled subscriptions against Stripe")
+	return report, nil
+}
+
+// subscriptionDrift returns how the local subscription differs from Stripe, or nil when they
+// agree. A nil stripeSubscription means Stripe no longer knows the subscription.
+func subscriptionDrift(subscription *domain.UserSubscription, stripeSubscription *stripe.StripeSubscription) *domain.SubscriptionDrift {
+	drift := &domain.SubscriptionDrift{
+		SubscriptionID:         subscription.ID,
+		UserID:                 subscription.UserID,
+		StripeID:               *subscription.StripeID,
+		StripeStatus:           "missing",
+		LocalPeriodEnd:         subscription.PeriodEnd,
+		LocalCancelAtPeriodEnd: subscription.CancelAtPeriodEnd,
+	}
+	if stripeSubscription == nil {
+		drift.Action = domain.SubscriptionReconcileCancel
+		return drift
+	}
+
+	periodEnd := stripeSubscription.CurrentPeriodEnd
+	drift.StripeStatus = stripeSubscription.Status
+	drift.StripePeriodEnd = &periodEnd
+	drift.StripeCancelAtPeriodEnd = stripeSubscription.CancelAtPeriodEnd
+
+	switch stripeSubscription.Status {
+	case "canceled", "incomplete_expired":
+		drift.Action = domain.SubscriptionReconcileCancel
+	case "active", "trialing", "past_due":
+		if sameInstant(subscription.PeriodStart, stripeSubscription.CurrentPeriodStart) &&
+			sameInstant(subscription.PeriodEnd, stripeSubscription.CurrentPeriodEnd) &&
+			subscription.CancelAtPeriodEnd == stripeSubscription.CancelAtPeriodEnd {
+			return nil
+		}
+		drift.Action = domain.SubscriptionReconcileSyncPeriod
+	default:
+		drift.Action = domain.SubscriptionReconcileReview
+	}
+	return drift
+}
+
+func (s *subscriptionService) applySubscriptionDrift(ctx context.Context, subscription *domain.UserSubscription, stripeSubscription *stripe.StripeSubscription, action domain.SubscriptionReconcileAction) error {
+	if action == domain.SubscriptionReconcileCancel {
+		return s.markCancelled(ctx, subscription)
+	}
+
+	start, end := stripeSubscription.CurrentPeriodStart, stripeSubscription.CurrentPeriodEnd
+	if subscription.PeriodStart == nil || start.After(*subscription.PeriodStart) {
+		// The renewal webhook was missed, so the usage of the old period is still counted
+		subscription.StartNewPeriod(start, end)
+	} else {
+		subscription.PeriodStart = &start
+		subscription.PeriodEnd = &end
+		subscription.ExpiredAt = &end
+	}
+	subscription.CancelAtPeriodEnd = stripeSubscription.CancelAtPeriodEnd
+
+	if err := s.userSubscriptionRepo.Update(ctx, subscription); err != nil {
+		s.logger.Error().Err(err).Int("subscription_id", subscription.ID).Msg("Failed to sync subscription period")
+		return fmt.Errorf("failed to sync subscription period: %w", err)
+	}
+	return nil
+}
+
+func sameInstant(local *time.Time, remote time.Time) bool {
+	return local != nil && local.Unix() == remote.Unix()
+}
+
 // Plan seeding (for initial setup)
 func (s *subscriptionService) SeedDefaultPlans(ctx context.Context) error {
 	// Check if plans already exist
@@ -508,6 +961,19 @@ func (s *subscriptionService) CancelSubscriptionByStripeID(ctx context.Context,
 		return fmt.Errorf("subscription not found for Stripe ID: %s", stripeID)
 	}
 
-	// Cancel the subscription
-	return s.CancelSubscription(ctx, uint(subscription.ID))
+	// Stripe has already ended the subscription, only the local record needs updating
+	return s.markCancelled(ctx, subscription)
+}
+
+// HandleSubscriptionRenewal resets usage for the subscription renewed by a Stripe invoice
+func (s *subscriptionService) HandleSubscriptionRenewal(ctx context.Context, stripeID string) error {
+	subscription, err := s.userSubscriptionRepo.GetByStripeSubscriptionID(ctx, stripeID)
+	if err != nil {
+		return err
+	}
+	if subscription == nil {
+		return fmt.Errorf("subscription not found for Stripe ID: %s", stripeID)
+	}
+
+	return s.ResetUsageForNewPeriod(ctx, uint(subscription.ID))
 }
@@ -0,0 +1,609 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/repository"
+	"github.com/louco-event/internal/repository/postgres"
+	"github.com/louco-event/internal/testutil"
+	"github.com/louco-event/pkg/logger"
+	"github.com/louco-event/pkg/stripe"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+// fakeStripe stands in for the Stripe API; subscriptions missing from the map are unknown to it
+type fakeStripe struct {
+	subscriptions map[string]*stripe.StripeSubscription
+	preview       *stripe.StripeInvoicePreview
+	previewedPlan *domain.SubscriptionPlan
+}
+
+func (f *fakeStripe) CancelSubscription(ctx context.Context, subscriptionID string, atPeriodEnd bool) error {
+	return nil
+}
+
+func (f *fakeStripe) ReactivateSubscription(ctx context.Context, subscriptionID string) error {
+	return nil
+}
+
+func (f *fakeStripe) GetSubscription(ctx context.Context, subscriptionID string) (*stripe.StripeSubscription, error) {
+	subscription, ok := f.subscriptions[subscriptionID]
+	if !ok {
+		return nil, stripe.ErrSubscriptionNotFound
+	}
+	return subscription, nil
+}
+
+func (f *fakeStripe) PreviewSubscriptionChange(ctx context.Context, subscriptionID string, plan *domain.SubscriptionPlan) (*stripe.StripeInvoicePreview, error) {
+	f.previewedPlan = plan
+	return f.preview, nil
+}
+
+func (f *fakeStripe) ConvertCentsToDollars(cents int64) float64 {
+	return float64(cents) / 100
+}
+
+// fakeUserSubscriptionRepo keeps subscriptions in memory; methods the tests do not reach are
+// left to the embedded nil interface
+type fakeUserSubscriptionRepo struct {
+	repository.UserSubscriptionRepository
+	subscriptions map[int]*domain.UserSubscription
+	updated       map[int]bool
+}
+
+func (r *fakeUserSubscriptionRepo) GetByID(ctx context.Context, id uint) (*domain.UserSubscription, error) {
+	return r.subscriptions[int(id)], nil
+}
+
+func (r *fakeUserSubscriptionRepo) Update(ctx context.Context, subscription *domain.UserSubscription) error {
+	r.subscriptions[subscription.ID] = subscription
+	r.updated[subscription.ID] = true
+	return nil
+}
+
+func (r *fakeUserSubscriptionRepo) GetActiveStripeSubscriptions(ctx context.Context) ([]*domain.UserSubscription, error) {
+	var subscriptions []*domain.UserSubscription
+	for _, subscription := range r.subscriptions {
+		if subscription.IsActive() && subscription.StripeID != nil {
+			subscriptions = append(subscriptions, subscription)
+		}
+	}
+	return subscriptions, nil
+}
+
+func (r *fakeUserSubscriptionRepo) GetByStripeSubscriptionID(ctx context.Context, stripeID string) (*domain.UserSubscription, error) {
+	for _, subscription := range r.subscriptions {
+		if subscription.StripeID != nil && *subscription.StripeID == stripeID {
+			return subscription, nil
+		}
+	}
+	return nil, nil
+}
+
+// CreateTrial claims the one-time trial of the subscription's user like the real repository
+func (r *fakeUserSubscriptionRepo) CreateTrial(ctx context.Context, subscription *domain.UserSubscription) error {
+	for _, existing := range r.subscriptions {
+		if existing.UserID == subscription.UserID && existing.TrialEndsAt != nil {
+			return domain.ErrTrialAlreadyUsed
+		}
+	}
+	subscription.ID = len(r.subscriptions) + 1
+	r.subscriptions[subscription.ID] = subscription
+	return nil
+}
+
+type fakeSubscriptionPlanRepo struct {
+	repository.SubscriptionPlanRepository
+	plans map[int]*domain.SubscriptionPlan
+}
+
+func (r *fakeSubscriptionPlanRepo) GetByID(ctx context.Context, id uint) (*domain.SubscriptionPlan, error) {
+	return r.plans[int(id)], nil
+}
+
+// fakeSubscriptionHistoryRepo collects audit entries in memory
+type fakeSubscriptionHistoryRepo struct {
+	entries []*domain.SubscriptionHistory
+}
+
+func (r *fakeSubscriptionHistoryRepo) Create(ctx context.Context, history *domain.SubscriptionHistory) error {
+	r.entries = append(r.entries, history)
+	return nil
+}
+
+func newTestSubscriptionService(subscriptions []*domain.UserSubscription, plans []*domain.SubscriptionPlan, stripeClient *fakeStripe) (*subscriptionService, *fakeUserSubscriptionRepo) {
+	subscriptionRepo := &fakeUserSubscriptionRepo{subscriptions: map[int]*domain.UserSubscription{}, updated: map[int]bool{}}
+	for _, subscription := range subscriptions {
+		subscriptionRepo.subscriptions[subscription.ID] = subscription
+	}
+	planRepo := &fakeSubscriptionPlanRepo{plans: map[int]*domain.SubscriptionPlan{}}
+	for _, plan := range plans {
+		planRepo.plans[plan.ID] = plan
+	}
+	nop := zerolog.Nop()
+	return &subscriptionService{
+		userSubscriptionRepo: subscriptionRepo,
+		subscriptionPlanRepo: planRepo,
+		historyRepo:          &fakeSubscriptionHistoryRepo{},
+		stripeService:        stripeClient,
+		logger:               &logger.Logger{Logger: &nop},
+	}, subscriptionRepo
+}
+
+// newTestSubscriptionServiceWithDB stores subscriptions in Postgres and serves the given plans
+// from memory
+func newTestSubscriptionServiceWithDB(t *testing.T, db *gorm.DB, plans ...*domain.SubscriptionPlan) SubscriptionService {
+	t.Helper()
+	planRepo := &fakeSubscriptionPlanRepo{plans: map[int]*domain.SubscriptionPlan{}}
+	for _, plan := range plans {
+		planRepo.plans[plan.ID] = plan
+	}
+	nop := zerolog.Nop()
+	return NewSubscriptionService(
+		postgres.NewUserSubscriptionRepository(db, &logger.Logger{Logger: &nop}),
+		planRepo,
+		postgres.NewSubscriptionHistoryRepository(db),
+		postgres.NewUserRepository(db),
+		&fakeStripe{},
+		&fakeEmailService{},
+		newTestTranslator(t),
+		&logger.Logger{Logger: &nop},
+	)
+}
+
+func stripeBackedSubscription(id int, name domain.SubscriptionName, stripeID string, periodStart, periodEnd time.Time) *domain.UserSubscription {
+	return &domain.UserSubscription{
+		ID:          id,
+		UserID:      7,
+		Type:        domain.SubscriptionTypeSubscription,
+		Name:        name,
+		Currency:    "EUR",
+		Status:      domain.SubscriptionStatusActive,
+		PeriodStart: &periodStart,
+		PeriodEnd:   &periodEnd,
+		StripeID:    &stripeID,
+	}
+}
+
+func TestPreviewPlanChange(t *testing.T) {
+	periodStart := time.Now().Add(-10 * 24 * time.Hour).Truncate(time.Second)
+	periodEnd := periodStart.Add(30 * 24 * time.Hour)
+	nextBilling := periodEnd
+	plan := &domain.SubscriptionPlan{ID: 2, Type: domain.SubscriptionTypeSubscription, Name: domain.SubscriptionNamePro, Price: 49, Currency: "EUR"}
+
+	t.Run("prorates an active subscription through Stripe", func(t *testing.T) {
+		stripeClient := &fakeStripe{preview: &stripe.StripeInvoicePreview{ProrationAmount: 1250, Total: 4900, NextBillingDate: &nextBilling}}
+		subscription := stripeBackedSubscription(1, domain.SubscriptionNameBasic, "sub_basic", periodStart, periodEnd)
+		service, _ := newTestSubscriptionService([]*domain.UserSubscription{subscription}, []*domain.SubscriptionPlan{plan}, stripeClient)
+
+		preview, err := service.PreviewPlanChange(context.Background(), 7, 1, 2)
+		if err != nil {
+			t.Fatalf("PreviewPlanChange: %v", err)
+		}
+		if stripeClient.previewedPlan != plan {
+			t.Fatalf("Stripe was not asked to preview the new plan")
+		}
+		if preview.IsNewPurchase || preview.ImmediateCharge != 12.5 || preview.NextInvoiceTotal != 49 {
+			t.Fatalf("unexpected preview: %+v", preview)
+		}
+		if preview.CurrentPlan != domain.SubscriptionNameBasic || preview.NewPlan != domain.SubscriptionNamePro {
+			t.Fatalf("unexpected plans: %s -> %s", preview.CurrentPlan, preview.NewPlan)
+		}
+		if preview.NextBillingDate == nil || !preview.NextBillingDate.Equal(nextBilling) {
+			t.Fatalf("next billing date %v, want %v", preview.NextBillingDate, nextBilling)
+		}
+	})
+
+	t.Run("prices a missing subscription as a new purchase", func(t *testing.T) {
+		stripeClient := &fakeStripe{}
+		service, _ := newTestSubscriptionService(nil, []*domain.SubscriptionPlan{plan}, stripeClient)
+
+		preview, err := service.PreviewPlanChange(context.Background(), 7, 1, 2)
+		if err != nil {
+			t.Fatalf("PreviewPlanChange: %v", err)
+		}
+		if !preview.IsNewPurchase || preview.ImmediateCharge != plan.Price {
+			t.Fatalf("unexpected preview: %+v", preview)
+		}
+		if stripeClient.previewedPlan != nil {
+			t.Fatalf("Stripe was asked to preview a new purchase")
+		}
+	})
+
+	t.Run("rejects another user's subscription", func(t *testing.T) {
+		subscription := stripeBackedSubscription(1, domain.SubscriptionNameBasic, "sub_basic", periodStart, periodEnd)
+		service, _ := newTestSubscriptionService([]*domain.UserSubscription{subscription}, []*domain.SubscriptionPlan{plan}, &fakeStripe{})
+
+		if _, err := service.PreviewPlanChange(context.Background(), 8, 1, 2); err == nil {
+			t.Fatalf("expected an error for another user's subscription")
+		}
+	})
+
+	t.Run("rejects the current plan", func(t *testing.T) {
+		subscription := stripeBackedSubscription(1, domain.SubscriptionNamePro, "sub_pro", periodStart, periodEnd)
+		service, _ := newTestSubscriptionService([]*domain.UserSubscription{subscription}, []*domain.SubscriptionPlan{plan}, &fakeStripe{})
+
+		if _, err := service.PreviewPlanChange(context.Background(), 7, 1, 2); err == nil {
+			t.Fatalf("expected an error for the current plan")
+		}
+	})
+}
+
+func TestReconcileSubscriptions(t *testing.T) {
+	periodStart := time.Now().Add(-10 * 24 * time.Hour).Truncate(time.Second)
+	periodEnd := periodStart.Add(30 * 24 * time.Hour)
+	renewedStart := periodEnd.Add(-24 * time.Hour)
+	renewedEnd := renewedStart.Add(30 * 24 * time.Hour)
+
+	newSubscriptions := func() []*domain.UserSubscription {
+		inSync := stripeBackedSubscription(1, domain.SubscriptionNameBasic, "sub_in_sync", periodStart, periodEnd)
+		missing := stripeBackedSubscription(2, domain.SubscriptionNameBasic, "sub_missing", periodStart, periodEnd)
+		canceled := stripeBackedSubscription(3, domain.SubscriptionNameBasic, "sub_canceled", periodStart, periodEnd)
+		renewed := stripeBackedSubscription(4, domain.SubscriptionNamePlus, "sub_renewed", periodStart, periodEnd)
+		renewed.MonthlyUsed = 3
+		unpaid := stripeBackedSubscription(5, domain.SubscriptionNamePro, "sub_unpaid", periodStart, periodEnd)
+		return []*domain.UserSubscription{inSync, missing, canceled, renewed, unpaid}
+	}
+	newStripe := func() *fakeStripe {
+		return &fakeStripe{subscriptions: map[string]*stripe.StripeSubscription{
+			"sub_in_sync":  {ID: "sub_in_sync", Status: "active", CurrentPeriodStart: periodStart, CurrentPeriodEnd: periodEnd},
+			"sub_canceled": {ID: "sub_canceled", Status: "canceled", CurrentPeriodStart: periodStart, CurrentPeriodEnd: periodEnd},
+			"sub_renewed":  {ID: "sub_renewed", Status: "active", CurrentPeriodStart: renewedStart, CurrentPeriodEnd: renewedEnd},
+			"sub_unpaid":   {ID: "sub_unpaid", Status: "unpaid", CurrentPeriodStart: periodStart, CurrentPeriodEnd: periodEnd},
+		}}
+	}
+	wantActions := map[int]domain.SubscriptionReconcileAction{
+		2: domain.SubscriptionReconcileCancel,
+		3: domain.SubscriptionReconcileCancel,
+		4: domain.SubscriptionReconcileSyncPeriod,
+		5: domain.SubscriptionReconcileReview,
+	}
+
+	checkDrifts := func(t *testing.T, report *domain.SubscriptionReconciliation) {
+		t.Helper()
+		if report.Checked != 5 {
+			t.Fatalf("checked %d subscriptions, want 5", report.Checked)
+		}
+		if len(report.Drifts) != len(wantActions) {
+			t.Fatalf("got %d drifts, want %d", len(report.Drifts), len(wantActions))
+		}
+		for _, drift := range report.Drifts {
+			if want, ok := wantActions[drift.SubscriptionID]; !ok || drift.Action != want {
+				t.Errorf("subscription %d: action %q, want %q", drift.SubscriptionID, drift.Action, want)
+			}
+		}
+	}
+
+	t.Run("dry run only reports drift", func(t *testing.T) {
+		service, repo := newTestSubscriptionService(newSubscriptions(), nil, newStripe())
+
+		report, err := service.ReconcileSubscriptions(context.Background(), true)
+		if err != nil {
+			t.Fatalf("ReconcileSubscriptions: %v", err)
+		}
+		checkDrifts(t, report)
+		if report.Corrected != 0 || len(repo.updated) != 0 {
+			t.Fatalf("dry run changed %d subscriptions", len(repo.updated))
+		}
+	})
+
+	t.Run("corrects drifted subscriptions", func(t *testing.T) {
+		service, repo := newTestSubscriptionService(newSubscriptions(), nil, newStripe())
+
+		report, err := service.ReconcileSubscriptions(context.Background(), false)
+		if err != nil {
+			t.Fatalf("ReconcileSubscriptions: %v", err)
+		}
+		checkDrifts(t, report)
+		if report.Corrected != 3 {
+			t.Fatalf("corrected %d subscriptions, want 3", report.Corrected)
+		}
+
+		for _, id := range []int{2, 3} {
+			if status := repo.subscriptions[id].Status; status != domain.SubscriptionStatusCancelled {
+				t.Errorf("subscription %d: status %q, want cancelled", id, status)
+			}
+		}
+		renewed := repo.subscriptions[4]
+		if !renewed.PeriodEnd.Equal(renewedEnd) || renewed.MonthlyUsed != 0 {
+			t.Errorf("renewed subscription not synced: period end %v, monthly used %d", renewed.PeriodEnd, renewed.MonthlyUsed)
+		}
+		for _, id := range []int{1, 5} {
+			if repo.updated[id] {
+				t.Errorf("subscription %d should not have been changed", id)
+			}
+		}
+	})
+}
+
+func TestCancelSubscriptionRecordsReason(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	tests := []struct {
+		name        string
+		atPeriodEnd bool
+		reason      string
+		wantAction  domain.SubscriptionHistoryAction
+		wantReason  string // empty when no reason is kept
+	}{
+		{"at period end", true, "  too expensive  ", domain.SubscriptionHistoryActionCancellationScheduled, "too expensive"},
+		{"immediately", false, "switching tools", domain.SubscriptionHistoryActionCancelled, "switching tools"},
+		{"without a reason", false, " ", domain.SubscriptionHistoryActionCancelled, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscription := stripeBackedSubscription(1, domain.SubscriptionNameBasic, "sub_1", now.AddDate(0, 0, -10), now.AddDate(0, 0, 20))
+			service, repo := newTestSubscriptionService([]*domain.UserSubscription{subscription}, nil, &fakeStripe{})
+
+			if err := service.CancelSubscription(ctx, uint(subscription.UserID), uint(subscription.ID), tt.atPeriodEnd, tt.reason); err != nil {
+				t.Fatalf("CancelSubscription: %v", err)
+			}
+
+			stored := repo.subscriptions[subscription.ID]
+			if !repo.updated[subscription.ID] || stored.CancelledAt == nil {
+				t.Errorf("stored subscription cancelled at %v, want the cancellation saved", stored.CancelledAt)
+			}
+			storedReason := ""
+			if stored.CancellationReason != nil {
+				storedReason = *stored.CancellationReason
+			}
+			if storedReason != tt.wantReason {
+				t.Errorf("stored cancellation reason = %v, want %q", stored.CancellationReason, tt.wantReason)
+			}
+
+			entries := service.historyRepo.(*fakeSubscriptionHistoryRepo).entries
+			if len(entries) != 1 {
+				t.Fatalf("recorded %d history entries, want 1", len(entries))
+			}
+			entry := entries[0]
+			if entry.SubscriptionID != subscription.ID || entry.Action != tt.wantAction {
+				t.Errorf("entry = subscription %d %s, want subscription %d %s", entry.SubscriptionID, entry.Action, subscription.ID, tt.wantAction)
+			}
+			if entry.ActorUserID == nil || *entry.ActorUserID != subscription.UserID {
+				t.Errorf("actor = %v, want user %d", entry.ActorUserID, subscription.UserID)
+			}
+			reason := ""
+			if entry.Reason != nil {
+				reason = *entry.Reason
+			}
+			if reason != tt.wantReason {
+				t.Errorf("reason = %v, want %q", entry.Reason, tt.wantReason)
+			}
+		})
+	}
+
+	t.Run("another user's subscription", func(t *testing.T) {
+		subscription := stripeBackedSubscription(1, domain.SubscriptionNameBasic, "sub_1", now.AddDate(0, 0, -10), now.AddDate(0, 0, 20))
+		service, _ := newTestSubscriptionService([]*domain.UserSubscription{subscription}, nil, &fakeStripe{})
+
+		if err := service.CancelSubscription(ctx, uint(subscription.UserID+1), uint(subscription.ID), false, "reason"); err == nil {
+			t.Fatal("cancelled another user's subscription")
+		}
+		if entries := service.historyRepo.(*fakeSubscriptionHistoryRepo).entries; len(entries) != 0 {
+			t.Errorf("recorded %d history entries, want none", len(entries))
+		}
+	})
+}
+
+func TestCancelAtPeriodEndKeepsPublishingRights(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	service := newTestSubscriptionServiceWithDB(t, db)
+	now := time.Now()
+
+	subscribe := func(t *testing.T) *domain.UserSubscription {
+		t.Helper()
+		user := testutil.CreateUser(t, db, domain.UserTypeCreator)
+		subscription := stripeBackedSubscription(0, domain.SubscriptionNameBasic, fmt.Sprintf("sub_%d", user.ID), now.AddDate(0, 0, -10), now.AddDate(0, 0, 20))
+		subscription.UserID = user.ID
+		if err := db.Omit("User").Create(subscription).Error; err != nil {
+			t.Fatalf("failed to create subscription: %v", err)
+		}
+		return subscription
+	}
+	canPublish := func(t *testing.T, subscription *domain.UserSubscription) bool {
+		t.Helper()
+		ok, err := service.CanPublishEvent(ctx, uint(subscription.UserID))
+		if err != nil {
+			t.Fatalf("CanPublishEvent: %v", err)
+		}
+		return ok
+	}
+
+	t.Run("at period end", func(t *testing.T) {
+		subscription := subscribe(t)
+		if err := service.CancelSubscription(ctx, uint(subscription.UserID), uint(subscription.ID), true, ""); err != nil {
+			t.Fatalf("CancelSubscription: %v", err)
+		}
+		if !canPublish(t, subscription) {
+			t.Error("lost publishing rights before the period ended")
+		}
+		active, err := service.GetActiveSubscription(ctx, uint(subscription.UserID))
+		if err != nil || active == nil || !active.IsCanceledButActive() {
+			t.Fatalf("active subscription = %+v, %v; want it cancelled but active", active, err)
+		}
+
+		// Reactivating before the period ends clears the scheduled cancellation
+		if err := service.ReactivateSubscription(ctx, uint(subscription.UserID), uint(subscription.ID)); err != nil {
+			t.Fatalf("ReactivateSubscription: %v", err)
+		}
+		active, err = service.GetActiveSubscription(ctx, uint(subscription.UserID))
+		if err != nil || active == nil || active.CancelAtPeriodEnd || active.CancelledAt != nil {
+			t.Errorf("reactivated subscription = %+v, %v; want no cancellation left", active, err)
+		}
+	})
+
+	t.Run("immediately", func(t *testing.T) {
+		subscription := subscribe(t)
+		if err := service.CancelSubscription(ctx, uint(subscription.UserID), uint(subscription.ID), false, ""); err != nil {
+			t.Fatalf("CancelSubscription: %v", err)
+		}
+		if canPublish(t, subscription) {
+			t.Error("kept publishing rights after an immediate cancellation")
+		}
+		if err := service.ReactivateSubscription(ctx, uint(subscription.UserID), uint(subscription.ID)); err == nil {
+			t.Error("reactivated a subscription that was cancelled immediately")
+		}
+	})
+}
+
+// fakeUserRepo returns the users of a map; methods the tests do not reach are left to the
+// embedded nil interface
+type fakeUserRepo struct {
+	repository.UserRepository
+	users map[int]*domain.User
+}
+
+func (r *fakeUserRepo) GetByID(ctx context.Context, id int) (*domain.User, error) {
+	user, ok := r.users[id]
+	if !ok {
+		return nil, errors.New("user not found")
+	}
+	return user, nil
+}
+
+func TestHandleTrialWillEndUsesUserLanguage(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	trialEnd := time.Date(2026, 11, 2, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		language    string
+		trialEnd    *time.Time
+		wantSubject string
+		wantMessage string
+	}{
+		{"turkish", "tr", &trialEnd, "Ücretsiz deneme süreniz yakında bitiyor", "Ücretsiz deneme süreniz 2026-11-02 tarihinde bitiyor."},
+		{"english", "en", &trialEnd, "Your free trial is ending soon", "Your free trial ends on 2026-11-02."},
+		{"no stored language", "", nil, "Your free trial is ending soon", "Your free trial is ending soon."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscription := stripeBackedSubscription(1, domain.SubscriptionNameBasic, "sub_trial", now, now.AddDate(0, 1, 0))
+			subscription.TrialEndsAt = tt.trialEnd
+			email := "member@example.com"
+			user := &domain.User{ID: subscription.UserID, Email: &email, Language: tt.language}
+			emails := &fakeEmailService{}
+
+			service, _ := newTestSubscriptionService([]*domain.UserSubscription{subscription}, nil, &fakeStripe{})
+			service.userRepo = &fakeUserRepo{users: map[int]*domain.User{user.ID: user}}
+			service.emailService = emails
+			service.translator = newTestTranslator(t)
+
+			if err := service.HandleTrialWillEnd(ctx, "sub_trial"); err != nil {
+				t.Fatalf("HandleTrialWillEnd: %v", err)
+			}
+			if len(emails.sent) != 1 {
+				t.Fatalf("sent %d emails, want 1", len(emails.sent))
+			}
+			sent := emails.sent[0]
+			if sent.to != email || sent.subject != tt.wantSubject || !strings.HasPrefix(sent.message, tt.wantMessage) {
+				t.Errorf("sent %+v, want subject %q and a message starting %q", sent, tt.wantSubject, tt.wantMessage)
+			}
+		})
+	}
+}
+
+func TestCreateTrialSubscriptionOncePerUser(t *testing.T) {
+	weekly, monthly := 5, 20
+	plan := &domain.SubscriptionPlan{ID: 2, Type: domain.SubscriptionTypeSubscription, Name: domain.SubscriptionNamePro, Price: 49, Currency: "EUR", WeeklyLimit: &weekly, MonthlyLimit: &monthly, TrialDays: 14}
+	service, _ := newTestSubscriptionService(nil, []*domain.SubscriptionPlan{plan}, &fakeStripe{})
+
+	subscription, err := service.CreateTrialSubscription(context.Background(), 7, 2, "sub_trial")
+	if err != nil {
+		t.Fatalf("CreateTrialSubscription: %v", err)
+	}
+	if subscription.TrialEndsAt == nil {
+		t.Fatal("trial subscription has no trial end")
+	}
+
+	// A redelivered webhook returns the same subscription instead of claiming a second trial
+	again, err := service.CreateTrialSubscription(context.Background(), 7, 2, "sub_trial")
+	if err != nil || again.ID != subscription.ID {
+		t.Fatalf("redelivery = %+v, %v; want the first subscription", again, err)
+	}
+
+	if _, err := service.CreateTrialSubscription(context.Background(), 7, 2, "sub_second_trial"); !errors.Is(err, domain.ErrTrialAlreadyUsed) {
+		t.Errorf("second trial: err = %v, want ErrTrialAlreadyUsed", err)
+	}
+}
+
+func TestTrialSubscriptionGrantsPublishingRights(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	user := testutil.CreateUser(t, db, domain.UserTypeCreator)
+	weekly, monthly := 5, 20
+	plan := &domain.SubscriptionPlan{ID: 2, Type: domain.SubscriptionTypeSubscription, Name: domain.SubscriptionNamePro, Price: 49, Currency: "EUR", WeeklyLimit: &weekly, MonthlyLimit: &monthly, TrialDays: 14}
+
+	service := newTestSubscriptionServiceWithDB(t, db, plan)
+
+	if canPublish, err := service.CanPublishEvent(ctx, uint(user.ID)); err != nil || canPublish {
+		t.Fatalf("before the trial: can publish = %v, %v; want false", canPublish, err)
+	}
+	if _, err := service.CreateTrialSubscription(ctx, uint(user.ID), uint(plan.ID), "sub_trial"); err != nil {
+		t.Fatalf("CreateTrialSubscription: %v", err)
+	}
+
+	subscription, err := service.GetActiveSubscription(ctx, uint(user.ID))
+	if err != nil || subscription == nil || !subscription.IsTrialing() {
+		t.Fatalf("active subscription = %+v, %v; want the trial", subscription, err)
+	}
+	canPublish, err := service.CanPublishEvent(ctx, uint(user.ID))
+	if err != nil || !canPublish {
+		t.Errorf("during the trial: can publish = %v, %v; want true", canPublish, err)
+	}
+	if err := service.ConsumeEventCredit(ctx, uint(user.ID)); err != nil {
+		t.Errorf("ConsumeEventCredit during the trial: %v", err)
+	}
+}
+
+func TestHandleSubscriptionRenewalIgnoresRedeliveredWebhooks(t *testing.T) {
+	ctx := context.Background()
+	periodStart := time.Now().Add(-30 * 24 * time.Hour).Truncate(time.Second)
+	periodEnd := periodStart.Add(30 * 24 * time.Hour)
+	renewedEnd := periodEnd.Add(30 * 24 * time.Hour)
+
+	subscription := stripeBackedSubscription(1, domain.SubscriptionNamePlus, "sub_renewing", periodStart, periodEnd)
+	subscription.MonthlyUsed = 4
+	stripeClient := &fakeStripe{subscriptions: map[string]*stripe.StripeSubscription{
+		"sub_renewing": {ID: "sub_renewing", Status: "active", CurrentPeriodStart: periodEnd, CurrentPeriodEnd: renewedEnd},
+	}}
+	service, repo := newTestSubscriptionService([]*domain.UserSubscription{subscription}, nil, stripeClient)
+
+	if err := service.HandleSubscriptionRenewal(ctx, "sub_renewing"); err != nil {
+		t.Fatalf("HandleSubscriptionRenewal: %v", err)
+	}
+	if got := repo.subscriptions[1]; got.MonthlyUsed != 0 || !got.PeriodStart.Equal(periodEnd) {
+		t.Fatalf("renewal: monthly used %d, period start %v; want usage reset and the new period", got.MonthlyUsed, got.PeriodStart)
+	}
+
+	// Usage in the new period survives the same invoice.paid delivered again
+	repo.subscriptions[1].MonthlyUsed = 2
+	if err := service.HandleSubscriptionRenewal(ctx, "sub_renewing"); err != nil {
+		t.Fatalf("redelivered HandleSubscriptionRenewal: %v", err)
+	}
+	if got := repo.subscriptions[1].MonthlyUsed; got != 2 {
+		t.Errorf("redelivered webhook: monthly used %d, want 2", got)
+	}
+
+	// An out-of-order webhook for the previous period is ignored as well
+	stripeClient.subscriptions["sub_renewing"].CurrentPeriodStart = periodStart
+	stripeClient.subscriptions["sub_renewing"].CurrentPeriodEnd = periodEnd
+	if err := service.HandleSubscriptionRenewal(ctx, "sub_renewing"); err != nil {
+		t.Fatalf("out-of-order HandleSubscriptionRenewal: %v", err)
+	}
+	if got := repo.subscriptions[1]; got.MonthlyUsed != 2 || !got.PeriodStart.Equal(periodEnd) {
+		t.Errorf("out-of-order webhook: monthly used %d, period start %v; want both kept", got.MonthlyUsed, got.PeriodStart)
+	}
+}
@@ -2,14 +2,25 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/louco-event/internal/domain"
 	"github.com/louco-event/internal/dto"
 	"github.com/louco-event/internal/repository"
 	"github.com/rs/zerolog"
+	"gorm.io/gorm"
 )
 
+// maxTicketAvailabilityItems caps how many cart lines one availability check may contain
+const maxTicketAvailabilityItems = 50
+
+// maxBulkTickets caps how many tiers one bulk create request may add
+const maxBulkTickets = 50
+
 type TicketService interface {
 	// Basic CRUD operations
 	CreateTicket(ctx context.Context, eventID int, creatorID int, req dto.CreateTicketRequest) (*dto.TicketResponse, error)
@@ -26,11 +37,25 @@ type TicketService interface {
 	// Availability operations
 	CheckTicketAvailability(ctx context.Context, ticketID int, quantity int) (bool, error)
 	GetTicketAvailability(ctx context.Context, ticketID int) (int, error)
+	CheckMultipleTicketAvailability(ctx context.Context, items []dto.TicketQuantityItem) (*dto.CheckTicketAvailabilityResponse, error)
+	// ValidateCart checks a cart whose lines may span several events: availability, current
+	// price and whether each parent event is still on sale, in one response
+	ValidateCart(ctx context.Context, items []dto.TicketQuantityItem) (*dto.ValidateCartResponse, error)
+
+	// Cart reservation operations
+	ReserveCart(ctx context.Context, userID int, items []dto.TicketQuantityItem, holdDuration time.Duration) (*dto.CartReservationResponse, error)
+	ConfirmCart(ctx context.Context, userID int, cartReservationID string) error
+	ReleaseCart(ctx context.Context, userID int, cartReservationID string) error
 
 	// Sales operations
-	SellTickets(ctx context.Context, ticketID int, quantity int) error
+	SellTickets(ctx context.Context, ticketID int, userID int, quantity int) error
 	RefundTickets(ctx context.Context, ticketID int, quantity int) error
 	UpdateSoldQuantity(ctx context.Context, ticketID int, quantity int) error
+	CloseTicketSales(ctx context.Context, eventID int, creatorID int, atEventStart bool) error
+	ReopenTicketSales(ctx context.Context, eventID int, creatorID int) error
+
+	// Purchase operations
+	GetUserTickets(ctx context.Context, userID int, req dto.UserTicketsRequest, pagination dto.PaginationRequest) ([]*dto.UserEventTicketsResponse, *dto.PaginationResponse, error)
 
 	// Price operations
 	GetTicketsByPriceRange(ctx context.Context, eventID int, minPrice, maxPrice float64) ([]*dto.TicketResponse, error)
@@ -44,7 +69,13 @@ type TicketService interface {
 	DeactivateAllEventTickets(ctx context.Context, eventID int) error
 
 	// Bulk operations
-	CreateMultipleTickets(ctx context.Context, eventID int, requests []dto.CreateTicketRequest) ([]*dto.TicketResponse, error)
+	CreateMultipleTickets(ctx context.Context, eventID int, creatorID int, requests []dto.CreateTicketRequest) ([]*dto.TicketResponse, error)
+	// CreateTicketsBestEffort creates each ticket on its own, so invalid tiers are reported per
+	// index without stopping the valid ones
+	CreateTicketsBestEffort(ctx context.Context, eventID int, creatorID int, requests []dto.CreateTicketRequest) (*dto.BulkCreateTicketsResponse, error)
+	// BulkUpdateTicketPrices adjusts the price of every active ticket of the event, recording the
+	// old and new prices in the event history with userID as the actor
+	BulkUpdateTicketPrices(ctx context.Context, eventID int, creatorID int, userID int, req dto.BulkUpdateTicketPriceRequest) ([]*dto.TicketResponse, error)
 	DeleteAllEventTickets(ctx context.Context, eventID int) error
 
 	// Statistics operations
@@ -53,6 +84,7 @@ type TicketService interface {
 	GetTotalRevenue(ctx context.Context, eventID int) (float64, error)
 	GetTotalTicketsSold(ctx context.Context, eventID int) (int, error)
 	GetTotalTicketsAvailable(ctx context.Context, eventID int) (int, error)
+	GetEventTicketAvailabilitySummary(ctx context.Context, eventID int) (*dto.TicketAvailabilitySummaryResponse, error)
 
 	// Advanced filtering
 	GetTicketsWithFilters(ctx context.Context, filters dto.TicketFilterRequest, pagination dto.PaginationRequest) ([]*dto.TicketResponse, *dto.PaginationResponse, error)
@@ -63,16 +95,49 @@ type TicketService interface {
 }
 
 type ticketService struct {
-	ticketRepo repository.TicketRepository
-	eventRepo  repository.EventRepository
-	logger     zerolog.Logger
+	ticketRepo            repository.TicketRepository
+	ticketPurchaseRepo    repository.TicketPurchaseRepository
+	ticketReservationRepo repository.TicketReservationRepository
+	eventRepo             repository.EventRepository
+	eventHistoryRepo      repository.EventHistoryRepository
+	waitlistService       WaitlistService
+	capacityThresholds    []int
+	cartHoldDuration      time.Duration
+	minTicketPrice        float64
+	currency              string
+	logger                zerolog.Logger
 }
 
-func NewTicketService(ticketRepo repository.TicketRepository, eventRepo repository.EventRepository, logger zerolog.Logger) TicketService {
+// NewTicketService creates the ticket service. capacityThresholds are the sold percentages at
+// which a capacity warning is recorded for the event. cartHoldDuration is the default and
+// longest time a cart reservation holds its tickets. minTicketPrice is the lowest price a paid
+// ticket may have in the platform currency; zero disables it. currency is the platform currency
+// ticket prices are quoted in.
+func NewTicketService(
+	ticketRepo repository.TicketRepository,
+	ticketPurchaseRepo repository.TicketPurchaseRepository,
+	ticketReservationRepo repository.TicketReservationRepository,
+	eventRepo repository.EventRepository,
+	eventHistoryRepo repository.EventHistoryRepository,
+	waitlistService WaitlistService,
+	capacityThresholds []int,
+	cartHoldDuration time.Duration,
+	minTicketPrice float64,
+	currency string,
+	logger zerolog.Logger,
+) TicketService {
 	return &ticketService{
-		ticketRepo: ticketRepo,
-		eventRepo:  eventRepo,
-		logger:     logger.With().Str("service", "ticket").Logger(),
+		ticketRepo:            ticketRepo,
+		ticketPurchaseRepo:    ticketPurchaseRepo,
+		ticketReservationRepo: ticketReservationRepo,
+		eventRepo:             eventRepo,
+		eventHistoryRepo:      eventHistoryRepo,
+		waitlistService:       waitlistService,
+		capacityThresholds:    capacityThresholds,
+		cartHoldDuration:      cartHoldDuration,
+		minTicketPrice:        minTicketPrice,
+		currency:              currency,
+		logger:                logger.With().Str("service", "ticket").Logger(),
 	}
 }
 
@@ -84,7 +149,7 @@ func (s *ticketService) CreateTicket(ctx context.Context, eventID int, creatorID
 		return nil, fmt.Errorf("failed to check event ownership: %w", err)
 	}
 	if !isOwner {
-		return nil, fmt.Errorf("event not found")
+		return nil, domain.ErrEventNotFound
 	}
 
 	// Validate request
@@ -107,6 +172,9 @@ func (s *ticketService) CreateTicket(ctx context.Context, eventID int, creatorID
 	if err := s.ValidateTicketData(ctx, ticket); err != nil {
 		return nil, err
 	}
+	if err := s.checkTitleAvailable(ctx, eventID, ticket.Title, 0); err != nil {
+		return nil, err
+	}
 
 	// Create ticket
 	if err := s.ticketRepo.Create(ctx, ticket); err != nil {
@@ -144,6 +212,9 @@ func (s *ticketService) UpdateTicket(ctx context.Context, id int, req dto.Update
 
 	// Update fields
 	if req.Title != nil {
+		if err := s.checkTitleAvailable(ctx, existingTicket.EventID, *req.Title, existingTicket.ID); err != nil {
+			return nil, err
+		}
 		existingTicket.Title = *req.Title
 	}
 	if req.Price != nil {
@@ -152,7 +223,7 @@ func (s *ticketService) UpdateTicket(ctx context.Context, id int, req dto.Update
 	if req.TotalQuantity != nil {
 		// Validate that new total quantity is not less than sold quantity
 		if *req.TotalQuantity < existingTicket.SoldQuantity {
-			return nil, fmt.Errorf("total quantity cannot be less than sold quantity (%d)", existingTicket.SoldQuantity)
+			return nil, domain.ErrTicketQuantityBelowSold
 		}
 		existingTicket.TotalQuantity = *req.TotalQuantity
 	}
@@ -180,7 +251,7 @@ func (s *ticketService) DeleteTicket(ctx context.Context, id int) error {
 		return fmt.Errorf("failed to check ticket existence: %w", err)
 	}
 	if !exists {
-		return fmt.Errorf("ticket not found")
+		return domain.ErrTicketNotFound
 	}
 
 	// Get ticket to check if it has sales
@@ -191,7 +262,7 @@ func (s *ticketService) DeleteTicket(ctx context.Context, id int) error {
 
 	// Don't allow deletion if tickets have been sold
 	if ticket.SoldQuantity > 0 {
-		return fmt.Errorf("cannot delete ticket: %d tickets have been sold", ticket.SoldQuantity)
+		return domain.ErrTicketCannotBeDeleted
 	}
 
 	// Delete ticket
@@ -290,10 +361,344 @@ func (s *ticketService) GetTicketAvailability(ctx context.Context, ticketID int)
 	return available, nil
 }
 
+// CheckMultipleTicketAvailability checks every line of a cart against a single ticket lookup.
+// Lines for the same tier are judged against their combined quantity, and tickets held by other
+// carts do not count as available.
+func (s *ticketService) CheckMultipleTicketAvailability(ctx context.Context, items []dto.TicketQuantityItem) (*dto.CheckTicketAvailabilityResponse, error) {
+	requested, ids, err := validateCartItems(items)
+	if err != nil {
+		return nil, err
+	}
+
+	ticketsByID, held, err := s.getCartTickets(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.CheckTicketAvailabilityResponse{
+		AllAvailable: true,
+		Items:        make([]dto.TicketAvailabilityItemResponse, 0, len(items)),
+	}
+	for _, item := range items {
+		result := ticketAvailabilityItem(item, ticketsByID[item.TicketID], held, requested)
+		if !result.IsAvailable {
+			response.AllAvailable = false
+		}
+		response.Items = append(response.Items, result)
+	}
+
+	return response, nil
+}
+
+// ValidateCart runs the availability check of CheckMultipleTicketAvailability and also marks
+// lines whose event is unpublished or no longer selling, so checkout needs a single call
+func (s *ticketService) ValidateCart(ctx context.Context, items []dto.TicketQuantityItem) (*dto.ValidateCartResponse, error) {
+	requested, ids, err := validateCartItems(items)
+	if err != nil {
+		return nil, err
+	}
+
+	ticketsByID, held, err := s.getCartTickets(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	eventIDs := make([]int, 0, len(ticketsByID))
+	for _, ticket := range ticketsByID {
+		eventIDs = append(eventIDs, ticket.EventID)
+	}
+	events, err := s.eventRepo.GetMultipleByIDs(ctx, uniqueInts(eventIDs))
+	if err != nil {
+		s.logger.Error().Err(err).Ints("event_ids", eventIDs).Msg("Failed to get events for cart validation")
+		return nil, fmt.Errorf("failed to validate cart: %w", err)
+	}
+	eventsByID := make(map[int]*domain.Event, len(events))
+	for _, event := range events {
+		eventsByID[event.ID] = event
+	}
+
+	response := &dto.ValidateCartResponse{
+		Valid: true,
+		Items: make([]dto.CartValidationItemResponse, 0, len(items)),
+	}
+	for _, item := range items {
+		ticket := ticketsByID[item.TicketID]
+		result := dto.CartValidationItemResponse{
+			TicketAvailabilityItemResponse: ticketAvailabilityItem(item, ticket, held, requested),
+		}
+
+		if ticket != nil {
+			result.EventID = ticket.EventID
+			result.Price = ticket.Price
+			result.Currency = s.currency
+
+			event, found := eventsByID[ticket.EventID]
+			result.SalesOpen = found && event.IsPublished() && !event.AreSalesClosed()
+			if !result.SalesOpen && result.IsAvailable {
+				result.Result = dto.TicketAvailabilitySalesClosed
+				result.IsAvailable = false
+			}
+		}
+
+		if !result.IsAvailable {
+			response.Valid = false
+		}
+		response.Items = append(response.Items, result)
+	}
+
+	return response, nil
+}
+
+// getCartTickets loads the tickets of a cart by id along with the quantities other carts hold
+func (s *ticketService) getCartTickets(ctx context.Context, ids []int) (map[int]*domain.Ticket, map[int]int, error) {
+	tickets, err := s.ticketRepo.GetMultipleByIDs(ctx, ids)
+	if err != nil {
+		s.logger.Error().Err(err).Ints("ticket_ids", ids).Msg("Failed to get tickets for availability check")
+		return nil, nil, fmt.Errorf("failed to check availability: %w", err)
+	}
+	held, err := s.ticketReservationRepo.GetHeldQuantities(ctx, ids)
+	if err != nil {
+		s.logger.Error().Err(err).Ints("ticket_ids", ids).Msg("Failed to get held ticket quantities")
+		return nil, nil, fmt.Errorf("failed to check availability: %w", err)
+	}
+
+	ticketsByID := make(map[int]*domain.Ticket, len(tickets))
+	for _, ticket := range tickets {
+		ticketsByID[ticket.ID] = ticket
+	}
+	return ticketsByID, held, nil
+}
+
+// ticketAvailabilityItem judges one cart line; ticket is nil when it does not exist. Lines for
+// the same tier are judged against their combined requested quantity.
+func ticketAvailabilityItem(item dto.TicketQuantityItem, ticket *domain.Ticket, held, requested map[int]int) dto.TicketAvailabilityItemResponse {
+	result := dto.TicketAvailabilityItemResponse{
+		TicketID: item.TicketID,
+		Quantity: item.Quantity,
+		Result:   dto.TicketAvailabilityAvailable,
+	}
+
+	switch {
+	case ticket == nil:
+		result.Result = dto.TicketAvailabilityNotFound
+	case !ticket.IsActive:
+		result.Result = dto.TicketAvailabilityInactive
+	default:
+		result.AvailableQuantity = max(ticket.GetAvailableQuantity()-held[ticket.ID], 0)
+		if result.AvailableQuantity < requested[item.TicketID] {
+			result.Result = dto.TicketAvailabilityInsufficient
+		}
+	}
+
+	result.IsAvailable = result.Result == dto.TicketAvailabilityAvailable
+	return result
+}
+
+// ReserveCart holds every tier of a cart in one transaction; either all holds are stored or
+// none are. The returned cart reservation id is used to confirm or release the cart.
+func (s *ticketService) ReserveCart(ctx context.Context, userID int, items []dto.TicketQuantityItem, holdDuration time.Duration) (*dto.CartReservationResponse, error) {
+	requested, ids, err := validateCartItems(items)
+	if err != nil {
+		return nil, err
+	}
+	if holdDuration <= 0 || holdDuration > s.cartHoldDuration {
+		holdDuration = s.cartHoldDuration
+	}
+
+	if err := s.checkCartSalesOpen(ctx, ids, requested); err != nil {
+		return nil, err
+	}
+
+	cartID := uuid.New().String()
+	expiresAt := time.Now().Add(holdDuration)
+	reservations := make([]*domain.TicketReservation, 0, len(ids))
+	response := &dto.CartReservationResponse{
+		CartReservationID: cartID,
+		ExpiresAt:         expiresAt,
+		Items:             make([]dto.TicketReservationResponse, 0, len(ids)),
+	}
+	for _, ticketID := range ids {
+		reservations = append(reservations, domain.NewTicketReservation(cartID, ticketID, userID, requested[ticketID], expiresAt))
+		response.Items = append(response.Items, dto.TicketReservationResponse{TicketID: ticketID, Quantity: requested[ticketID]})
+	}
+
+	if err := s.ticketReservationRepo.CreateCart(ctx, reservations); err != nil {
+		var domainErr *domain.DomainError
+		if errors.As(err, &domainErr) {
+			return nil, err
+		}
+		s.logger.Error().Err(err).Int("user_id", userID).Ints("ticket_ids", ids).Msg("Failed to reserve cart")
+		return nil, fmt.Errorf("failed to reserve cart: %w", err)
+	}
+
+	s.logger.Info().Int("user_id", userID).Str("cart_id", cartID).Int("tiers", len(ids)).Msg("Cart reserved")
+	return response, nil
+}
+
+// ConfirmCart sells the tickets held by a cart. It is the checkout step after payment.
+func (s *ticketService) ConfirmCart(ctx context.Context, userID int, cartReservationID string) error {
+	if err := s.checkCartOwner(ctx, userID, cartReservationID); err != nil {
+		return err
+	}
+
+	purchases, err := s.ticketReservationRepo.ConfirmCart(ctx, cartReservationID)
+	if err != nil {
+		var domainErr *domain.DomainError
+		if errors.As(err, &domainErr) {
+			return err
+		}
+		s.logger.Error().Err(err).Str("cart_id", cartReservationID).Msg("Failed to confirm cart")
+		return fmt.Errorf("failed to confirm cart: %w", err)
+	}
+
+	s.logger.Info().Int("user_id", userID).Str("cart_id", cartReservationID).Int("purchases", len(purchases)).Msg("Cart confirmed")
+	for _, eventID := range s.purchaseEventIDs(ctx, purchases) {
+		if event, err := s.eventRepo.GetByID(ctx, eventID); err == nil {
+			s.checkCapacityWarning(ctx, event)
+		}
+	}
+	return nil
+}
+
+// ReleaseCart gives the held tickets of a cart back before it expires
+func (s *ticketService) ReleaseCart(ctx context.Context, userID int, cartReservationID string) error {
+	if err := s.checkCartOwner(ctx, userID, cartReservationID); err != nil {
+		return err
+	}
+
+	released, err := s.ticketReservationRepo.ReleaseCart(ctx, cartReservationID)
+	if err != nil {
+		s.logger.Error().Err(err).Str("cart_id", cartReservationID).Msg("Failed to release cart")
+		return fmt.Errorf("failed to release cart: %w", err)
+	}
+	if released == 0 {
+		return domain.ErrTicketReservationNotFound
+	}
+
+	s.logger.Info().Int("user_id", userID).Str("cart_id", cartReservationID).Msg("Cart released")
+	return nil
+}
+
+// checkCartOwner reports carts of other users as missing so cart ids cannot be probed
+func (s *ticketService) checkCartOwner(ctx context.Context, userID int, cartReservationID string) error {
+	reservations, err := s.ticketReservationRepo.GetByCartID(ctx, cartReservationID)
+	if err != nil {
+		return fmt.Errorf("failed to get cart reservation: %w", err)
+	}
+	if len(reservations) == 0 || reservations[0].UserID != userID {
+		return domain.ErrTicketReservationNotFound
+	}
+	return nil
+}
+
+// checkCartSalesOpen rejects carts containing tickets of events whose sales are closed or
+// whose remaining capacity is smaller than the requested quantity
+func (s *ticketService) checkCartSalesOpen(ctx context.Context, ticketIDs []int, requested map[int]int) error {
+	tickets, err := s.ticketRepo.GetMultipleByIDs(ctx, ticketIDs)
+	if err != nil {
+		return fmt.Errorf("failed to get tickets: %w", err)
+	}
+
+	eventIDs := make([]int, 0, len(tickets))
+	seats := make(map[int]int, len(tickets))
+	for _, ticket := range tickets {
+		eventIDs = append(eventIDs, ticket.EventID)
+		seats[ticket.EventID] += requested[ticket.ID]
+	}
+	events, err := s.eventRepo.GetMultipleByIDs(ctx, uniqueInts(eventIDs))
+	if err != nil {
+		return fmt.Errorf("failed to get events: %w", err)
+	}
+	for _, event := range events {
+		if !event.IsPublished() {
+			return domain.ErrTicketNotActive
+		}
+		if event.AreSalesClosed() {
+			return domain.ErrEventSalesClosed
+		}
+		if err := s.checkEventCapacity(ctx, event, seats[event.ID]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkEventCapacity rejects seats more attendees when the event's capacity would be exceeded
+func (s *ticketService) checkEventCapacity(ctx context.Context, event *domain.Event, seats int) error {
+	if event.Capacity == nil {
+		return nil
+	}
+	attendees, err := s.eventRepo.CountAttendees(ctx, event.ID)
+	if err != nil {
+		s.logger.Error().Err(err).Int("event_id", event.ID).Msg("Failed to count event attendees")
+		return fmt.Errorf("failed to check capacity: %w", err)
+	}
+	return event.CheckCapacity(attendees, seats)
+}
+
+// purchaseEventIDs returns the events a set of purchases belongs to
+func (s *ticketService) purchaseEventIDs(ctx context.Context, purchases []*domain.TicketPurchase) []int {
+	ticketIDs := make([]int, 0, len(purchases))
+	for _, purchase := range purchases {
+		ticketIDs = append(ticketIDs, purchase.TicketID)
+	}
+	tickets, err := s.ticketRepo.GetMultipleByIDs(ctx, ticketIDs)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("Failed to get purchased tickets for capacity check")
+		return nil
+	}
+
+	eventIDs := make([]int, 0, len(tickets))
+	for _, ticket := range tickets {
+		eventIDs = append(eventIDs, ticket.EventID)
+	}
+	return uniqueInts(eventIDs)
+}
+
+// validateCartItems checks the cart lines and returns the requested quantity per ticket along
+// with the distinct ticket ids in request order
+func validateCartItems(items []dto.TicketQuantityItem) (map[int]int, []int, error) {
+	if len(items) == 0 {
+		return nil, nil, domain.ErrTicketNoneToCheck
+	}
+	if len(items) > maxTicketAvailabilityItems {
+		return nil, nil, domain.ErrTicketTooManyToCheck
+	}
+
+	requested := make(map[int]int, len(items))
+	ids := make([]int, 0, len(items))
+	for _, item := range items {
+		if item.TicketID <= 0 || item.Quantity <= 0 {
+			return nil, nil, domain.ErrTicketInvalidQuantity
+		}
+		if _, seen := requested[item.TicketID]; !seen {
+			ids = append(ids, item.TicketID)
+		}
+		requested[item.TicketID] += item.Quantity
+	}
+	return requested, ids, nil
+}
+
 // Sales operations
-func (s *ticketService) SellTickets(ctx context.Context, ticketID int, quantity int) error {
+func (s *ticketService) SellTickets(ctx context.Context, ticketID int, userID int, quantity int) error {
 	if quantity <= 0 {
-		return fmt.Errorf("quantity must be positive")
+		return domain.ErrTicketInvalidQuantity
+	}
+
+	// Sales can be closed for the whole event regardless of the ticket's own status
+	ticket, err := s.ticketRepo.GetByID(ctx, ticketID)
+	if err != nil {
+		return fmt.Errorf("failed to get ticket: %w", err)
+	}
+	event, err := s.eventRepo.GetByID(ctx, ticket.EventID)
+	if err != nil {
+		return fmt.Errorf("failed to get event: %w", err)
+	}
+	if event.AreSalesClosed() {
+		return domain.ErrEventSalesClosed
+	}
+	if err := s.checkEventCapacity(ctx, event, quantity); err != nil {
+		return err
 	}
 
 	// Check availability
@@ -302,22 +707,146 @@ func (s *ticketService) SellTickets(ctx context.Context, ticketID int, quantity
 		return err
 	}
 	if !available {
-		return fmt.Errorf("insufficient tickets available")
+		return domain.ErrTicketInsufficientQuantity
 	}
 
-	// Increment sold quantity
-	if err := s.ticketRepo.IncrementSoldQuantity(ctx, ticketID, quantity); err != nil {
-		s.logger.Error().Err(err).Int("ticket_id", ticketID).Int("quantity", quantity).Msg("Failed to sell tickets")
+	// The checks above are only a fast path; the sale re-checks sales, capacity and availability
+	// under lock and fails when a concurrent sale took the remaining tickets
+	purchase := domain.NewTicketPurchase(ticketID, userID, quantity)
+	if err := s.ticketRepo.SellTickets(ctx, purchase); err != nil {
+		var domainErr *domain.DomainError
+		if errors.As(err, &domainErr) {
+			return err
+		}
+		s.logger.Error().Err(err).Int("ticket_id", ticketID).Int("user_id", userID).Int("quantity", quantity).Msg("Failed to sell tickets")
 		return fmt.Errorf("failed to sell tickets: %w", err)
 	}
 
-	s.logger.Info().Int("ticket_id", ticketID).Int("quantity", quantity).Msg("Tickets sold successfully")
+	s.logger.Info().Int("ticket_id", ticketID).Int("user_id", userID).Int("quantity", quantity).Msg("Tickets sold successfully")
+	s.checkCapacityWarning(ctx, event)
+	return nil
+}
+
+// checkCapacityWarning records an event.capacity_warning once sales cross a configured
+// threshold. The sale has already gone through, so failures are only logged.
+func (s *ticketService) checkCapacityWarning(ctx context.Context, event *domain.Event) {
+	if len(s.capacityThresholds) == 0 {
+		return
+	}
+
+	stats, err := s.ticketRepo.GetSalesStats(ctx, event.ID)
+	if err != nil {
+		s.logger.Error().Err(err).Int("event_id", event.ID).Msg("Failed to get sales stats for capacity warning")
+		return
+	}
+	if stats.TotalTickets == 0 {
+		return
+	}
+
+	soldPercent := float64(stats.SoldTickets) / float64(stats.TotalTickets) * 100
+	threshold, reached := event.CapacityWarningThreshold(s.capacityThresholds, soldPercent)
+	if !reached {
+		return
+	}
+
+	raised, err := s.eventRepo.RaiseCapacityWarningLevel(ctx, event.ID, threshold)
+	if err != nil {
+		s.logger.Error().Err(err).Int("event_id", event.ID).Int("threshold", threshold).Msg("Failed to update capacity warning level")
+		return
+	}
+	// Another sale already warned about this threshold
+	if !raised {
+		return
+	}
+
+	if err := s.eventHistoryRepo.Create(ctx, domain.NewEventCapacityWarningHistory(event.ID, threshold)); err != nil {
+		s.logger.Error().Err(err).Int("event_id", event.ID).Int("threshold", threshold).Msg("Failed to record capacity warning")
+		return
+	}
+
+	s.logger.Info().Int("event_id", event.ID).Int("threshold", threshold).Float64("sold_percent", soldPercent).Msg("event.capacity_warning")
+}
+
+// Purchase operations
+func (s *ticketService) GetUserTickets(ctx context.Context, userID int, req dto.UserTicketsRequest, pagination dto.PaginationRequest) ([]*dto.UserEventTicketsResponse, *dto.PaginationResponse, error) {
+	var upcoming *bool
+	switch req.Segment {
+	case "":
+	case "upcoming", "past":
+		isUpcoming := req.Segment == "upcoming"
+		upcoming = &isUpcoming
+	default:
+		return nil, nil, domain.ErrTicketInvalidSegment
+	}
+
+	purchases, paginationResp, err := s.ticketPurchaseRepo.GetByUserID(ctx, userID, upcoming, pagination)
+	if err != nil {
+		s.logger.Error().Err(err).Int("user_id", userID).Msg("Failed to get user tickets")
+		return nil, nil, fmt.Errorf("failed to get user tickets: %w", err)
+	}
+
+	return dto.UserTicketsToResponse(purchases), paginationResp, nil
+}
+
+func (s *ticketService) CloseTicketSales(ctx context.Context, eventID int, creatorID int, atEventStart bool) error {
+	event, err := s.getOwnedEvent(ctx, eventID, creatorID)
+	if err != nil {
+		return err
+	}
+
+	var salesClosedAt *time.Time
+	if atEventStart {
+		if event.GetFullStartDateTime() == nil {
+			return domain.ErrTicketSalesStartTimeRequired
+		}
+	} else {
+		now := time.Now()
+		salesClosedAt = &now
+	}
+
+	if err := s.eventRepo.UpdateSalesClosure(ctx, eventID, salesClosedAt, atEventStart); err != nil {
+		s.logger.Error().Err(err).Int("event_id", eventID).Msg("Failed to close ticket sales")
+		return fmt.Errorf("failed to close ticket sales: %w", err)
+	}
+
+	s.logger.Info().Int("event_id", eventID).Bool("at_event_start", atEventStart).Msg("Ticket sales closed")
 	return nil
 }
 
+func (s *ticketService) ReopenTicketSales(ctx context.Context, eventID int, creatorID int) error {
+	if _, err := s.getOwnedEvent(ctx, eventID, creatorID); err != nil {
+		return err
+	}
+
+	if err := s.eventRepo.UpdateSalesClosure(ctx, eventID, nil, false); err != nil {
+		s.logger.Error().Err(err).Int("event_id", eventID).Msg("Failed to reopen ticket sales")
+		return fmt.Errorf("failed to reopen ticket sales: %w", err)
+	}
+
+	s.logger.Info().Int("event_id", eventID).Msg("Ticket sales reopened")
+	return nil
+}
+
+// getOwnedEvent returns the event if it belongs to the creator
+func (s *ticketService) getOwnedEvent(ctx context.Context, eventID int, creatorID int) (*domain.Event, error) {
+	isOwner, err := s.eventRepo.IsEventOwner(ctx, eventID, creatorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check event ownership: %w", err)
+	}
+	if !isOwner {
+		return nil, domain.ErrEventNotFound
+	}
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	return event, nil
+}
+
 func (s *ticketService) RefundTickets(ctx context.Context, ticketID int, quantity int) error {
 	if quantity <= 0 {
-		return fmt.Errorf("quantity must be positive")
+		return domain.ErrTicketInvalidQuantity
 	}
 
 	// Get current ticket
@@ -328,7 +857,7 @@ func (s *ticketService) RefundTickets(ctx context.Context, ticketID int, quantit
 
 	// Check if we can refund this quantity
 	if ticket.SoldQuantity < quantity {
-		return fmt.Errorf("cannot refund %d tickets: only %d tickets have been sold", quantity, ticket.SoldQuantity)
+		return domain.ErrTicketRefundExceedsSold
 	}
 
 	// Decrement sold quantity
@@ -338,12 +867,17 @@ func (s *ticketService) RefundTickets(ctx context.Context, ticketID int, quantit
 	}
 
 	s.logger.Info().Int("ticket_id", ticketID).Int("quantity", quantity).Msg("Tickets refunded successfully")
+
+	// The refund is already stored, so a failed promotion is only logged
+	if _, err := s.waitlistService.Promote(ctx, ticket.EventID, quantity); err != nil {
+		s.logger.Error().Err(err).Int("event_id", ticket.EventID).Msg("Failed to promote waitlist after refund")
+	}
 	return nil
 }
 
 func (s *ticketService) UpdateSoldQuantity(ctx context.Context, ticketID int, quantity int) error {
 	if quantity < 0 {
-		return fmt.Errorf("sold quantity cannot be negative")
+		return domain.ErrTicketInvalidQuantityDecrement
 	}
 
 	// Get ticket to validate
@@ -354,7 +888,7 @@ func (s *ticketService) UpdateSoldQuantity(ctx context.Context, ticketID int, qu
 
 	// Check if new quantity doesn't exceed total
 	if quantity > ticket.TotalQuantity {
-		return fmt.Errorf("sold quantity (%d) cannot exceed total quantity (%d)", quantity, ticket.TotalQuantity)
+		return domain.ErrTicketSoldQuantityExceedsTotal
 	}
 
 	// Update sold quantity
@@ -370,10 +904,10 @@ func (s *ticketService) UpdateSoldQuantity(ctx context.Context, ticketID int, qu
 // Price operations
 func (s *ticketService) GetTicketsByPriceRange(ctx context.Context, eventID int, minPrice, maxPrice float64) ([]*dto.TicketResponse, error) {
 	if minPrice < 0 || maxPrice < 0 {
-		return nil, fmt.Errorf("prices cannot be negative")
+		return nil, domain.ErrTicketInvalidPrice
 	}
 	if minPrice > maxPrice {
-		return nil, fmt.Errorf("minimum price cannot be greater than maximum price")
+		return nil, domain.ErrTicketInvalidPriceRange
 	}
 
 	tickets, err := s.ticketRepo.GetTicketsByPriceRange(ctx, eventID, minPrice, maxPrice)
@@ -462,41 +996,27 @@ func (s *ticketService) DeactivateAllEventTickets(ctx context.Context, eventID i
 }
 
 // Bulk operations
-func (s *ticketService) CreateMultipleTickets(ctx context.Context, eventID int, requests []dto.CreateTicketRequest) ([]*dto.TicketResponse, error) {
-	if len(requests) == 0 {
-		return nil, fmt.Errorf("no tickets to create")
-	}
-
-	// Validate event exists
-	exists, err := s.eventRepo.ExistsByID(ctx, eventID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to check event existence: %w", err)
-	}
-	if !exists {
-		return nil, fmt.Errorf("event not found")
+// CreateMultipleTickets creates all tickets in one transaction; a single invalid tier fails
+// the whole batch
+func (s *ticketService) CreateMultipleTickets(ctx context.Context, eventID int, creatorID int, requests []dto.CreateTicketRequest) ([]*dto.TicketResponse, error) {
+	if err := s.validateBulkCreate(ctx, eventID, creatorID, requests); err != nil {
+		return nil, err
 	}
 
 	var tickets []*domain.Ticket
+	titles := make(map[string]bool, len(requests))
 	for i, req := range requests {
-		// Validate request
-		if err := s.validateCreateTicketRequest(&req); err != nil {
-			return nil, fmt.Errorf("validation failed for ticket %d: %w", i+1, err)
+		// Tiers of the batch are only stored at the end, so check them against each other here
+		title := ticketTitleKey(req.Title)
+		if titles[title] {
+			return nil, fmt.Errorf("validation failed for ticket %d: %w", i+1, domain.ErrTicketDuplicateTitle)
 		}
+		titles[title] = true
 
-		ticket := &domain.Ticket{
-			EventID:       eventID,
-			Title:         req.Title,
-			Price:         req.Price,
-			TotalQuantity: req.TotalQuantity,
-			SoldQuantity:  0,
-			IsActive:      true,
-		}
-
-		// Validate domain entity
-		if err := s.ValidateTicketData(ctx, ticket); err != nil {
+		ticket, err := s.newTicketFromRequest(ctx, eventID, req)
+		if err != nil {
 			return nil, fmt.Errorf("validation failed for ticket %d: %w", i+1, err)
 		}
-
 		tickets = append(tickets, ticket)
 	}
 
@@ -516,6 +1036,140 @@ func (s *ticketService) CreateMultipleTickets(ctx context.Context, eventID int,
 	return responses, nil
 }
 
+func (s *ticketService) CreateTicketsBestEffort(ctx context.Context, eventID int, creatorID int, requests []dto.CreateTicketRequest) (*dto.BulkCreateTicketsResponse, error) {
+	if err := s.validateBulkCreate(ctx, eventID, creatorID, requests); err != nil {
+		return nil, err
+	}
+
+	response := &dto.BulkCreateTicketsResponse{Results: make([]dto.BulkTicketResult, len(requests))}
+	for i, req := range requests {
+		response.Results[i] = dto.BulkTicketResult{Index: i}
+
+		ticket, err := s.newTicketFromRequest(ctx, eventID, req)
+		if err == nil {
+			if err = s.ticketRepo.Create(ctx, ticket); err != nil {
+				s.logger.Error().Err(err).Int("event_id", eventID).Int("index", i).Msg("Failed to create ticket in bulk")
+				err = fmt.Errorf("failed to create ticket: %w", err)
+			}
+		}
+		if err != nil {
+			response.Results[i].Status = dto.BulkTicketResultError
+			response.Results[i].Err = err
+			response.Failed++
+			continue
+		}
+
+		response.Results[i].Status = dto.BulkTicketResultCreated
+		response.Results[i].Ticket = s.ticketToResponse(ticket)
+		response.Created++
+	}
+
+	s.logger.Info().Int("event_id", eventID).Int("created", response.Created).Int("failed", response.Failed).Msg("Best-effort bulk ticket creation finished")
+	return response, nil
+}
+
+// validateBulkCreate checks the batch size and that the creator owns the event
+func (s *ticketService) validateBulkCreate(ctx context.Context, eventID int, creatorID int, requests []dto.CreateTicketRequest) error {
+	if len(requests) == 0 {
+		return domain.ErrTicketNoneToCreate
+	}
+	if len(requests) > maxBulkTickets {
+		return domain.ErrTicketTooManyToCreate
+	}
+
+	isOwner, err := s.eventRepo.IsEventOwner(ctx, eventID, creatorID)
+	if err != nil {
+		return fmt.Errorf("failed to check event ownership: %w", err)
+	}
+	if !isOwner {
+		return domain.ErrEventNotFound
+	}
+	return nil
+}
+
+// newTicketFromRequest validates a create request and builds the active ticket it describes
+func (s *ticketService) newTicketFromRequest(ctx context.Context, eventID int, req dto.CreateTicketRequest) (*domain.Ticket, error) {
+	if err := s.validateCreateTicketRequest(&req); err != nil {
+		return nil, err
+	}
+
+	ticket := &domain.Ticket{
+		EventID:       eventID,
+		Title:         req.Title,
+		Price:         req.Price,
+		TotalQuantity: req.TotalQuantity,
+		SoldQuantity:  0,
+		IsActive:      true,
+	}
+	if err := s.ValidateTicketData(ctx, ticket); err != nil {
+		return nil, err
+	}
+	if err := s.checkTitleAvailable(ctx, eventID, ticket.Title, 0); err != nil {
+		return nil, err
+	}
+	return ticket, nil
+}
+
+// checkTitleAvailable rejects a title another ticket of the event already has, ignoring case
+func (s *ticketService) checkTitleAvailable(ctx context.Context, eventID int, title string, excludeTicketID int) error {
+	exists, err := s.ticketRepo.ExistsByEventAndTitle(ctx, eventID, strings.TrimSpace(title), excludeTicketID)
+	if err != nil {
+		return fmt.Errorf("failed to check ticket title: %w", err)
+	}
+	if exists {
+		return domain.ErrTicketDuplicateTitle
+	}
+	return nil
+}
+
+// ticketTitleKey is the form in which ticket titles are compared for uniqueness
+func ticketTitleKey(title string) string {
+	return strings.ToLower(strings.TrimSpace(title))
+}
+
+func (s *ticketService) BulkUpdateTicketPrices(ctx context.Context, eventID int, creatorID int, userID int, req dto.BulkUpdateTicketPriceRequest) ([]*dto.TicketResponse, error) {
+	// Validate event exists and belongs to the creator
+	isOwner, err := s.eventRepo.IsEventOwner(ctx, eventID, creatorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check event ownership: %w", err)
+	}
+	if !isOwner {
+		return nil, domain.ErrEventNotFound
+	}
+
+	if req.AdjustmentType == domain.TicketPriceAdjustmentPercentage && req.Value < -100 {
+		return nil, domain.ErrTicketInvalidPriceAdjustment
+	}
+
+	tickets, err := s.ticketRepo.UpdatePrices(ctx, eventID, &userID, func(ticket *domain.Ticket) (float64, error) {
+		newPrice, err := ticket.AdjustedPrice(req.AdjustmentType, req.Value)
+		if err != nil {
+			return 0, err
+		}
+		if newPrice > 0 && newPrice < s.minTicketPrice {
+			return 0, domain.ErrTicketPriceBelowMinimum
+		}
+		return newPrice, nil
+	})
+	if err != nil {
+		var domainErr *domain.DomainError
+		if errors.As(err, &domainErr) {
+			return nil, err
+		}
+		s.logger.Error().Err(err).Int("event_id", eventID).Msg("Failed to bulk update ticket prices")
+		return nil, fmt.Errorf("failed to update ticket prices: %w", err)
+	}
+
+	s.logger.Info().Int("event_id", eventID).Int("creator_id", creatorID).Int("ticket_count", len(tickets)).Msg("Ticket prices changed by bulk update")
+
+	responses := make([]*dto.TicketResponse, 0, len(tickets))
+	for _, ticket := range tickets {
+		responses = append(responses, s.ticketToResponse(ticket))
+	}
+
+	return responses, nil
+}
+
 func (s *ticketService) DeleteAllEventTickets(ctx context.Context, eventID int) error {
 	// Check if any tickets have been sold
 	totalSold, err := s.GetTotalTicketsSold(ctx, eventID)
@@ -523,7 +1177,7 @@ func (s *ticketService) DeleteAllEventTickets(ctx context.Context, eventID int)
 		return fmt.Errorf("failed to check sold tickets: %w", err)
 	}
 	if totalSold > 0 {
-		return fmt.Errorf("cannot delete tickets: %d tickets have been sold", totalSold)
+		return domain.ErrTicketCannotBeDeleted
 	}
 
 	// Delete all event tickets
@@ -587,6 +1241,30 @@ func (s *ticketService) GetTotalTicketsAvailable(ctx context.Context, eventID in
 	return available, nil
 }
 
+// GetEventTicketAvailabilitySummary returns the combined availability of a published public event.
+// Other events are reported as not found so their existence is not revealed.
+func (s *ticketService) GetEventTicketAvailabilitySummary(ctx context.Context, eventID int) (*dto.TicketAvailabilitySummaryResponse, error) {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrEventNotFound
+		}
+		s.logger.Error().Err(err).Int("event_id", eventID).Msg("Failed to get event for ticket availability summary")
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	if !event.IsPublic() || !event.IsPublished() {
+		return nil, domain.ErrEventNotFound
+	}
+
+	summary, err := s.ticketRepo.GetAvailabilitySummary(ctx, eventID)
+	if err != nil {
+		s.logger.Error().Err(err).Int("event_id", eventID).Msg("Failed to get ticket availability summary")
+		return nil, fmt.Errorf("failed to get ticket availability summary: %w", err)
+	}
+
+	return summary, nil
+}
+
 // Advanced filtering
 func (s *ticketService) GetTicketsWithFilters(ctx context.Context, filters dto.TicketFilterRequest, pagination dto.PaginationRequest) ([]*dto.TicketResponse, *dto.PaginationResponse, error) {
 	tickets, paginationResp, err := s.ticketRepo.GetTicketsWithFilters(ctx, filters, pagination)
@@ -611,26 +1289,29 @@ func (s *ticketService) ValidateTicketOwnership(ctx context.Context, ticketID, e
 		return fmt.Errorf("failed to validate ownership: %w", err)
 	}
 	if !isOwner {
-		return fmt.Errorf("access denied: ticket does not belong to this event")
+		return domain.ErrTicketNotFound
 	}
 	return nil
 }
 
 func (s *ticketService) ValidateTicketData(ctx context.Context, ticket *domain.Ticket) error {
 	if ticket.Title == "" {
-		return fmt.Errorf("ticket title is required")
+		return domain.ErrTicketTitleRequired
 	}
 	if ticket.Price < 0 {
-		return fmt.Errorf("ticket price cannot be negative")
+		return domain.ErrTicketInvalidPrice
+	}
+	if !ticket.MeetsMinimumPrice(s.minTicketPrice) {
+		return domain.ErrTicketPriceBelowMinimum
 	}
 	if ticket.TotalQuantity <= 0 {
-		return fmt.Errorf("total quantity must be positive")
+		return domain.ErrTicketInvalidTotalQuantity
 	}
 	if ticket.SoldQuantity < 0 {
-		return fmt.Errorf("sold quantity cannot be negative")
+		return domain.ErrTicketInvalidQuantityDecrement
 	}
 	if ticket.SoldQuantity > ticket.TotalQuantity {
-		return fmt.Errorf("sold quantity cannot exceed total quantity")
+		return domain.ErrTicketSoldQuantityExceedsTotal
 	}
 
 	return nil
@@ -639,13 +1320,13 @@ func (s *ticketService) ValidateTicketData(ctx context.Context, ticket *domain.T
 // Helper methods
 func (s *ticketService) validateCreateTicketRequest(req *dto.CreateTicketRequest) error {
 	if req.Title == "" {
-		return fmt.Errorf("title is required")
+		return domain.ErrTicketTitleRequired
 	}
 	if req.Price < 0 {
-		return fmt.Errorf("price cannot be negative")
+		return domain.ErrTicketInvalidPrice
 	}
 	if req.TotalQuantity <= 0 {
-		return fmt.Errorf("total quantity must be positive")
+		return domain.ErrTicketInvalidTotalQuantity
 	}
 
 	return nil
@@ -653,13 +1334,13 @@ func (s *ticketService) validateCreateTicketRequest(req *dto.CreateTicketRequest
 
 func (s *ticketService) validateUpdateTicketRequest(req *dto.UpdateTicketRequest) error {
 	if req.Title != nil && *req.Title == "" {
-		return fmt.Errorf("title cannot be empty")
+		return domain.ErrTicketTitleRequired
 	}
 	if req.Price != nil && *req.Price < 0 {
-		return fmt.Errorf("price cannot be negative")
+		return domain.ErrTicketInvalidPrice
 	}
 	if req.TotalQuantity != nil && *req.TotalQuantity <= 0 {
-		return fmt.Errorf("total quantity must be positive")
+		return domain.ErrTicketInvalidTotalQuantity
 	}
 
 	return nil
@@ -0,0 +1,909 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/louco-event/internal/config"
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/repository/postgres"
+	"github.com/louco-event/internal/testutil"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+func newTestTicketService(db *gorm.DB, waitlist WaitlistService) TicketService {
+	return NewTicketService(
+		postgres.NewTicketRepository(db),
+		postgres.NewTicketPurchaseRepository(db),
+		postgres.NewTicketReservationRepository(db),
+		postgres.NewEventRepository(db),
+		postgres.NewEventHistoryRepository(db),
+		waitlist,
+		nil,
+		15*time.Minute,
+		0,
+		"usd",
+		zerolog.Nop(),
+	)
+}
+
+func soldQuantity(t *testing.T, db *gorm.DB, ticketID int) int {
+	t.Helper()
+	var ticket domain.Ticket
+	if err := db.First(&ticket, ticketID).Error; err != nil {
+		t.Fatalf("failed to load ticket: %v", err)
+	}
+	return ticket.SoldQuantity
+}
+
+func TestSellTicketsLeavesCartHoldsAlone(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	holder := testutil.CreateUser(t, db, domain.UserTypeUser)
+	buyer := testutil.CreateUser(t, db, domain.UserTypeUser)
+	service := newTestTicketService(db, nil)
+	event := testutil.CreateEvent(t, db, creator.ID, nil)
+	ticket := testutil.CreateTicket(t, db, event.ID, 3)
+
+	items := []dto.TicketQuantityItem{{TicketID: ticket.ID, Quantity: 2}}
+	if _, err := service.ReserveCart(ctx, holder.ID, items, time.Minute); err != nil {
+		t.Fatalf("ReserveCart: %v", err)
+	}
+
+	if err := service.SellTickets(ctx, ticket.ID, buyer.ID, 2); !errors.Is(err, domain.ErrTicketInsufficientQuantity) {
+		t.Fatalf("selling held tickets: err = %v, want ErrTicketInsufficientQuantity", err)
+	}
+	if err := service.SellTickets(ctx, ticket.ID, buyer.ID, 1); err != nil {
+		t.Fatalf("selling the ticket nobody holds: %v", err)
+	}
+	if sold := soldQuantity(t, db, ticket.ID); sold != 1 {
+		t.Errorf("sold %d tickets, want 1", sold)
+	}
+}
+
+func TestSellTicketsRollsBackWithoutPurchase(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestTicketService(db, nil)
+	event := testutil.CreateEvent(t, db, creator.ID, nil)
+	ticket := testutil.CreateTicket(t, db, event.ID, 3)
+
+	// The purchase of a user that does not exist cannot be recorded
+	if err := service.SellTickets(ctx, ticket.ID, 999999, 2); err == nil {
+		t.Fatal("SellTickets for a missing user succeeded")
+	}
+	if sold := soldQuantity(t, db, ticket.ID); sold != 0 {
+		t.Errorf("sold %d tickets without a purchase, want 0", sold)
+	}
+	var purchases int64
+	if err := db.Model(&domain.TicketPurchase{}).Where("ticket_id = ?", ticket.ID).Count(&purchases).Error; err != nil {
+		t.Fatalf("failed to count purchases: %v", err)
+	}
+	if purchases != 0 {
+		t.Errorf("%d purchases recorded, want 0", purchases)
+	}
+}
+
+func TestReserveCartOverlappingCarts(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestTicketService(db, nil)
+	event := testutil.CreateEvent(t, db, creator.ID, nil)
+	ticket := testutil.CreateTicket(t, db, event.ID, 3)
+	users := []*domain.User{testutil.CreateUser(t, db, domain.UserTypeUser), testutil.CreateUser(t, db, domain.UserTypeUser)}
+
+	// Both carts fit on their own but not together
+	items := []dto.TicketQuantityItem{{TicketID: ticket.ID, Quantity: 2}}
+	start := make(chan struct{})
+	errs := make([]error, len(users))
+	var wg sync.WaitGroup
+	for i, user := range users {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			_, errs[i] = service.ReserveCart(ctx, user.ID, items, time.Minute)
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	reserved := 0
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			reserved++
+		case !errors.Is(err, domain.ErrTicketInsufficientQuantity):
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+	if reserved != 1 {
+		t.Errorf("%d carts were reserved, want exactly 1", reserved)
+	}
+}
+
+func TestCheckMultipleTicketAvailabilityCountsHolds(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	user := testutil.CreateUser(t, db, domain.UserTypeUser)
+	service := newTestTicketService(db, nil)
+	event := testutil.CreateEvent(t, db, creator.ID, nil)
+	general := testutil.CreateTicket(t, db, event.ID, 10)
+	vip := testutil.CreateTicket(t, db, event.ID, 5)
+
+	// Another cart holds 3 of the 5 VIP seats
+	if _, err := service.ReserveCart(ctx, user.ID, []dto.TicketQuantityItem{{TicketID: vip.ID, Quantity: 3}}, time.Minute); err != nil {
+		t.Fatalf("ReserveCart: %v", err)
+	}
+
+	// The two VIP lines are judged together: 3 requested against 2 left
+	items := []dto.TicketQuantityItem{
+		{TicketID: general.ID, Quantity: 4},
+		{TicketID: vip.ID, Quantity: 2},
+		{TicketID: vip.ID, Quantity: 1},
+		{TicketID: vip.ID + 1000, Quantity: 1},
+	}
+	response, err := service.CheckMultipleTicketAvailability(ctx, items)
+	if err != nil {
+		t.Fatalf("CheckMultipleTicketAvailability: %v", err)
+	}
+	if response.AllAvailable {
+		t.Error("all_available = true for a cart short on VIP seats")
+	}
+	want := []dto.TicketAvailabilityItemResponse{
+		{TicketID: general.ID, Quantity: 4, AvailableQuantity: 10, IsAvailable: true, Result: dto.TicketAvailabilityAvailable},
+		{TicketID: vip.ID, Quantity: 2, AvailableQuantity: 2, Result: dto.TicketAvailabilityInsufficient},
+		{TicketID: vip.ID, Quantity: 1, AvailableQuantity: 2, Result: dto.TicketAvailabilityInsufficient},
+		{TicketID: vip.ID + 1000, Quantity: 1, Result: dto.TicketAvailabilityNotFound},
+	}
+	if len(response.Items) != len(want) {
+		t.Fatalf("got %d items, want %d", len(response.Items), len(want))
+	}
+	for i, item := range response.Items {
+		if item != want[i] {
+			t.Errorf("item %d = %+v, want %+v", i, item, want[i])
+		}
+	}
+
+	// A cart within what is left is available as a whole
+	response, err = service.CheckMultipleTicketAvailability(ctx, []dto.TicketQuantityItem{{TicketID: general.ID, Quantity: 10}, {TicketID: vip.ID, Quantity: 2}})
+	if err != nil {
+		t.Fatalf("CheckMultipleTicketAvailability: %v", err)
+	}
+	if !response.AllAvailable {
+		t.Errorf("items = %+v, want the whole cart available", response.Items)
+	}
+}
+
+func TestValidateCartAcrossEvents(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestTicketService(db, nil)
+	festival := testutil.CreateEvent(t, db, creator.ID, nil)
+	closed := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+		closedAt := time.Now().UTC().Add(-time.Hour)
+		event.SalesClosedAt = &closedAt
+	})
+	dayPass := testutil.CreateTicket(t, db, festival.ID, 10)
+	afterParty := testutil.CreateTicket(t, db, closed.ID, 10)
+
+	items := []dto.TicketQuantityItem{
+		{TicketID: dayPass.ID, Quantity: 2},
+		{TicketID: afterParty.ID, Quantity: 1},
+		{TicketID: afterParty.ID + 1000, Quantity: 1},
+	}
+	response, err := service.ValidateCart(ctx, items)
+	if err != nil {
+		t.Fatalf("ValidateCart: %v", err)
+	}
+	if response.Valid {
+		t.Error("valid = true for a cart with a closed event")
+	}
+	want := []dto.CartValidationItemResponse{
+		{
+			TicketAvailabilityItemResponse: dto.TicketAvailabilityItemResponse{TicketID: dayPass.ID, Quantity: 2, AvailableQuantity: 10, IsAvailable: true, Result: dto.TicketAvailabilityAvailable},
+			EventID:                        festival.ID,
+			Price:                          dayPass.Price,
+			Currency:                       "usd",
+			SalesOpen:                      true,
+		},
+		{
+			TicketAvailabilityItemResponse: dto.TicketAvailabilityItemResponse{TicketID: afterParty.ID, Quantity: 1, AvailableQuantity: 10, Result: dto.TicketAvailabilitySalesClosed},
+			EventID:                        closed.ID,
+			Price:                          afterParty.Price,
+			Currency:                       "usd",
+		},
+		{
+			TicketAvailabilityItemResponse: dto.TicketAvailabilityItemResponse{TicketID: afterParty.ID + 1000, Quantity: 1, Result: dto.TicketAvailabilityNotFound},
+		},
+	}
+	if len(response.Items) != len(want) {
+		t.Fatalf("got %d items, want %d", len(response.Items), len(want))
+	}
+	for i, item := range response.Items {
+		if item != want[i] {
+			t.Errorf("item %d = %+v, want %+v", i, item, want[i])
+		}
+	}
+
+	// Without the closed event the cart can be checked out
+	response, err = service.ValidateCart(ctx, items[:1])
+	if err != nil {
+		t.Fatalf("ValidateCart: %v", err)
+	}
+	if !response.Valid {
+		t.Errorf("items = %+v, want the cart valid", response.Items)
+	}
+}
+
+func TestSellTicketsLastSeat(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	buyer := testutil.CreateUser(t, db, domain.UserTypeUser)
+	service := newTestTicketService(db, nil)
+	capacity := 3
+	event := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+		event.Capacity = &capacity
+	})
+	ticket := testutil.CreateTicket(t, db, event.ID, 10)
+
+	if err := service.SellTickets(ctx, ticket.ID, buyer.ID, 2); err != nil {
+		t.Fatalf("SellTickets: %v", err)
+	}
+	if err := service.SellTickets(ctx, ticket.ID, buyer.ID, 2); !errors.Is(err, domain.ErrEventCapacityExceeded) {
+		t.Errorf("one seat over capacity: err = %v, want ErrEventCapacityExceeded", err)
+	}
+	if err := service.SellTickets(ctx, ticket.ID, buyer.ID, 1); err != nil {
+		t.Fatalf("selling the last seat: %v", err)
+	}
+	if err := service.SellTickets(ctx, ticket.ID, buyer.ID, 1); !errors.Is(err, domain.ErrEventCapacityExceeded) {
+		t.Errorf("selling past a full event: err = %v, want ErrEventCapacityExceeded", err)
+	}
+	if sold := soldQuantity(t, db, ticket.ID); sold != capacity {
+		t.Errorf("sold %d tickets, want %d", sold, capacity)
+	}
+}
+
+func TestSellTicketsLastSeatAcrossTiers(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestTicketService(db, nil)
+	capacity := 1
+	event := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+		event.Capacity = &capacity
+	})
+	// Two tiers with room of their own compete for the event's only seat
+	tickets := []*domain.Ticket{testutil.CreateTicket(t, db, event.ID, 5), testutil.CreateTicket(t, db, event.ID, 5)}
+
+	start := make(chan struct{})
+	errs := make([]error, len(tickets))
+	var wg sync.WaitGroup
+	for i, ticket := range tickets {
+		buyer := testutil.CreateUser(t, db, domain.UserTypeUser)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			errs[i] = service.SellTickets(ctx, ticket.ID, buyer.ID, 1)
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	sold := 0
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			sold++
+		case !errors.Is(err, domain.ErrEventCapacityExceeded):
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+	if sold != 1 {
+		t.Errorf("%d sales went through, want exactly 1", sold)
+	}
+}
+
+func TestConfirmCartRechecksEvent(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	holder := testutil.CreateUser(t, db, domain.UserTypeUser)
+	buyer := testutil.CreateUser(t, db, domain.UserTypeUser)
+	service := newTestTicketService(db, nil)
+
+	t.Run("capacity filled after reserving", func(t *testing.T) {
+		capacity := 2
+		event := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+			event.Capacity = &capacity
+		})
+		ticket := testutil.CreateTicket(t, db, event.ID, 10)
+		cart, err := service.ReserveCart(ctx, holder.ID, []dto.TicketQuantityItem{{TicketID: ticket.ID, Quantity: 2}}, time.Minute)
+		if err != nil {
+			t.Fatalf("ReserveCart: %v", err)
+		}
+		if err := service.SellTickets(ctx, ticket.ID, buyer.ID, 1); err != nil {
+			t.Fatalf("SellTickets: %v", err)
+		}
+
+		if err := service.ConfirmCart(ctx, holder.ID, cart.CartReservationID); !errors.Is(err, domain.ErrEventCapacityExceeded) {
+			t.Fatalf("err = %v, want ErrEventCapacityExceeded", err)
+		}
+		if sold := soldQuantity(t, db, ticket.ID); sold != 1 {
+			t.Errorf("sold %d tickets, want 1", sold)
+		}
+	})
+
+	t.Run("cart fills the last seats", func(t *testing.T) {
+		capacity := 2
+		event := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+			event.Capacity = &capacity
+		})
+		ticket := testutil.CreateTicket(t, db, event.ID, 10)
+		cart, err := service.ReserveCart(ctx, holder.ID, []dto.TicketQuantityItem{{TicketID: ticket.ID, Quantity: 2}}, time.Minute)
+		if err != nil {
+			t.Fatalf("ReserveCart: %v", err)
+		}
+
+		if err := service.ConfirmCart(ctx, holder.ID, cart.CartReservationID); err != nil {
+			t.Fatalf("ConfirmCart: %v", err)
+		}
+		if sold := soldQuantity(t, db, ticket.ID); sold != capacity {
+			t.Errorf("sold %d tickets, want %d", sold, capacity)
+		}
+	})
+
+	t.Run("sales closed after reserving", func(t *testing.T) {
+		event := testutil.CreateEvent(t, db, creator.ID, nil)
+		ticket := testutil.CreateTicket(t, db, event.ID, 10)
+		cart, err := service.ReserveCart(ctx, holder.ID, []dto.TicketQuantityItem{{TicketID: ticket.ID, Quantity: 1}}, time.Minute)
+		if err != nil {
+			t.Fatalf("ReserveCart: %v", err)
+		}
+		if err := db.Model(event).Update("sales_closed_at", time.Now().Add(-time.Minute)).Error; err != nil {
+			t.Fatalf("failed to close sales: %v", err)
+		}
+
+		if err := service.ConfirmCart(ctx, holder.ID, cart.CartReservationID); !errors.Is(err, domain.ErrEventSalesClosed) {
+			t.Fatalf("err = %v, want ErrEventSalesClosed", err)
+		}
+		if sold := soldQuantity(t, db, ticket.ID); sold != 0 {
+			t.Errorf("sold %d tickets, want 0", sold)
+		}
+	})
+}
+
+func TestSellTicketsConcurrentBuyersNeverOversell(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestTicketService(db, nil)
+	event := testutil.CreateEvent(t, db, creator.ID, nil)
+	const total, buyers = 5, 20
+	ticket := testutil.CreateTicket(t, db, event.ID, total)
+
+	users := make([]*domain.User, buyers)
+	for i := range users {
+		users[i] = testutil.CreateUser(t, db, domain.UserTypeUser)
+	}
+
+	start := make(chan struct{})
+	errs := make([]error, buyers)
+	var wg sync.WaitGroup
+	for i, user := range users {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			errs[i] = service.SellTickets(ctx, ticket.ID, user.ID, 1)
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			succeeded++
+		case !errors.Is(err, domain.ErrTicketInsufficientQuantity):
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+	if succeeded != total {
+		t.Errorf("%d sales went through, want %d", succeeded, total)
+	}
+	if sold := soldQuantity(t, db, ticket.ID); sold > total || sold != succeeded {
+		t.Errorf("sold quantity = %d after %d sales of %d tickets", sold, succeeded, total)
+	}
+	if purchases := countRows(t, db, "ticket_purchases", "ticket_id = ?", ticket.ID); purchases != int64(succeeded) {
+		t.Errorf("%d purchases recorded for %d sales", purchases, succeeded)
+	}
+}
+
+func TestSellTicketsWarnsOncePerCapacityThreshold(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	buyer := testutil.CreateUser(t, db, domain.UserTypeUser)
+	event := testutil.CreateEvent(t, db, creator.ID, nil)
+	ticket := testutil.CreateTicket(t, db, event.ID, 10)
+	service := NewTicketService(
+		postgres.NewTicketRepository(db),
+		postgres.NewTicketPurchaseRepository(db),
+		postgres.NewTicketReservationRepository(db),
+		postgres.NewEventRepository(db),
+		postgres.NewEventHistoryRepository(db),
+		nil,
+		[]int{80, 95, 100},
+		15*time.Minute,
+		0,
+		"usd",
+		zerolog.Nop(),
+	)
+	warnings := func() []int {
+		var thresholds []int
+		if err := db.Model(&domain.EventHistory{}).
+			Where("event_id = ? AND action = ?", event.ID, domain.EventHistoryActionCapacityWarning).
+			Order("id").Pluck("threshold", &thresholds).Error; err != nil {
+			t.Fatalf("failed to load capacity warnings: %v", err)
+		}
+		return thresholds
+	}
+
+	sales := []struct {
+		quantity int
+		want     []int
+	}{
+		{7, nil},            // 70%
+		{1, []int{80}},      // 80% crosses the first threshold
+		{1, []int{80}},      // 90% stays below the next one
+		{1, []int{80, 100}}, // 100% jumps past 95 with a single warning
+	}
+	for _, sale := range sales {
+		if err := service.SellTickets(ctx, ticket.ID, buyer.ID, sale.quantity); err != nil {
+			t.Fatalf("SellTickets: %v", err)
+		}
+		if got := warnings(); fmt.Sprint(got) != fmt.Sprint(sale.want) {
+			t.Fatalf("after selling %d tickets: warnings %v, want %v", soldQuantity(t, db, ticket.ID), got, sale.want)
+		}
+	}
+}
+
+func TestCloseTicketSales(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	buyer := testutil.CreateUser(t, db, domain.UserTypeUser)
+	service := newTestTicketService(db, nil)
+	items := func(ticket *domain.Ticket) []dto.TicketQuantityItem {
+		return []dto.TicketQuantityItem{{TicketID: ticket.ID, Quantity: 1}}
+	}
+
+	t.Run("manual close", func(t *testing.T) {
+		event := testutil.CreateEvent(t, db, creator.ID, nil)
+		ticket := testutil.CreateTicket(t, db, event.ID, 10)
+
+		if err := service.CloseTicketSales(ctx, event.ID, creator.ID, false); err != nil {
+			t.Fatalf("CloseTicketSales: %v", err)
+		}
+		if err := service.SellTickets(ctx, ticket.ID, buyer.ID, 1); !errors.Is(err, domain.ErrEventSalesClosed) {
+			t.Errorf("selling after close: err = %v, want ErrEventSalesClosed", err)
+		}
+		if _, err := service.ReserveCart(ctx, buyer.ID, items(ticket), time.Minute); !errors.Is(err, domain.ErrEventSalesClosed) {
+			t.Errorf("reserving after close: err = %v, want ErrEventSalesClosed", err)
+		}
+
+		if err := service.ReopenTicketSales(ctx, event.ID, creator.ID); err != nil {
+			t.Fatalf("ReopenTicketSales: %v", err)
+		}
+		if err := service.SellTickets(ctx, ticket.ID, buyer.ID, 1); err != nil {
+			t.Errorf("selling after reopening: %v", err)
+		}
+	})
+
+	t.Run("close at start before the event starts", func(t *testing.T) {
+		start := time.Now().UTC().Add(2 * time.Hour)
+		event := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+			event.StartDate = &start
+			event.StartTime = &start
+		})
+		ticket := testutil.CreateTicket(t, db, event.ID, 10)
+
+		if err := service.CloseTicketSales(ctx, event.ID, creator.ID, true); err != nil {
+			t.Fatalf("CloseTicketSales: %v", err)
+		}
+		if err := service.SellTickets(ctx, ticket.ID, buyer.ID, 1); err != nil {
+			t.Errorf("selling before the start: %v", err)
+		}
+	})
+
+	t.Run("close at start after the event started", func(t *testing.T) {
+		start := time.Now().UTC().Add(-time.Hour)
+		event := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+			event.StartDate = &start
+			event.StartTime = &start
+		})
+		ticket := testutil.CreateTicket(t, db, event.ID, 10)
+
+		if err := service.CloseTicketSales(ctx, event.ID, creator.ID, true); err != nil {
+			t.Fatalf("CloseTicketSales: %v", err)
+		}
+		if err := service.SellTickets(ctx, ticket.ID, buyer.ID, 1); !errors.Is(err, domain.ErrEventSalesClosed) {
+			t.Errorf("selling after the start: err = %v, want ErrEventSalesClosed", err)
+		}
+		if _, err := service.ReserveCart(ctx, buyer.ID, items(ticket), time.Minute); !errors.Is(err, domain.ErrEventSalesClosed) {
+			t.Errorf("reserving after the start: err = %v, want ErrEventSalesClosed", err)
+		}
+	})
+
+	t.Run("close at start without a start time", func(t *testing.T) {
+		event := testutil.CreateEvent(t, db, creator.ID, nil)
+
+		if err := service.CloseTicketSales(ctx, event.ID, creator.ID, true); !errors.Is(err, domain.ErrTicketSalesStartTimeRequired) {
+			t.Errorf("err = %v, want ErrTicketSalesStartTimeRequired", err)
+		}
+	})
+}
+
+func TestBulkUpdateTicketPrices(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	other := testutil.CreateCreator(t, db)
+	service := newTestTicketService(db, nil)
+	event := testutil.CreateEvent(t, db, creator.ID, nil)
+
+	want := map[int]float64{}
+	oldPrices := map[int]float64{}
+	for price, discounted := range map[float64]float64{10: 8, 24.99: 19.99, 33.33: 26.66} {
+		ticket := testutil.CreateTicket(t, db, event.ID, 10)
+		if err := db.Model(ticket).Update("price", price).Error; err != nil {
+			t.Fatalf("failed to set price: %v", err)
+		}
+		want[ticket.ID] = discounted
+		oldPrices[ticket.ID] = price
+	}
+	inactive := testutil.CreateTicket(t, db, event.ID, 10)
+	if err := db.Model(inactive).Update("is_active", false).Error; err != nil {
+		t.Fatalf("failed to deactivate ticket: %v", err)
+	}
+
+	discount := dto.BulkUpdateTicketPriceRequest{AdjustmentType: domain.TicketPriceAdjustmentPercentage, Value: -20}
+	if _, err := service.BulkUpdateTicketPrices(ctx, event.ID, other.ID, other.UserID, discount); !errors.Is(err, domain.ErrEventNotFound) {
+		t.Errorf("another creator's event: err = %v, want event not found", err)
+	}
+	tooDeep := dto.BulkUpdateTicketPriceRequest{AdjustmentType: domain.TicketPriceAdjustmentPercentage, Value: -150}
+	if _, err := service.BulkUpdateTicketPrices(ctx, event.ID, creator.ID, creator.UserID, tooDeep); !errors.Is(err, domain.ErrTicketInvalidPriceAdjustment) {
+		t.Errorf("discount over 100%%: err = %v, want ErrTicketInvalidPriceAdjustment", err)
+	}
+	tooHigh := dto.BulkUpdateTicketPriceRequest{AdjustmentType: domain.TicketPriceAdjustmentFixed, Value: domain.MaxTicketPrice}
+	if _, err := service.BulkUpdateTicketPrices(ctx, event.ID, creator.ID, creator.UserID, tooHigh); !errors.Is(err, domain.ErrTicketPriceTooHigh) {
+		t.Errorf("price over the maximum: err = %v, want ErrTicketPriceTooHigh", err)
+	}
+
+	tickets, err := service.BulkUpdateTicketPrices(ctx, event.ID, creator.ID, creator.UserID, discount)
+	if err != nil {
+		t.Fatalf("BulkUpdateTicketPrices: %v", err)
+	}
+	if len(tickets) != len(want) {
+		t.Errorf("updated %d tickets, want %d", len(tickets), len(want))
+	}
+	for ticketID, price := range want {
+		var ticket domain.Ticket
+		if err := db.First(&ticket, ticketID).Error; err != nil {
+			t.Fatalf("failed to load ticket: %v", err)
+		}
+		if ticket.Price != price {
+			t.Errorf("ticket %d costs %.2f, want %.2f", ticketID, ticket.Price, price)
+		}
+	}
+	var untouched domain.Ticket
+	if err := db.First(&untouched, inactive.ID).Error; err != nil {
+		t.Fatalf("failed to load ticket: %v", err)
+	}
+	if untouched.Price != inactive.Price {
+		t.Errorf("inactive ticket costs %.2f, want %.2f", untouched.Price, inactive.Price)
+	}
+
+	// Only the applied update is audited, with the old and new price of every tier
+	var histories []domain.EventHistory
+	if err := db.Where("event_id = ? AND action = ?", event.ID, domain.EventHistoryActionTicketPricesChanged).Find(&histories).Error; err != nil {
+		t.Fatalf("failed to load history: %v", err)
+	}
+	if len(histories) != 1 {
+		t.Fatalf("%d price history entries, want 1", len(histories))
+	}
+	if actor := histories[0].ActorUserID; actor == nil || *actor != creator.UserID {
+		t.Errorf("price history actor = %v, want user %d", actor, creator.UserID)
+	}
+	var changes []domain.TicketPriceChange
+	if err := json.Unmarshal(histories[0].Changes, &changes); err != nil {
+		t.Fatalf("failed to decode price changes: %v", err)
+	}
+	if len(changes) != len(want) {
+		t.Errorf("%d price changes recorded, want %d", len(changes), len(want))
+	}
+	for _, change := range changes {
+		if change.From != oldPrices[change.TicketID] || change.To != want[change.TicketID] {
+			t.Errorf("ticket %d recorded %.2f -> %.2f, want %.2f -> %.2f", change.TicketID, change.From, change.To, oldPrices[change.TicketID], want[change.TicketID])
+		}
+	}
+
+	empty := testutil.CreateEvent(t, db, creator.ID, nil)
+	if _, err := service.BulkUpdateTicketPrices(ctx, empty.ID, creator.ID, creator.UserID, discount); !errors.Is(err, domain.ErrTicketNoActiveTickets) {
+		t.Errorf("event without tickets: err = %v, want ErrTicketNoActiveTickets", err)
+	}
+}
+
+func TestGetUserTicketsGroupsByEvent(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	buyer := testutil.CreateUser(t, db, domain.UserTypeUser)
+	service := newTestTicketService(db, nil)
+
+	lastWeek := time.Now().UTC().AddDate(0, 0, -7)
+	upcoming := testutil.CreateEvent(t, db, creator.ID, nil)
+	past := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+		event.StartDate = &lastWeek
+	})
+	purchase := func(ticket *domain.Ticket, userID, quantity int) {
+		if err := db.Omit("Ticket", "User").Create(domain.NewTicketPurchase(ticket.ID, userID, quantity)).Error; err != nil {
+			t.Fatalf("failed to record purchase: %v", err)
+		}
+	}
+	general := testutil.CreateTicket(t, db, upcoming.ID, 10)
+	vip := testutil.CreateTicket(t, db, upcoming.ID, 10)
+	purchase(general, buyer.ID, 2)
+	purchase(vip, buyer.ID, 1)
+	purchase(testutil.CreateTicket(t, db, past.ID, 10), buyer.ID, 3)
+	purchase(general, testutil.CreateUser(t, db, domain.UserTypeUser).ID, 4)
+
+	groups, paginationResp, err := service.GetUserTickets(ctx, buyer.ID, dto.UserTicketsRequest{}, dto.PaginationRequest{})
+	if err != nil {
+		t.Fatalf("GetUserTickets: %v", err)
+	}
+	if len(groups) != 2 || paginationResp.Total != 2 {
+		t.Fatalf("got %d groups of %d, want 2 of 2", len(groups), paginationResp.Total)
+	}
+	byEvent := map[int]*dto.UserEventTicketsResponse{}
+	for _, group := range groups {
+		byEvent[group.Event.ID] = group
+	}
+	if group := byEvent[upcoming.ID]; group == nil || len(group.Purchases) != 2 || group.TotalQuantity != 3 || !group.IsUpcoming {
+		t.Errorf("upcoming event group = %+v, want two upcoming purchases of 3 tickets", group)
+	}
+	if group := byEvent[past.ID]; group == nil || len(group.Purchases) != 1 || group.TotalQuantity != 3 || group.IsUpcoming {
+		t.Errorf("past event group = %+v, want one past purchase of 3 tickets", group)
+	}
+
+	// Pages hold whole events, never splitting one event's purchases
+	seen := map[int]bool{}
+	for page := 1; page <= 2; page++ {
+		groups, paginationResp, err := service.GetUserTickets(ctx, buyer.ID, dto.UserTicketsRequest{}, dto.PaginationRequest{Page: page, PageSize: 1})
+		if err != nil {
+			t.Fatalf("GetUserTickets page %d: %v", page, err)
+		}
+		if len(groups) != 1 || paginationResp.TotalPages != 2 {
+			t.Fatalf("page %d: got %d groups of %d pages, want 1 of 2", page, len(groups), paginationResp.TotalPages)
+		}
+		if seen[groups[0].Event.ID] {
+			t.Errorf("page %d repeats event %d", page, groups[0].Event.ID)
+		}
+		seen[groups[0].Event.ID] = true
+		if groups[0].Event.ID == upcoming.ID && len(groups[0].Purchases) != 2 {
+			t.Errorf("page %d split the upcoming event's purchases: %d", page, len(groups[0].Purchases))
+		}
+	}
+
+	for segment, want := range map[string]int{"upcoming": upcoming.ID, "past": past.ID} {
+		groups, _, err := service.GetUserTickets(ctx, buyer.ID, dto.UserTicketsRequest{Segment: segment}, dto.PaginationRequest{})
+		if err != nil {
+			t.Fatalf("GetUserTickets %s: %v", segment, err)
+		}
+		if len(groups) != 1 || groups[0].Event.ID != want {
+			t.Errorf("%s segment returned %d groups, want only event %d", segment, len(groups), want)
+		}
+	}
+	if _, _, err := service.GetUserTickets(ctx, buyer.ID, dto.UserTicketsRequest{Segment: "soon"}, dto.PaginationRequest{}); !errors.Is(err, domain.ErrTicketInvalidSegment) {
+		t.Errorf("unknown segment: err = %v, want ErrTicketInvalidSegment", err)
+	}
+}
+
+func TestCreateTicketsRejectDuplicateTitles(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestTicketService(db, nil)
+	event := testutil.CreateEvent(t, db, creator.ID, nil)
+	tier := func(title string) dto.CreateTicketRequest {
+		return dto.CreateTicketRequest{Title: title, Price: 10, TotalQuantity: 50}
+	}
+	countTickets := func(eventID int) int64 {
+		t.Helper()
+		var count int64
+		if err := db.Model(&domain.Ticket{}).Where("event_id = ?", eventID).Count(&count).Error; err != nil {
+			t.Fatalf("failed to count tickets: %v", err)
+		}
+		return count
+	}
+
+	if _, err := service.CreateTicket(ctx, event.ID, creator.ID, tier("General Admission")); err != nil {
+		t.Fatalf("CreateTicket: %v", err)
+	}
+
+	// Against a tier the event already has, ignoring case and surrounding spaces
+	if _, err := service.CreateTicket(ctx, event.ID, creator.ID, tier(" general admission ")); !errors.Is(err, domain.ErrTicketDuplicateTitle) {
+		t.Errorf("existing title: err = %v, want ErrTicketDuplicateTitle", err)
+	}
+	if _, err := service.CreateMultipleTickets(ctx, event.ID, creator.ID, []dto.CreateTicketRequest{tier("VIP"), tier("GENERAL ADMISSION")}); !errors.Is(err, domain.ErrTicketDuplicateTitle) {
+		t.Errorf("bulk with an existing title: err = %v, want ErrTicketDuplicateTitle", err)
+	}
+
+	// Within one bulk request
+	if _, err := service.CreateMultipleTickets(ctx, event.ID, creator.ID, []dto.CreateTicketRequest{tier("VIP"), tier("vip")}); !errors.Is(err, domain.ErrTicketDuplicateTitle) {
+		t.Errorf("bulk duplicate: err = %v, want ErrTicketDuplicateTitle", err)
+	}
+	if got := countTickets(event.ID); got != 1 {
+		t.Errorf("event has %d tickets after the rejected batches, want 1", got)
+	}
+
+	report, err := service.CreateTicketsBestEffort(ctx, event.ID, creator.ID, []dto.CreateTicketRequest{tier("VIP"), tier("vip")})
+	if err != nil {
+		t.Fatalf("CreateTicketsBestEffort: %v", err)
+	}
+	if report.Created != 1 || report.Failed != 1 || !errors.Is(report.Results[1].Err, domain.ErrTicketDuplicateTitle) {
+		t.Errorf("best-effort duplicate: created %d, failed %d, second err %v", report.Created, report.Failed, report.Results[1].Err)
+	}
+
+	// Titles are only unique within an event
+	other := testutil.CreateEvent(t, db, creator.ID, nil)
+	if _, err := service.CreateTicket(ctx, other.ID, creator.ID, tier("General Admission")); err != nil {
+		t.Errorf("same title on another event: %v", err)
+	}
+}
+
+func TestCreateTicketsBestEffortKeepsValidTiers(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestTicketService(db, nil)
+	countTickets := func(eventID int) int64 {
+		t.Helper()
+		var count int64
+		if err := db.Model(&domain.Ticket{}).Where("event_id = ?", eventID).Count(&count).Error; err != nil {
+			t.Fatalf("failed to count tickets: %v", err)
+		}
+		return count
+	}
+	requests := []dto.CreateTicketRequest{
+		{Title: "Early Bird", Price: 10, TotalQuantity: 20},
+		{Title: "Broken Tier", Price: 15, TotalQuantity: 0},
+		{Title: "General Admission", Price: 20, TotalQuantity: 100},
+	}
+
+	// The default mode creates all or nothing
+	strict := testutil.CreateEvent(t, db, creator.ID, nil)
+	if _, err := service.CreateMultipleTickets(ctx, strict.ID, creator.ID, requests); !errors.Is(err, domain.ErrTicketInvalidTotalQuantity) {
+		t.Errorf("all-or-nothing: err = %v, want ErrTicketInvalidTotalQuantity", err)
+	}
+	if got := countTickets(strict.ID); got != 0 {
+		t.Errorf("all-or-nothing batch left %d tickets, want none", got)
+	}
+
+	event := testutil.CreateEvent(t, db, creator.ID, nil)
+	report, err := service.CreateTicketsBestEffort(ctx, event.ID, creator.ID, requests)
+	if err != nil {
+		t.Fatalf("CreateTicketsBestEffort: %v", err)
+	}
+	if report.Created != 2 || report.Failed != 1 {
+		t.Errorf("created %d, failed %d; want 2 and 1", report.Created, report.Failed)
+	}
+	for i, result := range report.Results {
+		wantStatus := dto.BulkTicketResultCreated
+		if i == 1 {
+			wantStatus = dto.BulkTicketResultError
+		}
+		if result.Index != i || result.Status != wantStatus {
+			t.Errorf("result %d = index %d, %s; want %s", i, result.Index, result.Status, wantStatus)
+		}
+		if wantStatus == dto.BulkTicketResultCreated && (result.Ticket == nil || result.Ticket.Title != requests[i].Title) {
+			t.Errorf("result %d ticket = %+v, want %q", i, result.Ticket, requests[i].Title)
+		}
+	}
+	if !errors.Is(report.Results[1].Err, domain.ErrTicketInvalidTotalQuantity) {
+		t.Errorf("invalid tier err = %v, want ErrTicketInvalidTotalQuantity", report.Results[1].Err)
+	}
+	if got := countTickets(event.ID); got != 2 {
+		t.Errorf("event has %d tickets, want the 2 valid tiers", got)
+	}
+}
+
+func TestTicketMinimumPricePerCurrency(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	eventConfig := config.EventConfig{MinTicketPrices: map[string]float64{"eur": 0.5, "usd": 1}}
+
+	for _, currency := range []string{"EUR", "usd"} {
+		t.Run(currency, func(t *testing.T) {
+			minPrice := eventConfig.MinTicketPrice(currency)
+			service := NewTicketService(
+				postgres.NewTicketRepository(db),
+				postgres.NewTicketPurchaseRepository(db),
+				postgres.NewTicketReservationRepository(db),
+				postgres.NewEventRepository(db),
+				postgres.NewEventHistoryRepository(db),
+				nil,
+				nil,
+				15*time.Minute,
+				minPrice,
+				currency,
+				zerolog.Nop(),
+			)
+			event := testutil.CreateEvent(t, db, creator.ID, nil)
+
+			tests := []struct {
+				title   string
+				price   float64
+				wantErr error
+			}{
+				{"Free Entry", 0, nil},
+				{"Below Minimum", minPrice - 0.01, domain.ErrTicketPriceBelowMinimum},
+				{"At Minimum", minPrice, nil},
+			}
+			for _, tt := range tests {
+				_, err := service.CreateTicket(ctx, event.ID, creator.ID, dto.CreateTicketRequest{Title: tt.title, Price: tt.price, TotalQuantity: 10})
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("%s at %.2f: err = %v, want %v", tt.title, tt.price, err, tt.wantErr)
+				}
+			}
+
+			// Updates are held to the same minimum
+			free, err := service.CreateTicket(ctx, event.ID, creator.ID, dto.CreateTicketRequest{Title: "Later Paid", Price: 0, TotalQuantity: 10})
+			if err != nil {
+				t.Fatalf("CreateTicket: %v", err)
+			}
+			below := minPrice / 2
+			if _, err := service.UpdateTicket(ctx, free.ID, dto.UpdateTicketRequest{Price: &below}); !errors.Is(err, domain.ErrTicketPriceBelowMinimum) {
+				t.Errorf("update to %.2f: err = %v, want ErrTicketPriceBelowMinimum", below, err)
+			}
+		})
+	}
+}
+
+func TestGetEventTicketAvailabilitySummaryReportsDatabaseErrors(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	service := newTestTicketService(db, nil)
+
+	if _, err := service.GetEventTicketAvailabilitySummary(ctx, 999999); !errors.Is(err, domain.ErrEventNotFound) {
+		t.Errorf("missing event: err = %v, want ErrEventNotFound", err)
+	}
+
+	// A failing database is an error of its own, not a missing event
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get sql.DB: %v", err)
+	}
+	sqlDB.Close()
+	_, err = service.GetEventTicketAvailabilitySummary(ctx, 999999)
+	if err == nil || errors.Is(err, domain.ErrEventNotFound) {
+		t.Errorf("closed database: err = %v, want a database error", err)
+	}
+}
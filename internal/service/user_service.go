@@ -9,6 +9,7 @@ import (
 
 	"github.com/louco-event/internal/domain"
 	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/i18n"
 	"github.com/louco-event/internal/repository"
 	"github.com/louco-event/pkg/logger"
 	"golang.org/x/crypto/bcrypt"
@@ -110,6 +111,7 @@ func (s *userService) RegisterStep1(ctx context.Context, req *dto.RegisterStep1R
 	// Create user
 	userType := domain.UserType(req.UserType)
 	user := domain.NewUser("", string(hashedPassword), userType)
+	user.Language = i18n.LanguageFromContext(ctx)
 
 	if isEmail {
 		user.SetEmail(req.Identifier)
@@ -127,6 +129,7 @@ func (s *userService) RegisterStep1(ctx context.Context, req *dto.RegisterStep1R
 	claims := &dto.JWTClaims{
 		UserID:   user.ID,
 		UserType: string(user.UserType),
+		Role:     string(user.Role),
 	}
 	if user.Email != nil {
 		claims.Email = *user.Email
@@ -273,6 +276,7 @@ func (s *userService) Login(ctx context.Context, req *dto.LoginRequest) (*dto.Lo
 	claims := &dto.JWTClaims{
 		UserID:   user.ID,
 		UserType: string(user.UserType),
+		Role:     string(user.Role),
 	}
 	if user.Email != nil {
 		claims.Email = *user.Email
@@ -310,6 +314,7 @@ func (s *userService) SocialLogin(ctx context.Context, req *dto.SocialLoginReque
 	if err != nil {
 		userType := domain.UserType(req.UserType)
 		user = domain.NewUser("", "", userType) // No password for social login
+		user.Language = i18n.LanguageFromContext(ctx)
 
 		if req.FullName != nil {
 			user.FullName = *req.FullName
@@ -330,6 +335,7 @@ func (s *userService) SocialLogin(ctx context.Context, req *dto.SocialLoginReque
 	claims := &dto.JWTClaims{
 		UserID:   user.ID,
 		UserType: string(user.UserType),
+		Role:     string(user.Role),
 	}
 	if user.Email != nil {
 		claims.Email = *user.Email
@@ -389,6 +395,9 @@ func (s *userService) UpdateProfile(ctx context.Context, userID int, req *dto.Up
 	}
 
 	user.UpdateProfile(req.FullName, req.Biography, req.BirthDate)
+	if req.Language != nil {
+		user.Language = *req.Language
+	}
 
 	// Validate required fields for creator users
 	if err := user.ValidateRequiredFields(); err != nil {
@@ -528,6 +537,7 @@ func (s *userService) mapUserToResponse(user *domain.User) dto.UserResponse {
 		CoverPicID:      user.CoverPicID,
 		EmailVerifiedAt: user.EmailVerifiedAt,
 		PhoneVerifiedAt: user.PhoneVerifiedAt,
+		Language:        user.Language,
 		IsActive:        user.IsActive,
 		CreatedAt:       user.CreatedAt,
 		UpdatedAt:       user.UpdatedAt,
@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/i18n"
+	"github.com/louco-event/internal/repository"
+	"github.com/louco-event/pkg/email"
+	"github.com/rs/zerolog"
+)
+
+type WaitlistService interface {
+	// Join puts the user at the end of the waitlist of a published event that is at capacity
+	Join(ctx context.Context, eventID, userID int) (*dto.WaitlistResponse, error)
+	// Leave takes the user off the waitlist; everyone behind moves up one place
+	Leave(ctx context.Context, eventID, userID int) error
+	// Promote hands seats freed seats to the head of the waitlist and emails the promoted
+	// users. Promotion does not hold a seat; the users still have to get a ticket.
+	Promote(ctx context.Context, eventID, seats int) ([]*dto.WaitlistResponse, error)
+	// GetPosition returns the user's place on the waitlist
+	GetPosition(ctx context.Context, eventID, userID int) (*dto.WaitlistResponse, error)
+}
+
+type waitlistService struct {
+	waitlistRepo repository.WaitlistRepository
+	eventRepo    repository.EventRepository
+	userRepo     repository.UserRepository
+	eventService EventService
+	emailService email.EmailService
+	translator   *i18n.I18n
+	logger       zerolog.Logger
+}
+
+func NewWaitlistService(
+	waitlistRepo repository.WaitlistRepository,
+	eventRepo repository.EventRepository,
+	userRepo repository.UserRepository,
+	eventService EventService,
+	emailService email.EmailService,
+	translator *i18n.I18n,
+	logger zerolog.Logger,
+) WaitlistService {
+	return &waitlistService{
+		waitlistRepo: waitlistRepo,
+		eventRepo:    eventRepo,
+		userRepo:     userRepo,
+		eventService: eventService,
+		emailService: emailService,
+		translator:   translator,
+		logger:       logger.With().Str("service", "waitlist").Logger(),
+	}
+}
+
+func (s *waitlistService) Join(ctx context.Context, eventID, userID int) (*dto.WaitlistResponse, error) {
+	if err := s.eventService.AuthorizeEvent(ctx, eventID, &userID, domain.EventActionView); err != nil {
+		return nil, err
+	}
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	if event.Creator.UserID == userID {
+		return nil, domain.ErrWaitlistOwnEvent
+	}
+	if !event.IsPublished() || event.Capacity == nil || event.AreSalesClosed() {
+		return nil, domain.ErrWaitlistNotAllowed
+	}
+
+	attendees, err := s.eventRepo.CountAttendees(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count attendees: %w", err)
+	}
+	if *event.RemainingCapacity(attendees) > 0 {
+		return nil, domain.ErrWaitlistSeatsAvailable
+	}
+
+	existing, err := s.waitlistRepo.GetByEventAndUser(ctx, eventID, userID)
+	if err == nil && existing.IsWaiting() {
+		return nil, domain.ErrWaitlistAlreadyJoined
+	}
+	if err != nil && !errors.Is(err, domain.ErrWaitlistNotJoined) {
+		return nil, fmt.Errorf("failed to check waitlist: %w", err)
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	emailAddress := ""
+	if user.Email != nil {
+		emailAddress = strings.ToLower(strings.TrimSpace(*user.Email))
+	}
+
+	entry := domain.NewWaitlist(eventID, userID, emailAddress)
+	if err := s.waitlistRepo.Join(ctx, entry); err != nil {
+		s.logger.Error().Err(err).Int("event_id", eventID).Int("user_id", userID).Msg("Failed to join waitlist")
+		return nil, fmt.Errorf("failed to join waitlist: %w", err)
+	}
+
+	s.logger.Info().Int("event_id", eventID).Int("user_id", userID).Int("position", entry.Position).Msg("Waitlist joined")
+	return s.toResponse(ctx, entry)
+}
+
+func (s *waitlistService) Leave(ctx context.Context, eventID, userID int) error {
+	if err := s.waitlistRepo.Leave(ctx, eventID, userID); err != nil {
+		if errors.Is(err, domain.ErrWaitlistNotJoined) {
+			return err
+		}
+		s.logger.Error().Err(err).Int("event_id", eventID).Int("user_id", userID).Msg("Failed to leave waitlist")
+		return fmt.Errorf("failed to leave waitlist: %w", err)
+	}
+
+	s.logger.Info().Int("event_id", eventID).Int("user_id", userID).Msg("Waitlist left")
+	return nil
+}
+
+func (s *waitlistService) Promote(ctx context.Context, eventID, seats int) ([]*dto.WaitlistResponse, error) {
+	var promoted []*dto.WaitlistResponse
+	var event *domain.Event
+	for range seats {
+		entry, err := s.waitlistRepo.PromoteNext(ctx, eventID)
+		if err != nil {
+			return promoted, fmt.Errorf("failed to promote waitlist: %w", err)
+		}
+		// Nobody is waiting
+		if entry == nil {
+			break
+		}
+
+		if event == nil {
+			if event, err = s.eventRepo.GetByID(ctx, eventID); err != nil {
+				return promoted, fmt.Errorf("failed to get event: %w", err)
+			}
+		}
+		s.notifyPromoted(ctx, event, entry)
+
+		response, err := s.toResponse(ctx, entry)
+		if err != nil {
+			return promoted, err
+		}
+		promoted = append(promoted, response)
+	}
+
+	if len(promoted) > 0 {
+		s.logger.Info().Int("event_id", eventID).Int("seats", seats).Int("promoted", len(promoted)).Msg("Waitlist promoted")
+	}
+	return promoted, nil
+}
+
+func (s *waitlistService) GetPosition(ctx context.Context, eventID, userID int) (*dto.WaitlistResponse, error) {
+	entry, err := s.waitlistRepo.GetByEventAndUser(ctx, eventID, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrWaitlistNotJoined) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get waitlist entry: %w", err)
+	}
+	return s.toResponse(ctx, entry)
+}
+
+// notifyPromoted tells the user a seat opened up. The promotion is already stored, so a failed
+// email is only logged.
+func (s *waitlistService) notifyPromoted(ctx context.Context, event *domain.Event, entry *domain.Waitlist) {
+	if entry.Email == "" {
+		return
+	}
+	// Someone else freed the seat, so the email goes out in the language stored on the user
+	lang := ""
+	if user, err := s.userRepo.GetByID(ctx, entry.UserID); err != nil {
+		s.logger.Warn().Err(err).Int("user_id", entry.UserID).Msg("Failed to get user language for waitlist promotion email")
+	} else {
+		lang = user.Language
+	}
+	params := map[string]string{"event": event.Name}
+	subject := s.translator.Format(lang, "email.waitlist_promoted.subject", params)
+	message := s.translator.Format(lang, "email.waitlist_promoted.body", params)
+	if err := s.emailService.SendNotification(ctx, entry.Email, subject, message); err != nil {
+		s.logger.Error().Err(err).Int("event_id", event.ID).Int("user_id", entry.UserID).Msg("Failed to send waitlist promotion email")
+	}
+}
+
+func (s *waitlistService) toResponse(ctx context.Context, entry *domain.Waitlist) (*dto.WaitlistResponse, error) {
+	waiting, err := s.waitlistRepo.CountWaiting(ctx, entry.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count waitlist: %w", err)
+	}
+	return dto.WaitlistToResponse(entry, waiting), nil
+}
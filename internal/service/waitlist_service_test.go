@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/repository/postgres"
+	"github.com/louco-event/internal/testutil"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+func newTestWaitlistService(t *testing.T, db *gorm.DB, emails *fakeEmailService) WaitlistService {
+	t.Helper()
+	eventService := newTestEventService(t, db, &fakePublishingRights{}, emails)
+	return NewWaitlistService(postgres.NewWaitlistRepository(db), postgres.NewEventRepository(db), postgres.NewUserRepository(db), eventService, emails, newTestTranslator(t), zerolog.Nop())
+}
+
+// createSoldOutEvent inserts an event whose only ticket sold every seat of its capacity
+func createSoldOutEvent(t *testing.T, db *gorm.DB, creatorID, capacity int) (*domain.Event, *domain.Ticket) {
+	t.Helper()
+	event := testutil.CreateEvent(t, db, creatorID, func(event *domain.Event) {
+		event.Capacity = &capacity
+	})
+	ticket := testutil.CreateTicket(t, db, event.ID, capacity)
+	if err := db.Model(ticket).Update("sold_quantity", capacity).Error; err != nil {
+		t.Fatalf("failed to sell tickets: %v", err)
+	}
+	ticket.SoldQuantity = capacity
+	return event, ticket
+}
+
+func waitlistPositions(t *testing.T, service WaitlistService, eventID int, users []*domain.User) []int {
+	t.Helper()
+	positions := make([]int, len(users))
+	for i, user := range users {
+		entry, err := service.GetPosition(context.Background(), eventID, user.ID)
+		if err != nil {
+			t.Fatalf("GetPosition(%d): %v", user.ID, err)
+		}
+		positions[i] = entry.Position
+	}
+	return positions
+}
+
+func TestWaitlistJoin(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestWaitlistService(t, db, &fakeEmailService{})
+	event, _ := createSoldOutEvent(t, db, creator.ID, 2)
+
+	var users []*domain.User
+	for i := range 3 {
+		user := testutil.CreateUser(t, db, domain.UserTypeUser)
+		entry, err := service.Join(ctx, event.ID, user.ID)
+		if err != nil {
+			t.Fatalf("Join: %v", err)
+		}
+		if entry.Position != i+1 {
+			t.Errorf("user %d joined at position %d, want %d", i, entry.Position, i+1)
+		}
+		users = append(users, user)
+	}
+
+	if _, err := service.Join(ctx, event.ID, users[0].ID); !errors.Is(err, domain.ErrWaitlistAlreadyJoined) {
+		t.Errorf("joining twice: err = %v, want ErrWaitlistAlreadyJoined", err)
+	}
+	if _, err := service.Join(ctx, event.ID, creator.UserID); !errors.Is(err, domain.ErrWaitlistOwnEvent) {
+		t.Errorf("organizer joining: err = %v, want ErrWaitlistOwnEvent", err)
+	}
+
+	capacity := 5
+	open := testutil.CreateEvent(t, db, creator.ID, func(event *domain.Event) {
+		event.Capacity = &capacity
+	})
+	if _, err := service.Join(ctx, open.ID, users[0].ID); !errors.Is(err, domain.ErrWaitlistSeatsAvailable) {
+		t.Errorf("joining with seats left: err = %v, want ErrWaitlistSeatsAvailable", err)
+	}
+}
+
+func TestWaitlistLeaveMidQueue(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	service := newTestWaitlistService(t, db, &fakeEmailService{})
+	event, _ := createSoldOutEvent(t, db, creator.ID, 1)
+
+	var users []*domain.User
+	for range 3 {
+		user := testutil.CreateUser(t, db, domain.UserTypeUser)
+		if _, err := service.Join(ctx, event.ID, user.ID); err != nil {
+			t.Fatalf("Join: %v", err)
+		}
+		users = append(users, user)
+	}
+
+	if err := service.Leave(ctx, event.ID, users[1].ID); err != nil {
+		t.Fatalf("Leave: %v", err)
+	}
+	got := waitlistPositions(t, service, event.ID, []*domain.User{users[0], users[2]})
+	if got[0] != 1 || got[1] != 2 {
+		t.Errorf("positions after leaving = %v, want [1 2]", got)
+	}
+	if _, err := service.GetPosition(ctx, event.ID, users[1].ID); !errors.Is(err, domain.ErrWaitlistNotJoined) {
+		t.Errorf("left user: err = %v, want ErrWaitlistNotJoined", err)
+	}
+	if err := service.Leave(ctx, event.ID, users[1].ID); !errors.Is(err, domain.ErrWaitlistNotJoined) {
+		t.Errorf("leaving twice: err = %v, want ErrWaitlistNotJoined", err)
+	}
+
+	// A user who left joins again at the end of the queue
+	entry, err := service.Join(ctx, event.ID, users[1].ID)
+	if err != nil {
+		t.Fatalf("Join after leaving: %v", err)
+	}
+	if entry.Position != 3 {
+		t.Errorf("rejoined at position %d, want 3", entry.Position)
+	}
+}
+
+func TestRefundTicketsPromotesWaitlist(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	ctx := context.Background()
+	creator := testutil.CreateCreator(t, db)
+	emails := &fakeEmailService{}
+	waitlist := newTestWaitlistService(t, db, emails)
+	tickets := newTestTicketService(db, waitlist)
+	event, ticket := createSoldOutEvent(t, db, creator.ID, 2)
+
+	var users []*domain.User
+	for range 3 {
+		user := testutil.CreateUser(t, db, domain.UserTypeUser)
+		if len(users) == 0 {
+			// The promotion email follows the promoted user's language, not the refunder's
+			if err := db.Model(user).Update("language", "tr").Error; err != nil {
+				t.Fatalf("failed to set language: %v", err)
+			}
+		}
+		if _, err := waitlist.Join(ctx, event.ID, user.ID); err != nil {
+			t.Fatalf("Join: %v", err)
+		}
+		users = append(users, user)
+	}
+
+	if err := tickets.RefundTickets(ctx, ticket.ID, 1); err != nil {
+		t.Fatalf("RefundTickets: %v", err)
+	}
+
+	head, err := waitlist.GetPosition(ctx, event.ID, users[0].ID)
+	if err != nil {
+		t.Fatalf("GetPosition: %v", err)
+	}
+	if head.Status != domain.WaitlistStatusPromoted || head.Position != 0 {
+		t.Errorf("head of the queue = %s at %d, want promoted at 0", head.Status, head.Position)
+	}
+	got := waitlistPositions(t, waitlist, event.ID, users[1:])
+	if got[0] != 1 || got[1] != 2 {
+		t.Errorf("positions after promotion = %v, want [1 2]", got)
+	}
+	if len(emails.sent) != 1 || emails.sent[0].to != *users[0].Email {
+		t.Fatalf("sent %+v, want one email to the promoted user", emails.sent)
+	}
+	translator := newTestTranslator(t)
+	if want := translator.Format("tr", "email.waitlist_promoted.subject", map[string]string{"event": event.Name}); emails.sent[0].subject != want {
+		t.Errorf("subject = %q, want %q", emails.sent[0].subject, want)
+	}
+}
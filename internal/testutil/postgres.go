@@ -0,0 +1,174 @@
+// Package testutil holds helpers shared by tests that need a real database.
+package testutil
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/pkg/database"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	gormLogger "gorm.io/gorm/logger"
+)
+
+// NewPostgres connects to the database in TEST_DATABASE_URL and returns a handle scoped to a
+// fresh schema with every migration applied. The schema is dropped when the test ends. Tests
+// are skipped when TEST_DATABASE_URL is not set.
+func NewPostgres(t testing.TB) *gorm.DB {
+	t.Helper()
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	admin := open(t, dsn)
+	schema := "test_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	if err := admin.Exec(fmt.Sprintf("CREATE SCHEMA %s", schema)).Error; err != nil {
+		t.Fatalf("failed to create schema: %v", err)
+	}
+
+	db := open(t, withSearchPath(dsn, schema))
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+		admin.Exec(fmt.Sprintf("DROP SCHEMA %s CASCADE", schema))
+		if sqlDB, err := admin.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+
+	if err := (&database.Database{DB: db}).Migrate(); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	return db
+}
+
+func open(t testing.TB, dsn string) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
+		Logger: gormLogger.Default.LogMode(gormLogger.Silent),
+		NowFunc: func() time.Time {
+			return time.Now().UTC()
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to connect to test database: %v", err)
+	}
+	return db
+}
+
+// withSearchPath adds a search_path runtime parameter to a URL or key/value DSN
+func withSearchPath(dsn, schema string) string {
+	if parsed, err := url.Parse(dsn); err == nil && parsed.Scheme != "" {
+		query := parsed.Query()
+		query.Set("search_path", schema)
+		parsed.RawQuery = query.Encode()
+		return parsed.String()
+	}
+	return dsn + " search_path=" + schema
+}
+
+// CreateUser inserts an active user of the given type
+func CreateUser(t testing.TB, db *gorm.DB, userType domain.UserType) *domain.User {
+	t.Helper()
+	email := uuid.NewString() + "@example.com"
+	user := domain.NewUser("Test User", "password", userType)
+	user.Email = &email
+	if err := db.Create(user).Error; err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	return user
+}
+
+// CreateCreator inserts a creator with its own user
+func CreateCreator(t testing.TB, db *gorm.DB) *domain.Creator {
+	t.Helper()
+	user := CreateUser(t, db, domain.UserTypeCreator)
+	creator := &domain.Creator{
+		UserID:           user.ID,
+		CompanyName:      "Test Company",
+		Address:          "Test Address",
+		EstimatedTickets: 100,
+		EstimatedEvents:  10,
+	}
+	if err := db.Omit("User", "Industries").Create(creator).Error; err != nil {
+		t.Fatalf("failed to create creator: %v", err)
+	}
+	creator.User = *user
+	return creator
+}
+
+// CreateEvent inserts a published public online event starting tomorrow; mutate may adjust it
+// before it is saved
+func CreateEvent(t testing.TB, db *gorm.DB, creatorID int, mutate func(event *domain.Event)) *domain.Event {
+	t.Helper()
+	start := time.Now().UTC().AddDate(0, 0, 1)
+	event := &domain.Event{
+		CreatorID:    creatorID,
+		Name:         "Test Event",
+		Type:         domain.EventTypePublic,
+		LocationType: domain.EventLocationTypeOnline,
+		Status:       domain.EventStatusPublished,
+		StartDate:    &start,
+	}
+	if mutate != nil {
+		mutate(event)
+	}
+	if err := db.Omit("Creator", "Categories", "Tickets", "Invitations", "CoHosts").Create(event).Error; err != nil {
+		t.Fatalf("failed to create event: %v", err)
+	}
+	return event
+}
+
+// CreateTicket inserts an active ticket for an event
+func CreateTicket(t testing.TB, db *gorm.DB, eventID int, totalQuantity int) *domain.Ticket {
+	t.Helper()
+	ticket := domain.NewTicket(eventID, "Ticket "+uuid.NewString()[:8], 10, totalQuantity)
+	if err := db.Omit("Event").Create(ticket).Error; err != nil {
+		t.Fatalf("failed to create ticket: %v", err)
+	}
+	return ticket
+}
+
+// CreateCategory inserts a root category and adds it to the given events
+func CreateCategory(t testing.TB, db *gorm.DB, name string, eventIDs ...int) *domain.Category {
+	t.Helper()
+	category := domain.NewCategory(name, domain.CategoryTypeOther, nil)
+	category.Slug += "-" + uuid.NewString()[:8]
+	category.Lft, category.Rgt = 1, 2
+	if err := db.Omit("Icon", "Parent", "Children").Create(category).Error; err != nil {
+		t.Fatalf("failed to create category: %v", err)
+	}
+	for _, eventID := range eventIDs {
+		link := &domain.EventCategory{EventID: eventID, CategoryID: category.ID}
+		if err := db.Omit("Event", "Category").Create(link).Error; err != nil {
+			t.Fatalf("failed to add category to event: %v", err)
+		}
+	}
+	return category
+}
+
+// CreateAddress inserts an address at the given coordinates
+func CreateAddress(t testing.TB, db *gorm.DB, country, city string, latitude, longitude float64) *domain.Address {
+	t.Helper()
+	address := domain.NewAddress(uuid.NewString(), city+", "+country, country, city, latitude, longitude)
+	if err := db.Create(address).Error; err != nil {
+		t.Fatalf("failed to create address: %v", err)
+	}
+	return address
+}
+
+// AtAddress makes a CreateEvent event a location event at the address
+func AtAddress(address *domain.Address) func(event *domain.Event) {
+	return func(event *domain.Event) {
+		event.LocationType = domain.EventLocationTypeLocation
+		event.AddressID = &address.ID
+	}
+}
@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/i18n"
+	"github.com/louco-event/internal/middleware"
+	"github.com/louco-event/internal/service"
+	"github.com/louco-event/pkg/pagination"
+)
+
+type ActivityHandler struct {
+	activityService service.ActivityService
+	i18n            *i18n.I18n
+}
+
+func NewActivityHandler(activityService service.ActivityService, i18n *i18n.I18n) *ActivityHandler {
+	return &ActivityHandler{
+		activityService: activityService,
+		i18n:            i18n,
+	}
+}
+
+// GetMyActivity lists the current user's recent activity, newest first
+func (h *ActivityHandler) GetMyActivity(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.unauthorized"),
+			nil,
+		)
+		c.JSON(http.StatusUnauthorized, response)
+		return
+	}
+
+	var req dto.ActivityTimelineRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.validation_failed"),
+			err.Error(),
+		)
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	timeline, err := h.activityService.GetUserActivityTimeline(c.Request.Context(), int(userID), req)
+	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidCursor) {
+			response := dto.NewErrorResponse(
+				middleware.Translate(c, "pagination.invalid_cursor"),
+				nil,
+			)
+			c.JSON(http.StatusBadRequest, response)
+			return
+		}
+
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "user.activity.failed"),
+			nil,
+		)
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "user.activity.success"),
+		timeline,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetMyEvents lists the events the current user owns, attends or is invited to
+// (?relation=all|owned|attending|invited), latest start first
+func (h *ActivityHandler) GetMyEvents(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.unauthorized"),
+			nil,
+		)
+		c.JSON(http.StatusUnauthorized, response)
+		return
+	}
+
+	var req dto.UserEventsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.validation_failed"),
+			err.Error(),
+		)
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	events, err := h.activityService.GetUserEventsUnified(c.Request.Context(), int(userID), req)
+	if err != nil {
+		switch {
+		case errors.Is(err, pagination.ErrInvalidCursor):
+			c.JSON(http.StatusBadRequest, dto.NewErrorResponse(middleware.Translate(c, "pagination.invalid_cursor"), nil))
+		case errors.Is(err, domain.ErrUserEventInvalidRelation):
+			c.JSON(http.StatusBadRequest, dto.NewErrorResponse(
+				middleware.Translate(c, "user.events.invalid_relation"),
+				"relation must be all, owned, attending or invited",
+			))
+		default:
+			c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(middleware.Translate(c, "user.events.failed"), nil))
+		}
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "user.events.success"),
+		events,
+	)
+	c.JSON(http.StatusOK, response)
+}
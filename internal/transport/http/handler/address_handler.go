@@ -122,12 +122,10 @@ func (h *AddressHandler) SearchAddresses(c *gin.Context) {
 		return
 	}
 
-	response := dto.NewSuccessResponse(
+	response := dto.NewListSuccessResponse(
 		middleware.Translate(c, "address.search.success"),
-		dto.ListResponse{
-			Items:      addresses,
-			Pagination: *paginationResp,
-		},
+		addresses,
+		*paginationResp,
 	)
 	c.JSON(http.StatusOK, response)
 }
@@ -156,12 +154,10 @@ func (h *AddressHandler) GetAddressesByCity(c *gin.Context) {
 		return
 	}
 
-	response := dto.NewSuccessResponse(
+	response := dto.NewListSuccessResponse(
 		middleware.Translate(c, "address.city.search.success"),
-		dto.ListResponse{
-			Items:      addresses,
-			Pagination: *paginationResp,
-		},
+		addresses,
+		*paginationResp,
 	)
 	c.JSON(http.StatusOK, response)
 }
@@ -392,6 +392,11 @@ func (h *CategoryHandler) SearchCategories(c *gin.Context) {
 	var categories []*dto.CategoryResponse
 	var err error
 
+	sortLocale := ""
+	if req.SortBy == "name" {
+		sortLocale = middleware.GetLanguage(c)
+	}
+
 	if req.Type != "" {
 		categoryType := domain.CategoryType(req.Type)
 		if err := (&domain.Category{Type: categoryType}).ValidateType(); err != nil {
@@ -402,9 +407,9 @@ func (h *CategoryHandler) SearchCategories(c *gin.Context) {
 			c.JSON(http.StatusBadRequest, response)
 			return
 		}
-		categories, err = h.categoryService.SearchCategoriesByType(c.Request.Context(), req.Query, categoryType)
+		categories, err = h.categoryService.SearchCategoriesByType(c.Request.Context(), req.Query, categoryType, sortLocale)
 	} else {
-		categories, err = h.categoryService.SearchCategories(c.Request.Context(), req.Query)
+		categories, err = h.categoryService.SearchCategories(c.Request.Context(), req.Query, sortLocale)
 	}
 
 	if err != nil {
@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/i18n"
+	"github.com/louco-event/internal/middleware"
+	"github.com/louco-event/internal/service"
+)
+
+type CheckoutHandler struct {
+	checkoutService service.CheckoutService
+	i18n            *i18n.I18n
+}
+
+func NewCheckoutHandler(checkoutService service.CheckoutService, i18n *i18n.I18n) *CheckoutHandler {
+	return &CheckoutHandler{
+		checkoutService: checkoutService,
+		i18n:            i18n,
+	}
+}
+
+// CheckoutCart opens a Stripe checkout session to pay for one of the user's held carts
+func (h *CheckoutHandler) CheckoutCart(c *gin.Context) {
+	userID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+
+	checkout, err := h.checkoutService.CheckoutCart(c.Request.Context(), userID, c.Param("cart_id"))
+	if err != nil {
+		status := http.StatusInternalServerError
+		message, isDomainErr := middleware.TranslateError(c, err, "ticket.checkout.failed")
+		switch {
+		case errors.Is(err, domain.ErrTicketReservationNotFound):
+			status = http.StatusNotFound
+		case errors.Is(err, domain.ErrTicketReservationExpired):
+			status = http.StatusConflict
+		case isDomainErr:
+			status = http.StatusBadRequest
+		}
+		c.JSON(status, dto.NewErrorResponse(message, nil))
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "ticket.checkout.success"),
+		checkout,
+	)
+	c.JSON(http.StatusCreated, response)
+}
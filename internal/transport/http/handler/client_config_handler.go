@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/i18n"
+	"github.com/louco-event/internal/middleware"
+	"github.com/louco-event/internal/service"
+)
+
+type ClientConfigHandler struct {
+	clientConfigService service.ClientConfigService
+	i18n                *i18n.I18n
+}
+
+func NewClientConfigHandler(clientConfigService service.ClientConfigService, i18n *i18n.I18n) *ClientConfigHandler {
+	return &ClientConfigHandler{
+		clientConfigService: clientConfigService,
+		i18n:                i18n,
+	}
+}
+
+// GetClientConfig returns the limits, enums and features clients should validate against
+func (h *ClientConfigHandler) GetClientConfig(c *gin.Context) {
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "config.client.success"),
+		h.clientConfigService.GetClientConfig(),
+	)
+	c.JSON(http.StatusOK, response)
+}
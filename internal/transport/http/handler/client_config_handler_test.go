@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/louco-event/internal/config"
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/service"
+)
+
+func TestGetClientConfigReflectsConfiguredLimits(t *testing.T) {
+	cfg := &config.Config{
+		Event: config.EventConfig{
+			AllowedTypes:                []string{"public"},
+			AllowedLocationTypes:        []string{"location", "online"},
+			MaxDraftsPerCreator:         5,
+			MaxInvitationsPerEvent:      250,
+			SearchMinQueryLength:        3,
+			CartHoldDuration:            15 * time.Minute,
+			InvitationLinkTTL:           48 * time.Hour,
+			InvitationLateResponseGrace: time.Hour,
+			MinTicketPrices:             map[string]float64{"eur": 0.5},
+			RequireEventImage:           true,
+		},
+		Stripe: config.StripeConfig{
+			SecretKey:      "sk_test_secret",
+			WebhookSecret:  "whsec_secret",
+			PublishableKey: "pk_test_public",
+			Currency:       "EUR",
+			Environment:    "test",
+		},
+	}
+	clientConfigHandler := NewClientConfigHandler(service.NewClientConfigService(cfg, []string{"tr", "en"}), nil)
+
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.GET("/config/client", clientConfigHandler.GetClientConfig)
+	recorder := httptest.NewRecorder()
+	engine.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/config/client", nil))
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("got %d, want %d", recorder.Code, http.StatusOK)
+	}
+	// Server-side secrets never reach clients
+	for _, secret := range []string{cfg.Stripe.SecretKey, cfg.Stripe.WebhookSecret} {
+		if strings.Contains(recorder.Body.String(), secret) {
+			t.Errorf("response exposes %q", secret)
+		}
+	}
+
+	var body struct {
+		Data dto.ClientConfigResponse `json:"data"`
+	}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &body); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	got := body.Data
+	wantLimits := dto.ClientLimitsConfig{
+		MaxDraftsPerCreator:        5,
+		MaxInvitationsPerEvent:     250,
+		SearchMinQueryLength:       3,
+		CartHoldSeconds:            900,
+		InvitationLinkTTLSeconds:   172800,
+		InvitationLateGraceSeconds: 3600,
+	}
+	// Limits fixed in code are left out; only the configured ones are compared
+	limits := got.Limits
+	limits.MaxEventNameLength, limits.MaxInvitationsPerRequest, limits.MaxTicketsPerBulkRequest = 0, 0, 0
+	limits.MaxCartItems, limits.MaxBulkStatusEvents = 0, 0
+	if limits != wantLimits {
+		t.Errorf("limits = %+v, want the configured %+v", got.Limits, wantLimits)
+	}
+	if got.Pagination.DefaultPageSize != dto.DefaultPageSize || got.Pagination.MaxPageSize != dto.MaxPageSize {
+		t.Errorf("pagination = %+v, want %d and %d", got.Pagination, dto.DefaultPageSize, dto.MaxPageSize)
+	}
+	if got.Payments.Currency != "eur" || got.Payments.MinTicketPrice != 0.5 || got.Payments.PublishableKey != "pk_test_public" {
+		t.Errorf("payments = %+v, want eur with a 0.5 minimum and the publishable key", got.Payments)
+	}
+	if !got.Features.RequireEventImage {
+		t.Error("require_event_image = false, want true")
+	}
+	if want := []domain.EventType{domain.EventTypePublic}; !slices.Equal(got.Enums.EventTypes, want) {
+		t.Errorf("event types = %v, want %v", got.Enums.EventTypes, want)
+	}
+	if want := []domain.EventLocationType{domain.EventLocationTypeLocation, domain.EventLocationTypeOnline}; !slices.Equal(got.Enums.EventLocationTypes, want) {
+		t.Errorf("location types = %v, want %v", got.Enums.EventLocationTypes, want)
+	}
+	if want := []string{"en", "tr"}; !slices.Equal(got.SupportedLocales, want) {
+		t.Errorf("supported locales = %v, want %v", got.SupportedLocales, want)
+	}
+}
@@ -1,10 +1,12 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/louco-event/internal/domain"
 	"github.com/louco-event/internal/dto"
 	"github.com/louco-event/internal/i18n"
 	"github.com/louco-event/internal/middleware"
@@ -63,13 +65,16 @@ func (h *CreatorHandler) CreateCreator(c *gin.Context) {
 		var message string
 		var statusCode int
 
-		switch err.Error() {
-		case "user is not a creator type":
+		switch {
+		case err.Error() == "user is not a creator type":
 			message = middleware.Translate(c, "creator.invalid_user_type")
 			statusCode = http.StatusBadRequest
-		case "creator profile already exists":
+		case err.Error() == "creator profile already exists":
 			message = middleware.Translate(c, "creator.already_exists")
 			statusCode = http.StatusConflict
+		case errors.Is(err, domain.ErrEventInvalidTimezone):
+			message = middleware.Translate(c, "event.invalid_timezone")
+			statusCode = http.StatusBadRequest
 		default:
 			message = middleware.Translate(c, "creator.create_failed")
 			statusCode = http.StatusInternalServerError
@@ -162,7 +167,7 @@ func (h *CreatorHandler) GetMyCreatorProfile(c *gin.Context) {
 		var message string
 		var statusCode int
 
-		if err.Error() == "creator profile not found" {
+		if errors.Is(err, domain.ErrCreatorProfileNotFound) {
 			message = middleware.Translate(c, "creator.profile_not_found")
 			statusCode = http.StatusNotFound
 		} else {
@@ -222,9 +227,12 @@ func (h *CreatorHandler) UpdateCreator(c *gin.Context) {
 		var message string
 		var statusCode int
 
-		if err.Error() == "creator profile not found" {
+		if errors.Is(err, domain.ErrCreatorProfileNotFound) {
 			message = middleware.Translate(c, "creator.profile_not_found")
 			statusCode = http.StatusNotFound
+		} else if errors.Is(err, domain.ErrEventInvalidTimezone) {
+			message = middleware.Translate(c, "event.invalid_timezone")
+			statusCode = http.StatusBadRequest
 		} else {
 			message = middleware.Translate(c, "creator.update_failed")
 			statusCode = http.StatusInternalServerError
@@ -282,7 +290,7 @@ func (h *CreatorHandler) SetWeeztixToken(c *gin.Context) {
 		var message string
 		var statusCode int
 
-		if err.Error() == "creator profile not found" {
+		if errors.Is(err, domain.ErrCreatorProfileNotFound) {
 			message = middleware.Translate(c, "creator.profile_not_found")
 			statusCode = http.StatusNotFound
 		} else {
@@ -333,6 +341,7 @@ func (h *CreatorHandler) GetCreatorList(c *gin.Context) {
 			req.IndustryID = industryID
 		}
 	}
+	req.SortBy = c.Query("sort_by")
 
 	creators, err := h.creatorService.GetCreatorList(c.Request.Context(), &req)
 	if err != nil {
@@ -378,7 +387,7 @@ func (h *CreatorHandler) DeleteCreator(c *gin.Context) {
 		var message string
 		var statusCode int
 
-		if err.Error() == "creator profile not found" {
+		if errors.Is(err, domain.ErrCreatorProfileNotFound) {
 			message = middleware.Translate(c, "creator.profile_not_found")
 			statusCode = http.StatusNotFound
 		} else {
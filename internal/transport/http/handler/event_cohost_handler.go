@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/i18n"
+	"github.com/louco-event/internal/middleware"
+	"github.com/louco-event/internal/service"
+)
+
+type EventCoHostHandler struct {
+	coHostService service.EventCoHostService
+	i18n          *i18n.I18n
+}
+
+func NewEventCoHostHandler(coHostService service.EventCoHostService, i18n *i18n.I18n) *EventCoHostHandler {
+	return &EventCoHostHandler{
+		coHostService: coHostService,
+		i18n:          i18n,
+	}
+}
+
+// InviteCoHost lets the event owner invite another creator as co-host
+func (h *EventCoHostHandler) InviteCoHost(c *gin.Context) {
+	eventID, userID, ok := h.parseEventRequest(c)
+	if !ok {
+		return
+	}
+
+	var req dto.InviteEventCoHostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.validation_failed"),
+			err.Error(),
+		)
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	coHost, err := h.coHostService.InviteCoHost(c.Request.Context(), eventID, userID, req)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.cohost.invite_success"),
+		coHost,
+	)
+	c.JSON(http.StatusCreated, response)
+}
+
+// AcceptCoHostInvitation accepts the authenticated creator's co-host invitation
+func (h *EventCoHostHandler) AcceptCoHostInvitation(c *gin.Context) {
+	eventID, userID, ok := h.parseEventRequest(c)
+	if !ok {
+		return
+	}
+
+	coHost, err := h.coHostService.AcceptCoHostInvitation(c.Request.Context(), eventID, userID)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.cohost.accept_success"),
+		coHost,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetCoHosts lists the co-hosts of an event, pending invitations included
+func (h *EventCoHostHandler) GetCoHosts(c *gin.Context) {
+	eventID, userID, ok := h.parseEventRequest(c)
+	if !ok {
+		return
+	}
+
+	coHosts, err := h.coHostService.GetCoHosts(c.Request.Context(), eventID, userID)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.cohost.list_success"),
+		coHosts,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *EventCoHostHandler) parseEventRequest(c *gin.Context) (int, int, bool) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.unauthorized"),
+			nil,
+		)
+		c.JSON(http.StatusUnauthorized, response)
+		return 0, 0, false
+	}
+
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.validation_failed"),
+			"Invalid event ID",
+		)
+		c.JSON(http.StatusBadRequest, response)
+		return 0, 0, false
+	}
+
+	return int(eventID), int(userID), true
+}
+
+func (h *EventCoHostHandler) respondError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domain.ErrEventNotFound):
+		c.JSON(http.StatusNotFound, dto.NewErrorResponse(middleware.Translate(c, "event.not_found"), nil))
+	case errors.Is(err, domain.ErrCreatorProfileNotFound):
+		c.JSON(http.StatusNotFound, dto.NewErrorResponse(middleware.Translate(c, "creator.not_found"), nil))
+	case errors.Is(err, domain.ErrEventUnauthorized):
+		c.JSON(http.StatusForbidden, dto.NewErrorResponse(middleware.Translate(c, "event.access_denied"), nil))
+	default:
+		message, isDomainErr := middleware.TranslateError(c, err, "event.cohost.failed")
+		status := http.StatusInternalServerError
+		if isDomainErr {
+			status = http.StatusBadRequest
+		}
+		c.JSON(status, dto.NewErrorResponse(message, nil))
+	}
+}
@@ -1,14 +1,21 @@
 package handler
 
 import (
+	"context"
+	"errors"
+	"io"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/louco-event/internal/domain"
 	"github.com/louco-event/internal/dto"
 	"github.com/louco-event/internal/i18n"
 	"github.com/louco-event/internal/middleware"
 	"github.com/louco-event/internal/service"
+	"github.com/louco-event/pkg/pagination"
 )
 
 type EventHandler struct {
@@ -40,44 +47,24 @@ func NewEventHandler(
 
 // CreateEvent creates a new event
 func (h *EventHandler) CreateEvent(c *gin.Context) {
-	userID, exists := middleware.GetCurrentUserID(c)
-	if !exists {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.unauthorized"),
-			nil,
-		)
-		c.JSON(http.StatusUnauthorized, response)
+	userID, ok := requireUser(c)
+	if !ok {
 		return
 	}
 
 	var req dto.CreateEventRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.validation_failed"),
-			err.Error(),
-		)
-		c.JSON(http.StatusBadRequest, response)
+	if !bindJSON(c, &req) {
 		return
 	}
 
-	event, err := h.eventService.CreateEvent(c.Request.Context(), int(userID), req)
+	event, err := h.eventService.CreateEvent(c.Request.Context(), userID, req)
 	if err != nil {
 		var message string
-		switch err.Error() {
-		case "user not found":
-			message = middleware.Translate(c, "user.not_found")
-		case "creator profile not found":
+		switch {
+		case errors.Is(err, domain.ErrCreatorProfileNotFound):
 			message = middleware.Translate(c, "creator.not_found")
-		case "event.image_not_found":
-			message = middleware.Translate(c, "event.image_not_found")
-		case "event.video_not_found":
-			message = middleware.Translate(c, "event.video_not_found")
-		case "event.address_not_found":
-			message = middleware.Translate(c, "event.address_not_found")
-		case "event.category_not_found":
-			message = middleware.Translate(c, "event.category_not_found")
 		default:
-			message = middleware.Translate(c, "event.create.failed")
+			message, _ = middleware.TranslateError(c, err, "event.create.failed")
 		}
 
 		response := dto.NewErrorResponse(message, nil)
@@ -94,14 +81,8 @@ func (h *EventHandler) CreateEvent(c *gin.Context) {
 
 // GetEvent retrieves an event by ID
 func (h *EventHandler) GetEvent(c *gin.Context) {
-	eventIDStr := c.Param("id")
-	eventID, err := strconv.ParseInt(eventIDStr, 10, 32)
-	if err != nil {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.validation_failed"),
-			"Invalid event ID",
-		)
-		c.JSON(http.StatusBadRequest, response)
+	eventID, ok := parseIDParam(c, "id", "event ID")
+	if !ok {
 		return
 	}
 
@@ -111,20 +92,22 @@ func (h *EventHandler) GetEvent(c *gin.Context) {
 		userID = &uidInt
 	}
 
-	event, err := h.eventService.GetEventByID(c.Request.Context(), int(eventID), userID)
+	event, err := h.eventService.GetEventByID(c.Request.Context(), eventID, userID, c.ClientIP())
 	if err != nil {
+		status := http.StatusNotFound
 		var message string
-		switch err.Error() {
-		case "event not found":
+		switch {
+		case errors.Is(err, domain.ErrEventNotFound):
 			message = middleware.Translate(c, "event.not_found")
-		case "access denied":
+		case errors.Is(err, domain.ErrEventUnauthorized):
+			status = http.StatusForbidden
 			message = middleware.Translate(c, "event.access_denied")
 		default:
 			message = middleware.Translate(c, "common.internal_server_error")
 		}
 
 		response := dto.NewErrorResponse(message, nil)
-		c.JSON(http.StatusNotFound, response)
+		c.JSON(status, response)
 		return
 	}
 
@@ -137,53 +120,44 @@ func (h *EventHandler) GetEvent(c *gin.Context) {
 
 // UpdateEvent updates an existing event
 func (h *EventHandler) UpdateEvent(c *gin.Context) {
-	userID, exists := middleware.GetCurrentUserID(c)
-	if !exists {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.unauthorized"),
-			nil,
-		)
-		c.JSON(http.StatusUnauthorized, response)
+	userID, ok := requireUser(c)
+	if !ok {
 		return
 	}
 
-	eventIDStr := c.Param("id")
-	eventID, err := strconv.ParseInt(eventIDStr, 10, 32)
-	if err != nil {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.validation_failed"),
-			"Invalid event ID",
-		)
-		c.JSON(http.StatusBadRequest, response)
+	eventID, ok := parseIDParam(c, "id", "event ID")
+	if !ok {
 		return
 	}
 
 	var req dto.UpdateEventRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.validation_failed"),
-			err.Error(),
-		)
-		c.JSON(http.StatusBadRequest, response)
+	if !bindJSON(c, &req) {
 		return
 	}
 
 	// Get creator by user ID first
 	// Note: UpdateEvent service method should be updated to accept userID instead of creatorID
-	event, err := h.eventService.UpdateEvent(c.Request.Context(), int(eventID), int(userID), req)
+	event, err := h.eventService.UpdateEvent(c.Request.Context(), eventID, userID, req)
 	if err != nil {
+		status := http.StatusInternalServerError
 		var message string
-		switch err.Error() {
-		case "event not found":
+		switch {
+		case errors.Is(err, domain.ErrEventNotFound):
+			status = http.StatusNotFound
 			message = middleware.Translate(c, "event.not_found")
-		case "access denied":
+		case errors.Is(err, domain.ErrEventUnauthorized):
+			status = http.StatusForbidden
 			message = middleware.Translate(c, "event.access_denied")
 		default:
-			message = middleware.Translate(c, "event.update.failed")
+			var isDomainErr bool
+			message, isDomainErr = middleware.TranslateError(c, err, "event.update.failed")
+			if isDomainErr {
+				status = http.StatusBadRequest
+			}
 		}
 
 		response := dto.NewErrorResponse(message, nil)
-		c.JSON(http.StatusInternalServerError, response)
+		c.JSON(status, response)
 		return
 	}
 
@@ -196,42 +170,37 @@ func (h *EventHandler) UpdateEvent(c *gin.Context) {
 
 // DeleteEvent deletes an event
 func (h *EventHandler) DeleteEvent(c *gin.Context) {
-	userID, exists := middleware.GetCurrentUserID(c)
-	if !exists {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.unauthorized"),
-			nil,
-		)
-		c.JSON(http.StatusUnauthorized, response)
+	userID, ok := requireUser(c)
+	if !ok {
 		return
 	}
 
-	eventIDStr := c.Param("id")
-	eventID, err := strconv.ParseInt(eventIDStr, 10, 32)
-	if err != nil {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.validation_failed"),
-			"Invalid event ID",
-		)
-		c.JSON(http.StatusBadRequest, response)
+	eventID, ok := parseIDParam(c, "id", "event ID")
+	if !ok {
 		return
 	}
 
 	// Note: DeleteEvent service method should be updated to accept userID instead of creatorID
-	err = h.eventService.DeleteEvent(c.Request.Context(), int(eventID), int(userID))
-	if err != nil {
+	if err := h.eventService.DeleteEvent(c.Request.Context(), eventID, userID); err != nil {
+		status := http.StatusInternalServerError
 		var message string
-		switch err.Error() {
-		case "event not found":
+		switch {
+		case errors.Is(err, domain.ErrEventNotFound):
+			status = http.StatusNotFound
 			message = middleware.Translate(c, "event.not_found")
-		case "access denied":
+		case errors.Is(err, domain.ErrEventUnauthorized):
+			status = http.StatusForbidden
 			message = middleware.Translate(c, "event.access_denied")
 		default:
-			message = middleware.Translate(c, "event.delete.failed")
+			var isDomainErr bool
+			message, isDomainErr = middleware.TranslateError(c, err, "event.delete.failed")
+			if isDomainErr {
+				status = http.StatusBadRequest
+			}
 		}
 
 		response := dto.NewErrorResponse(message, nil)
-		c.JSON(http.StatusInternalServerError, response)
+		c.JSON(status, response)
 		return
 	}
 
@@ -244,29 +213,43 @@ func (h *EventHandler) DeleteEvent(c *gin.Context) {
 
 // GetMyEvents retrieves events created by the authenticated user
 func (h *EventHandler) GetMyEvents(c *gin.Context) {
-	userID, exists := middleware.GetCurrentUserID(c)
-	if !exists {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.unauthorized"),
-			nil,
-		)
-		c.JSON(http.StatusUnauthorized, response)
+	userID, ok := requireUser(c)
+	if !ok {
 		return
 	}
 
 	var pagination dto.PaginationRequest
-	if err := c.ShouldBindQuery(&pagination); err != nil {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.validation_failed"),
-			err.Error(),
-		)
-		c.JSON(http.StatusBadRequest, response)
+	if !bindQuery(c, &pagination) {
 		return
 	}
 
-	// Note: GetCreatorEvents service method should be updated to accept userID instead of creatorID
-	events, paginationResp, err := h.eventService.GetCreatorEvents(c.Request.Context(), int(userID), pagination)
+	// Optional comma separated status filter, e.g. ?status=draft,pending
+	var (
+		events         []*dto.EventListResponse
+		paginationResp *dto.PaginationResponse
+		err            error
+	)
+	if statusParam := c.Query("status"); statusParam != "" {
+		var statuses []domain.EventStatus
+		for _, status := range strings.Split(statusParam, ",") {
+			if status = strings.TrimSpace(status); status != "" {
+				statuses = append(statuses, domain.EventStatus(status))
+			}
+		}
+		events, paginationResp, err = h.eventService.GetCreatorEventsByStatuses(c.Request.Context(), userID, statuses, pagination)
+	} else {
+		events, paginationResp, err = h.eventService.GetCreatorEvents(c.Request.Context(), userID, pagination)
+	}
 	if err != nil {
+		if errors.Is(err, domain.ErrEventInvalidStatus) {
+			response := dto.NewErrorResponse(
+				middleware.Translate(c, "event.invalid_status"),
+				"status must be one of draft, pending, rejected, stopped, cancelled, published",
+			)
+			c.JSON(http.StatusBadRequest, response)
+			return
+		}
+
 		response := dto.NewErrorResponse(
 			middleware.Translate(c, "event.list.failed"),
 			nil,
@@ -275,12 +258,51 @@ func (h *EventHandler) GetMyEvents(c *gin.Context) {
 		return
 	}
 
-	response := dto.NewSuccessResponse(
+	response := dto.NewListSuccessResponse(
 		middleware.Translate(c, "event.list.success"),
-		dto.ListResponse{
-			Items:      events,
-			Pagination: *paginationResp,
-		},
+		events,
+		*paginationResp,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetEventHistory retrieves the change history of an event owned by the current user, or of
+// any event for admins
+func (h *EventHandler) GetEventHistory(c *gin.Context) {
+	userID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+
+	eventID, ok := parseIDParam(c, "id", "event ID")
+	if !ok {
+		return
+	}
+
+	var pagination dto.PaginationRequest
+	if !bindQuery(c, &pagination) {
+		return
+	}
+
+	history, paginationResp, err := h.eventService.GetEventHistory(c.Request.Context(), eventID, userID, middleware.IsAdmin(c), pagination)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrEventNotFound):
+			c.JSON(http.StatusNotFound, dto.NewErrorResponse(middleware.Translate(c, "event.not_found"), nil))
+		case errors.Is(err, domain.ErrCreatorProfileNotFound):
+			c.JSON(http.StatusNotFound, dto.NewErrorResponse(middleware.Translate(c, "creator.not_found"), nil))
+		case errors.Is(err, domain.ErrEventUnauthorized):
+			c.JSON(http.StatusForbidden, dto.NewErrorResponse(middleware.Translate(c, "event.access_denied"), nil))
+		default:
+			c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(middleware.Translate(c, "event.history.failed"), nil))
+		}
+		return
+	}
+
+	response := dto.NewListSuccessResponse(
+		middleware.Translate(c, "event.history.success"),
+		history,
+		*paginationResp,
 	)
 	c.JSON(http.StatusOK, response)
 }
@@ -288,86 +310,85 @@ func (h *EventHandler) GetMyEvents(c *gin.Context) {
 // GetEvents retrieves events with filtering
 func (h *EventHandler) GetEvents(c *gin.Context) {
 	var pagination dto.PaginationRequest
-	if err := c.ShouldBindQuery(&pagination); err != nil {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.validation_failed"),
-			err.Error(),
-		)
-		c.JSON(http.StatusBadRequest, response)
+	if !bindQuery(c, &pagination) {
 		return
 	}
 
-	// Use GetPublicEvents method for now
-	events, paginationResp, err := h.eventService.GetPublicEvents(c.Request.Context(), pagination)
+	var filters dto.EventFilterRequest
+	if !bindQuery(c, &filters) {
+		return
+	}
+	filters.Locale = middleware.GetLanguage(c)
+
+	var userID *int
+	if uid, exists := middleware.GetCurrentUserID(c); exists {
+		uidInt := int(uid)
+		userID = &uidInt
+	}
+
+	events, paginationResp, err := h.eventService.GetEventsWithFilters(c.Request.Context(), filters, pagination, userID)
 	if err != nil {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "event.list.failed"),
-			nil,
-		)
-		c.JSON(http.StatusInternalServerError, response)
+		status := http.StatusBadRequest
+		message, isDomainErr := middleware.TranslateError(c, err, "event.list.failed")
+		if !isDomainErr {
+			status = http.StatusInternalServerError
+		}
+
+		response := dto.NewErrorResponse(message, nil)
+		c.JSON(status, response)
 		return
 	}
 
-	response := dto.NewSuccessResponse(
+	response := dto.NewListSuccessResponse(
 		middleware.Translate(c, "event.list.success"),
-		dto.ListResponse{
-			Items:      events,
-			Pagination: *paginationResp,
-		},
+		events,
+		*paginationResp,
 	)
 	c.JSON(http.StatusOK, response)
 }
 
 // UpdateEventStatus updates event status
 func (h *EventHandler) UpdateEventStatus(c *gin.Context) {
-	userID, exists := middleware.GetCurrentUserID(c)
-	if !exists {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.unauthorized"),
-			nil,
-		)
-		c.JSON(http.StatusUnauthorized, response)
+	userID, ok := requireUser(c)
+	if !ok {
 		return
 	}
 
-	eventIDStr := c.Param("id")
-	eventID, err := strconv.ParseInt(eventIDStr, 10, 32)
-	if err != nil {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.validation_failed"),
-			"Invalid event ID",
-		)
-		c.JSON(http.StatusBadRequest, response)
+	eventID, ok := parseIDParam(c, "id", "event ID")
+	if !ok {
 		return
 	}
 
 	var req dto.UpdateEventStatusRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.validation_failed"),
-			err.Error(),
-		)
-		c.JSON(http.StatusBadRequest, response)
+	if !bindJSON(c, &req) {
 		return
 	}
 
 	// Note: UpdateEventStatus service method should be updated to accept userID instead of creatorID
-	event, err := h.eventService.UpdateEventStatus(c.Request.Context(), int(eventID), int(userID), req)
+	event, err := h.eventService.UpdateEventStatus(c.Request.Context(), eventID, userID, req)
 	if err != nil {
+		if respondStatusTransitionError(c, err) {
+			return
+		}
+		status := http.StatusInternalServerError
 		var message string
-		switch err.Error() {
-		case "event not found":
+		switch {
+		case errors.Is(err, domain.ErrEventNotFound):
+			status = http.StatusNotFound
 			message = middleware.Translate(c, "event.not_found")
-		case "access denied":
+		case errors.Is(err, domain.ErrEventUnauthorized):
+			status = http.StatusForbidden
 			message = middleware.Translate(c, "event.access_denied")
-		case "invalid status transition":
-			message = middleware.Translate(c, "event.invalid_status_transition")
 		default:
-			message = middleware.Translate(c, "event.status.update.failed")
+			var isDomainErr bool
+			message, isDomainErr = middleware.TranslateError(c, err, "event.status.update.failed")
+			if isDomainErr {
+				status = http.StatusBadRequest
+			}
 		}
 
 		response := dto.NewErrorResponse(message, nil)
-		c.JSON(http.StatusInternalServerError, response)
+		c.JSON(status, response)
 		return
 	}
 
@@ -378,20 +399,121 @@ func (h *EventHandler) UpdateEventStatus(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// BulkUpdateEventStatus moves several of the creator's events to one status and reports the
+// outcome per event
+func (h *EventHandler) BulkUpdateEventStatus(c *gin.Context) {
+	userID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+
+	var req dto.BulkUpdateEventStatusRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	result, err := h.eventService.BulkUpdateStatus(c.Request.Context(), userID, req.EventIDs, req.Status)
+	if err != nil {
+		status := http.StatusInternalServerError
+		var message string
+		if errors.Is(err, domain.ErrCreatorProfileNotFound) {
+			status = http.StatusForbidden
+			message = middleware.Translate(c, "event.access_denied")
+		} else {
+			var isDomainErr bool
+			message, isDomainErr = middleware.TranslateError(c, err, "event.bulk_status.failed")
+			if isDomainErr {
+				status = http.StatusBadRequest
+			}
+		}
+
+		response := dto.NewErrorResponse(message, nil)
+		c.JSON(status, response)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.bulk_status.success"),
+		result,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// BulkAddCategoryToEvents adds one category to several of the creator's events and reports the
+// outcome per event
+func (h *EventHandler) BulkAddCategoryToEvents(c *gin.Context) {
+	userID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+
+	var req dto.BulkAddEventCategoryRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	result, err := h.eventService.BulkAddCategoryToEvents(c.Request.Context(), userID, req.EventIDs, req.CategoryID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		var message string
+		switch {
+		case errors.Is(err, domain.ErrCreatorProfileNotFound):
+			status = http.StatusForbidden
+			message = middleware.Translate(c, "event.access_denied")
+		case errors.Is(err, domain.ErrEventCategoryNotFound):
+			status = http.StatusNotFound
+			message = middleware.Translate(c, "event.category_not_found")
+		default:
+			var isDomainErr bool
+			message, isDomainErr = middleware.TranslateError(c, err, "event.bulk_category.failed")
+			if isDomainErr {
+				status = http.StatusBadRequest
+			}
+		}
+
+		response := dto.NewErrorResponse(message, nil)
+		c.JSON(status, response)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.bulk_category.success"),
+		result,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// respondStatusTransitionError answers a rejected status change with the statuses the event can
+// move to, so clients can offer only valid actions. Reports false for any other error.
+func respondStatusTransitionError(c *gin.Context, err error) bool {
+	var transitionErr *domain.EventStatusTransitionError
+	if !errors.As(err, &transitionErr) {
+		return false
+	}
+
+	allowed := make([]domain.EventStatus, len(transitionErr.Allowed))
+	copy(allowed, transitionErr.Allowed)
+	response := dto.NewErrorResponse(
+		middleware.Translate(c, "event.invalid_status_transition"),
+		dto.StatusTransitionErrorDetails{
+			CurrentStatus:   transitionErr.From,
+			RequestedStatus: transitionErr.To,
+			AllowedStatuses: allowed,
+		},
+	)
+	c.JSON(http.StatusBadRequest, response)
+	return true
+}
+
 // GetEventStatistics retrieves event statistics
 func (h *EventHandler) GetEventStatistics(c *gin.Context) {
-	userID, exists := middleware.GetCurrentUserID(c)
-	if !exists {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.unauthorized"),
-			nil,
-		)
-		c.JSON(http.StatusUnauthorized, response)
+	userID, ok := requireUser(c)
+	if !ok {
 		return
 	}
 
 	// Note: GetEventStats service method should be updated to accept userID instead of creatorID
-	stats, err := h.eventService.GetEventStats(c.Request.Context(), int(userID))
+	stats, err := h.eventService.GetEventStats(c.Request.Context(), userID)
 	if err != nil {
 		response := dto.NewErrorResponse(
 			middleware.Translate(c, "event.statistics.failed"),
@@ -411,12 +533,7 @@ func (h *EventHandler) GetEventStatistics(c *gin.Context) {
 // SearchEventsByLocation searches events by location
 func (h *EventHandler) SearchEventsByLocation(c *gin.Context) {
 	var pagination dto.PaginationRequest
-	if err := c.ShouldBindQuery(&pagination); err != nil {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.validation_failed"),
-			err.Error(),
-		)
-		c.JSON(http.StatusBadRequest, response)
+	if !bindQuery(c, &pagination) {
 		return
 	}
 
@@ -433,51 +550,64 @@ func (h *EventHandler) SearchEventsByLocation(c *gin.Context) {
 		return
 	}
 
-	response := dto.NewSuccessResponse(
+	response := dto.NewListSuccessResponse(
 		middleware.Translate(c, "event.location.search.success"),
-		dto.ListResponse{
-			Items:      events,
-			Pagination: *paginationResp,
-		},
+		events,
+		*paginationResp,
 	)
 	c.JSON(http.StatusOK, response)
 }
 
-// CreateTicket creates a new ticket for an event
-func (h *EventHandler) CreateTicket(c *gin.Context) {
-	userID, exists := middleware.GetCurrentUserID(c)
-	if !exists {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.unauthorized"),
-			nil,
-		)
-		c.JSON(http.StatusUnauthorized, response)
+// GetNearbyEvents lists published events around a point with their distance in km, nearest
+// first unless sort=date is given
+func (h *EventHandler) GetNearbyEvents(c *gin.Context) {
+	var pagination dto.PaginationRequest
+	if !bindQuery(c, &pagination) {
+		return
+	}
+
+	var req dto.EventNearbyRequest
+	if !bindQuery(c, &req) {
 		return
 	}
 
-	eventIDStr := c.Param("event_id")
-	eventID, err := strconv.ParseInt(eventIDStr, 10, 32)
+	events, paginationResp, err := h.eventService.GetNearbyEvents(c.Request.Context(), req, pagination)
 	if err != nil {
 		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.validation_failed"),
-			"Invalid event ID format: "+eventIDStr,
+			middleware.Translate(c, "event.nearby.failed"),
+			nil,
 		)
-		c.JSON(http.StatusBadRequest, response)
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	response := dto.NewListSuccessResponse(
+		middleware.Translate(c, "event.nearby.success"),
+		events,
+		*paginationResp,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateTicket creates a new ticket for an event
+func (h *EventHandler) CreateTicket(c *gin.Context) {
+	userID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+
+	eventID, ok := parseIDParam(c, "event_id", "event ID")
+	if !ok {
 		return
 	}
 
 	var req dto.CreateTicketRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.validation_failed"),
-			err.Error(),
-		)
-		c.JSON(http.StatusBadRequest, response)
+	if !bindJSON(c, &req) {
 		return
 	}
 
 	// Get creator ID from user ID using creator service
-	creator, err := h.creatorService.GetCreatorByUserID(c.Request.Context(), int(userID))
+	creator, err := h.creatorService.GetCreatorByUserID(c.Request.Context(), userID)
 	if err != nil {
 		response := dto.NewErrorResponse(
 			middleware.Translate(c, "creator.not_found"),
@@ -487,20 +617,27 @@ func (h *EventHandler) CreateTicket(c *gin.Context) {
 		return
 	}
 
-	ticket, err := h.ticketService.CreateTicket(c.Request.Context(), int(eventID), creator.ID, req)
+	ticket, err := h.ticketService.CreateTicket(c.Request.Context(), eventID, creator.ID, req)
 	if err != nil {
+		status := http.StatusInternalServerError
 		var message string
-		switch err.Error() {
-		case "event not found":
+		switch {
+		case errors.Is(err, domain.ErrEventNotFound):
+			status = http.StatusNotFound
 			message = middleware.Translate(c, "event.not_found")
-		case "access denied":
+		case errors.Is(err, domain.ErrEventUnauthorized):
+			status = http.StatusForbidden
 			message = middleware.Translate(c, "event.access_denied")
 		default:
-			message = middleware.Translate(c, "ticket.create.failed")
+			var isDomainErr bool
+			message, isDomainErr = middleware.TranslateError(c, err, "ticket.create.failed")
+			if isDomainErr {
+				status = http.StatusBadRequest
+			}
 		}
 
 		response := dto.NewErrorResponse(message, nil)
-		c.JSON(http.StatusInternalServerError, response)
+		c.JSON(status, response)
 		return
 	}
 
@@ -511,234 +648,1449 @@ func (h *EventHandler) CreateTicket(c *gin.Context) {
 	c.JSON(http.StatusCreated, response)
 }
 
-// GetEventTickets retrieves tickets for an event
-func (h *EventHandler) GetEventTickets(c *gin.Context) {
-	eventIDStr := c.Param("event_id")
-	eventID, err := strconv.ParseInt(eventIDStr, 10, 32)
-	if err != nil {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.validation_failed"),
-			"Invalid event ID",
-		)
-		c.JSON(http.StatusBadRequest, response)
+// CreateTicketsBulk adds several ticket tiers at once. By default the batch is all or nothing;
+// with ?continue_on_error=true each tier is created on its own and a result per index is returned.
+func (h *EventHandler) CreateTicketsBulk(c *gin.Context) {
+	userID, ok := requireUser(c)
+	if !ok {
 		return
 	}
 
-	tickets, err := h.ticketService.GetTicketsByEventID(c.Request.Context(), int(eventID))
-	if err != nil {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "ticket.list.failed"),
-			nil,
-		)
-		c.JSON(http.StatusInternalServerError, response)
+	eventID, ok := parseIDParam(c, "event_id", "event ID")
+	if !ok {
 		return
 	}
 
-	response := dto.NewSuccessResponse(
-		middleware.Translate(c, "ticket.list.success"),
-		tickets,
-	)
-	c.JSON(http.StatusOK, response)
-}
-
-// CreateInvitation creates a new invitation for an event
-func (h *EventHandler) CreateInvitation(c *gin.Context) {
-	_, exists := middleware.GetCurrentUserID(c)
-	if !exists {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.unauthorized"),
-			nil,
-		)
-		c.JSON(http.StatusUnauthorized, response)
+	var query dto.BulkCreateTicketsQuery
+	if !bindQuery(c, &query) {
 		return
 	}
 
-	eventIDStr := c.Param("event_id")
-	eventID, err := strconv.ParseInt(eventIDStr, 10, 32)
-	if err != nil {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.validation_failed"),
-			"Invalid event ID",
-		)
-		c.JSON(http.StatusBadRequest, response)
+	var req dto.BulkCreateTicketsRequest
+	if !bindJSON(c, &req) {
 		return
 	}
 
-	var req dto.CreateInvitationRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
+	creator, err := h.creatorService.GetCreatorByUserID(c.Request.Context(), userID)
+	if err != nil {
 		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.validation_failed"),
-			err.Error(),
+			middleware.Translate(c, "creator.not_found"),
+			nil,
 		)
 		c.JSON(http.StatusBadRequest, response)
 		return
 	}
 
-	invitation, err := h.invitationService.CreateInvitation(c.Request.Context(), int(eventID), req)
+	var result interface{}
+	if query.ContinueOnError {
+		bulk, bulkErr := h.ticketService.CreateTicketsBestEffort(c.Request.Context(), eventID, creator.ID, req.Tickets)
+		if bulkErr == nil {
+			for i := range bulk.Results {
+				if bulk.Results[i].Err != nil {
+					bulk.Results[i].Reason, _ = middleware.TranslateError(c, bulk.Results[i].Err, "ticket.create.failed")
+				}
+			}
+		}
+		result, err = bulk, bulkErr
+	} else {
+		result, err = h.ticketService.CreateMultipleTickets(c.Request.Context(), eventID, creator.ID, req.Tickets)
+	}
 	if err != nil {
+		status := http.StatusInternalServerError
 		var message string
-		switch err.Error() {
-		case "event not found":
+		switch {
+		case errors.Is(err, domain.ErrEventNotFound):
+			status = http.StatusNotFound
 			message = middleware.Translate(c, "event.not_found")
-		case "access denied":
-			message = middleware.Translate(c, "event.access_denied")
-		case "invitation already exists":
-			message = middleware.Translate(c, "invitation.already_exists")
 		default:
-			message = middleware.Translate(c, "invitation.create.failed")
+			var isDomainErr bool
+			message, isDomainErr = middleware.TranslateError(c, err, "ticket.create.failed")
+			if isDomainErr {
+				status = http.StatusBadRequest
+			}
 		}
 
 		response := dto.NewErrorResponse(message, nil)
-		c.JSON(http.StatusInternalServerError, response)
+		c.JSON(status, response)
 		return
 	}
 
 	response := dto.NewSuccessResponse(
-		middleware.Translate(c, "invitation.create.success"),
-		invitation,
+		middleware.Translate(c, "ticket.bulk_create.success"),
+		result,
 	)
 	c.JSON(http.StatusCreated, response)
 }
 
-// GetEventInvitations retrieves invitations for an event
-func (h *EventHandler) GetEventInvitations(c *gin.Context) {
-	_, exists := middleware.GetCurrentUserID(c)
-	if !exists {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.unauthorized"),
-			nil,
-		)
-		c.JSON(http.StatusUnauthorized, response)
+// BulkUpdateTicketPrices applies a percentage or fixed price adjustment to all active tickets of an event
+func (h *EventHandler) BulkUpdateTicketPrices(c *gin.Context) {
+	userID, ok := requireUser(c)
+	if !ok {
 		return
 	}
 
-	eventIDStr := c.Param("event_id")
-	eventID, err := strconv.ParseInt(eventIDStr, 10, 32)
-	if err != nil {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.validation_failed"),
-			"Invalid event ID",
-		)
-		c.JSON(http.StatusBadRequest, response)
+	eventID, ok := parseIDParam(c, "event_id", "event ID")
+	if !ok {
 		return
 	}
 
-	var pagination dto.PaginationRequest
-	if err := c.ShouldBindQuery(&pagination); err != nil {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.validation_failed"),
-			err.Error(),
+	var req dto.BulkUpdateTicketPriceRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	creator, err := h.creatorService.GetCreatorByUserID(c.Request.Context(), userID)
+	if err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "creator.not_found"),
+			nil,
 		)
 		c.JSON(http.StatusBadRequest, response)
 		return
 	}
 
-	invitations, paginationResp, err := h.invitationService.GetInvitationsByEventID(c.Request.Context(), int(eventID), pagination)
+	tickets, err := h.ticketService.BulkUpdateTicketPrices(c.Request.Context(), eventID, creator.ID, userID, req)
+	if err != nil {
+		status := http.StatusBadRequest
+		var message string
+		if errors.Is(err, domain.ErrEventNotFound) {
+			status = http.StatusNotFound
+			message = middleware.Translate(c, "event.not_found")
+		} else {
+			var isDomainErr bool
+			if message, isDomainErr = middleware.TranslateError(c, err, "ticket.update.failed"); !isDomainErr {
+				status = http.StatusInternalServerError
+			}
+		}
+
+		response := dto.NewErrorResponse(message, nil)
+		c.JSON(status, response)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "ticket.bulk_price.success"),
+		tickets,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetMyTickets retrieves the current user's purchased tickets grouped by event
+func (h *EventHandler) GetMyTickets(c *gin.Context) {
+	userID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UserTicketsRequest
+	if !bindQuery(c, &req) {
+		return
+	}
+
+	var pagination dto.PaginationRequest
+	if !bindQuery(c, &pagination) {
+		return
+	}
+
+	tickets, paginationResp, err := h.ticketService.GetUserTickets(c.Request.Context(), userID, req, pagination)
+	if err != nil {
+		status := http.StatusBadRequest
+		message, isDomainErr := middleware.TranslateError(c, err, "ticket.my_tickets.failed")
+		if !isDomainErr {
+			status = http.StatusInternalServerError
+		}
+
+		response := dto.NewErrorResponse(message, nil)
+		c.JSON(status, response)
+		return
+	}
+
+	response := dto.NewListSuccessResponse(
+		middleware.Translate(c, "ticket.my_tickets.success"),
+		tickets,
+		*paginationResp,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// CloseTicketSales stops ticket sales for an event, either now or when the event starts
+func (h *EventHandler) CloseTicketSales(c *gin.Context) {
+	h.updateTicketSales(c, true)
+}
+
+// ReopenTicketSales resumes ticket sales for an event
+func (h *EventHandler) ReopenTicketSales(c *gin.Context) {
+	h.updateTicketSales(c, false)
+}
+
+func (h *EventHandler) updateTicketSales(c *gin.Context, closeSales bool) {
+	userID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+
+	eventID, ok := parseIDParam(c, "event_id", "event ID")
+	if !ok {
+		return
+	}
+
+	var req dto.CloseTicketSalesRequest
+	if closeSales {
+		// The body is optional; without it sales close immediately
+		if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+			response := dto.NewErrorResponse(
+				middleware.Translate(c, "common.validation_failed"),
+				err.Error(),
+			)
+			c.JSON(http.StatusBadRequest, response)
+			return
+		}
+	}
+
+	creator, err := h.creatorService.GetCreatorByUserID(c.Request.Context(), userID)
 	if err != nil {
 		response := dto.NewErrorResponse(
-			middleware.Translate(c, "invitation.list.failed"),
+			middleware.Translate(c, "creator.not_found"),
 			nil,
 		)
-		c.JSON(http.StatusInternalServerError, response)
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	successKey := "ticket.sales.reopened"
+	if closeSales {
+		successKey = "ticket.sales.closed"
+		err = h.ticketService.CloseTicketSales(c.Request.Context(), eventID, creator.ID, req.AtEventStart)
+	} else {
+		err = h.ticketService.ReopenTicketSales(c.Request.Context(), eventID, creator.ID)
+	}
+	if err != nil {
+		status := http.StatusBadRequest
+		var message string
+		if errors.Is(err, domain.ErrEventNotFound) {
+			status = http.StatusNotFound
+			message = middleware.Translate(c, "event.not_found")
+		} else {
+			var isDomainErr bool
+			if message, isDomainErr = middleware.TranslateError(c, err, "ticket.sales.update_failed"); !isDomainErr {
+				status = http.StatusInternalServerError
+			}
+		}
+
+		response := dto.NewErrorResponse(message, nil)
+		c.JSON(status, response)
 		return
 	}
 
 	response := dto.NewSuccessResponse(
-		middleware.Translate(c, "invitation.list.success"),
-		dto.ListResponse{
-			Items:      invitations,
-			Pagination: *paginationResp,
-		},
+		middleware.Translate(c, successKey),
+		nil,
 	)
 	c.JSON(http.StatusOK, response)
 }
 
-// RespondToInvitation responds to an event invitation
-func (h *EventHandler) RespondToInvitation(c *gin.Context) {
-	_, exists := middleware.GetCurrentUserID(c)
-	if !exists {
+// GetEventTickets retrieves tickets for an event
+func (h *EventHandler) GetEventTickets(c *gin.Context) {
+	eventID, ok := parseIDParam(c, "event_id", "event ID")
+	if !ok {
+		return
+	}
+
+	tickets, err := h.ticketService.GetTicketsByEventID(c.Request.Context(), eventID)
+	if err != nil {
 		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.unauthorized"),
+			middleware.Translate(c, "ticket.list.failed"),
 			nil,
 		)
-		c.JSON(http.StatusUnauthorized, response)
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "ticket.list.success"),
+		tickets,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateInvitation creates a new invitation for an event
+func (h *EventHandler) CreateInvitation(c *gin.Context) {
+	userID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+
+	eventID, ok := parseIDParam(c, "event_id", "event ID")
+	if !ok {
+		return
+	}
+
+	if !h.authorizeEvent(c, eventID, userID, domain.EventActionManageInvitations) {
+		return
+	}
+
+	var req dto.CreateInvitationRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	invitation, err := h.invitationService.CreateInvitation(c.Request.Context(), eventID, req)
+	if err != nil {
+		status := http.StatusInternalServerError
+		var message string
+		switch {
+		case errors.Is(err, domain.ErrEventNotFound):
+			status = http.StatusNotFound
+			message = middleware.Translate(c, "event.not_found")
+		case errors.Is(err, domain.ErrEventUnauthorized):
+			status = http.StatusForbidden
+			message = middleware.Translate(c, "event.access_denied")
+		case errors.Is(err, domain.ErrInvitationDuplicateEmail):
+			status = http.StatusConflict
+			message = middleware.Translate(c, "invitation.already_exists")
+		default:
+			var isDomainErr bool
+			message, isDomainErr = middleware.TranslateError(c, err, "invitation.create.failed")
+			if isDomainErr {
+				status = http.StatusBadRequest
+			}
+		}
+
+		response := dto.NewErrorResponse(message, nil)
+		c.JSON(status, response)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "invitation.create.success"),
+		invitation,
+	)
+	c.JSON(http.StatusCreated, response)
+}
+
+// authorizeEvent checks an action against the event policy and writes the error response when
+// it is not allowed
+func (h *EventHandler) authorizeEvent(c *gin.Context, eventID, userID int, action domain.EventAction) bool {
+	err := h.eventService.AuthorizeEvent(c.Request.Context(), eventID, &userID, action)
+	if err == nil {
+		return true
+	}
+
+	status := http.StatusInternalServerError
+	message := middleware.Translate(c, "common.internal_server_error")
+	switch {
+	case errors.Is(err, domain.ErrEventUnauthorized):
+		status = http.StatusForbidden
+		message = middleware.Translate(c, "event.access_denied")
+	case errors.Is(err, domain.ErrEventNotFound):
+		status = http.StatusNotFound
+		message = middleware.Translate(c, "event.not_found")
+	}
+
+	c.JSON(status, dto.NewErrorResponse(message, nil))
+	return false
+}
+
+// GetEventInvitations retrieves invitations for an event
+func (h *EventHandler) GetEventInvitations(c *gin.Context) {
+	userID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+
+	eventID, ok := parseIDParam(c, "event_id", "event ID")
+	if !ok {
+		return
+	}
+
+	if !h.authorizeEvent(c, eventID, userID, domain.EventActionManageInvitations) {
+		return
+	}
+
+	var pagination dto.PaginationRequest
+	if !bindQuery(c, &pagination) {
 		return
 	}
 
-	invitationIDStr := c.Param("invitation_id")
-	invitationID, err := strconv.ParseInt(invitationIDStr, 10, 32)
+	invitations, paginationResp, err := h.invitationService.GetInvitationsByEventID(c.Request.Context(), eventID, pagination)
 	if err != nil {
 		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.validation_failed"),
-			"Invalid invitation ID",
+			middleware.Translate(c, "invitation.list.failed"),
+			nil,
 		)
-		c.JSON(http.StatusBadRequest, response)
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	response := dto.NewListSuccessResponse(
+		middleware.Translate(c, "invitation.list.success"),
+		invitations,
+		*paginationResp,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// RespondToInvitation responds to an event invitation
+func (h *EventHandler) RespondToInvitation(c *gin.Context) {
+	if _, ok := requireUser(c); !ok {
+		return
+	}
+
+	invitationID, ok := parseIDParam(c, "invitation_id", "invitation ID")
+	if !ok {
 		return
 	}
 
 	var req dto.UpdateInvitationStatusRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.validation_failed"),
-			err.Error(),
-		)
-		c.JSON(http.StatusBadRequest, response)
+	if !bindJSON(c, &req) {
 		return
 	}
 
-	invitation, err := h.invitationService.UpdateInvitationStatus(c.Request.Context(), int(invitationID), req)
+	invitation, err := h.invitationService.UpdateInvitationStatus(c.Request.Context(), invitationID, req)
 	if err != nil {
+		status := http.StatusInternalServerError
 		var message string
-		switch err.Error() {
-		case "invitation not found":
+		switch {
+		case errors.Is(err, domain.ErrInvitationNotFound):
+			status = http.StatusNotFound
 			message = middleware.Translate(c, "invitation.not_found")
-		case "access denied":
+		case errors.Is(err, domain.ErrInvitationUnauthorized):
+			status = http.StatusForbidden
 			message = middleware.Translate(c, "invitation.access_denied")
-		case "invitation expired":
+		case errors.Is(err, domain.ErrInvitationExpired):
+			status = http.StatusGone
 			message = middleware.Translate(c, "invitation.expired")
 		default:
-			message = middleware.Translate(c, "invitation.respond.failed")
+			var isDomainErr bool
+			message, isDomainErr = middleware.TranslateError(c, err, "invitation.respond.failed")
+			if isDomainErr {
+				status = http.StatusBadRequest
+			}
+		}
+
+		response := dto.NewErrorResponse(message, nil)
+		c.JSON(status, response)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "invitation.respond.success"),
+		invitation,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetMyDraftEvents retrieves draft events created by the authenticated user
+func (h *EventHandler) GetMyDraftEvents(c *gin.Context) {
+	h.listMyEvents(c, h.eventService.GetCreatorDraftEvents)
+}
+
+// GetMyPublishedEvents retrieves published events created by the authenticated user
+func (h *EventHandler) GetMyPublishedEvents(c *gin.Context) {
+	h.listMyEvents(c, h.eventService.GetCreatorPublishedEvents)
+}
+
+// GetMyPendingEvents retrieves the authenticated user's events awaiting review
+func (h *EventHandler) GetMyPendingEvents(c *gin.Context) {
+	h.listMyEvents(c, h.eventService.GetCreatorPendingEvents)
+}
+
+// GetMyRejectedEvents retrieves the authenticated user's events rejected in review
+func (h *EventHandler) GetMyRejectedEvents(c *gin.Context) {
+	h.listMyEvents(c, h.eventService.GetCreatorRejectedEvents)
+}
+
+// GetMyStoppedEvents retrieves the authenticated user's stopped events
+func (h *EventHandler) GetMyStoppedEvents(c *gin.Context) {
+	h.listMyEvents(c, h.eventService.GetCreatorStoppedEvents)
+}
+
+// GetMyCancelledEvents retrieves the authenticated user's cancelled events
+func (h *EventHandler) GetMyCancelledEvents(c *gin.Context) {
+	h.listMyEvents(c, h.eventService.GetCreatorCancelledEvents)
+}
+
+// listMyEvents responds with one page of the authenticated creator's events as returned by list
+func (h *EventHandler) listMyEvents(c *gin.Context, list func(ctx context.Context, userID int, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error)) {
+	userID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+
+	var pagination dto.PaginationRequest
+	if !bindQuery(c, &pagination) {
+		return
+	}
+
+	events, paginationResp, err := list(c.Request.Context(), userID, pagination)
+	if err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "event.list.failed"),
+			nil,
+		)
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	response := dto.NewListSuccessResponse(
+		middleware.Translate(c, "event.list.success"),
+		events,
+		*paginationResp,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// SubmitForReview submits an event for review
+func (h *EventHandler) SubmitForReview(c *gin.Context) {
+	userID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+
+	eventID, ok := parseIDParam(c, "id", "event ID")
+	if !ok {
+		return
+	}
+
+	// Note: SubmitEventForReview service method should be updated to accept userID instead of creatorID
+	event, err := h.eventService.SubmitEventForReview(c.Request.Context(), eventID, userID)
+	if err != nil {
+		if respondStatusTransitionError(c, err) {
+			return
+		}
+		status := http.StatusInternalServerError
+		var message string
+		switch {
+		case errors.Is(err, domain.ErrEventNotFound):
+			status = http.StatusNotFound
+			message = middleware.Translate(c, "event.not_found")
+		case errors.Is(err, domain.ErrEventUnauthorized):
+			status = http.StatusForbidden
+			message = middleware.Translate(c, "event.access_denied")
+		default:
+			var isDomainErr bool
+			message, isDomainErr = middleware.TranslateError(c, err, "event.submit.failed")
+			if isDomainErr {
+				status = http.StatusBadRequest
+			}
 		}
 
-		response := dto.NewErrorResponse(message, nil)
-		c.JSON(http.StatusInternalServerError, response)
+		response := dto.NewErrorResponse(message, nil)
+		c.JSON(status, response)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.submit.success"),
+		event,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetCreatorTrashedEvents lists the current user's deleted events
+func (h *EventHandler) GetCreatorTrashedEvents(c *gin.Context) {
+	userID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+
+	var pagination dto.PaginationRequest
+	if !bindQuery(c, &pagination) {
+		return
+	}
+
+	events, paginationResp, err := h.eventService.GetCreatorTrashedEvents(c.Request.Context(), userID, pagination)
+	if err != nil {
+		status := http.StatusInternalServerError
+		message := middleware.Translate(c, "event.trash.list_failed")
+		if errors.Is(err, domain.ErrCreatorProfileNotFound) {
+			status = http.StatusNotFound
+			message = middleware.Translate(c, "creator.not_found")
+		}
+		c.JSON(status, dto.NewErrorResponse(message, nil))
+		return
+	}
+
+	response := dto.NewListSuccessResponse(
+		middleware.Translate(c, "event.trash.list_success"),
+		events,
+		*paginationResp,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// RestoreEvent takes one of the user's deleted events out of the trash
+func (h *EventHandler) RestoreEvent(c *gin.Context) {
+	userID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+
+	eventID, ok := parseIDParam(c, "id", "event ID")
+	if !ok {
+		return
+	}
+
+	event, err := h.eventService.RestoreEvent(c.Request.Context(), eventID, userID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		var message string
+		switch {
+		case errors.Is(err, domain.ErrCreatorProfileNotFound):
+			status = http.StatusNotFound
+			message = middleware.Translate(c, "creator.not_found")
+		case errors.Is(err, domain.ErrEventNotFound):
+			status = http.StatusNotFound
+			message = middleware.Translate(c, "event.not_found")
+		default:
+			var isDomainErr bool
+			message, isDomainErr = middleware.TranslateError(c, err, "event.restore.failed")
+			if isDomainErr {
+				status = http.StatusBadRequest
+			}
+		}
+
+		c.JSON(status, dto.NewErrorResponse(message, nil))
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.restore.success"),
+		event,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// CloneEvent duplicates one of the user's events as a new draft
+func (h *EventHandler) CloneEvent(c *gin.Context) {
+	userID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+
+	eventID, ok := parseIDParam(c, "id", "event ID")
+	if !ok {
+		return
+	}
+
+	event, err := h.eventService.CloneEvent(c.Request.Context(), eventID, userID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		var message string
+		switch {
+		case errors.Is(err, domain.ErrCreatorProfileNotFound):
+			status = http.StatusNotFound
+			message = middleware.Translate(c, "creator.not_found")
+		case errors.Is(err, domain.ErrEventUnauthorized):
+			status = http.StatusForbidden
+			message = middleware.Translate(c, "event.access_denied")
+		default:
+			var isDomainErr bool
+			message, isDomainErr = middleware.TranslateError(c, err, "event.clone.failed")
+			if isDomainErr {
+				status = http.StatusBadRequest
+			}
+		}
+
+		c.JSON(status, dto.NewErrorResponse(message, nil))
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.clone.success"),
+		event,
+	)
+	c.JSON(http.StatusCreated, response)
+}
+
+// PublishEvent publishes an event
+func (h *EventHandler) PublishEvent(c *gin.Context) {
+	userID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+
+	eventID, ok := parseIDParam(c, "id", "event ID")
+	if !ok {
+		return
+	}
+
+	// Note: PublishEvent service method should be updated to accept userID instead of creatorID
+	event, err := h.eventService.PublishEvent(c.Request.Context(), eventID, userID)
+	if err != nil {
+		if respondStatusTransitionError(c, err) {
+			return
+		}
+		status := http.StatusInternalServerError
+		var message string
+		switch {
+		case errors.Is(err, domain.ErrEventNotFound):
+			status = http.StatusNotFound
+			message = middleware.Translate(c, "event.not_found")
+		case errors.Is(err, domain.ErrEventUnauthorized):
+			status = http.StatusForbidden
+			message = middleware.Translate(c, "event.access_denied")
+		default:
+			var isDomainErr bool
+			message, isDomainErr = middleware.TranslateError(c, err, "event.publish.failed")
+			if isDomainErr {
+				status = http.StatusBadRequest
+			}
+		}
+
+		response := dto.NewErrorResponse(message, nil)
+		c.JSON(status, response)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.publish.success"),
+		event,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// PublishAndNotify publishes a private event and emails its pending invitees
+func (h *EventHandler) PublishAndNotify(c *gin.Context) {
+	userID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+
+	eventID, ok := parseIDParam(c, "id", "event ID")
+	if !ok {
+		return
+	}
+
+	result, err := h.eventService.PublishAndNotify(c.Request.Context(), eventID, userID)
+	if err != nil {
+		if respondStatusTransitionError(c, err) {
+			return
+		}
+		status := http.StatusInternalServerError
+		var message string
+		switch {
+		case errors.Is(err, domain.ErrEventNotFound):
+			status = http.StatusNotFound
+			message = middleware.Translate(c, "event.not_found")
+		case errors.Is(err, domain.ErrEventUnauthorized):
+			status = http.StatusForbidden
+			message = middleware.Translate(c, "event.access_denied")
+		default:
+			var isDomainErr bool
+			message, isDomainErr = middleware.TranslateError(c, err, "event.publish.failed")
+			if isDomainErr {
+				status = http.StatusBadRequest
+			}
+		}
+
+		response := dto.NewErrorResponse(message, nil)
+		c.JSON(status, response)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.publish_and_notify.success"),
+		result,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// CancelEvent cancels an event
+func (h *EventHandler) CancelEvent(c *gin.Context) {
+	userID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+
+	eventID, ok := parseIDParam(c, "id", "event ID")
+	if !ok {
+		return
+	}
+
+	// Note: CancelEvent service method should be updated to accept userID instead of creatorID
+	event, err := h.eventService.CancelEvent(c.Request.Context(), eventID, userID)
+	if err != nil {
+		if respondStatusTransitionError(c, err) {
+			return
+		}
+		status := http.StatusInternalServerError
+		var message string
+		switch {
+		case errors.Is(err, domain.ErrEventNotFound):
+			status = http.StatusNotFound
+			message = middleware.Translate(c, "event.not_found")
+		case errors.Is(err, domain.ErrEventUnauthorized):
+			status = http.StatusForbidden
+			message = middleware.Translate(c, "event.access_denied")
+		default:
+			var isDomainErr bool
+			message, isDomainErr = middleware.TranslateError(c, err, "event.cancel.failed")
+			if isDomainErr {
+				status = http.StatusBadRequest
+			}
+		}
+
+		response := dto.NewErrorResponse(message, nil)
+		c.JSON(status, response)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.cancel.success"),
+		event,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetEventStats retrieves event statistics for the authenticated creator
+func (h *EventHandler) GetEventStats(c *gin.Context) {
+	userID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+
+	// Note: GetEventStats service method should be updated to accept userID instead of creatorID
+	stats, err := h.eventService.GetEventStats(c.Request.Context(), userID)
+	if err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "event.statistics.failed"),
+			nil,
+		)
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.statistics.success"),
+		stats,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetMyStatusCounts returns how many of the authenticated creator's events are in each status,
+// for dashboard tab counts
+func (h *EventHandler) GetMyStatusCounts(c *gin.Context) {
+	userID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+
+	counts, err := h.eventService.GetCreatorStatusCounts(c.Request.Context(), userID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		message := middleware.Translate(c, "event.status_counts.failed")
+		if errors.Is(err, domain.ErrCreatorProfileNotFound) {
+			status = http.StatusNotFound
+			message = middleware.Translate(c, "creator.not_found")
+		}
+		c.JSON(status, dto.NewErrorResponse(message, nil))
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.status_counts.success"),
+		counts,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// SearchMyEvents searches the authenticated creator's own events in every status, e.g.
+// ?q=summer&status=draft
+func (h *EventHandler) SearchMyEvents(c *gin.Context) {
+	userID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+
+	var pagination dto.PaginationRequest
+	if !bindQuery(c, &pagination) {
+		return
+	}
+
+	var statusFilter *domain.EventStatus
+	if statusParam := strings.TrimSpace(c.Query("status")); statusParam != "" {
+		status := domain.EventStatus(statusParam)
+		statusFilter = &status
+	}
+
+	events, paginationResp, err := h.eventService.SearchCreatorEvents(c.Request.Context(), userID, c.Query("q"), statusFilter, pagination)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrEventInvalidStatus):
+			response := dto.NewErrorResponse(
+				middleware.Translate(c, "event.invalid_status"),
+				"status must be one of draft, pending, rejected, stopped, cancelled, published",
+			)
+			c.JSON(http.StatusBadRequest, response)
+		case errors.Is(err, domain.ErrCreatorProfileNotFound):
+			response := dto.NewErrorResponse(
+				middleware.Translate(c, "creator.not_found"),
+				nil,
+			)
+			c.JSON(http.StatusNotFound, response)
+		default:
+			status := http.StatusBadRequest
+			message, isDomainErr := middleware.TranslateError(c, err, "event.search.failed")
+			if !isDomainErr {
+				status = http.StatusInternalServerError
+			}
+			response := dto.NewErrorResponse(message, nil)
+			c.JSON(status, response)
+		}
+		return
+	}
+
+	response := dto.NewListSuccessResponse(
+		middleware.Translate(c, "event.search.success"),
+		events,
+		*paginationResp,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetEventsNeedingAttention lists the creator's events that need action, each with the reason
+func (h *EventHandler) GetEventsNeedingAttention(c *gin.Context) {
+	userID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+
+	items, err := h.eventService.GetEventsNeedingAttention(c.Request.Context(), userID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		message := middleware.Translate(c, "event.attention.failed")
+		if errors.Is(err, domain.ErrCreatorProfileNotFound) {
+			status = http.StatusNotFound
+			message = middleware.Translate(c, "creator.not_found")
+		}
+		c.JSON(status, dto.NewErrorResponse(message, nil))
+		return
+	}
+
+	for _, item := range items {
+		if item.Err != nil {
+			item.Detail, _ = middleware.TranslateError(c, item.Err, "event.attention.incomplete")
+		}
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.attention.success"),
+		items,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetInvitationStatsForEvents returns invitation stats for a comma separated list of the
+// creator's events (?event_ids=1,2,3), keyed by event ID
+func (h *EventHandler) GetInvitationStatsForEvents(c *gin.Context) {
+	userID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+
+	var eventIDs []int
+	for _, part := range strings.Split(c.Query("event_ids"), ",") {
+		if part = strings.TrimSpace(part); part == "" {
+			continue
+		}
+		eventID, err := strconv.ParseInt(part, 10, 32)
+		if err != nil {
+			response := dto.NewErrorResponse(
+				middleware.Translate(c, "common.validation_failed"),
+				"Invalid event ID",
+			)
+			c.JSON(http.StatusBadRequest, response)
+			return
+		}
+		eventIDs = append(eventIDs, int(eventID))
+	}
+	if len(eventIDs) == 0 {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.validation_failed"),
+			"event_ids is required",
+		)
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	creator, err := h.creatorService.GetCreatorByUserID(c.Request.Context(), userID)
+	if err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "creator.not_found"),
+			nil,
+		)
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	stats, err := h.invitationService.GetInvitationStatsForEvents(c.Request.Context(), creator.ID, eventIDs)
+	if err != nil {
+		status := http.StatusBadRequest
+		var message string
+		if errors.Is(err, domain.ErrEventNotFound) {
+			status = http.StatusNotFound
+			message = middleware.Translate(c, "event.not_found")
+		} else {
+			var isDomainErr bool
+			if message, isDomainErr = middleware.TranslateError(c, err, "invitation.stats.failed"); !isDomainErr {
+				status = http.StatusInternalServerError
+			}
+		}
+
+		response := dto.NewErrorResponse(message, nil)
+		c.JSON(status, response)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "invitation.stats.success"),
+		stats,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetPublicEvents retrieves public events; page/page_size by default, or cursor pages when
+// after or limit is given
+func (h *EventHandler) GetPublicEvents(c *gin.Context) {
+	var cursorPagination dto.CursorPaginationRequest
+	if !bindQuery(c, &cursorPagination) {
+		return
+	}
+	if cursorPagination.IsSet() {
+		h.getPublicEventsAfter(c, cursorPagination)
+		return
+	}
+
+	var pagination dto.PaginationRequest
+	if !bindQuery(c, &pagination) {
+		return
+	}
+
+	events, paginationResp, err := h.eventService.GetPublicEvents(c.Request.Context(), pagination)
+	if err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "event.list.failed"),
+			nil,
+		)
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	response := dto.NewListSuccessResponse(
+		middleware.Translate(c, "event.list.success"),
+		events,
+		*paginationResp,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *EventHandler) getPublicEventsAfter(c *gin.Context, req dto.CursorPaginationRequest) {
+	events, paginationResp, err := h.eventService.GetPublicEventsAfter(c.Request.Context(), req)
+	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidCursor) {
+			response := dto.NewErrorResponse(
+				middleware.Translate(c, "pagination.invalid_cursor"),
+				nil,
+			)
+			c.JSON(http.StatusBadRequest, response)
+			return
+		}
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "event.list.failed"),
+			nil,
+		)
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	response := dto.NewCursorListSuccessResponse(
+		middleware.Translate(c, "event.list.success"),
+		events,
+		*paginationResp,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// SearchEvents searches public events
+func (h *EventHandler) SearchEvents(c *gin.Context) {
+	var pagination dto.PaginationRequest
+	if !bindQuery(c, &pagination) {
+		return
+	}
+
+	var searchReq dto.EventSearchRequest
+	if !bindQuery(c, &searchReq) {
+		return
+	}
+
+	events, paginationResp, err := h.eventService.SearchPublicEvents(c.Request.Context(), searchReq, pagination)
+	if err != nil {
+		status := http.StatusBadRequest
+		message, isDomainErr := middleware.TranslateError(c, err, "event.search.failed")
+		if !isDomainErr {
+			status = http.StatusInternalServerError
+		}
+
+		response := dto.NewErrorResponse(message, nil)
+		c.JSON(status, response)
+		return
+	}
+
+	response := dto.NewListSuccessResponse(
+		middleware.Translate(c, "event.search.success"),
+		events,
+		*paginationResp,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetEventsByLocation retrieves events by location
+func (h *EventHandler) GetEventsByLocation(c *gin.Context) {
+	city := c.Param("city")
+	country := c.Query("country")
+
+	var pagination dto.PaginationRequest
+	if !bindQuery(c, &pagination) {
+		return
+	}
+
+	events, paginationResp, err := h.eventService.GetPublicEventsByLocation(c.Request.Context(), city, country, pagination)
+	if err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "event.location.search.failed"),
+			nil,
+		)
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	response := dto.NewListSuccessResponse(
+		middleware.Translate(c, "event.location.search.success"),
+		events,
+		*paginationResp,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// CountEvents returns the number of events matching the given filters without fetching them
+func (h *EventHandler) CountEvents(c *gin.Context) {
+	var filters dto.EventFilterRequest
+	if !bindQuery(c, &filters) {
+		return
+	}
+
+	var userID *int
+	if uid, exists := middleware.GetCurrentUserID(c); exists {
+		uidInt := int(uid)
+		userID = &uidInt
+	}
+
+	count, err := h.eventService.CountEventsWithFilters(c.Request.Context(), filters, userID)
+	if err != nil {
+		status := http.StatusBadRequest
+		message, isDomainErr := middleware.TranslateError(c, err, "event.count.failed")
+		if !isDomainErr {
+			status = http.StatusInternalServerError
+		}
+
+		response := dto.NewErrorResponse(message, nil)
+		c.JSON(status, response)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.count.success"),
+		dto.EventCountResponse{Count: count},
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetSimilarEvents retrieves published events related to the given event
+func (h *EventHandler) GetSimilarEvents(c *gin.Context) {
+	eventID, ok := parseIDParam(c, "id", "event ID")
+	if !ok {
+		return
+	}
+
+	limit := 0
+	if limitStr := c.Query("limit"); limitStr != "" {
+		var err error
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil {
+			response := dto.NewErrorResponse(
+				middleware.Translate(c, "common.validation_failed"),
+				"Invalid limit",
+			)
+			c.JSON(http.StatusBadRequest, response)
+			return
+		}
+	}
+
+	events, err := h.eventService.GetSimilarEvents(c.Request.Context(), eventID, limit)
+	if err != nil {
+		if errors.Is(err, domain.ErrEventNotFound) {
+			response := dto.NewErrorResponse(
+				middleware.Translate(c, "event.not_found"),
+				nil,
+			)
+			c.JSON(http.StatusNotFound, response)
+			return
+		}
+
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "event.similar.failed"),
+			nil,
+		)
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.similar.success"),
+		events,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetEventPublicStats retrieves public counters for a published event
+func (h *EventHandler) GetEventPublicStats(c *gin.Context) {
+	eventID, ok := parseIDParam(c, "id", "event ID")
+	if !ok {
+		return
+	}
+
+	stats, err := h.eventService.GetEventPublicStats(c.Request.Context(), eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrEventNotFound) {
+			response := dto.NewErrorResponse(
+				middleware.Translate(c, "event.not_found"),
+				nil,
+			)
+			c.JSON(http.StatusNotFound, response)
+			return
+		}
+
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "event.public_stats.failed"),
+			nil,
+		)
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.public_stats.success"),
+		stats,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// ExportEventICS downloads the event as an iCalendar file for adding it to a calendar
+func (h *EventHandler) ExportEventICS(c *gin.Context) {
+	eventID, ok := parseIDParam(c, "id", "event ID")
+	if !ok {
+		return
+	}
+
+	var userID *int
+	if uid, exists := middleware.GetCurrentUserID(c); exists {
+		userID = &uid
+	}
+
+	calendar, err := h.eventService.ExportICS(c.Request.Context(), eventID, userID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		var message string
+		switch {
+		case errors.Is(err, domain.ErrEventNotFound):
+			status = http.StatusNotFound
+			message = middleware.Translate(c, "event.not_found")
+		case errors.Is(err, domain.ErrEventUnauthorized):
+			status = http.StatusForbidden
+			message = middleware.Translate(c, "event.access_denied")
+		default:
+			var isDomainErr bool
+			message, isDomainErr = middleware.TranslateError(c, err, "event.calendar.failed")
+			if isDomainErr {
+				status = http.StatusBadRequest
+			}
+		}
+
+		c.JSON(status, dto.NewErrorResponse(message, nil))
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="event-`+strconv.Itoa(eventID)+`.ics"`)
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", calendar)
+}
+
+// GetEventShareMeta returns the link preview metadata of a published public event
+func (h *EventHandler) GetEventShareMeta(c *gin.Context) {
+	eventID, ok := parseIDParam(c, "id", "event ID")
+	if !ok {
+		return
+	}
+
+	meta, err := h.eventService.GetEventShareMeta(c.Request.Context(), eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrEventNotFound) {
+			response := dto.NewErrorResponse(
+				middleware.Translate(c, "event.not_found"),
+				nil,
+			)
+			c.JSON(http.StatusNotFound, response)
+			return
+		}
+
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "event.share_meta.failed"),
+			nil,
+		)
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.share_meta.success"),
+		meta,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// CheckTicketAvailability validates a multi-tier cart in one call before reserving it
+func (h *EventHandler) CheckTicketAvailability(c *gin.Context) {
+	var req dto.CheckTicketAvailabilityRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	result, err := h.ticketService.CheckMultipleTicketAvailability(c.Request.Context(), req.Items)
+	if err != nil {
+		message, isDomainErr := middleware.TranslateError(c, err, "ticket.availability_check.failed")
+		status := http.StatusInternalServerError
+		if isDomainErr {
+			status = http.StatusBadRequest
+		}
+		c.JSON(status, dto.NewErrorResponse(message, nil))
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "ticket.availability_check.success"),
+		result,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// ValidateCart checks a checkout cart spanning any number of events: availability, current price
+// and whether each event still sells tickets
+func (h *EventHandler) ValidateCart(c *gin.Context) {
+	var req dto.ValidateCartRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	result, err := h.ticketService.ValidateCart(c.Request.Context(), req.Items)
+	if err != nil {
+		message, isDomainErr := middleware.TranslateError(c, err, "ticket.cart_validation.failed")
+		status := http.StatusInternalServerError
+		if isDomainErr {
+			status = http.StatusBadRequest
+		}
+		c.JSON(status, dto.NewErrorResponse(message, nil))
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "ticket.cart_validation.success"),
+		result,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// ReserveCart holds every ticket tier of a cart at once, or none of them
+func (h *EventHandler) ReserveCart(c *gin.Context) {
+	userID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+
+	var req dto.ReserveCartRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	holdDuration := time.Duration(req.HoldMinutes) * time.Minute
+	reservation, err := h.ticketService.ReserveCart(c.Request.Context(), userID, req.Items, holdDuration)
+	if err != nil {
+		status := http.StatusInternalServerError
+		message, isDomainErr := middleware.TranslateError(c, err, "ticket.reservation.failed")
+		switch {
+		case errors.Is(err, domain.ErrTicketInsufficientQuantity):
+			status = http.StatusConflict
+		case isDomainErr:
+			status = http.StatusBadRequest
+		}
+		c.JSON(status, dto.NewErrorResponse(message, nil))
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "ticket.reservation.success"),
+		reservation,
+	)
+	c.JSON(http.StatusCreated, response)
+}
+
+// ReleaseCart gives back the tickets held by one of the user's carts
+func (h *EventHandler) ReleaseCart(c *gin.Context) {
+	userID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+
+	err := h.ticketService.ReleaseCart(c.Request.Context(), userID, c.Param("cart_id"))
+	if err != nil {
+		status := http.StatusInternalServerError
+		message, isDomainErr := middleware.TranslateError(c, err, "ticket.reservation.release_failed")
+		switch {
+		case errors.Is(err, domain.ErrTicketReservationNotFound):
+			status = http.StatusNotFound
+		case isDomainErr:
+			status = http.StatusBadRequest
+		}
+		c.JSON(status, dto.NewErrorResponse(message, nil))
 		return
 	}
 
 	response := dto.NewSuccessResponse(
-		middleware.Translate(c, "invitation.respond.success"),
-		invitation,
+		middleware.Translate(c, "ticket.reservation.release_success"),
+		nil,
 	)
 	c.JSON(http.StatusOK, response)
 }
 
-// GetMyDraftEvents retrieves draft events created by the authenticated user
-func (h *EventHandler) GetMyDraftEvents(c *gin.Context) {
-	userID, exists := middleware.GetCurrentUserID(c)
-	if !exists {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.unauthorized"),
-			nil,
-		)
-		c.JSON(http.StatusUnauthorized, response)
-		return
-	}
-
-	var pagination dto.PaginationRequest
-	if err := c.ShouldBindQuery(&pagination); err != nil {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.validation_failed"),
-			err.Error(),
-		)
-		c.JSON(http.StatusBadRequest, response)
+// GetEventTicketAvailabilitySummary returns how many tickets are left across all tiers of an event
+func (h *EventHandler) GetEventTicketAvailabilitySummary(c *gin.Context) {
+	eventID, ok := parseIDParam(c, "id", "event ID")
+	if !ok {
 		return
 	}
 
-	// Note: GetCreatorDraftEvents service method should be updated to accept userID instead of creatorID
-	events, paginationResp, err := h.eventService.GetCreatorDraftEvents(c.Request.Context(), int(userID), pagination)
+	summary, err := h.ticketService.GetEventTicketAvailabilitySummary(c.Request.Context(), eventID)
 	if err != nil {
+		if errors.Is(err, domain.ErrEventNotFound) {
+			response := dto.NewErrorResponse(
+				middleware.Translate(c, "event.not_found"),
+				nil,
+			)
+			c.JSON(http.StatusNotFound, response)
+			return
+		}
+
 		response := dto.NewErrorResponse(
-			middleware.Translate(c, "event.list.failed"),
+			middleware.Translate(c, "ticket.availability_summary.failed"),
 			nil,
 		)
 		c.JSON(http.StatusInternalServerError, response)
@@ -746,296 +2098,317 @@ func (h *EventHandler) GetMyDraftEvents(c *gin.Context) {
 	}
 
 	response := dto.NewSuccessResponse(
-		middleware.Translate(c, "event.list.success"),
-		dto.ListResponse{
-			Items:      events,
-			Pagination: *paginationResp,
-		},
+		middleware.Translate(c, "ticket.availability_summary.success"),
+		summary,
 	)
 	c.JSON(http.StatusOK, response)
 }
 
-// GetMyPublishedEvents retrieves published events created by the authenticated user
-func (h *EventHandler) GetMyPublishedEvents(c *gin.Context) {
-	userID, exists := middleware.GetCurrentUserID(c)
-	if !exists {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.unauthorized"),
-			nil,
-		)
-		c.JSON(http.StatusUnauthorized, response)
+// LookupInvitation resolves an RSVP link token into the invitation and its event summary
+func (h *EventHandler) LookupInvitation(c *gin.Context) {
+	eventID, ok := parseIDParam(c, "id", "event ID")
+	if !ok {
 		return
 	}
 
-	var pagination dto.PaginationRequest
-	if err := c.ShouldBindQuery(&pagination); err != nil {
+	token := strings.TrimSpace(c.Query("token"))
+	if token == "" {
 		response := dto.NewErrorResponse(
 			middleware.Translate(c, "common.validation_failed"),
-			err.Error(),
+			"token is required",
 		)
 		c.JSON(http.StatusBadRequest, response)
 		return
 	}
 
-	// Note: GetCreatorPublishedEvents service method should be updated to accept userID instead of creatorID
-	events, paginationResp, err := h.eventService.GetCreatorPublishedEvents(c.Request.Context(), int(userID), pagination)
+	lookup, err := h.invitationService.GetInvitationByToken(c.Request.Context(), eventID, token)
 	if err != nil {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "event.list.failed"),
-			nil,
-		)
-		c.JSON(http.StatusInternalServerError, response)
+		switch {
+		case errors.Is(err, domain.ErrInvitationLinkExpired):
+			c.JSON(http.StatusGone, dto.NewErrorResponse(middleware.Translate(c, "invitation.link_expired"), nil))
+		case errors.Is(err, domain.ErrInvitationNotFound):
+			c.JSON(http.StatusNotFound, dto.NewErrorResponse(middleware.Translate(c, "invitation.not_found"), nil))
+		case errors.Is(err, domain.ErrEventNotFound):
+			c.JSON(http.StatusNotFound, dto.NewErrorResponse(middleware.Translate(c, "event.not_found"), nil))
+		default:
+			c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(middleware.Translate(c, "invitation.lookup.failed"), nil))
+		}
 		return
 	}
 
 	response := dto.NewSuccessResponse(
-		middleware.Translate(c, "event.list.success"),
-		dto.ListResponse{
-			Items:      events,
-			Pagination: *paginationResp,
-		},
+		middleware.Translate(c, "invitation.lookup.success"),
+		lookup,
 	)
 	c.JSON(http.StatusOK, response)
 }
 
-// SubmitForReview submits an event for review
-func (h *EventHandler) SubmitForReview(c *gin.Context) {
-	userID, exists := middleware.GetCurrentUserID(c)
-	if !exists {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.unauthorized"),
-			nil,
-		)
-		c.JSON(http.StatusUnauthorized, response)
+// RespondToInvitationByToken answers an invitation through its RSVP link token. Answers shortly after
+// the event started are accepted within the configured grace and stored as late_approved.
+func (h *EventHandler) RespondToInvitationByToken(c *gin.Context) {
+	eventID, ok := parseIDParam(c, "id", "event ID")
+	if !ok {
 		return
 	}
 
-	eventIDStr := c.Param("id")
-	eventID, err := strconv.ParseInt(eventIDStr, 10, 32)
-	if err != nil {
+	token := strings.TrimSpace(c.Query("token"))
+	if token == "" {
 		response := dto.NewErrorResponse(
 			middleware.Translate(c, "common.validation_failed"),
-			"Invalid event ID",
+			"token is required",
 		)
 		c.JSON(http.StatusBadRequest, response)
 		return
 	}
 
-	// Note: SubmitEventForReview service method should be updated to accept userID instead of creatorID
-	event, err := h.eventService.SubmitEventForReview(c.Request.Context(), int(eventID), int(userID))
+	var req dto.RespondToInvitationRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	invitation, err := h.invitationService.RespondToInvitationByToken(c.Request.Context(), eventID, token, req.Status)
 	if err != nil {
-		var message string
-		switch err.Error() {
-		case "event not found":
-			message = middleware.Translate(c, "event.not_found")
-		case "access denied":
-			message = middleware.Translate(c, "event.access_denied")
-		case "invalid status transition":
-			message = middleware.Translate(c, "event.invalid_status_transition")
+		switch {
+		case errors.Is(err, domain.ErrInvitationLinkExpired), errors.Is(err, domain.ErrInvitationExpired):
+			message, _ := middleware.TranslateError(c, err, "invitation.respond.failed")
+			c.JSON(http.StatusGone, dto.NewErrorResponse(message, nil))
+		case errors.Is(err, domain.ErrInvitationNotFound):
+			c.JSON(http.StatusNotFound, dto.NewErrorResponse(middleware.Translate(c, "invitation.not_found"), nil))
+		case errors.Is(err, domain.ErrEventNotFound):
+			c.JSON(http.StatusNotFound, dto.NewErrorResponse(middleware.Translate(c, "event.not_found"), nil))
 		default:
-			message = middleware.Translate(c, "event.submit.failed")
+			message, isDomainErr := middleware.TranslateError(c, err, "invitation.respond.failed")
+			status := http.StatusInternalServerError
+			if isDomainErr || strings.HasPrefix(err.Error(), "invalid status transition") {
+				status = http.StatusBadRequest
+			}
+			c.JSON(status, dto.NewErrorResponse(message, nil))
 		}
-
-		response := dto.NewErrorResponse(message, nil)
-		c.JSON(http.StatusInternalServerError, response)
 		return
 	}
 
 	response := dto.NewSuccessResponse(
-		middleware.Translate(c, "event.submit.success"),
-		event,
+		middleware.Translate(c, "invitation.respond.success"),
+		invitation,
 	)
 	c.JSON(http.StatusOK, response)
 }
 
-// PublishEvent publishes an event
-func (h *EventHandler) PublishEvent(c *gin.Context) {
-	userID, exists := middleware.GetCurrentUserID(c)
-	if !exists {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.unauthorized"),
-			nil,
-		)
-		c.JSON(http.StatusUnauthorized, response)
+// GetAdminEvents lists events of all creators in one status, for admins
+func (h *EventHandler) GetAdminEvents(c *gin.Context) {
+	var filters dto.AdminEventFilterRequest
+	if !bindQuery(c, &filters) {
 		return
 	}
 
-	eventIDStr := c.Param("id")
-	eventID, err := strconv.ParseInt(eventIDStr, 10, 32)
-	if err != nil {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.validation_failed"),
-			"Invalid event ID",
-		)
-		c.JSON(http.StatusBadRequest, response)
+	var pagination dto.PaginationRequest
+	if !bindQuery(c, &pagination) {
 		return
 	}
 
-	// Note: PublishEvent service method should be updated to accept userID instead of creatorID
-	event, err := h.eventService.PublishEvent(c.Request.Context(), int(eventID), int(userID))
+	events, paginationResp, err := h.eventService.GetAllEventsByStatus(c.Request.Context(), filters, pagination)
 	if err != nil {
-		var message string
-		switch err.Error() {
-		case "event not found":
-			message = middleware.Translate(c, "event.not_found")
-		case "access denied":
-			message = middleware.Translate(c, "event.access_denied")
-		case "invalid status transition":
-			message = middleware.Translate(c, "event.invalid_status_transition")
-		default:
-			message = middleware.Translate(c, "event.publish.failed")
+		message, isDomainErr := middleware.TranslateError(c, err, "event.admin_list.failed")
+		status := http.StatusInternalServerError
+		if isDomainErr {
+			status = http.StatusBadRequest
 		}
-
-		response := dto.NewErrorResponse(message, nil)
-		c.JSON(http.StatusInternalServerError, response)
+		c.JSON(status, dto.NewErrorResponse(message, nil))
 		return
 	}
 
-	response := dto.NewSuccessResponse(
-		middleware.Translate(c, "event.publish.success"),
-		event,
+	response := dto.NewListSuccessResponse(
+		middleware.Translate(c, "event.admin_list.success"),
+		events,
+		*paginationResp,
 	)
 	c.JSON(http.StatusOK, response)
 }
 
-// CancelEvent cancels an event
-func (h *EventHandler) CancelEvent(c *gin.Context) {
-	userID, exists := middleware.GetCurrentUserID(c)
-	if !exists {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.unauthorized"),
-			nil,
-		)
-		c.JSON(http.StatusUnauthorized, response)
+// GetTrashedEvents lists deleted events waiting to be purged
+func (h *EventHandler) GetTrashedEvents(c *gin.Context) {
+	var pagination dto.PaginationRequest
+	if !bindQuery(c, &pagination) {
 		return
 	}
 
-	eventIDStr := c.Param("id")
-	eventID, err := strconv.ParseInt(eventIDStr, 10, 32)
+	events, paginationResp, err := h.eventService.GetTrashedEvents(c.Request.Context(), pagination)
 	if err != nil {
 		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.validation_failed"),
-			"Invalid event ID",
+			middleware.Translate(c, "event.trash.list_failed"),
+			nil,
 		)
-		c.JSON(http.StatusBadRequest, response)
+		c.JSON(http.StatusInternalServerError, response)
 		return
 	}
 
-	// Note: CancelEvent service method should be updated to accept userID instead of creatorID
-	event, err := h.eventService.CancelEvent(c.Request.Context(), int(eventID), int(userID))
-	if err != nil {
-		var message string
-		switch err.Error() {
-		case "event not found":
-			message = middleware.Translate(c, "event.not_found")
-		case "access denied":
-			message = middleware.Translate(c, "event.access_denied")
-		case "invalid status transition":
-			message = middleware.Translate(c, "event.invalid_status_transition")
-		default:
-			message = middleware.Translate(c, "event.cancel.failed")
-		}
+	response := dto.NewListSuccessResponse(
+		middleware.Translate(c, "event.trash.list_success"),
+		events,
+		*paginationResp,
+	)
+	c.JSON(http.StatusOK, response)
+}
 
-		response := dto.NewErrorResponse(message, nil)
-		c.JSON(http.StatusInternalServerError, response)
+// PurgeEvent permanently deletes an event from the trash
+func (h *EventHandler) PurgeEvent(c *gin.Context) {
+	eventID, ok := parseIDParam(c, "id", "event ID")
+	if !ok {
+		return
+	}
+
+	if err := h.eventService.PurgeEvent(c.Request.Context(), eventID); err != nil {
+		message, isDomainErr := middleware.TranslateError(c, err, "event.purge.failed")
+		status := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, domain.ErrEventNotFound):
+			status = http.StatusNotFound
+		case errors.Is(err, domain.ErrEventHasPurchases):
+			status = http.StatusConflict
+		case isDomainErr:
+			status = http.StatusBadRequest
+		}
+		c.JSON(status, dto.NewErrorResponse(message, nil))
 		return
 	}
 
 	response := dto.NewSuccessResponse(
-		middleware.Translate(c, "event.cancel.success"),
-		event,
+		middleware.Translate(c, "event.purge.success"),
+		nil,
 	)
 	c.JSON(http.StatusOK, response)
 }
 
-// GetEventStats retrieves event statistics for the authenticated creator
-func (h *EventHandler) GetEventStats(c *gin.Context) {
-	userID, exists := middleware.GetCurrentUserID(c)
-	if !exists {
+// GetUpcomingEvents retrieves upcoming events
+func (h *EventHandler) GetUpcomingEvents(c *gin.Context) {
+	var pagination dto.PaginationRequest
+	if !bindQuery(c, &pagination) {
+		return
+	}
+
+	var req dto.UpcomingEventsRequest
+	if !bindQuery(c, &req) {
+		return
+	}
+
+	events, paginationResp, err := h.eventService.GetUpcomingEvents(c.Request.Context(), req.IncludeAnnouncements, pagination)
+	if err != nil {
 		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.unauthorized"),
+			middleware.Translate(c, "event.upcoming.failed"),
 			nil,
 		)
-		c.JSON(http.StatusUnauthorized, response)
+		c.JSON(http.StatusInternalServerError, response)
 		return
 	}
 
-	// Note: GetEventStats service method should be updated to accept userID instead of creatorID
-	stats, err := h.eventService.GetEventStats(c.Request.Context(), int(userID))
+	response := dto.NewListSuccessResponse(
+		middleware.Translate(c, "event.upcoming.success"),
+		events,
+		*paginationResp,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetAnnouncements retrieves public announcements, including those without a date
+func (h *EventHandler) GetAnnouncements(c *gin.Context) {
+	var pagination dto.PaginationRequest
+	if !bindQuery(c, &pagination) {
+		return
+	}
+
+	events, paginationResp, err := h.eventService.GetAnnouncements(c.Request.Context(), pagination)
 	if err != nil {
 		response := dto.NewErrorResponse(
-			middleware.Translate(c, "event.statistics.failed"),
+			middleware.Translate(c, "event.announcements.failed"),
 			nil,
 		)
 		c.JSON(http.StatusInternalServerError, response)
 		return
 	}
 
-	response := dto.NewSuccessResponse(
-		middleware.Translate(c, "event.statistics.success"),
-		stats,
+	response := dto.NewListSuccessResponse(
+		middleware.Translate(c, "event.announcements.success"),
+		events,
+		*paginationResp,
 	)
 	c.JSON(http.StatusOK, response)
 }
 
-// GetPublicEvents retrieves public events
-func (h *EventHandler) GetPublicEvents(c *gin.Context) {
+// GetOngoingEvents retrieves published events that are in progress right now
+func (h *EventHandler) GetOngoingEvents(c *gin.Context) {
 	var pagination dto.PaginationRequest
-	if err := c.ShouldBindQuery(&pagination); err != nil {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.validation_failed"),
-			err.Error(),
-		)
-		c.JSON(http.StatusBadRequest, response)
+	if !bindQuery(c, &pagination) {
 		return
 	}
 
-	events, paginationResp, err := h.eventService.GetPublicEvents(c.Request.Context(), pagination)
+	events, paginationResp, err := h.eventService.GetOngoingEvents(c.Request.Context(), pagination)
 	if err != nil {
 		response := dto.NewErrorResponse(
-			middleware.Translate(c, "event.list.failed"),
+			middleware.Translate(c, "event.ongoing.failed"),
 			nil,
 		)
 		c.JSON(http.StatusInternalServerError, response)
 		return
 	}
 
-	response := dto.NewSuccessResponse(
-		middleware.Translate(c, "event.list.success"),
-		dto.ListResponse{
-			Items:      events,
-			Pagination: *paginationResp,
-		},
+	response := dto.NewListSuccessResponse(
+		middleware.Translate(c, "event.ongoing.success"),
+		events,
+		*paginationResp,
 	)
 	c.JSON(http.StatusOK, response)
 }
 
-// SearchEvents searches public events
-func (h *EventHandler) SearchEvents(c *gin.Context) {
+// GetEventsStartingSoon lists published events starting within the next within_hours hours
+func (h *EventHandler) GetEventsStartingSoon(c *gin.Context) {
+	var req dto.StartingSoonRequest
+	if !bindQuery(c, &req) {
+		return
+	}
+
 	var pagination dto.PaginationRequest
-	if err := c.ShouldBindQuery(&pagination); err != nil {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.validation_failed"),
-			err.Error(),
-		)
-		c.JSON(http.StatusBadRequest, response)
+	if !bindQuery(c, &pagination) {
 		return
 	}
 
-	var searchReq dto.EventSearchRequest
-	if err := c.ShouldBindQuery(&searchReq); err != nil {
+	events, paginationResp, err := h.eventService.GetEventsStartingSoon(c.Request.Context(), req.WithinHours, req.City, pagination)
+	if err != nil {
 		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.validation_failed"),
-			err.Error(),
+			middleware.Translate(c, "event.starting_soon.failed"),
+			nil,
 		)
-		c.JSON(http.StatusBadRequest, response)
+		c.JSON(http.StatusInternalServerError, response)
 		return
 	}
 
-	events, paginationResp, err := h.eventService.SearchPublicEvents(c.Request.Context(), searchReq, pagination)
+	response := dto.NewListSuccessResponse(
+		middleware.Translate(c, "event.starting_soon.success"),
+		events,
+		*paginationResp,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetFeaturedEvents lists the events curated by admins
+func (h *EventHandler) GetFeaturedEvents(c *gin.Context) {
+	h.getShowcaseEvents(c, h.eventService.GetFeaturedEvents, "event.featured")
+}
+
+// GetTrendingEvents lists the events trending right now
+func (h *EventHandler) GetTrendingEvents(c *gin.Context) {
+	h.getShowcaseEvents(c, h.eventService.GetTrendingEvents, "event.trending")
+}
+
+func (h *EventHandler) getShowcaseEvents(c *gin.Context, list func(context.Context, dto.EventShowcaseRequest) ([]*dto.EventListResponse, error), keyPrefix string) {
+	var req dto.EventShowcaseRequest
+	if !bindQuery(c, &req) {
+		return
+	}
+
+	events, err := list(c.Request.Context(), req)
 	if err != nil {
 		response := dto.NewErrorResponse(
-			middleware.Translate(c, "event.search.failed"),
+			middleware.Translate(c, keyPrefix+".failed"),
 			nil,
 		)
 		c.JSON(http.StatusInternalServerError, response)
@@ -1043,22 +2416,21 @@ func (h *EventHandler) SearchEvents(c *gin.Context) {
 	}
 
 	response := dto.NewSuccessResponse(
-		middleware.Translate(c, "event.search.success"),
-		dto.ListResponse{
-			Items:      events,
-			Pagination: *paginationResp,
-		},
+		middleware.Translate(c, keyPrefix+".success"),
+		events,
 	)
 	c.JSON(http.StatusOK, response)
 }
 
-// GetEventsByLocation retrieves events by location
-func (h *EventHandler) GetEventsByLocation(c *gin.Context) {
-	city := c.Param("city")
-	country := c.Query("country")
+// FeatureEvent adds an event to the featured list, or removes it with {"featured": false}
+func (h *EventHandler) FeatureEvent(c *gin.Context) {
+	eventID, ok := parseIDParam(c, "id", "event ID")
+	if !ok {
+		return
+	}
 
-	var pagination dto.PaginationRequest
-	if err := c.ShouldBindQuery(&pagination); err != nil {
+	var req dto.FeatureEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
 		response := dto.NewErrorResponse(
 			middleware.Translate(c, "common.validation_failed"),
 			err.Error(),
@@ -1066,83 +2438,81 @@ func (h *EventHandler) GetEventsByLocation(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, response)
 		return
 	}
+	featured := req.Featured == nil || *req.Featured
 
-	events, paginationResp, err := h.eventService.GetPublicEventsByLocation(c.Request.Context(), city, country, pagination)
+	event, err := h.eventService.SetEventFeatured(c.Request.Context(), eventID, featured)
 	if err != nil {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "event.location.search.failed"),
-			nil,
-		)
-		c.JSON(http.StatusInternalServerError, response)
+		message, isDomainErr := middleware.TranslateError(c, err, "event.feature.failed")
+		status := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, domain.ErrEventNotFound):
+			status = http.StatusNotFound
+		case isDomainErr:
+			status = http.StatusBadRequest
+		}
+		c.JSON(status, dto.NewErrorResponse(message, nil))
 		return
 	}
 
 	response := dto.NewSuccessResponse(
-		middleware.Translate(c, "event.location.search.success"),
-		dto.ListResponse{
-			Items:      events,
-			Pagination: *paginationResp,
-		},
+		middleware.Translate(c, "event.feature.success"),
+		event,
 	)
 	c.JSON(http.StatusOK, response)
 }
 
-// GetUpcomingEvents retrieves upcoming events
-func (h *EventHandler) GetUpcomingEvents(c *gin.Context) {
+// GetCreatorUpcomingEvents lists a creator's upcoming public events for their profile page
+func (h *EventHandler) GetCreatorUpcomingEvents(c *gin.Context) {
+	creatorID, ok := parseIDParam(c, "id", "creator ID")
+	if !ok {
+		return
+	}
+
 	var pagination dto.PaginationRequest
-	if err := c.ShouldBindQuery(&pagination); err != nil {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.validation_failed"),
-			err.Error(),
-		)
-		c.JSON(http.StatusBadRequest, response)
+	if !bindQuery(c, &pagination) {
 		return
 	}
 
-	events, paginationResp, err := h.eventService.GetUpcomingEvents(c.Request.Context(), pagination)
+	events, paginationResp, err := h.eventService.GetCreatorUpcomingEvents(c.Request.Context(), creatorID, pagination)
 	if err != nil {
+		if errors.Is(err, domain.ErrCreatorProfileNotFound) {
+			response := dto.NewErrorResponse(
+				middleware.Translate(c, "creator.not_found"),
+				nil,
+			)
+			c.JSON(http.StatusNotFound, response)
+			return
+		}
+
 		response := dto.NewErrorResponse(
-			middleware.Translate(c, "event.upcoming.failed"),
+			middleware.Translate(c, "event.creator_upcoming.failed"),
 			nil,
 		)
 		c.JSON(http.StatusInternalServerError, response)
 		return
 	}
 
-	response := dto.NewSuccessResponse(
-		middleware.Translate(c, "event.upcoming.success"),
-		dto.ListResponse{
-			Items:      events,
-			Pagination: *paginationResp,
-		},
+	response := dto.NewListSuccessResponse(
+		middleware.Translate(c, "event.creator_upcoming.success"),
+		events,
+		*paginationResp,
 	)
 	c.JSON(http.StatusOK, response)
 }
 
 // GetEventsByCategory retrieves events by category
 func (h *EventHandler) GetEventsByCategory(c *gin.Context) {
-	categoryIDStr := c.Param("category_id")
-	categoryID, err := strconv.ParseInt(categoryIDStr, 10, 32)
-	if err != nil {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.validation_failed"),
-			"Invalid category ID",
-		)
-		c.JSON(http.StatusBadRequest, response)
+	categoryID, ok := parseIDParam(c, "category_id", "category ID")
+	if !ok {
 		return
 	}
 
 	var pagination dto.PaginationRequest
-	if err := c.ShouldBindQuery(&pagination); err != nil {
-		response := dto.NewErrorResponse(
-			middleware.Translate(c, "common.validation_failed"),
-			err.Error(),
-		)
-		c.JSON(http.StatusBadRequest, response)
+	if !bindQuery(c, &pagination) {
 		return
 	}
 
-	events, paginationResp, err := h.eventService.GetPublicEventsByCategory(c.Request.Context(), int(categoryID), pagination)
+	events, paginationResp, err := h.eventService.GetPublicEventsByCategory(c.Request.Context(), categoryID, pagination)
 	if err != nil {
 		response := dto.NewErrorResponse(
 			middleware.Translate(c, "event.category.search.failed"),
@@ -1152,12 +2522,59 @@ func (h *EventHandler) GetEventsByCategory(c *gin.Context) {
 		return
 	}
 
-	response := dto.NewSuccessResponse(
+	response := dto.NewListSuccessResponse(
 		middleware.Translate(c, "event.category.search.success"),
-		dto.ListResponse{
-			Items:      events,
-			Pagination: *paginationResp,
-		},
+		events,
+		*paginationResp,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// InviteUsersByIDs invites existing platform users to an event by their user ids
+func (h *EventHandler) InviteUsersByIDs(c *gin.Context) {
+	userID, ok := requireUser(c)
+	if !ok {
+		return
+	}
+
+	eventID, ok := parseIDParam(c, "id", "event ID")
+	if !ok {
+		return
+	}
+
+	var req dto.InviteUsersByIDsRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	result, err := h.invitationService.InviteUsersByIDs(c.Request.Context(), eventID, userID, req.UserIDs)
+	if err != nil {
+		status := http.StatusInternalServerError
+		var message string
+
+		switch {
+		case errors.Is(err, domain.ErrEventNotFound):
+			status = http.StatusNotFound
+			message = middleware.Translate(c, "event.not_found")
+		case errors.Is(err, domain.ErrEventUnauthorized):
+			status = http.StatusForbidden
+			message = middleware.Translate(c, "event.access_denied")
+		default:
+			var isDomainErr bool
+			message, isDomainErr = middleware.TranslateError(c, err, "invitation.by_users.failed")
+			if isDomainErr {
+				status = http.StatusBadRequest
+			}
+		}
+
+		response := dto.NewErrorResponse(message, nil)
+		c.JSON(status, response)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "invitation.by_users.success"),
+		result,
 	)
 	c.JSON(http.StatusOK, response)
 }
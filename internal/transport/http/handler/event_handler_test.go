@@ -0,0 +1,353 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/middleware"
+	"github.com/louco-event/internal/service"
+)
+
+// fakeAdminEvents lists a fixed page of events and records the filters it was asked for;
+// methods the tests do not reach are left to the embedded nil interface
+type fakeAdminEvents struct {
+	service.EventService
+	events  []*dto.EventListResponse
+	filters *dto.AdminEventFilterRequest
+}
+
+func (f *fakeAdminEvents) GetAllEventsByStatus(ctx context.Context, filters dto.AdminEventFilterRequest, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
+	f.filters = &filters
+	return f.events, dto.NewPaginationResponse(1, 10, int64(len(f.events))), nil
+}
+
+func TestGetAdminEventsListsCancelledEventsForAdminsOnly(t *testing.T) {
+	events := &fakeAdminEvents{events: []*dto.EventListResponse{
+		{ID: 1, Status: domain.EventStatusCancelled, CreatorID: 10},
+		{ID: 2, Status: domain.EventStatusCancelled, CreatorID: 20},
+	}}
+	jwtService := service.NewJWTService("test-secret", time.Hour)
+	eventHandler := NewEventHandler(events, nil, nil, nil, nil, nil)
+
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	admin := engine.Group("/admin")
+	admin.Use(middleware.JWTAuth(jwtService), middleware.RequireRole("admin"))
+	admin.GET("/events", eventHandler.GetAdminEvents)
+
+	tests := []struct {
+		name   string
+		claims *dto.JWTClaims
+		want   int
+	}{
+		{"normal user", &dto.JWTClaims{UserID: 1, UserType: "user", Role: "member"}, http.StatusForbidden},
+		{"creator", &dto.JWTClaims{UserID: 2, UserType: "creator", Role: "member"}, http.StatusForbidden},
+		{"admin", &dto.JWTClaims{UserID: 3, UserType: "user", Role: "admin"}, http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events.filters = nil
+			token, err := jwtService.GenerateToken(tt.claims)
+			if err != nil {
+				t.Fatalf("GenerateToken: %v", err)
+			}
+			req := httptest.NewRequest(http.MethodGet, "/admin/events?status=cancelled", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+			recorder := httptest.NewRecorder()
+			engine.ServeHTTP(recorder, req)
+
+			if recorder.Code != tt.want {
+				t.Fatalf("got %d, want %d", recorder.Code, tt.want)
+			}
+			if tt.want != http.StatusOK {
+				if events.filters != nil {
+					t.Error("events were listed for a non-admin")
+				}
+				return
+			}
+
+			if events.filters == nil || events.filters.Status != domain.EventStatusCancelled || events.filters.CreatorID != nil {
+				t.Errorf("filters = %+v, want cancelled events of every creator", events.filters)
+			}
+			var body struct {
+				Data dto.ListResponse `json:"data"`
+			}
+			if err := json.Unmarshal(recorder.Body.Bytes(), &body); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if items, ok := body.Data.Items.([]interface{}); !ok || len(items) != 2 {
+				t.Errorf("items = %v, want both cancelled events", body.Data.Items)
+			}
+		})
+	}
+}
+
+// fakeStatusEvents fails every status change with a fixed error, or succeeds when it is nil
+type fakeStatusEvents struct {
+	service.EventService
+	err error
+}
+
+func (f *fakeStatusEvents) result(id int) (*dto.EventResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &dto.EventResponse{ID: id, Status: domain.EventStatusPublished}, nil
+}
+
+func (f *fakeStatusEvents) UpdateEventStatus(ctx context.Context, id, userID int, req dto.UpdateEventStatusRequest) (*dto.EventResponse, error) {
+	return f.result(id)
+}
+
+func (f *fakeStatusEvents) SubmitEventForReview(ctx context.Context, id, userID int) (*dto.EventResponse, error) {
+	return f.result(id)
+}
+
+func (f *fakeStatusEvents) PublishEvent(ctx context.Context, id, userID int) (*dto.EventResponse, error) {
+	return f.result(id)
+}
+
+func (f *fakeStatusEvents) CancelEvent(ctx context.Context, id, userID int) (*dto.EventResponse, error) {
+	return f.result(id)
+}
+
+func TestStatusChangesReportAllowedTransitions(t *testing.T) {
+	events := &fakeStatusEvents{}
+	jwtService := service.NewJWTService("test-secret", time.Hour)
+	eventHandler := NewEventHandler(events, nil, nil, nil, nil, nil)
+
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	manage := engine.Group("/events")
+	manage.Use(middleware.JWTAuth(jwtService))
+	manage.PUT("/:id/status", eventHandler.UpdateEventStatus)
+	manage.POST("/:id/submit", eventHandler.SubmitForReview)
+	manage.POST("/:id/publish", eventHandler.PublishEvent)
+	manage.POST("/:id/cancel", eventHandler.CancelEvent)
+
+	token, err := jwtService.GenerateToken(&dto.JWTClaims{UserID: 2, UserType: "creator", Role: "member"})
+	if err != nil {
+		t.Fatalf("GenerateToken: %v", err)
+	}
+	transitionErr := domain.NewEventStatusTransitionError(domain.EventStatusPublished, domain.EventStatusDraft,
+		[]domain.EventStatus{domain.EventStatusStopped, domain.EventStatusCancelled})
+
+	tests := []struct {
+		name    string
+		method  string
+		path    string
+		err     error
+		want    int
+		details bool
+	}{
+		{"status change", http.MethodPut, "/events/7/status", transitionErr, http.StatusBadRequest, true},
+		{"wrapped by the service", http.MethodPut, "/events/7/status", fmt.Errorf("failed to update event status: %w", transitionErr), http.StatusBadRequest, true},
+		{"submit", http.MethodPost, "/events/7/submit", transitionErr, http.StatusBadRequest, true},
+		{"publish", http.MethodPost, "/events/7/publish", transitionErr, http.StatusBadRequest, true},
+		{"cancel", http.MethodPost, "/events/7/cancel", transitionErr, http.StatusBadRequest, true},
+		{"other domain error", http.MethodPut, "/events/7/status", domain.ErrEventSalesClosed, http.StatusBadRequest, false},
+		{"internal error", http.MethodPut, "/events/7/status", errors.New("connection reset"), http.StatusInternalServerError, false},
+		{"allowed change", http.MethodPut, "/events/7/status", nil, http.StatusOK, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events.err = tt.err
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(`{"status":"draft"}`))
+			req.Header.Set("Authorization", "Bearer "+token)
+			req.Header.Set("Content-Type", "application/json")
+			recorder := httptest.NewRecorder()
+			engine.ServeHTTP(recorder, req)
+
+			if recorder.Code != tt.want {
+				t.Fatalf("got %d, want %d: %s", recorder.Code, tt.want, recorder.Body.String())
+			}
+			var body struct {
+				Message string                            `json:"message"`
+				Errors  *dto.StatusTransitionErrorDetails `json:"errors"`
+			}
+			if err := json.Unmarshal(recorder.Body.Bytes(), &body); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if !tt.details {
+				if body.Errors != nil {
+					t.Errorf("errors = %+v, want none", body.Errors)
+				}
+				return
+			}
+
+			if body.Message != "event.invalid_status_transition" {
+				t.Errorf("message = %q, want event.invalid_status_transition", body.Message)
+			}
+			want := dto.StatusTransitionErrorDetails{
+				CurrentStatus:   domain.EventStatusPublished,
+				RequestedStatus: domain.EventStatusDraft,
+				AllowedStatuses: []domain.EventStatus{domain.EventStatusStopped, domain.EventStatusCancelled},
+			}
+			if body.Errors == nil || body.Errors.CurrentStatus != want.CurrentStatus || body.Errors.RequestedStatus != want.RequestedStatus ||
+				!slices.Equal(body.Errors.AllowedStatuses, want.AllowedStatuses) {
+				t.Errorf("errors = %+v, want %+v", body.Errors, want)
+			}
+		})
+	}
+}
+
+// fakeEditEvents fails every update and delete with a fixed error
+type fakeEditEvents struct {
+	service.EventService
+	err error
+}
+
+func (f *fakeEditEvents) UpdateEvent(ctx context.Context, id, userID int, req dto.UpdateEventRequest) (*dto.EventResponse, error) {
+	return nil, f.err
+}
+
+func (f *fakeEditEvents) DeleteEvent(ctx context.Context, id, userID int) error {
+	return f.err
+}
+
+func TestEditingEventsReportMissingAndDeniedEvents(t *testing.T) {
+	events := &fakeEditEvents{}
+	jwtService := service.NewJWTService("test-secret", time.Hour)
+	eventHandler := NewEventHandler(events, nil, nil, nil, nil, nil)
+
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	manage := engine.Group("/events")
+	manage.Use(middleware.JWTAuth(jwtService))
+	manage.PUT("/:id", eventHandler.UpdateEvent)
+	manage.DELETE("/:id", eventHandler.DeleteEvent)
+
+	token, err := jwtService.GenerateToken(&dto.JWTClaims{UserID: 2, UserType: "creator", Role: "member"})
+	if err != nil {
+		t.Fatalf("GenerateToken: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		err    error
+		want   int
+	}{
+		{"update missing", http.MethodPut, domain.ErrEventNotFound, http.StatusNotFound},
+		{"update denied", http.MethodPut, fmt.Errorf("failed to load event: %w", domain.ErrEventUnauthorized), http.StatusForbidden},
+		{"update type not allowed", http.MethodPut, domain.ErrEventTypeNotAllowed, http.StatusBadRequest},
+		{"update internal error", http.MethodPut, errors.New("connection reset"), http.StatusInternalServerError},
+		{"delete missing", http.MethodDelete, domain.ErrEventNotFound, http.StatusNotFound},
+		{"delete denied", http.MethodDelete, domain.ErrEventUnauthorized, http.StatusForbidden},
+		{"delete published", http.MethodDelete, domain.ErrEventCannotBeDeleted, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events.err = tt.err
+			req := httptest.NewRequest(tt.method, "/events/7", strings.NewReader(`{}`))
+			req.Header.Set("Authorization", "Bearer "+token)
+			req.Header.Set("Content-Type", "application/json")
+			recorder := httptest.NewRecorder()
+			engine.ServeHTTP(recorder, req)
+
+			if recorder.Code != tt.want {
+				t.Errorf("got %d, want %d: %s", recorder.Code, tt.want, recorder.Body.String())
+			}
+		})
+	}
+}
+
+// fakeCreatorEvents records which status list was asked for and returns one event in it
+type fakeCreatorEvents struct {
+	service.EventService
+	status     domain.EventStatus
+	userID     int
+	pagination dto.PaginationRequest
+}
+
+func (f *fakeCreatorEvents) list(userID int, status domain.EventStatus, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
+	f.status, f.userID, f.pagination = status, userID, pagination
+	return []*dto.EventListResponse{{ID: 1, Status: status}}, dto.NewPaginationResponse(1, 10, 1), nil
+}
+
+func (f *fakeCreatorEvents) GetCreatorDraftEvents(ctx context.Context, userID int, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
+	return f.list(userID, domain.EventStatusDraft, pagination)
+}
+
+func (f *fakeCreatorEvents) GetCreatorPublishedEvents(ctx context.Context, userID int, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
+	return f.list(userID, domain.EventStatusPublished, pagination)
+}
+
+func (f *fakeCreatorEvents) GetCreatorPendingEvents(ctx context.Context, userID int, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
+	return f.list(userID, domain.EventStatusPending, pagination)
+}
+
+func (f *fakeCreatorEvents) GetCreatorRejectedEvents(ctx context.Context, userID int, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
+	return f.list(userID, domain.EventStatusRejected, pagination)
+}
+
+func (f *fakeCreatorEvents) GetCreatorStoppedEvents(ctx context.Context, userID int, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
+	return f.list(userID, domain.EventStatusStopped, pagination)
+}
+
+func (f *fakeCreatorEvents) GetCreatorCancelledEvents(ctx context.Context, userID int, pagination dto.PaginationRequest) ([]*dto.EventListResponse, *dto.PaginationResponse, error) {
+	return f.list(userID, domain.EventStatusCancelled, pagination)
+}
+
+func TestMyEventListsFilterByStatus(t *testing.T) {
+	events := &fakeCreatorEvents{}
+	jwtService := service.NewJWTService("test-secret", time.Hour)
+	eventHandler := NewEventHandler(events, nil, nil, nil, nil, nil)
+
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	mine := engine.Group("/events/my")
+	mine.Use(middleware.JWTAuth(jwtService))
+	mine.GET("/drafts", eventHandler.GetMyDraftEvents)
+	mine.GET("/published", eventHandler.GetMyPublishedEvents)
+	mine.GET("/pending", eventHandler.GetMyPendingEvents)
+	mine.GET("/rejected", eventHandler.GetMyRejectedEvents)
+	mine.GET("/stopped", eventHandler.GetMyStoppedEvents)
+	mine.GET("/cancelled", eventHandler.GetMyCancelledEvents)
+
+	token, err := jwtService.GenerateToken(&dto.JWTClaims{UserID: 2, UserType: "creator", Role: "member"})
+	if err != nil {
+		t.Fatalf("GenerateToken: %v", err)
+	}
+	tests := []struct {
+		path string
+		want domain.EventStatus
+	}{
+		{"/events/my/drafts", domain.EventStatusDraft},
+		{"/events/my/published", domain.EventStatusPublished},
+		{"/events/my/pending", domain.EventStatusPending},
+		{"/events/my/rejected", domain.EventStatusRejected},
+		{"/events/my/stopped", domain.EventStatusStopped},
+		{"/events/my/cancelled", domain.EventStatusCancelled},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.want), func(t *testing.T) {
+			*events = fakeCreatorEvents{}
+			req := httptest.NewRequest(http.MethodGet, tt.path+"?page=2&page_size=5", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+			recorder := httptest.NewRecorder()
+			engine.ServeHTTP(recorder, req)
+
+			if recorder.Code != http.StatusOK {
+				t.Fatalf("got %d, want %d: %s", recorder.Code, http.StatusOK, recorder.Body.String())
+			}
+			if events.status != tt.want || events.userID != 2 {
+				t.Errorf("listed %q events of user %d, want %q events of user 2", events.status, events.userID, tt.want)
+			}
+			if events.pagination.Page != 2 || events.pagination.PageSize != 5 {
+				t.Errorf("pagination = %+v, want page 2 of size 5", events.pagination)
+			}
+		})
+	}
+}
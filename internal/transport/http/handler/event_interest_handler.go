@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/i18n"
+	"github.com/louco-event/internal/middleware"
+	"github.com/louco-event/internal/service"
+)
+
+type EventInterestHandler struct {
+	interestService service.EventInterestService
+	i18n            *i18n.I18n
+}
+
+func NewEventInterestHandler(interestService service.EventInterestService, i18n *i18n.I18n) *EventInterestHandler {
+	return &EventInterestHandler{
+		interestService: interestService,
+		i18n:            i18n,
+	}
+}
+
+// RegisterInterest records that the user would like to attend, even when the event is sold
+// out or over
+func (h *EventInterestHandler) RegisterInterest(c *gin.Context) {
+	eventID, userID, ok := h.parseEventRequest(c)
+	if !ok {
+		return
+	}
+
+	interest, err := h.interestService.RegisterInterest(c.Request.Context(), eventID, userID)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.interest.register_success"),
+		interest,
+	)
+	c.JSON(http.StatusCreated, response)
+}
+
+// GetInterestCount returns how many users registered interest in the organizer's event
+func (h *EventInterestHandler) GetInterestCount(c *gin.Context) {
+	eventID, userID, ok := h.parseEventRequest(c)
+	if !ok {
+		return
+	}
+
+	count, err := h.interestService.GetInterestCount(c.Request.Context(), eventID, userID)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.interest.count_success"),
+		count,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *EventInterestHandler) parseEventRequest(c *gin.Context) (int, int, bool) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.unauthorized"),
+			nil,
+		)
+		c.JSON(http.StatusUnauthorized, response)
+		return 0, 0, false
+	}
+
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.validation_failed"),
+			"Invalid event ID",
+		)
+		c.JSON(http.StatusBadRequest, response)
+		return 0, 0, false
+	}
+
+	return int(eventID), int(userID), true
+}
+
+func (h *EventInterestHandler) respondError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domain.ErrEventNotFound):
+		c.JSON(http.StatusNotFound, dto.NewErrorResponse(middleware.Translate(c, "event.not_found"), nil))
+	case errors.Is(err, domain.ErrCreatorProfileNotFound):
+		c.JSON(http.StatusNotFound, dto.NewErrorResponse(middleware.Translate(c, "creator.not_found"), nil))
+	case errors.Is(err, domain.ErrEventUnauthorized):
+		c.JSON(http.StatusForbidden, dto.NewErrorResponse(middleware.Translate(c, "event.access_denied"), nil))
+	default:
+		message, isDomainErr := middleware.TranslateError(c, err, "event.interest.failed")
+		status := http.StatusInternalServerError
+		if isDomainErr {
+			status = http.StatusBadRequest
+		}
+		c.JSON(status, dto.NewErrorResponse(message, nil))
+	}
+}
@@ -0,0 +1,205 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/i18n"
+	"github.com/louco-event/internal/middleware"
+	"github.com/louco-event/internal/service"
+)
+
+type EventJoinRequestHandler struct {
+	joinRequestService service.EventJoinRequestService
+	i18n               *i18n.I18n
+}
+
+func NewEventJoinRequestHandler(joinRequestService service.EventJoinRequestService, i18n *i18n.I18n) *EventJoinRequestHandler {
+	return &EventJoinRequestHandler{
+		joinRequestService: joinRequestService,
+		i18n:               i18n,
+	}
+}
+
+// RequestToJoin asks the organizer of a private event for an invitation
+func (h *EventJoinRequestHandler) RequestToJoin(c *gin.Context) {
+	eventID, userID, ok := h.parseEventRequest(c)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateEventJoinRequestRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.validation_failed"),
+			err.Error(),
+		)
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	request, err := h.joinRequestService.RequestToJoinEvent(c.Request.Context(), eventID, userID, req)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.join_request.create_success"),
+		request,
+	)
+	c.JSON(http.StatusCreated, response)
+}
+
+// GetJoinRequests lists join requests for the organizer, optionally filtered by status
+func (h *EventJoinRequestHandler) GetJoinRequests(c *gin.Context) {
+	eventID, userID, ok := h.parseEventRequest(c)
+	if !ok {
+		return
+	}
+
+	var filters dto.EventJoinRequestFilterRequest
+	if err := c.ShouldBindQuery(&filters); err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.validation_failed"),
+			err.Error(),
+		)
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	var pagination dto.PaginationRequest
+	if err := c.ShouldBindQuery(&pagination); err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.validation_failed"),
+			err.Error(),
+		)
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	requests, paginationResp, err := h.joinRequestService.GetJoinRequests(c.Request.Context(), eventID, userID, filters, pagination)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	response := dto.NewListSuccessResponse(
+		middleware.Translate(c, "event.join_request.list_success"),
+		requests,
+		*paginationResp,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// ApproveJoinRequest approves a join request and invites the user
+func (h *EventJoinRequestHandler) ApproveJoinRequest(c *gin.Context) {
+	eventID, userID, ok := h.parseEventRequest(c)
+	if !ok {
+		return
+	}
+	requestID, ok := h.parseRequestID(c)
+	if !ok {
+		return
+	}
+
+	request, err := h.joinRequestService.ApproveJoinRequest(c.Request.Context(), eventID, requestID, userID)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.join_request.approve_success"),
+		request,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// RejectJoinRequest declines a join request
+func (h *EventJoinRequestHandler) RejectJoinRequest(c *gin.Context) {
+	eventID, userID, ok := h.parseEventRequest(c)
+	if !ok {
+		return
+	}
+	requestID, ok := h.parseRequestID(c)
+	if !ok {
+		return
+	}
+
+	request, err := h.joinRequestService.RejectJoinRequest(c.Request.Context(), eventID, requestID, userID)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.join_request.reject_success"),
+		request,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *EventJoinRequestHandler) parseEventRequest(c *gin.Context) (int, int, bool) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.unauthorized"),
+			nil,
+		)
+		c.JSON(http.StatusUnauthorized, response)
+		return 0, 0, false
+	}
+
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.validation_failed"),
+			"Invalid event ID",
+		)
+		c.JSON(http.StatusBadRequest, response)
+		return 0, 0, false
+	}
+
+	return int(eventID), int(userID), true
+}
+
+func (h *EventJoinRequestHandler) parseRequestID(c *gin.Context) (int, bool) {
+	requestID, err := strconv.ParseInt(c.Param("request_id"), 10, 32)
+	if err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.validation_failed"),
+			"Invalid join request ID",
+		)
+		c.JSON(http.StatusBadRequest, response)
+		return 0, false
+	}
+	return int(requestID), true
+}
+
+func (h *EventJoinRequestHandler) respondError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domain.ErrEventNotFound):
+		c.JSON(http.StatusNotFound, dto.NewErrorResponse(middleware.Translate(c, "event.not_found"), nil))
+	case errors.Is(err, domain.ErrEventJoinRequestNotFound):
+		c.JSON(http.StatusNotFound, dto.NewErrorResponse(middleware.Translate(c, "event.join_request.not_found"), nil))
+	case err.Error() == "user not found":
+		c.JSON(http.StatusNotFound, dto.NewErrorResponse(middleware.Translate(c, "user.not_found"), nil))
+	case errors.Is(err, domain.ErrCreatorProfileNotFound):
+		c.JSON(http.StatusNotFound, dto.NewErrorResponse(middleware.Translate(c, "creator.not_found"), nil))
+	case errors.Is(err, domain.ErrEventUnauthorized):
+		c.JSON(http.StatusForbidden, dto.NewErrorResponse(middleware.Translate(c, "event.access_denied"), nil))
+	default:
+		message, isDomainErr := middleware.TranslateError(c, err, "event.join_request.failed")
+		status := http.StatusInternalServerError
+		if isDomainErr {
+			status = http.StatusBadRequest
+		}
+		c.JSON(status, dto.NewErrorResponse(message, nil))
+	}
+}
@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/i18n"
+	"github.com/louco-event/internal/middleware"
+	"github.com/louco-event/internal/service"
+)
+
+type EventRatingHandler struct {
+	ratingService service.EventRatingService
+	i18n          *i18n.I18n
+}
+
+func NewEventRatingHandler(ratingService service.EventRatingService, i18n *i18n.I18n) *EventRatingHandler {
+	return &EventRatingHandler{
+		ratingService: ratingService,
+		i18n:          i18n,
+	}
+}
+
+// RateEvent rates a completed event the user attended; rating again updates the earlier rating
+func (h *EventRatingHandler) RateEvent(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.unauthorized"),
+			nil,
+		)
+		c.JSON(http.StatusUnauthorized, response)
+		return
+	}
+
+	eventID, ok := h.parseEventID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.RateEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.validation_failed"),
+			err.Error(),
+		)
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	rating, err := h.ratingService.RateEvent(c.Request.Context(), eventID, int(userID), req)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.rating.rate_success"),
+		rating,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetEventRating returns the public average rating and rating count of an event
+func (h *EventRatingHandler) GetEventRating(c *gin.Context) {
+	eventID, ok := h.parseEventID(c)
+	if !ok {
+		return
+	}
+
+	var userID *int
+	if uid, exists := middleware.GetCurrentUserID(c); exists {
+		id := int(uid)
+		userID = &id
+	}
+
+	summary, err := h.ratingService.GetEventRatingSummary(c.Request.Context(), eventID, userID)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.rating.summary_success"),
+		summary,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *EventRatingHandler) parseEventID(c *gin.Context) (int, bool) {
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.validation_failed"),
+			"Invalid event ID",
+		)
+		c.JSON(http.StatusBadRequest, response)
+		return 0, false
+	}
+	return int(eventID), true
+}
+
+func (h *EventRatingHandler) respondError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domain.ErrEventNotFound):
+		c.JSON(http.StatusNotFound, dto.NewErrorResponse(middleware.Translate(c, "event.not_found"), nil))
+	case errors.Is(err, domain.ErrEventUnauthorized), errors.Is(err, domain.ErrEventRatingNotAttended):
+		message, _ := middleware.TranslateError(c, err, "event.access_denied")
+		c.JSON(http.StatusForbidden, dto.NewErrorResponse(message, nil))
+	default:
+		message, isDomainErr := middleware.TranslateError(c, err, "event.rating.failed")
+		status := http.StatusInternalServerError
+		if isDomainErr {
+			status = http.StatusBadRequest
+		}
+		c.JSON(status, dto.NewErrorResponse(message, nil))
+	}
+}
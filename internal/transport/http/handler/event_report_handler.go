@@ -0,0 +1,196 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/i18n"
+	"github.com/louco-event/internal/middleware"
+	"github.com/louco-event/internal/service"
+)
+
+type EventReportHandler struct {
+	eventReportService service.EventReportService
+	i18n               *i18n.I18n
+}
+
+func NewEventReportHandler(eventReportService service.EventReportService, i18n *i18n.I18n) *EventReportHandler {
+	return &EventReportHandler{
+		eventReportService: eventReportService,
+		i18n:               i18n,
+	}
+}
+
+// ReportEvent flags an event as inappropriate
+func (h *EventReportHandler) ReportEvent(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.unauthorized"),
+			nil,
+		)
+		c.JSON(http.StatusUnauthorized, response)
+		return
+	}
+
+	eventIDStr := c.Param("id")
+	eventID, err := strconv.ParseInt(eventIDStr, 10, 32)
+	if err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.validation_failed"),
+			"Invalid event ID",
+		)
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	var req dto.ReportEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.validation_failed"),
+			err.Error(),
+		)
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	report, err := h.eventReportService.ReportEvent(c.Request.Context(), int(eventID), int(userID), req)
+	if err != nil {
+		var status int
+		var message string
+
+		switch {
+		case errors.Is(err, domain.ErrEventReportInvalidReason):
+			status = http.StatusBadRequest
+			message = middleware.Translate(c, "event.report.invalid_reason")
+		case errors.Is(err, domain.ErrEventNotFound):
+			status = http.StatusNotFound
+			message = middleware.Translate(c, "event.not_found")
+		case errors.Is(err, domain.ErrEventReportDuplicate):
+			status = http.StatusConflict
+			message = middleware.Translate(c, "event.report.duplicate")
+		default:
+			status = http.StatusInternalServerError
+			message = middleware.Translate(c, "event.report.failed")
+		}
+
+		response := dto.NewErrorResponse(message, nil)
+		c.JSON(status, response)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.report.success"),
+		report,
+	)
+	c.JSON(http.StatusCreated, response)
+}
+
+// GetReports lists event reports for admins
+func (h *EventReportHandler) GetReports(c *gin.Context) {
+	var filters dto.EventReportFilterRequest
+	if err := c.ShouldBindQuery(&filters); err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.validation_failed"),
+			err.Error(),
+		)
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	var pagination dto.PaginationRequest
+	if err := c.ShouldBindQuery(&pagination); err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.validation_failed"),
+			err.Error(),
+		)
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	reports, paginationResp, err := h.eventReportService.GetReports(c.Request.Context(), filters, pagination)
+	if err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "event.report.list_failed"),
+			nil,
+		)
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	response := dto.NewListSuccessResponse(
+		middleware.Translate(c, "event.report.list_success"),
+		reports,
+		*paginationResp,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// ResolveReport resolves or dismisses an event report
+func (h *EventReportHandler) ResolveReport(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.unauthorized"),
+			nil,
+		)
+		c.JSON(http.StatusUnauthorized, response)
+		return
+	}
+
+	reportIDStr := c.Param("id")
+	reportID, err := strconv.ParseInt(reportIDStr, 10, 32)
+	if err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.validation_failed"),
+			"Invalid report ID",
+		)
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	var req dto.ResolveEventReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.validation_failed"),
+			err.Error(),
+		)
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	report, err := h.eventReportService.ResolveReport(c.Request.Context(), int(reportID), int(userID), req)
+	if err != nil {
+		var status int
+		var message string
+
+		switch {
+		case errors.Is(err, domain.ErrEventReportNotFound):
+			status = http.StatusNotFound
+			message = middleware.Translate(c, "event.report.not_found")
+		case errors.Is(err, domain.ErrEventReportInvalidStatus):
+			status = http.StatusBadRequest
+			message = middleware.Translate(c, "event.report.invalid_status")
+		case errors.Is(err, domain.ErrEventReportAlreadyClosed):
+			status = http.StatusConflict
+			message = middleware.Translate(c, "event.report.already_closed")
+		default:
+			status = http.StatusInternalServerError
+			message = middleware.Translate(c, "event.report.resolve_failed")
+		}
+
+		response := dto.NewErrorResponse(message, nil)
+		c.JSON(status, response)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.report.resolve_success"),
+		report,
+	)
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,176 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/i18n"
+	"github.com/louco-event/internal/middleware"
+	"github.com/louco-event/internal/service"
+)
+
+type EventShareHandler struct {
+	eventShareService service.EventShareService
+	i18n              *i18n.I18n
+}
+
+func NewEventShareHandler(eventShareService service.EventShareService, i18n *i18n.I18n) *EventShareHandler {
+	return &EventShareHandler{
+		eventShareService: eventShareService,
+		i18n:              i18n,
+	}
+}
+
+// GetShareToken returns the event's current share token and whether it is enabled
+func (h *EventShareHandler) GetShareToken(c *gin.Context) {
+	eventID, userID, ok := h.parseOwnerRequest(c)
+	if !ok {
+		return
+	}
+
+	token, err := h.eventShareService.GetShareToken(c.Request.Context(), eventID, userID)
+	if err != nil {
+		h.respondOwnerError(c, err)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.share.token_success"),
+		token,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// RegenerateShareToken issues a new share token, invalidating the old link
+func (h *EventShareHandler) RegenerateShareToken(c *gin.Context) {
+	eventID, userID, ok := h.parseOwnerRequest(c)
+	if !ok {
+		return
+	}
+
+	token, err := h.eventShareService.RegenerateShareToken(c.Request.Context(), eventID, userID)
+	if err != nil {
+		h.respondOwnerError(c, err)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.share.regenerated"),
+		token,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// RevokeShareToken disables the event's share link
+func (h *EventShareHandler) RevokeShareToken(c *gin.Context) {
+	eventID, userID, ok := h.parseOwnerRequest(c)
+	if !ok {
+		return
+	}
+
+	if err := h.eventShareService.RevokeShareToken(c.Request.Context(), eventID, userID); err != nil {
+		h.respondOwnerError(c, err)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.share.revoked"),
+		nil,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetShareTokenStats returns how often the current share link has been used
+func (h *EventShareHandler) GetShareTokenStats(c *gin.Context) {
+	eventID, userID, ok := h.parseOwnerRequest(c)
+	if !ok {
+		return
+	}
+
+	stats, err := h.eventShareService.GetShareTokenStats(c.Request.Context(), eventID, userID)
+	if err != nil {
+		h.respondOwnerError(c, err)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.share.stats_success"),
+		stats,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetSharedEvent opens an event through its share link
+func (h *EventShareHandler) GetSharedEvent(c *gin.Context) {
+	var userID *int
+	if uid, exists := middleware.GetCurrentUserID(c); exists {
+		id := int(uid)
+		userID = &id
+	}
+
+	event, err := h.eventShareService.GetEventByShareToken(c.Request.Context(), c.Param("token"), userID, c.ClientIP())
+	if err != nil {
+		if errors.Is(err, domain.ErrEventNotFound) {
+			response := dto.NewErrorResponse(
+				middleware.Translate(c, "event.not_found"),
+				nil,
+			)
+			c.JSON(http.StatusNotFound, response)
+			return
+		}
+
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "event.get.failed"),
+			nil,
+		)
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.get.success"),
+		event,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *EventShareHandler) parseOwnerRequest(c *gin.Context) (int, int, bool) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.unauthorized"),
+			nil,
+		)
+		c.JSON(http.StatusUnauthorized, response)
+		return 0, 0, false
+	}
+
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.validation_failed"),
+			"Invalid event ID",
+		)
+		c.JSON(http.StatusBadRequest, response)
+		return 0, 0, false
+	}
+
+	return int(eventID), int(userID), true
+}
+
+func (h *EventShareHandler) respondOwnerError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domain.ErrEventNotFound):
+		c.JSON(http.StatusNotFound, dto.NewErrorResponse(middleware.Translate(c, "event.not_found"), nil))
+	case errors.Is(err, domain.ErrCreatorProfileNotFound):
+		c.JSON(http.StatusNotFound, dto.NewErrorResponse(middleware.Translate(c, "creator.not_found"), nil))
+	case errors.Is(err, domain.ErrEventUnauthorized):
+		c.JSON(http.StatusForbidden, dto.NewErrorResponse(middleware.Translate(c, "event.access_denied"), nil))
+	default:
+		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(middleware.Translate(c, "event.share.failed"), nil))
+	}
+}
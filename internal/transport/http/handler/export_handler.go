@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/i18n"
+	"github.com/louco-event/internal/middleware"
+	"github.com/louco-event/internal/service"
+)
+
+type ExportHandler struct {
+	exportService service.ExportService
+	i18n          *i18n.I18n
+}
+
+func NewExportHandler(exportService service.ExportService, i18n *i18n.I18n) *ExportHandler {
+	return &ExportHandler{
+		exportService: exportService,
+		i18n:          i18n,
+	}
+}
+
+// CreateExport queues a CSV export; poll GetExport for the download URL
+func (h *ExportHandler) CreateExport(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.unauthorized"),
+			nil,
+		)
+		c.JSON(http.StatusUnauthorized, response)
+		return
+	}
+
+	var req dto.CreateExportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.validation_failed"),
+			err.Error(),
+		)
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	job, err := h.exportService.CreateExport(c.Request.Context(), userID, req)
+	if err != nil {
+		var status int
+		var message string
+
+		switch {
+		case errors.Is(err, domain.ErrExportJobInvalidType):
+			status = http.StatusBadRequest
+			message = middleware.Translate(c, "export.invalid_type")
+		case errors.Is(err, domain.ErrEventNotFound):
+			status = http.StatusNotFound
+			message = middleware.Translate(c, "event.not_found")
+		case errors.Is(err, domain.ErrCreatorProfileNotFound):
+			status = http.StatusForbidden
+			message = middleware.Translate(c, "creator.not_found")
+		case errors.Is(err, domain.ErrEventUnauthorized):
+			status = http.StatusForbidden
+			message = middleware.Translate(c, "event.access_denied")
+		default:
+			status = http.StatusInternalServerError
+			message = middleware.Translate(c, "export.create.failed")
+		}
+
+		response := dto.NewErrorResponse(message, nil)
+		c.JSON(status, response)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "export.create.success"),
+		job,
+	)
+	c.JSON(http.StatusAccepted, response)
+}
+
+// GetExport returns the status of an export and its download URL once done
+func (h *ExportHandler) GetExport(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.unauthorized"),
+			nil,
+		)
+		c.JSON(http.StatusUnauthorized, response)
+		return
+	}
+
+	jobIDStr := c.Param("id")
+	jobID, err := strconv.ParseInt(jobIDStr, 10, 32)
+	if err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.validation_failed"),
+			"Invalid export ID",
+		)
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	job, err := h.exportService.GetExport(c.Request.Context(), userID, int(jobID))
+	if err != nil {
+		status := http.StatusInternalServerError
+		message := middleware.Translate(c, "export.get.failed")
+		if errors.Is(err, domain.ErrExportJobNotFound) {
+			status = http.StatusNotFound
+			message = middleware.Translate(c, "export.not_found")
+		}
+
+		response := dto.NewErrorResponse(message, nil)
+		c.JSON(status, response)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "export.get.success"),
+		job,
+	)
+	c.JSON(http.StatusOK, response)
+}
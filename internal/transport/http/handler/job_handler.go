@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/middleware"
+	"github.com/louco-event/internal/scheduler"
+)
+
+// JobStatuses reports the latest execution of each background job
+func JobStatuses(s *scheduler.Scheduler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		response := dto.NewSuccessResponse(
+			middleware.Translate(c, "admin.jobs.success"),
+			s.Statuses(),
+		)
+		c.JSON(http.StatusOK, response)
+	}
+}
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/louco-event/internal/scheduler"
+	"github.com/rs/zerolog"
+)
+
+func TestJobStatusesReflectLastRun(t *testing.T) {
+	// The first run fails and every later one succeeds
+	var runs atomic.Int32
+	jobs := scheduler.New(zerolog.Nop())
+	jobs.Register("reservation_release", 5*time.Millisecond, func(ctx context.Context) error {
+		if runs.Add(1) == 1 {
+			return errors.New("database unavailable")
+		}
+		return nil
+	})
+
+	jobs.Start(context.Background())
+	deadline := time.Now().Add(5 * time.Second)
+	for runs.Load() < 2 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	jobs.Stop()
+	if runs.Load() < 2 {
+		t.Fatal("job did not run twice")
+	}
+
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.GET("/admin/jobs", JobStatuses(jobs))
+	recorder := httptest.NewRecorder()
+	engine.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/admin/jobs", nil))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("got %d, want 200", recorder.Code)
+	}
+
+	var body struct {
+		Data []scheduler.JobStatus `json:"data"`
+	}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &body); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(body.Data) != 1 {
+		t.Fatalf("got %d jobs, want 1", len(body.Data))
+	}
+	status := body.Data[0]
+	if status.Name != "reservation_release" || status.Running {
+		t.Errorf("status = %+v, want reservation_release at rest", status)
+	}
+	if status.RunCount != int(runs.Load()) || status.FailureCount != 1 {
+		t.Errorf("run count %d, failure count %d; want %d runs and 1 failure", status.RunCount, status.FailureCount, runs.Load())
+	}
+	if status.LastResult != scheduler.JobResultSuccess || status.LastError != nil {
+		t.Errorf("last result %q, error %v; want the latest successful run", status.LastResult, status.LastError)
+	}
+	if status.LastRunAt == nil || status.NextRunAt == nil || status.LastDuration == "" {
+		t.Errorf("status = %+v, want last run, duration and next run set", status)
+	}
+}
@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/i18n"
+	"github.com/louco-event/internal/middleware"
+	"github.com/louco-event/internal/service"
+)
+
+type MaintenanceHandler struct {
+	maintenanceService service.MaintenanceService
+	i18n               *i18n.I18n
+}
+
+func NewMaintenanceHandler(maintenanceService service.MaintenanceService, i18n *i18n.I18n) *MaintenanceHandler {
+	return &MaintenanceHandler{
+		maintenanceService: maintenanceService,
+		i18n:               i18n,
+	}
+}
+
+// PurgeOldRecords deletes records past their retention window; dry_run=true only counts them
+func (h *MaintenanceHandler) PurgeOldRecords(c *gin.Context) {
+	var req dto.PurgeRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.validation_failed"),
+			err.Error(),
+		)
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	result, err := h.maintenanceService.PurgeConfigured(c.Request.Context(), req.DryRun)
+	if err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "admin.purge.failed"),
+			nil,
+		)
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "admin.purge.success"),
+		result,
+	)
+	c.JSON(http.StatusOK, response)
+}
@@ -95,7 +95,8 @@ func (h *MediaHandler) GetMedia(c *gin.Context) {
 		return
 	}
 
-	result, err := h.mediaService.GetMediaByID(c.Request.Context(), mediaID)
+	viewerID, _ := middleware.GetCurrentUserID(c)
+	result, err := h.mediaService.GetMediaByID(c.Request.Context(), mediaID, viewerID)
 	if err != nil {
 		var message string
 		if err.Error() == "media not found" {
@@ -116,6 +117,62 @@ func (h *MediaHandler) GetMedia(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// ServeSignedMedia redirects a valid signed media link to the file. The signature in the
+// query authorizes the request, so the route needs no login.
+func (h *MediaHandler) ServeSignedMedia(c *gin.Context) {
+	mediaID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.validation_failed"),
+			"Invalid media ID",
+		)
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	expires, err := strconv.ParseInt(c.Query("expires"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusForbidden, dto.NewErrorResponse(middleware.Translate(c, "media.signed_url.invalid"), nil))
+		return
+	}
+
+	storageURL, err := h.mediaService.ResolveSignedURL(c.Request.Context(), mediaID, expires, c.Query("signature"))
+	if err != nil {
+		switch err.Error() {
+		case "invalid signature":
+			c.JSON(http.StatusForbidden, dto.NewErrorResponse(middleware.Translate(c, "media.signed_url.invalid"), nil))
+		case "signed url expired":
+			c.JSON(http.StatusGone, dto.NewErrorResponse(middleware.Translate(c, "media.signed_url.expired"), nil))
+		case "media not found":
+			c.JSON(http.StatusNotFound, dto.NewErrorResponse(middleware.Translate(c, "media.not_found"), nil))
+		default:
+			c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(middleware.Translate(c, "common.internal_server_error"), nil))
+		}
+		return
+	}
+
+	c.Header("Cache-Control", "private, no-store")
+	c.Redirect(http.StatusFound, storageURL)
+}
+
+// ServeMediaFile redirects the file URL of media that does not belong to a private event to
+// the stored file. Private event media is only served through signed links.
+func (h *MediaHandler) ServeMediaFile(c *gin.Context) {
+	storageURL, err := h.mediaService.ResolveFileURL(c.Request.Context(), c.Param("file_name"))
+	if err != nil {
+		if err.Error() == "media not found" {
+			c.JSON(http.StatusNotFound, dto.NewErrorResponse(middleware.Translate(c, "media.not_found"), nil))
+			return
+		}
+		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(middleware.Translate(c, "common.internal_server_error"), nil))
+		return
+	}
+
+	// The storage link expires within minutes, so the redirect may only be cached briefly
+	c.Header("Cache-Control", "public, max-age=60")
+	c.Redirect(http.StatusFound, storageURL)
+}
+
 func (h *MediaHandler) GetUserMedia(c *gin.Context) {
 	userIDStr := c.Param("user_id")
 	userID, err := strconv.Atoi(userIDStr)
@@ -147,7 +204,8 @@ func (h *MediaHandler) GetUserMedia(c *gin.Context) {
 		req.MediaType = mediaType
 	}
 
-	result, err := h.mediaService.GetUserMedia(c.Request.Context(), userID, &req)
+	viewerID, _ := middleware.GetCurrentUserID(c)
+	result, err := h.mediaService.GetUserMedia(c.Request.Context(), userID, viewerID, &req)
 	if err != nil {
 		response := dto.NewErrorResponse(
 			middleware.Translate(c, "common.internal_server_error"),
@@ -298,7 +356,8 @@ func (h *MediaHandler) GetAllMedia(c *gin.Context) {
 	var err error
 
 	if req.UserID != nil {
-		result, err = h.mediaService.GetUserMedia(c.Request.Context(), *req.UserID, &req)
+		viewerID, _ := middleware.GetCurrentUserID(c)
+		result, err = h.mediaService.GetUserMedia(c.Request.Context(), *req.UserID, viewerID, &req)
 	} else {
 		// TODO: Implement GetAllMedia method in service for admin
 		response := dto.NewErrorResponse(
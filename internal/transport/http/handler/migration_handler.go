@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/middleware"
+	"github.com/louco-event/pkg/database"
+)
+
+// MigrationStatus reports the applied schema version and whether it is up to date
+func MigrationStatus(db *database.Database) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		status, err := db.MigrationStatus()
+		if err != nil {
+			response := dto.NewErrorResponse(
+				middleware.Translate(c, "admin.migrations.failed"),
+				nil,
+			)
+			c.JSON(http.StatusInternalServerError, response)
+			return
+		}
+
+		response := dto.NewSuccessResponse(
+			middleware.Translate(c, "admin.migrations.success"),
+			status,
+		)
+		c.JSON(http.StatusOK, response)
+	}
+}
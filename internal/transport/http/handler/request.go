@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/middleware"
+)
+
+// Request helpers write the standard error envelope when they fail; the handler only returns.
+
+// requireUser returns the authenticated user's id, or responds 401 and reports false
+func requireUser(c *gin.Context) (int, bool) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.unauthorized"),
+			nil,
+		)
+		c.JSON(http.StatusUnauthorized, response)
+		return 0, false
+	}
+	return userID, true
+}
+
+// parseIDParam reads a numeric path parameter such as "id" or "invitation_id", or responds
+// 400 and reports false. label names the ID in the error details, e.g. "event ID".
+func parseIDParam(c *gin.Context, name, label string) (int, bool) {
+	id, err := strconv.ParseInt(c.Param(name), 10, 32)
+	if err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.validation_failed"),
+			"Invalid "+label,
+		)
+		c.JSON(http.StatusBadRequest, response)
+		return 0, false
+	}
+	return int(id), true
+}
+
+// bindJSON decodes the request body into req, or responds 400 and reports false
+func bindJSON(c *gin.Context, req interface{}) bool {
+	if err := c.ShouldBindJSON(req); err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.validation_failed"),
+			err.Error(),
+		)
+		c.JSON(http.StatusBadRequest, response)
+		return false
+	}
+	return true
+}
+
+// bindQuery decodes the query string into req, or responds 400 and reports false
+func bindQuery(c *gin.Context, req interface{}) bool {
+	if err := c.ShouldBindQuery(req); err != nil {
+		response := dto.NewErrorResponse(
+			middleware.Translate(c, "common.validation_failed"),
+			err.Error(),
+		)
+		c.JSON(http.StatusBadRequest, response)
+		return false
+	}
+	return true
+}
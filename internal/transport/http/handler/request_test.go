@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/louco-event/internal/i18n"
+	"github.com/louco-event/internal/middleware"
+)
+
+// newRequestTestEngine mounts one route that runs every request helper in order, so each case
+// can fail at a different step
+func newRequestTestEngine(t *testing.T) *gin.Engine {
+	t.Helper()
+	translator, err := i18n.New("../../../i18n/locales", "en")
+	if err != nil {
+		t.Fatalf("failed to load translations: %v", err)
+	}
+
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.Use(middleware.I18n(translator))
+	engine.POST("/events/:event_id", func(c *gin.Context) {
+		// Stands in for JWTAuth, which stores the token's user id
+		if c.GetHeader("X-User") != "" {
+			c.Set("user_id", 7)
+		}
+		if _, ok := requireUser(c); !ok {
+			return
+		}
+		if _, ok := parseIDParam(c, "event_id", "event ID"); !ok {
+			return
+		}
+		var req struct {
+			Name string `json:"name" binding:"required"`
+		}
+		if !bindJSON(c, &req) {
+			return
+		}
+		c.Status(http.StatusNoContent)
+	})
+	// Routes whose parameter is a bare :id still name the entity in the details
+	engine.GET("/events/:id", func(c *gin.Context) {
+		if _, ok := parseIDParam(c, "id", "event ID"); ok {
+			c.Status(http.StatusNoContent)
+		}
+	})
+	engine.GET("/creators/:id/upcoming-events", func(c *gin.Context) {
+		if _, ok := parseIDParam(c, "id", "creator ID"); ok {
+			c.Status(http.StatusNoContent)
+		}
+	})
+	return engine
+}
+
+func TestRequestHelpers(t *testing.T) {
+	engine := newRequestTestEngine(t)
+
+	tests := []struct {
+		name        string
+		path        string
+		user        bool
+		body        string
+		wantStatus  int
+		wantMessage string
+		wantErrors  string
+	}{
+		{"missing auth", "/events/1", false, `{"name":"Launch"}`, http.StatusUnauthorized, "Unauthorized access", ""},
+		{"non-numeric id", "/events/abc", true, `{"name":"Launch"}`, http.StatusBadRequest, "Validation failed", "Invalid event ID"},
+		{"id out of range", "/events/99999999999", true, `{"name":"Launch"}`, http.StatusBadRequest, "Validation failed", "Invalid event ID"},
+		{"malformed body", "/events/1", true, `{"name":`, http.StatusBadRequest, "Validation failed", "unexpected EOF"},
+		{"missing required field", "/events/1", true, `{}`, http.StatusBadRequest, "Validation failed", "'required'"},
+		{"valid", "/events/1", true, `{"name":"Launch"}`, http.StatusNoContent, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.user {
+				req.Header.Set("X-User", "7")
+			}
+			recorder := httptest.NewRecorder()
+			engine.ServeHTTP(recorder, req)
+
+			if recorder.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", recorder.Code, tt.wantStatus)
+			}
+			if tt.wantStatus == http.StatusNoContent {
+				return
+			}
+
+			var response struct {
+				Success bool   `json:"success"`
+				Message string `json:"message"`
+				Errors  any    `json:"errors"`
+			}
+			if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+				t.Fatalf("invalid response body %q: %v", recorder.Body.String(), err)
+			}
+			if response.Success || response.Message != tt.wantMessage {
+				t.Errorf("response = %+v, want a failure with message %q", response, tt.wantMessage)
+			}
+			if details, _ := response.Errors.(string); !strings.Contains(details, tt.wantErrors) {
+				t.Errorf("errors = %v, want them to mention %q", response.Errors, tt.wantErrors)
+			}
+		})
+	}
+}
+
+func TestParseIDParamLabels(t *testing.T) {
+	engine := newRequestTestEngine(t)
+
+	tests := []struct {
+		path        string
+		wantStatus  int
+		wantDetails string
+	}{
+		{"/events/abc", http.StatusBadRequest, "Invalid event ID"},
+		{"/creators/abc/upcoming-events", http.StatusBadRequest, "Invalid creator ID"},
+		{"/events/42", http.StatusNoContent, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			engine.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			if recorder.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", recorder.Code, tt.wantStatus)
+			}
+			if tt.wantStatus == http.StatusNoContent {
+				return
+			}
+			var response struct {
+				Errors any `json:"errors"`
+			}
+			if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+				t.Fatalf("invalid response body %q: %v", recorder.Body.String(), err)
+			}
+			if response.Errors != tt.wantDetails {
+				t.Errorf("errors = %v, want %q", response.Errors, tt.wantDetails)
+			}
+		})
+	}
+}
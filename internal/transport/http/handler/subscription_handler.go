@@ -3,39 +3,53 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/louco-event/internal/domain"
 	"github.com/louco-event/internal/dto"
 	"github.com/louco-event/internal/i18n"
+	"github.com/louco-event/internal/middleware"
 	"github.com/louco-event/internal/service"
 	"github.com/louco-event/pkg/logger"
 	"github.com/louco-event/pkg/stripe"
 )
 
 type SubscriptionHandler struct {
-	subscriptionService service.SubscriptionService
-	userService         service.UserService
-	stripeService       *stripe.StripeService
-	i18n                *i18n.I18n
-	logger              *logger.Logger
+	subscriptionService  service.SubscriptionService
+	failedWebhookService service.FailedWebhookService
+	userService          service.UserService
+	checkoutService      service.CheckoutService
+	stripeService        *stripe.StripeService
+	// allowUnsignedWebhooks accepts webhooks without a Stripe-Signature header; development only
+	allowUnsignedWebhooks bool
+	i18n                  *i18n.I18n
+	logger                *logger.Logger
 }
 
 func NewSubscriptionHandler(
 	subscriptionService service.SubscriptionService,
+	failedWebhookService service.FailedWebhookService,
 	userService service.UserService,
+	checkoutService service.CheckoutService,
 	stripeService *stripe.StripeService,
+	allowUnsignedWebhooks bool,
 	i18n *i18n.I18n,
 	logger *logger.Logger,
 ) *SubscriptionHandler {
 	return &SubscriptionHandler{
-		subscriptionService: subscriptionService,
-		userService:         userService,
-		stripeService:       stripeService,
-		i18n:                i18n,
-		logger:              logger,
+		subscriptionService:   subscriptionService,
+		failedWebhookService:  failedWebhookService,
+		userService:           userService,
+		checkoutService:       checkoutService,
+		stripeService:         stripeService,
+		allowUnsignedWebhooks: allowUnsignedWebhooks,
+		i18n:                  i18n,
+		logger:                logger,
 	}
 }
 
@@ -474,8 +488,21 @@ func (h *SubscriptionHandler) PurchaseSubscription(c *gin.Context) {
 		email = *user.Email
 	}
 
+	// Only users who have never had a trial get one
+	trialDays, err := h.subscriptionService.GetEligibleTrialDays(c.Request.Context(), userID, req.PlanID)
+	if err != nil {
+		h.logger.Error().Err(err).Uint("user_id", userID).Uint("plan_id", req.PlanID).Msg("Failed to check trial eligibility")
+		c.JSON(http.StatusInternalServerError, dto.APIResponse{
+			Success: false,
+			Message: h.i18n.Translate(lang, "subscription.purchase.failed"),
+			Data:    nil,
+			Errors:  []string{err.Error()},
+		})
+		return
+	}
+
 	// Create Stripe Checkout Session for subscription
-	checkoutSession, err := h.stripeService.CreateCheckoutSessionForSubscription(c.Request.Context(), plan, email, user.FullName, userID)
+	checkoutSession, err := h.stripeService.CreateCheckoutSessionForSubscription(c.Request.Context(), plan, email, user.FullName, userID, trialDays)
 	if err != nil {
 		h.logger.Error().Err(err).Uint("user_id", userID).Uint("plan_id", req.PlanID).Msg("Failed to create Stripe checkout session")
 		c.JSON(http.StatusInternalServerError, dto.APIResponse{
@@ -638,6 +665,18 @@ func (h *SubscriptionHandler) PurchasePackage(c *gin.Context) {
 // @Router /subscriptions/{id}/cancel [post]
 func (h *SubscriptionHandler) CancelSubscription(c *gin.Context) {
 	lang := c.GetString("lang")
+	userIDInt, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.APIResponse{
+			Success: false,
+			Message: h.i18n.Translate(lang, "auth.token_required"),
+			Data:    nil,
+			Errors:  []string{"User ID not found in token"},
+		})
+		return
+	}
+
+	userID := uint(userIDInt.(int))
 
 	subscriptionIDStr := c.Param("id")
 	subscriptionID, err := strconv.ParseUint(subscriptionIDStr, 10, 32)
@@ -651,14 +690,30 @@ func (h *SubscriptionHandler) CancelSubscription(c *gin.Context) {
 		return
 	}
 
+	// The body is optional, but a malformed one is rejected
 	var req dto.CancelSubscriptionRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		// Reason is optional, so we can ignore binding errors
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, dto.APIResponse{
+			Success: false,
+			Message: h.i18n.Translate(lang, "validation.invalid_request"),
+			Data:    nil,
+			Errors:  []string{err.Error()},
+		})
+		return
 	}
 
-	if err := h.subscriptionService.CancelSubscription(c.Request.Context(), uint(subscriptionID)); err != nil {
-		h.logger.Error().Err(err).Uint("subscription_id", uint(subscriptionID)).Msg("Failed to cancel subscription")
-		c.JSON(http.StatusInternalServerError, dto.APIResponse{
+	if err := h.subscriptionService.CancelSubscription(c.Request.Context(), userID, uint(subscriptionID), req.CancelAtPeriodEnd, req.Reason); err != nil {
+		h.logger.Error().Err(err).Uint("user_id", userID).Uint("subscription_id", uint(subscriptionID)).Msg("Failed to cancel subscription")
+
+		status := http.StatusInternalServerError
+		switch err.Error() {
+		case "subscription not found":
+			status = http.StatusNotFound
+		case "only subscriptions can be cancelled at period end", "subscription is not active":
+			status = http.StatusBadRequest
+		}
+
+		c.JSON(status, dto.APIResponse{
 			Success: false,
 			Message: h.i18n.Translate(lang, "subscription.cancel.failed"),
 			Data:    nil,
@@ -667,14 +722,368 @@ func (h *SubscriptionHandler) CancelSubscription(c *gin.Context) {
 		return
 	}
 
+	messageKey := "subscription.cancel.success"
+	if req.CancelAtPeriodEnd {
+		messageKey = "subscription.cancel.scheduled"
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Message: h.i18n.Translate(lang, messageKey),
+		Data:    nil,
+		Errors:  nil,
+	})
+}
+
+// ReactivateSubscription godoc
+// @Summary Reactivate a subscription
+// @Description Undo a cancellation scheduled for the end of the billing period
+// @Tags subscriptions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Subscription ID"
+// @Success 200 {object} dto.APIResponse
+// @Failure 400 {object} dto.APIResponse
+// @Failure 401 {object} dto.APIResponse
+// @Failure 404 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /subscriptions/{id}/reactivate [post]
+func (h *SubscriptionHandler) ReactivateSubscription(c *gin.Context) {
+	lang := c.GetString("lang")
+	userIDInt, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.APIResponse{
+			Success: false,
+			Message: h.i18n.Translate(lang, "auth.token_required"),
+			Data:    nil,
+			Errors:  []string{"User ID not found in token"},
+		})
+		return
+	}
+
+	userID := uint(userIDInt.(int))
+
+	subscriptionID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.APIResponse{
+			Success: false,
+			Message: h.i18n.Translate(lang, "validation.invalid_id"),
+			Data:    nil,
+			Errors:  []string{"Invalid subscription ID"},
+		})
+		return
+	}
+
+	if err := h.subscriptionService.ReactivateSubscription(c.Request.Context(), userID, uint(subscriptionID)); err != nil {
+		h.logger.Error().Err(err).Uint("user_id", userID).Uint("subscription_id", uint(subscriptionID)).Msg("Failed to reactivate subscription")
+
+		status := http.StatusInternalServerError
+		switch err.Error() {
+		case "subscription not found":
+			status = http.StatusNotFound
+		case "subscription is not scheduled for cancellation":
+			status = http.StatusBadRequest
+		}
+
+		c.JSON(status, dto.APIResponse{
+			Success: false,
+			Message: h.i18n.Translate(lang, "subscription.reactivate.failed"),
+			Data:    nil,
+			Errors:  []string{err.Error()},
+		})
+		return
+	}
+
 	c.JSON(http.StatusOK, dto.APIResponse{
 		Success: true,
-		Message: h.i18n.Translate(lang, "subscription.cancel.success"),
+		Message: h.i18n.Translate(lang, "subscription.reactivate.success"),
 		Data:    nil,
 		Errors:  nil,
 	})
 }
 
+// PreviewPlanChange godoc
+// @Summary Preview a plan change
+// @Description Preview the prorated charge or credit for switching a subscription to another plan without making changes
+// @Tags subscriptions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Subscription ID"
+// @Param plan_id query int true "New plan ID"
+// @Success 200 {object} dto.APIResponse{data=dto.PlanChangePreviewResponse}
+// @Failure 400 {object} dto.APIResponse
+// @Failure 401 {object} dto.APIResponse
+// @Failure 404 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /subscriptions/{id}/change-plan/preview [get]
+func (h *SubscriptionHandler) PreviewPlanChange(c *gin.Context) {
+	lang := c.GetString("lang")
+	userIDInt, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.APIResponse{
+			Success: false,
+			Message: h.i18n.Translate(lang, "auth.token_required"),
+			Data:    nil,
+			Errors:  []string{"User ID not found in token"},
+		})
+		return
+	}
+
+	userID := uint(userIDInt.(int))
+
+	subscriptionID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.APIResponse{
+			Success: false,
+			Message: h.i18n.Translate(lang, "validation.invalid_id"),
+			Data:    nil,
+			Errors:  []string{"Invalid subscription ID"},
+		})
+		return
+	}
+
+	planID, err := strconv.ParseUint(c.Query("plan_id"), 10, 32)
+	if err != nil || planID == 0 {
+		c.JSON(http.StatusBadRequest, dto.APIResponse{
+			Success: false,
+			Message: h.i18n.Translate(lang, "validation.failed"),
+			Data:    nil,
+			Errors:  []string{"Plan ID is required"},
+		})
+		return
+	}
+
+	preview, err := h.subscriptionService.PreviewPlanChange(c.Request.Context(), userID, uint(subscriptionID), uint(planID))
+	if err != nil {
+		h.logger.Error().Err(err).Uint("user_id", userID).Uint("subscription_id", uint(subscriptionID)).Uint("plan_id", uint(planID)).Msg("Failed to preview plan change")
+
+		status := http.StatusInternalServerError
+		messageKey := "subscription.change.preview_failed"
+		switch err.Error() {
+		case "subscription not found":
+			status = http.StatusNotFound
+			messageKey = "subscription.user.not_found"
+		case "subscription plan not found":
+			status = http.StatusNotFound
+			messageKey = "subscription.plan.not_found"
+		case "plan is not a subscription type":
+			status = http.StatusBadRequest
+			messageKey = "subscription.purchase.invalid_plan"
+		case "subscription is already on this plan":
+			status = http.StatusBadRequest
+			messageKey = "subscription.change.same_plan"
+		}
+
+		c.JSON(status, dto.APIResponse{
+			Success: false,
+			Message: h.i18n.Translate(lang, messageKey),
+			Data:    nil,
+			Errors:  []string{err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Message: h.i18n.Translate(lang, "subscription.change.preview_success"),
+		Data:    dto.ToPlanChangePreviewResponse(preview),
+		Errors:  nil,
+	})
+}
+
+// GetCancellationStats godoc
+// @Summary Get cancellation reason statistics
+// @Description Get aggregate counts of subscription cancellation reasons
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.APIResponse{data=dto.CancellationStatsResponse}
+// @Failure 401 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /admin/subscriptions/cancellations [get]
+func (h *SubscriptionHandler) GetCancellationStats(c *gin.Context) {
+	lang := c.GetString("lang")
+
+	reasons, err := h.subscriptionService.GetCancellationStats(c.Request.Context())
+	if err != nil {
+		h.logger.Error().Err(err).Msg("Failed to get cancellation stats")
+		c.JSON(http.StatusInternalServerError, dto.APIResponse{
+			Success: false,
+			Message: h.i18n.Translate(lang, "subscription.stats.failed"),
+			Data:    nil,
+			Errors:  []string{err.Error()},
+		})
+		return
+	}
+
+	response := &dto.CancellationStatsResponse{
+		Reasons: reasons,
+	}
+	for _, reason := range reasons {
+		response.TotalCancellations += reason.Count
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Message: h.i18n.Translate(lang, "subscription.stats.success"),
+		Data:    response,
+		Errors:  nil,
+	})
+}
+
+// ReconcileSubscriptions godoc
+// @Summary Reconcile subscriptions with Stripe
+// @Description Compare active subscriptions with Stripe and correct the ones whose webhooks were missed
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param dry_run query bool false "Only report the differences"
+// @Success 200 {object} dto.APIResponse{data=domain.SubscriptionReconciliation}
+// @Failure 400 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /admin/subscriptions/reconcile [post]
+func (h *SubscriptionHandler) ReconcileSubscriptions(c *gin.Context) {
+	lang := c.GetString("lang")
+
+	var req dto.SubscriptionReconcileRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.APIResponse{
+			Success: false,
+			Message: h.i18n.Translate(lang, "validation.invalid_request"),
+			Data:    nil,
+			Errors:  []string{err.Error()},
+		})
+		return
+	}
+
+	report, err := h.subscriptionService.ReconcileSubscriptions(c.Request.Context(), req.DryRun)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("Failed to reconcile subscriptions")
+		c.JSON(http.StatusInternalServerError, dto.APIResponse{
+			Success: false,
+			Message: h.i18n.Translate(lang, "subscription.reconcile.failed"),
+			Data:    nil,
+			Errors:  []string{err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Message: h.i18n.Translate(lang, "subscription.reconcile.success"),
+		Data:    report,
+		Errors:  nil,
+	})
+}
+
+// GetFailedWebhooks godoc
+// @Summary List dead-lettered webhooks
+// @Description List webhook deliveries whose processing failed, newest first
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param include_resolved query bool false "Include webhooks a retry has processed"
+// @Success 200 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /admin/webhooks/failed [get]
+func (h *SubscriptionHandler) GetFailedWebhooks(c *gin.Context) {
+	lang := c.GetString("lang")
+
+	var req dto.FailedWebhookListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.APIResponse{
+			Success: false,
+			Message: h.i18n.Translate(lang, "validation.invalid_request"),
+			Data:    nil,
+			Errors:  []string{err.Error()},
+		})
+		return
+	}
+
+	webhooks, pagination, err := h.failedWebhookService.GetFailedWebhooks(c.Request.Context(), req.IncludeResolved, req.PaginationRequest)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("Failed to get failed webhooks")
+		c.JSON(http.StatusInternalServerError, dto.APIResponse{
+			Success: false,
+			Message: h.i18n.Translate(lang, "webhook.failed.list_failed"),
+			Data:    nil,
+			Errors:  []string{err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewListSuccessResponse(
+		h.i18n.Translate(lang, "webhook.failed.list_success"),
+		webhooks,
+		*pagination,
+	))
+}
+
+// RetryFailedWebhook godoc
+// @Summary Replay a dead-lettered webhook
+// @Description Process a failed webhook delivery again, e.g. after fixing the data it referenced
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Failed webhook ID"
+// @Success 200 {object} dto.APIResponse
+// @Failure 400 {object} dto.APIResponse
+// @Failure 404 {object} dto.APIResponse
+// @Failure 422 {object} dto.APIResponse
+// @Router /admin/webhooks/failed/{id}/retry [post]
+func (h *SubscriptionHandler) RetryFailedWebhook(c *gin.Context) {
+	lang := c.GetString("lang")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, dto.APIResponse{
+			Success: false,
+			Message: h.i18n.Translate(lang, "validation.invalid_request"),
+			Data:    nil,
+			Errors:  []string{"Invalid failed webhook ID"},
+		})
+		return
+	}
+
+	webhook, err := h.failedWebhookService.RetryFailedWebhook(c.Request.Context(), id, h.processStripePayload)
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, domain.ErrFailedWebhookNotFound):
+			status = http.StatusNotFound
+		case errors.Is(err, domain.ErrFailedWebhookAlreadyResolved), errors.Is(err, domain.ErrFailedWebhookRetryInProgress):
+			status = http.StatusConflict
+		}
+		message, _ := middleware.TranslateError(c, err, "webhook.failed.retry_failed")
+		c.JSON(status, dto.APIResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+		})
+		return
+	}
+
+	// The replay ran; a handler error leaves the webhook unresolved with the new error
+	if !webhook.IsResolved() {
+		c.JSON(http.StatusUnprocessableEntity, dto.APIResponse{
+			Success: false,
+			Message: h.i18n.Translate(lang, "webhook.failed.retry_unsuccessful"),
+			Data:    webhook,
+			Errors:  []string{webhook.Error},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Message: h.i18n.Translate(lang, "webhook.failed.retry_success"),
+		Data:    webhook,
+	})
+}
+
 // StripeWebhook godoc
 // @Summary Handle Stripe webhooks
 // @Description Handle Stripe webhook events for payment processing
@@ -690,6 +1099,16 @@ func (h *SubscriptionHandler) StripeWebhook(c *gin.Context) {
 	// Get raw body for signature verification
 	body, err := io.ReadAll(c.Request.Body)
 	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			h.logger.Warn().Int64("limit", tooLarge.Limit).Msg("Webhook body too large")
+			c.JSON(http.StatusRequestEntityTooLarge, dto.APIResponse{
+				Success: false,
+				Message: "Webhook body too large",
+				Data:    nil,
+			})
+			return
+		}
 		h.logger.Error().Err(err).Msg("Failed to read webhook body")
 		c.JSON(http.StatusBadRequest, dto.APIResponse{
 			Success: false,
@@ -703,6 +1122,15 @@ func (h *SubscriptionHandler) StripeWebhook(c *gin.Context) {
 	// Verify webhook signature
 	signature := c.GetHeader("Stripe-Signature")
 	if signature == "" {
+		if !h.allowUnsignedWebhooks {
+			h.logger.Warn().Str("client_ip", c.ClientIP()).Msg("Rejected webhook without Stripe-Signature header")
+			c.JSON(http.StatusBadRequest, dto.APIResponse{
+				Success: false,
+				Message: "Missing webhook signature",
+				Data:    nil,
+			})
+			return
+		}
 		h.logger.Warn().Msg("No Stripe-Signature header found, skipping verification in development")
 	} else {
 		if err := h.stripeService.VerifyWebhookSignature(body, signature); err != nil {
@@ -730,183 +1158,206 @@ func (h *SubscriptionHandler) StripeWebhook(c *gin.Context) {
 		return
 	}
 
-	// Handle different event types
+	if err := h.dispatchStripeEvent(c.Request.Context(), req); err != nil {
+		// Still acknowledge the delivery: a Stripe retry would fail the same way until the
+		// data is fixed. The dead letter keeps the payload for an admin replay; a failure to
+		// store it is logged by the service.
+		_ = h.failedWebhookService.RecordFailure(c.Request.Context(), req.ID, req.Type, body, err)
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Message: "Webhook processed",
+		Data:    nil,
+		Errors:  nil,
+	})
+}
+
+// dispatchStripeEvent runs the handler for the event type and returns its error. Unhandled
+// event types are acknowledged without error.
+func (h *SubscriptionHandler) dispatchStripeEvent(ctx context.Context, req dto.StripeWebhookRequest) error {
+	h.logger.Info().Str("event_type", req.Type).Str("stripe_event_id", req.ID).Msg("Handling Stripe webhook")
+
+	var err error
 	switch req.Type {
 	case "checkout.session.completed":
 		// Handle successful checkout session completion (both subscriptions and packages)
-		h.logger.Info().Str("event_type", req.Type).Msg("Handling Stripe webhook: checkout.session.completed")
-		if err := h.handleCheckoutSessionCompleted(c.Request.Context(), req.Data); err != nil {
-			h.logger.Error().Err(err).Msg("Failed to handle checkout.session.completed")
-		}
+		err = h.handleCheckoutSessionCompleted(ctx, req.Data)
+
+	case "checkout.session.expired":
+		// Handle checkout sessions abandoned before payment
+		err = h.handleCheckoutSessionExpired(ctx, req.Data)
 
 	case "payment_intent.succeeded":
 		// Handle successful payment for packages (legacy support)
-		h.logger.Info().Str("event_type", req.Type).Msg("Handling Stripe webhook: payment_intent.succeeded")
-		if err := h.handlePaymentIntentSucceeded(c.Request.Context(), req.Data); err != nil {
-			h.logger.Error().Err(err).Msg("Failed to handle payment_intent.succeeded")
-		}
+		err = h.handlePaymentIntentSucceeded(ctx, req.Data)
 
 	case "invoice.payment_succeeded":
 		// Handle successful subscription payment (legacy support)
-		h.logger.Info().Str("event_type", req.Type).Msg("Handling Stripe webhook: invoice.payment_succeeded")
-		if err := h.handleInvoicePaymentSucceeded(c.Request.Context(), req.Data); err != nil {
-			h.logger.Error().Err(err).Msg("Failed to handle invoice.payment_succeeded")
-		}
+		err = h.handleInvoicePaymentSucceeded(ctx, req.Data)
 
 	case "invoice.payment_failed":
 		// Handle failed subscription payment
-		h.logger.Info().Str("event_type", req.Type).Msg("Handling Stripe webhook: invoice.payment_failed")
-		if err := h.handleInvoicePaymentFailed(c.Request.Context(), req.Data); err != nil {
-			h.logger.Error().Err(err).Msg("Failed to handle invoice.payment_failed")
-		}
+		err = h.handleInvoicePaymentFailed(ctx, req.Data)
 
 	case "customer.subscription.deleted":
 		// Handle subscription cancellation
-		h.logger.Info().Str("event_type", req.Type).Msg("Handling Stripe webhook: customer.subscription.deleted")
-		if err := h.handleSubscriptionDeleted(c.Request.Context(), req.Data); err != nil {
-			h.logger.Error().Err(err).Msg("Failed to handle customer.subscription.deleted")
-		}
+		err = h.handleSubscriptionDeleted(ctx, req.Data)
+
+	case "customer.subscription.trial_will_end":
+		// Notify the user before the trial converts into a paid subscription
+		err = h.handleSubscriptionTrialWillEnd(ctx, req.Data)
 
 	default:
 		h.logger.Info().Str("event_type", req.Type).Msg("Unhandled Stripe webhook event")
 	}
 
-	c.JSON(http.StatusOK, dto.APIResponse{
-		Success: true,
-		Message: "Webhook processed",
-		Data:    nil,
-		Errors:  nil,
-	})
-}
-
-// Helper methods for webhook event handling
-func (h *SubscriptionHandler) handleCheckoutSessionCompleted(ctx context.Context, data interface{}) error {
-	h.logger.Info().Msg("Processing checkout.session.completed webhook")
-
-	// Parse the checkout session data
-	dataBytes, err := json.Marshal(data)
 	if err != nil {
-		h.logger.Error().Err(err).Msg("Failed to marshal checkout session data")
-		return err
-	}
-
-	var checkoutSessionData struct {
-		Object struct {
-			ID           string            `json:"id"`
-			Mode         string            `json:"mode"`
-			Subscription string            `json:"subscription"`
-			Metadata     map[string]string `json:"metadata"`
-		} `json:"object"`
+		h.logger.Error().Err(err).Str("event_type", req.Type).Str("stripe_event_id", req.ID).Msg("Failed to handle Stripe webhook")
 	}
+	return err
+}
 
-	if err := json.Unmarshal(dataBytes, &checkoutSessionData); err != nil {
-		h.logger.Error().Err(err).Msg("Failed to unmarshal checkout session data")
-		return err
+// processStripePayload parses a raw webhook body and dispatches it; used to replay
+// dead-lettered deliveries
+func (h *SubscriptionHandler) processStripePayload(ctx context.Context, payload []byte) error {
+	var req dto.StripeWebhookRequest
+	if err := json.Unmarshal(payload, &req); err != nil {
+		return fmt.Errorf("invalid webhook payload: %w", err)
 	}
+	return h.dispatchStripeEvent(ctx, req)
+}
 
-	sessionID := checkoutSessionData.Object.ID
-	mode := checkoutSessionData.Object.Mode
-	userIDStr, exists := checkoutSessionData.Object.Metadata["user_id"]
-	if !exists {
-		h.logger.Error().Str("session_id", sessionID).Msg("User ID not found in checkout session metadata")
-		return nil
-	}
+// Helper methods for webhook event handling
+func (h *SubscriptionHandler) handleCheckoutSessionCompleted(ctx context.Context, data interface{}) error {
+	h.logger.Info().Msg("Processing checkout.session.completed webhook")
 
-	userID, err := strconv.ParseUint(userIDStr, 10, 32)
-	if err != nil {
-		h.logger.Error().Err(err).Str("user_id", userIDStr).Msg("Invalid user ID in checkout session metadata")
+	// Ticket carts are paid in payment mode like packages; the cart they name tells them apart
+	var cartSession stripeCheckoutSession
+	if err := decodeStripeObject(data, &cartSession); err != nil {
+		h.logger.Error().Err(err).Msg("Failed to parse checkout session")
 		return err
 	}
-
-	planIDStr, exists := checkoutSessionData.Object.Metadata["plan_id"]
-	if !exists {
-		h.logger.Error().Str("session_id", sessionID).Msg("Plan ID not found in checkout session metadata")
+	if cartSession.Metadata[stripeMetadataCartReservationID] != "" {
+		if err := h.checkoutService.CompleteCheckout(ctx, cartSession.ID); err != nil {
+			h.logger.Error().Err(err).Str("session_id", cartSession.ID).Msg("Failed to complete cart checkout")
+			return err
+		}
 		return nil
 	}
 
-	planID, err := strconv.ParseUint(planIDStr, 10, 32)
+	session, metadata, err := parseCheckoutMetadata(data)
 	if err != nil {
-		h.logger.Error().Err(err).Str("plan_id", planIDStr).Msg("Invalid plan ID in checkout session metadata")
+		h.logger.Error().Err(err).Msg("Failed to parse checkout session")
 		return err
 	}
+	sessionID := session.ID
+	userID, planID := metadata.UserID, metadata.PlanID
 
 	// Handle based on mode (subscription or payment)
-	switch mode {
+	switch session.Mode {
 	case "subscription":
 		// Handle subscription activation
-		subscriptionID := checkoutSessionData.Object.Subscription
+		subscriptionID := session.Subscription
 		if subscriptionID == "" {
 			h.logger.Error().Str("session_id", sessionID).Msg("Subscription ID not found in checkout session")
+			return fmt.Errorf("checkout session %s has no subscription", sessionID)
+		}
+
+		// Checkouts that started a free trial are active immediately
+		if metadata.TrialDays > 0 {
+			if _, err := h.subscriptionService.CreateTrialSubscription(ctx, userID, planID, subscriptionID); err != nil {
+				h.logger.Error().Err(err).Uint("user_id", userID).Uint("plan_id", planID).Str("stripe_subscription_id", subscriptionID).Msg("Failed to create trial subscription")
+				return err
+			}
+
+			h.logger.Info().Str("session_id", sessionID).Uint("user_id", userID).Uint("plan_id", planID).Str("stripe_subscription_id", subscriptionID).Msg("Trial subscription created successfully")
 			return nil
 		}
 
 		// Create subscription in our database
-		if _, err := h.subscriptionService.CreateSubscription(ctx, uint(userID), uint(planID), subscriptionID); err != nil {
-			h.logger.Error().Err(err).Uint("user_id", uint(userID)).Uint("plan_id", uint(planID)).Str("stripe_subscription_id", subscriptionID).Msg("Failed to create subscription")
+		if _, err := h.subscriptionService.CreateSubscription(ctx, userID, planID, subscriptionID); err != nil {
+			h.logger.Error().Err(err).Uint("user_id", userID).Uint("plan_id", planID).Str("stripe_subscription_id", subscriptionID).Msg("Failed to create subscription")
 			return err
 		}
 
-		h.logger.Info().Str("session_id", sessionID).Uint("user_id", uint(userID)).Uint("plan_id", uint(planID)).Str("stripe_subscription_id", subscriptionID).Msg("Subscription created successfully")
+		h.logger.Info().Str("session_id", sessionID).Uint("user_id", userID).Uint("plan_id", planID).Str("stripe_subscription_id", subscriptionID).Msg("Subscription created successfully")
 
 	case "payment":
 		// Handle package activation
 		// Create package in our database
-		if _, err := h.subscriptionService.CreatePackage(ctx, uint(userID), uint(planID), sessionID); err != nil {
-			h.logger.Error().Err(err).Uint("user_id", uint(userID)).Uint("plan_id", uint(planID)).Str("stripe_session_id", sessionID).Msg("Failed to create package")
+		if _, err := h.subscriptionService.CreatePackage(ctx, userID, planID, sessionID); err != nil {
+			h.logger.Error().Err(err).Uint("user_id", userID).Uint("plan_id", planID).Str("stripe_session_id", sessionID).Msg("Failed to create package")
 			return err
 		}
 
-		h.logger.Info().Str("session_id", sessionID).Uint("user_id", uint(userID)).Uint("plan_id", uint(planID)).Msg("Package created successfully")
+		h.logger.Info().Str("session_id", sessionID).Uint("user_id", userID).Uint("plan_id", planID).Msg("Package created successfully")
 
 	default:
-		h.logger.Warn().Str("session_id", sessionID).Str("mode", mode).Msg("Unknown checkout session mode")
+		h.logger.Warn().Str("session_id", sessionID).Str("mode", session.Mode).Msg("Unknown checkout session mode")
 	}
 
 	return nil
 }
 
-func (h *SubscriptionHandler) handlePaymentIntentSucceeded(ctx context.Context, data interface{}) error {
-	h.logger.Info().Msg("Processing payment_intent.succeeded webhook")
+// handleCheckoutSessionExpired expires the pending checkout of an abandoned ticket cart and gives
+// its tickets back before the hold runs out. Subscriptions and packages are only created once
+// checkout completes, so sessions without a cart leave nothing behind to clean up.
+func (h *SubscriptionHandler) handleCheckoutSessionExpired(ctx context.Context, data interface{}) error {
+	h.logger.Info().Msg("Processing checkout.session.expired webhook")
 
-	// Parse the payment intent data
-	dataBytes, err := json.Marshal(data)
-	if err != nil {
-		h.logger.Error().Err(err).Msg("Failed to marshal payment intent data")
+	var session stripeCheckoutSession
+	if err := decodeStripeObject(data, &session); err != nil {
+		h.logger.Error().Err(err).Msg("Failed to parse checkout session")
 		return err
 	}
 
-	var paymentIntentData struct {
-		Object struct {
-			ID       string            `json:"id"`
-			Metadata map[string]string `json:"metadata"`
-		} `json:"object"`
+	if cartID := session.Metadata[stripeMetadataCartReservationID]; cartID != "" {
+		if err := h.checkoutService.ExpireCheckout(ctx, session.ID); err != nil {
+			h.logger.Error().Err(err).Str("session_id", session.ID).Str("cart_id", cartID).Msg("Failed to expire cart checkout")
+			return err
+		}
+		return nil
 	}
 
-	if err := json.Unmarshal(dataBytes, &paymentIntentData); err != nil {
-		h.logger.Error().Err(err).Msg("Failed to unmarshal payment intent data")
-		return err
-	}
+	// Nothing is acted on, so malformed metadata is logged as received rather than rejected
+	h.logger.Info().
+		Str("session_id", session.ID).
+		Str("mode", session.Mode).
+		Str("user_id", session.Metadata[stripeMetadataUserID]).
+		Str("plan_id", session.Metadata[stripeMetadataPlanID]).
+		Msg("Checkout session expired without payment")
+	return nil
+}
 
-	paymentIntentID := paymentIntentData.Object.ID
-	planIDStr, exists := paymentIntentData.Object.Metadata["plan_id"]
-	if !exists {
-		h.logger.Error().Str("payment_intent_id", paymentIntentID).Msg("Plan ID not found in payment intent metadata")
-		return nil
+func (h *SubscriptionHandler) handlePaymentIntentSucceeded(ctx context.Context, data interface{}) error {
+	h.logger.Info().Msg("Processing payment_intent.succeeded webhook")
+
+	var paymentIntent struct {
+		ID       string            `json:"id"`
+		Metadata map[string]string `json:"metadata"`
+	}
+	if err := decodeStripeObject(data, &paymentIntent); err != nil {
+		h.logger.Error().Err(err).Msg("Failed to parse payment intent")
+		return err
 	}
 
-	planID, err := strconv.ParseUint(planIDStr, 10, 32)
+	// Payment intents are created without a user; the plan identifies the package
+	paymentIntentID := paymentIntent.ID
+	metadata, err := parseStripeMetadata(paymentIntent.Metadata, false)
 	if err != nil {
-		h.logger.Error().Err(err).Str("plan_id", planIDStr).Msg("Invalid plan ID in payment intent metadata")
-		return err
+		h.logger.Error().Err(err).Str("payment_intent_id", paymentIntentID).Msg("Invalid payment intent metadata")
+		return fmt.Errorf("payment intent %s: %w", paymentIntentID, err)
 	}
+	planID := metadata.PlanID
 
 	// Find the user subscription by Stripe payment intent ID and activate it
 	if err := h.subscriptionService.ActivateSubscriptionByStripeID(ctx, paymentIntentID); err != nil {
-		h.logger.Error().Err(err).Str("payment_intent_id", paymentIntentID).Uint("plan_id", uint(planID)).Msg("Failed to activate package")
+		h.logger.Error().Err(err).Str("payment_intent_id", paymentIntentID).Uint("plan_id", planID).Msg("Failed to activate package")
 		return err
 	}
 
-	h.logger.Info().Str("payment_intent_id", paymentIntentID).Uint("plan_id", uint(planID)).Msg("Package activated successfully")
+	h.logger.Info().Str("payment_intent_id", paymentIntentID).Uint("plan_id", planID).Msg("Package activated successfully")
 	return nil
 }
 
@@ -922,8 +1373,9 @@ func (h *SubscriptionHandler) handleInvoicePaymentSucceeded(ctx context.Context,
 
 	var invoiceData struct {
 		Object struct {
-			ID           string `json:"id"`
-			Subscription string `json:"subscription"`
+			ID            string `json:"id"`
+			Subscription  string `json:"subscription"`
+			BillingReason string `json:"billing_reason"`
 		} `json:"object"`
 	}
 
@@ -944,6 +1396,14 @@ func (h *SubscriptionHandler) handleInvoicePaymentSucceeded(ctx context.Context,
 		return err
 	}
 
+	// Renewal invoices roll the subscription over into a new billing period
+	if invoiceData.Object.BillingReason == "subscription_cycle" {
+		if err := h.subscriptionService.HandleSubscriptionRenewal(ctx, subscriptionID); err != nil {
+			h.logger.Error().Err(err).Str("subscription_id", subscriptionID).Msg("Failed to reset usage for new billing period")
+			return err
+		}
+	}
+
 	h.logger.Info().Str("subscription_id", subscriptionID).Msg("Subscription activated successfully")
 	return nil
 }
@@ -1024,3 +1484,140 @@ func (h *SubscriptionHandler) handleSubscriptionDeleted(ctx context.Context, dat
 	h.logger.Info().Str("subscription_id", subscriptionID).Msg("Subscription cancelled successfully")
 	return nil
 }
+
+func (h *SubscriptionHandler) handleSubscriptionTrialWillEnd(ctx context.Context, data interface{}) error {
+	h.logger.Info().Msg("Processing customer.subscription.trial_will_end webhook")
+
+	// Parse the subscription data
+	dataBytes, err := json.Marshal(data)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("Failed to marshal subscription data")
+		return err
+	}
+
+	var subscriptionData struct {
+		Object struct {
+			ID string `json:"id"`
+		} `json:"object"`
+	}
+
+	if err := json.Unmarshal(dataBytes, &subscriptionData); err != nil {
+		h.logger.Error().Err(err).Msg("Failed to unmarshal subscription data")
+		return err
+	}
+
+	subscriptionID := subscriptionData.Object.ID
+	if subscriptionID == "" {
+		h.logger.Error().Msg("Subscription ID not found in subscription data")
+		return nil
+	}
+
+	if err := h.subscriptionService.HandleTrialWillEnd(ctx, subscriptionID); err != nil {
+		h.logger.Error().Err(err).Str("subscription_id", subscriptionID).Msg("Failed to notify trial ending")
+		return err
+	}
+
+	h.logger.Info().Str("subscription_id", subscriptionID).Msg("Trial ending handled successfully")
+	return nil
+}
+
+// Metadata keys set on the checkout sessions and payment intents this app creates
+const (
+	stripeMetadataUserID    = "user_id"
+	stripeMetadataPlanID    = "plan_id"
+	stripeMetadataTrialDays = "trial_days"
+	// stripeMetadataCartReservationID ties a checkout session to the ticket cart it pays for
+	stripeMetadataCartReservationID = "cart_reservation_id"
+)
+
+// errInvalidStripeMetadata marks webhooks whose metadata was not set by this app or was altered.
+// Such webhooks fail so they are kept for inspection instead of being dropped.
+var errInvalidStripeMetadata = errors.New("invalid stripe metadata")
+
+// stripeMetadata is the parsed form of the metadata this app attaches to Stripe objects
+type stripeMetadata struct {
+	UserID    uint
+	PlanID    uint
+	TrialDays int
+}
+
+// stripeCheckoutSession is the part of a checkout session object the webhooks use
+type stripeCheckoutSession struct {
+	ID           string            `json:"id"`
+	Mode         string            `json:"mode"`
+	Subscription string            `json:"subscription"`
+	Metadata     map[string]string `json:"metadata"`
+}
+
+// parseCheckoutMetadata decodes a checkout session webhook object and its metadata, which must
+// name both the user and the plan
+func parseCheckoutMetadata(data interface{}) (*stripeCheckoutSession, *stripeMetadata, error) {
+	var session stripeCheckoutSession
+	if err := decodeStripeObject(data, &session); err != nil {
+		return nil, nil, err
+	}
+
+	metadata, err := parseStripeMetadata(session.Metadata, true)
+	if err != nil {
+		return nil, nil, fmt.Errorf("checkout session %s: %w", session.ID, err)
+	}
+	return &session, metadata, nil
+}
+
+// parseStripeMetadata validates the ids in Stripe metadata. The plan is always required, the user
+// only when requireUser is set; trial days are optional and zero when absent.
+func parseStripeMetadata(raw map[string]string, requireUser bool) (*stripeMetadata, error) {
+	metadata := &stripeMetadata{}
+
+	planID, err := parseStripeMetadataID(raw, stripeMetadataPlanID)
+	if err != nil {
+		return nil, err
+	}
+	metadata.PlanID = planID
+
+	if requireUser {
+		userID, err := parseStripeMetadataID(raw, stripeMetadataUserID)
+		if err != nil {
+			return nil, err
+		}
+		metadata.UserID = userID
+	}
+
+	if value, ok := raw[stripeMetadataTrialDays]; ok && value != "" {
+		trialDays, err := strconv.Atoi(value)
+		if err != nil || trialDays < 0 {
+			return nil, fmt.Errorf("%w: %s %q is not a day count", errInvalidStripeMetadata, stripeMetadataTrialDays, value)
+		}
+		metadata.TrialDays = trialDays
+	}
+
+	return metadata, nil
+}
+
+func parseStripeMetadataID(raw map[string]string, key string) (uint, error) {
+	value, ok := raw[key]
+	if !ok || value == "" {
+		return 0, fmt.Errorf("%w: %s is missing", errInvalidStripeMetadata, key)
+	}
+	id, err := strconv.ParseUint(value, 10, 32)
+	if err != nil || id == 0 {
+		return 0, fmt.Errorf("%w: %s %q is not an id", errInvalidStripeMetadata, key, value)
+	}
+	return uint(id), nil
+}
+
+// decodeStripeObject decodes the object of a webhook's data into target
+func decodeStripeObject(data interface{}, target interface{}) error {
+	dataBytes, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook data: %w", err)
+	}
+
+	envelope := struct {
+		Object interface{} `json:"object"`
+	}{Object: target}
+	if err := json.Unmarshal(dataBytes, &envelope); err != nil {
+		return fmt.Errorf("failed to unmarshal webhook data: %w", err)
+	}
+	return nil
+}
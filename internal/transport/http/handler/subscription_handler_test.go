@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/louco-event/internal/service"
+	"github.com/louco-event/pkg/logger"
+	"github.com/rs/zerolog"
+)
+
+func TestParseStripeMetadata(t *testing.T) {
+	tests := []struct {
+		name        string
+		raw         map[string]string
+		requireUser bool
+		want        stripeMetadata
+		wantErr     bool
+	}{
+		{"checkout", map[string]string{"user_id": "7", "plan_id": "2"}, true, stripeMetadata{UserID: 7, PlanID: 2}, false},
+		{"with trial", map[string]string{"user_id": "7", "plan_id": "2", "trial_days": "14"}, true, stripeMetadata{UserID: 7, PlanID: 2, TrialDays: 14}, false},
+		{"empty trial", map[string]string{"user_id": "7", "plan_id": "2", "trial_days": ""}, true, stripeMetadata{UserID: 7, PlanID: 2}, false},
+		{"payment intent without user", map[string]string{"plan_id": "2"}, false, stripeMetadata{PlanID: 2}, false},
+		{"missing plan", map[string]string{"user_id": "7"}, true, stripeMetadata{}, true},
+		{"missing user", map[string]string{"plan_id": "2"}, true, stripeMetadata{}, true},
+		{"zero id", map[string]string{"user_id": "0", "plan_id": "2"}, true, stripeMetadata{}, true},
+		{"negative id", map[string]string{"user_id": "7", "plan_id": "-2"}, true, stripeMetadata{}, true},
+		{"id out of range", map[string]string{"user_id": "7", "plan_id": "99999999999"}, true, stripeMetadata{}, true},
+		{"non-numeric trial", map[string]string{"user_id": "7", "plan_id": "2", "trial_days": "two weeks"}, true, stripeMetadata{}, true},
+		{"negative trial", map[string]string{"user_id": "7", "plan_id": "2", "trial_days": "-1"}, true, stripeMetadata{}, true},
+		{"no metadata", nil, false, stripeMetadata{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseStripeMetadata(tt.raw, tt.requireUser)
+			if tt.wantErr {
+				if !errors.Is(err, errInvalidStripeMetadata) {
+					t.Fatalf("err = %v, want errInvalidStripeMetadata", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseStripeMetadata: %v", err)
+			}
+			if *got != tt.want {
+				t.Errorf("parseStripeMetadata() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseCheckoutMetadata(t *testing.T) {
+	data := map[string]interface{}{
+		"object": map[string]interface{}{
+			"id":           "cs_test",
+			"mode":         "subscription",
+			"subscription": "sub_test",
+			"metadata":     map[string]string{"user_id": "7", "plan_id": "2"},
+		},
+	}
+	session, metadata, err := parseCheckoutMetadata(data)
+	if err != nil {
+		t.Fatalf("parseCheckoutMetadata: %v", err)
+	}
+	if session.ID != "cs_test" || session.Subscription != "sub_test" || metadata.UserID != 7 || metadata.PlanID != 2 {
+		t.Errorf("got session %+v and metadata %+v", *session, *metadata)
+	}
+
+	// A session missing the user id is rejected with its id in the error
+	data["object"].(map[string]interface{})["metadata"] = map[string]string{"plan_id": "2"}
+	if _, _, err := parseCheckoutMetadata(data); !errors.Is(err, errInvalidStripeMetadata) {
+		t.Errorf("err = %v, want errInvalidStripeMetadata", err)
+	}
+}
+
+// fakeCheckouts records the checkout sessions resolved by the webhooks
+type fakeCheckouts struct {
+	service.CheckoutService
+	completed []string
+	expired   []string
+	err       error
+}
+
+func (f *fakeCheckouts) CompleteCheckout(ctx context.Context, sessionID string) error {
+	f.completed = append(f.completed, sessionID)
+	return f.err
+}
+
+func (f *fakeCheckouts) ExpireCheckout(ctx context.Context, sessionID string) error {
+	f.expired = append(f.expired, sessionID)
+	return f.err
+}
+
+func checkoutSessionEvent(id string, metadata map[string]string) map[string]interface{} {
+	return map[string]interface{}{
+		"object": map[string]interface{}{"id": id, "mode": "payment", "metadata": metadata},
+	}
+}
+
+func TestHandleCheckoutSessionExpiredExpiresCartCheckout(t *testing.T) {
+	nop := zerolog.Nop()
+	checkouts := &fakeCheckouts{}
+	h := &SubscriptionHandler{checkoutService: checkouts, logger: &logger.Logger{Logger: &nop}}
+
+	if err := h.handleCheckoutSessionExpired(context.Background(), checkoutSessionEvent("cs_cart", map[string]string{"user_id": "7", "cart_reservation_id": "cart-1"})); err != nil {
+		t.Fatalf("handleCheckoutSessionExpired: %v", err)
+	}
+	if len(checkouts.expired) != 1 || checkouts.expired[0] != "cs_cart" {
+		t.Errorf("expired %v, want cs_cart", checkouts.expired)
+	}
+
+	// Subscription checkouts carry no cart and expire nothing
+	if err := h.handleCheckoutSessionExpired(context.Background(), checkoutSessionEvent("cs_plan", map[string]string{"user_id": "7", "plan_id": "2"})); err != nil {
+		t.Fatalf("handleCheckoutSessionExpired without a cart: %v", err)
+	}
+	if len(checkouts.expired) != 1 {
+		t.Errorf("expired %v, want only cs_cart", checkouts.expired)
+	}
+
+	// Failures are returned so the webhook is kept for a retry
+	checkouts.err = errors.New("database unavailable")
+	if err := h.handleCheckoutSessionExpired(context.Background(), checkoutSessionEvent("cs_cart", map[string]string{"cart_reservation_id": "cart-1"})); err == nil {
+		t.Error("handleCheckoutSessionExpired: err = nil, want the service error")
+	}
+}
+
+func TestHandleCheckoutSessionCompletedCompletesCartCheckout(t *testing.T) {
+	nop := zerolog.Nop()
+	checkouts := &fakeCheckouts{}
+	h := &SubscriptionHandler{checkoutService: checkouts, logger: &logger.Logger{Logger: &nop}}
+
+	// Cart sessions carry no plan, which subscription and package sessions require
+	if err := h.handleCheckoutSessionCompleted(context.Background(), checkoutSessionEvent("cs_cart", map[string]string{"user_id": "7", "cart_reservation_id": "cart-1"})); err != nil {
+		t.Fatalf("handleCheckoutSessionCompleted: %v", err)
+	}
+	if len(checkouts.completed) != 1 || checkouts.completed[0] != "cs_cart" {
+		t.Errorf("completed %v, want cs_cart", checkouts.completed)
+	}
+}
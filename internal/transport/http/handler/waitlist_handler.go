@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/dto"
+	"github.com/louco-event/internal/i18n"
+	"github.com/louco-event/internal/middleware"
+	"github.com/louco-event/internal/service"
+)
+
+type WaitlistHandler struct {
+	waitlistService service.WaitlistService
+	i18n            *i18n.I18n
+}
+
+func NewWaitlistHandler(waitlistService service.WaitlistService, i18n *i18n.I18n) *WaitlistHandler {
+	return &WaitlistHandler{
+		waitlistService: waitlistService,
+		i18n:            i18n,
+	}
+}
+
+// JoinWaitlist queues the user for a seat of an event at capacity
+func (h *WaitlistHandler) JoinWaitlist(c *gin.Context) {
+	eventID, userID, ok := h.parseEventRequest(c)
+	if !ok {
+		return
+	}
+
+	entry, err := h.waitlistService.Join(c.Request.Context(), eventID, userID)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.waitlist.join_success"),
+		entry,
+	)
+	c.JSON(http.StatusCreated, response)
+}
+
+// LeaveWaitlist takes the user off the waitlist
+func (h *WaitlistHandler) LeaveWaitlist(c *gin.Context) {
+	eventID, userID, ok := h.parseEventRequest(c)
+	if !ok {
+		return
+	}
+
+	if err := h.waitlistService.Leave(c.Request.Context(), eventID, userID); err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.waitlist.leave_success"),
+		nil,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetWaitlistPosition returns the user's place on the waitlist
+func (h *WaitlistHandler) GetWaitlistPosition(c *gin.Context) {
+	eventID, userID, ok := h.parseEventRequest(c)
+	if !ok {
+		return
+	}
+
+	entry, err := h.waitlistService.GetPosition(c.Request.Context(), eventID, userID)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	response := dto.NewSuccessResponse(
+		middleware.Translate(c, "event.waitlist.position_success"),
+		entry,
+	)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *WaitlistHandler) parseEventRequest(c *gin.Context) (int, int, bool) {
+	userID, ok := requireUser(c)
+	if !ok {
+		return 0, 0, false
+	}
+	eventID, ok := parseIDParam(c, "id", "event ID")
+	if !ok {
+		return 0, 0, false
+	}
+	return eventID, userID, true
+}
+
+func (h *WaitlistHandler) respondError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domain.ErrEventNotFound):
+		c.JSON(http.StatusNotFound, dto.NewErrorResponse(middleware.Translate(c, "event.not_found"), nil))
+	case errors.Is(err, domain.ErrWaitlistNotJoined):
+		c.JSON(http.StatusNotFound, dto.NewErrorResponse(middleware.Translate(c, "event.waitlist.not_joined"), nil))
+	case errors.Is(err, domain.ErrEventUnauthorized):
+		c.JSON(http.StatusForbidden, dto.NewErrorResponse(middleware.Translate(c, "event.access_denied"), nil))
+	default:
+		message, isDomainErr := middleware.TranslateError(c, err, "event.waitlist.failed")
+		status := http.StatusInternalServerError
+		if isDomainErr {
+			status = http.StatusBadRequest
+		}
+		c.JSON(status, dto.NewErrorResponse(message, nil))
+	}
+}
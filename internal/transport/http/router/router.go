@@ -19,7 +19,19 @@ func SetupRoutes(r *gin.Engine, deps *factory.Dependencies) {
 	followHandler := handler.NewFollowHandler(deps.FollowService, deps.I18n)
 	eventHandler := handler.NewEventHandler(deps.EventService, deps.AddressService, deps.TicketService, deps.InvitationService, deps.CreatorService, deps.I18n)
 	addressHandler := handler.NewAddressHandler(deps.AddressService, deps.I18n)
-	subscriptionHandler := handler.NewSubscriptionHandler(deps.SubscriptionService, deps.UserService, deps.StripeService, deps.I18n, deps.Logger)
+	eventReportHandler := handler.NewEventReportHandler(deps.EventReportService, deps.I18n)
+	eventShareHandler := handler.NewEventShareHandler(deps.EventShareService, deps.I18n)
+	exportHandler := handler.NewExportHandler(deps.ExportService, deps.I18n)
+	maintenanceHandler := handler.NewMaintenanceHandler(deps.MaintenanceService, deps.I18n)
+	activityHandler := handler.NewActivityHandler(deps.ActivityService, deps.I18n)
+	eventJoinRequestHandler := handler.NewEventJoinRequestHandler(deps.EventJoinRequestService, deps.I18n)
+	eventCoHostHandler := handler.NewEventCoHostHandler(deps.EventCoHostService, deps.I18n)
+	eventInterestHandler := handler.NewEventInterestHandler(deps.EventInterestService, deps.I18n)
+	eventRatingHandler := handler.NewEventRatingHandler(deps.EventRatingService, deps.I18n)
+	waitlistHandler := handler.NewWaitlistHandler(deps.WaitlistService, deps.I18n)
+	clientConfigHandler := handler.NewClientConfigHandler(deps.ClientConfigService, deps.I18n)
+	checkoutHandler := handler.NewCheckoutHandler(deps.CheckoutService, deps.I18n)
+	subscriptionHandler := handler.NewSubscriptionHandler(deps.SubscriptionService, deps.FailedWebhookService, deps.UserService, deps.CheckoutService, deps.StripeService, deps.Config.Server.IsDevelopment(), deps.I18n, deps.Logger)
 
 	// Health check endpoint
 	r.GET("/health", handler.HealthCheck(deps.DB))
@@ -37,6 +49,9 @@ func SetupRoutes(r *gin.Engine, deps *factory.Dependencies) {
 			auth.POST("/reset-password", authHandler.ResetPassword)
 		}
 
+		// Effective business rules, so clients do not hardcode limits (no authentication required)
+		v1.GET("/config/client", clientConfigHandler.GetClientConfig)
+
 		// Public industry routes (no authentication required)
 		industries := v1.Group("/industries")
 		{
@@ -70,15 +85,26 @@ func SetupRoutes(r *gin.Engine, deps *factory.Dependencies) {
 
 		// Webhook routes (no authentication required)
 		webhooks := v1.Group("/webhooks")
+		webhooks.Use(
+			middleware.AllowSourceIPs(deps.Config.Stripe.WebhookAllowedIPs),
+			middleware.WebhookRateLimit(deps.Config.RateLimit),
+			middleware.LimitBodySize(deps.Config.Stripe.WebhookMaxBodyBytes),
+		)
 		{
 			webhooks.POST("/stripe", subscriptionHandler.StripeWebhook)
 		}
 
+		// Signed media links; the signature authorizes access to private event media
+		v1.GET("/media/:id/signed", mediaHandler.ServeSignedMedia)
+		// Files of media outside private events
+		v1.GET("/media/files/:file_name", mediaHandler.ServeMediaFile)
+
 		// Public creator routes (no authentication required)
 		creators := v1.Group("/creators")
 		{
 			creators.GET("", creatorHandler.GetCreatorList)
 			creators.GET("/:id", creatorHandler.GetCreator)
+			creators.GET("/:id/events/upcoming", eventHandler.GetCreatorUpcomingEvents)
 		}
 
 		// Username routes (require authentication)
@@ -112,6 +138,9 @@ func SetupRoutes(r *gin.Engine, deps *factory.Dependencies) {
 				users.POST("/register/step4", authHandler.RegisterStep4)
 				users.POST("/change-password", authHandler.ChangePassword)
 				users.DELETE("/deactivate", userHandler.DeactivateAccount)
+				users.GET("/me/tickets", eventHandler.GetMyTickets)
+				users.GET("/me/activity", activityHandler.GetMyActivity)
+				users.GET("/me/events", activityHandler.GetMyEvents)
 			}
 
 			// Media routes
@@ -154,28 +183,107 @@ func SetupRoutes(r *gin.Engine, deps *factory.Dependencies) {
 				eventManage.GET("/:id", eventHandler.GetEvent)
 				eventManage.PUT("/:id", eventHandler.UpdateEvent)
 				eventManage.DELETE("/:id", eventHandler.DeleteEvent)
+				eventManage.POST("/:id/clone", eventHandler.CloneEvent)
+				eventManage.POST("/:id/restore", eventHandler.RestoreEvent)
 
 				// Creator-specific operations
 				eventManage.GET("/my", eventHandler.GetMyEvents)
 				eventManage.GET("/my/drafts", eventHandler.GetMyDraftEvents)
 				eventManage.GET("/my/published", eventHandler.GetMyPublishedEvents)
+				eventManage.GET("/my/pending", eventHandler.GetMyPendingEvents)
+				eventManage.GET("/my/rejected", eventHandler.GetMyRejectedEvents)
+				eventManage.GET("/my/stopped", eventHandler.GetMyStoppedEvents)
+				eventManage.GET("/my/cancelled", eventHandler.GetMyCancelledEvents)
+				eventManage.GET("/my/status-counts", eventHandler.GetMyStatusCounts)
 
 				// Status management
 				eventManage.PUT("/:id/status", eventHandler.UpdateEventStatus)
 				eventManage.POST("/:id/submit", eventHandler.SubmitForReview)
 				eventManage.POST("/:id/publish", eventHandler.PublishEvent)
+				eventManage.POST("/:id/publish-and-notify", eventHandler.PublishAndNotify)
 				eventManage.POST("/:id/cancel", eventHandler.CancelEvent)
+				eventManage.GET("/:id/history", eventHandler.GetEventHistory)
+
+				// Share link management
+				eventManage.GET("/:id/share-token", eventShareHandler.GetShareToken)
+				eventManage.POST("/:id/share-token", eventShareHandler.RegenerateShareToken)
+				eventManage.DELETE("/:id/share-token", eventShareHandler.RevokeShareToken)
+				eventManage.GET("/:id/share-token/stats", eventShareHandler.GetShareTokenStats)
+
+				// Join requests for private events
+				eventManage.GET("/:id/join-requests", eventJoinRequestHandler.GetJoinRequests)
+				eventManage.POST("/:id/join-requests/:request_id/approve", eventJoinRequestHandler.ApproveJoinRequest)
+				eventManage.POST("/:id/join-requests/:request_id/reject", eventJoinRequestHandler.RejectJoinRequest)
+
+				// Co-hosts: the owner invites, the invited creator accepts
+				eventManage.GET("/:id/cohosts", eventCoHostHandler.GetCoHosts)
+				eventManage.POST("/:id/cohosts", eventCoHostHandler.InviteCoHost)
+				eventManage.POST("/:id/cohosts/accept", eventCoHostHandler.AcceptCoHostInvitation)
+
+				// Demand from users who could not attend
+				eventManage.GET("/:id/interest/count", eventInterestHandler.GetInterestCount)
 
 				// Statistics
 				eventManage.GET("/stats", eventHandler.GetEventStats)
+				eventManage.GET("/stats/invitations", eventHandler.GetInvitationStatsForEvents)
+			}
+
+			// Deleted events stay in the creator's trash until restored or purged
+			protected.GET("/events/trash", middleware.RequireUserType("creator"), eventHandler.GetCreatorTrashedEvents)
+
+			// Change the status of several owned events at once
+			protected.POST("/events/bulk-status", middleware.RequireUserType("creator"), eventHandler.BulkUpdateEventStatus)
+			protected.POST("/events/bulk/categories", middleware.RequireUserType("creator"), eventHandler.BulkAddCategoryToEvents)
+
+			// Creator to-do list: rejected events, incomplete drafts and low sales before the start
+			protected.GET("/events/mine/attention", middleware.RequireUserType("creator"), eventHandler.GetEventsNeedingAttention)
+			protected.GET("/events/mine/search", middleware.RequireUserType("creator"), eventHandler.SearchMyEvents)
+
+			// Event reporting routes
+			protected.POST("/events/:id/report", eventReportHandler.ReportEvent)
+
+			// Invite existing platform users by id
+			protected.POST("/events/:id/invitations/by-users", middleware.RequireUserType("creator"), eventHandler.InviteUsersByIDs)
+
+			// Register interest in an event the user cannot attend, including sold-out or past ones
+			protected.POST("/events/:id/interest", eventInterestHandler.RegisterInterest)
+
+			// Queue for a seat of an event at capacity
+			protected.POST("/events/:id/waitlist", waitlistHandler.JoinWaitlist)
+			protected.GET("/events/:id/waitlist", waitlistHandler.GetWaitlistPosition)
+			protected.DELETE("/events/:id/waitlist", waitlistHandler.LeaveWaitlist)
+
+			// Rate a completed event the user attended
+			protected.POST("/events/:id/rating", eventRatingHandler.RateEvent)
+
+			// Ask to join a private event that accepts join requests
+			protected.POST("/events/:id/join-requests", eventJoinRequestHandler.RequestToJoin)
+
+			// Background CSV exports
+			exports := protected.Group("/exports")
+			exports.Use(middleware.RequireUserType("creator"))
+			{
+				exports.POST("", exportHandler.CreateExport)
+				exports.GET("/:id", exportHandler.GetExport)
 			}
 
+			// Cart checks and holds are open to any buyer, unlike ticket management below
+			protected.POST("/tickets/availability/check", eventHandler.CheckTicketAvailability)
+			protected.POST("/tickets/reserve-cart", eventHandler.ReserveCart)
+			protected.DELETE("/tickets/reserve-cart/:cart_id", eventHandler.ReleaseCart)
+			protected.POST("/tickets/reserve-cart/:cart_id/checkout", checkoutHandler.CheckoutCart)
+			protected.POST("/cart/validate", eventHandler.ValidateCart)
+
 			// Ticket management routes (separate to avoid route conflicts)
 			tickets := protected.Group("/tickets")
 			tickets.Use(middleware.RequireUserType("creator"))
 			{
 				tickets.POST("/event/:event_id", eventHandler.CreateTicket)
 				tickets.GET("/event/:event_id", eventHandler.GetEventTickets)
+				tickets.POST("/event/:event_id/bulk", eventHandler.CreateTicketsBulk)
+				tickets.POST("/event/:event_id/bulk-price", eventHandler.BulkUpdateTicketPrices)
+				tickets.POST("/event/:event_id/close-sales", eventHandler.CloseTicketSales)
+				tickets.POST("/event/:event_id/reopen-sales", eventHandler.ReopenTicketSales)
 			}
 
 			// Invitation management routes (separate to avoid route conflicts)
@@ -212,26 +320,76 @@ func SetupRoutes(r *gin.Engine, deps *factory.Dependencies) {
 
 				// Subscription management
 				subscriptionProtected.POST("/:id/cancel", subscriptionHandler.CancelSubscription)
+				subscriptionProtected.POST("/:id/reactivate", subscriptionHandler.ReactivateSubscription)
+				subscriptionProtected.GET("/:id/change-plan/preview", subscriptionHandler.PreviewPlanChange)
 			}
 		}
 
-		// Public event routes (no authentication required)
+		// Public event routes (no authentication required). The search endpoints share one
+		// stricter bucket so clients cannot spread scraping across them.
+		searchRateLimit := middleware.SearchRateLimit(deps.Config.RateLimit)
 		publicEvents := v1.Group("/events")
 		{
 			publicEvents.GET("", eventHandler.GetPublicEvents)
-			publicEvents.GET("/search", eventHandler.SearchEvents)
-			publicEvents.GET("/location/:city", eventHandler.GetEventsByLocation)
+			publicEvents.GET("/search", searchRateLimit, eventHandler.SearchEvents)
+			publicEvents.GET("/filter", middleware.OptionalJWTAuth(deps.JWTService), eventHandler.GetEvents)
+			publicEvents.GET("/count", middleware.OptionalJWTAuth(deps.JWTService), eventHandler.CountEvents)
+			publicEvents.GET("/location/:city", searchRateLimit, eventHandler.GetEventsByLocation)
+			publicEvents.GET("/nearby", searchRateLimit, eventHandler.GetNearbyEvents)
 			publicEvents.GET("/upcoming", eventHandler.GetUpcomingEvents)
+			publicEvents.GET("/announcements", eventHandler.GetAnnouncements)
+			publicEvents.GET("/ongoing", eventHandler.GetOngoingEvents)
+			publicEvents.GET("/starting-soon", eventHandler.GetEventsStartingSoon)
+			publicEvents.GET("/featured", eventHandler.GetFeaturedEvents)
+			publicEvents.GET("/trending", eventHandler.GetTrendingEvents)
 			publicEvents.GET("/category/:category_id", eventHandler.GetEventsByCategory)
+			publicEvents.GET("/:id/similar", eventHandler.GetSimilarEvents)
+			publicEvents.GET("/:id/stats", eventHandler.GetEventPublicStats)
+			publicEvents.GET("/:id/rating", middleware.OptionalJWTAuth(deps.JWTService), eventRatingHandler.GetEventRating)
+			publicEvents.GET("/:id/share-meta", eventHandler.GetEventShareMeta)
+			publicEvents.GET("/:id/calendar.ics", middleware.OptionalJWTAuth(deps.JWTService), eventHandler.ExportEventICS)
+			publicEvents.GET("/:id/tickets/availability-summary", eventHandler.GetEventTicketAvailabilitySummary)
+			publicEvents.GET("/:id/invitations/lookup", eventHandler.LookupInvitation)
+			publicEvents.POST("/:id/invitations/respond", eventHandler.RespondToInvitationByToken)
+			publicEvents.GET("/shared/:token", middleware.OptionalJWTAuth(deps.JWTService), eventShareHandler.GetSharedEvent)
 		}
 
-		// Admin routes (require creator user type)
+		// Admin routes (require the admin role)
 		admin := v1.Group("/admin")
 		admin.Use(middleware.JWTAuth(deps.JWTService))
-		admin.Use(middleware.RequireUserType("creator"))
+		admin.Use(middleware.RequireRole("admin"))
 		{
 			admin.GET("/users", userHandler.GetUserList)
+			admin.GET("/events", eventHandler.GetAdminEvents)
+			admin.GET("/events/trashed", eventHandler.GetTrashedEvents)
+			admin.GET("/events/:id/history", eventHandler.GetEventHistory)
+			admin.POST("/events/:id/purge", eventHandler.PurgeEvent)
+			admin.POST("/events/:id/feature", eventHandler.FeatureEvent)
 			admin.GET("/media", mediaHandler.GetAllMedia)
+			admin.GET("/jobs", handler.JobStatuses(deps.Scheduler))
+			admin.GET("/migrations", handler.MigrationStatus(deps.DB))
+			admin.POST("/maintenance/purge", maintenanceHandler.PurgeOldRecords)
+
+			// Subscription analytics routes (admin only)
+			adminSubscriptions := admin.Group("/subscriptions")
+			{
+				adminSubscriptions.GET("/cancellations", subscriptionHandler.GetCancellationStats)
+				adminSubscriptions.POST("/reconcile", subscriptionHandler.ReconcileSubscriptions)
+			}
+
+			// Dead-lettered webhook routes (admin only)
+			adminWebhooks := admin.Group("/webhooks")
+			{
+				adminWebhooks.GET("/failed", subscriptionHandler.GetFailedWebhooks)
+				adminWebhooks.POST("/failed/:id/retry", subscriptionHandler.RetryFailedWebhook)
+			}
+
+			// Event report moderation routes (admin only)
+			adminReports := admin.Group("/reports")
+			{
+				adminReports.GET("", eventReportHandler.GetReports)
+				adminReports.POST("/:id/resolve", eventReportHandler.ResolveReport)
+			}
 
 			// Category cache management routes (admin only)
 			adminCategories := admin.Group("/categories")
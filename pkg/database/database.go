@@ -5,7 +5,6 @@ import (
 	"time"
 
 	"github.com/louco-event/internal/config"
-	"github.com/louco-event/internal/domain"
 	pkgLogger "github.com/louco-event/pkg/logger"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -54,33 +53,6 @@ func New(cfg *config.Config, logger *pkgLogger.Logger) (*Database, error) {
 	return &Database{DB: db}, nil
 }
 
-func (d *Database) AutoMigrate() error {
-	// Run auto migration for all models
-	err := d.DB.AutoMigrate(
-		&domain.User{},
-		&domain.Media{},
-		&domain.Industry{},
-		&domain.Creator{},
-		&domain.CreatorIndustry{},
-		&domain.Category{},
-		&domain.VerificationCode{},
-		&domain.Follow{},
-		&domain.Address{},
-		&domain.Event{},
-		&domain.EventCategory{},
-		&domain.Ticket{},
-		&domain.Invitation{},
-		&domain.SubscriptionPlan{},
-		&domain.UserSubscription{},
-	)
-
-	if err != nil {
-		return fmt.Errorf("failed to auto migrate: %w", err)
-	}
-
-	return nil
-}
-
 func (d *Database) Close() error {
 	sqlDB, err := d.DB.DB()
 	if err != nil {
@@ -0,0 +1,904 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Migration is a single ordered schema change. Versions must be unique and increasing;
+// append new migrations to the end of the list and never edit applied ones. Each migration
+// spells out its own DDL rather than migrating the domain structs, so replaying it on a fresh
+// database builds the schema as it was when the migration was written.
+type Migration struct {
+	Version int
+	Name    string
+	Up      func(tx *gorm.DB) error
+}
+
+// SchemaMigration records an applied migration
+type SchemaMigration struct {
+	Version   int       `json:"version" gorm:"primaryKey;autoIncrement:false"`
+	Name      string    `json:"name" gorm:"type:varchar(200);not null"`
+	AppliedAt time.Time `json:"applied_at" gorm:"not null"`
+}
+
+// MigrationStatus reports how far the database schema is behind the code
+type MigrationStatus struct {
+	CurrentVersion int               `json:"current_version"`
+	LatestVersion  int               `json:"latest_version"`
+	UpToDate       bool              `json:"up_to_date"`
+	Pending        []string          `json:"pending"`
+	Applied        []SchemaMigration `json:"applied"`
+}
+
+// execSQL builds a migration step that runs the given statements in order
+func execSQL(statements ...string) func(tx *gorm.DB) error {
+	return func(tx *gorm.DB) error {
+		for _, statement := range statements {
+			if err := tx.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+var migrations = []Migration{
+	{
+		Version: 1,
+		Name:    "initial_schema",
+		Up: execSQL(
+			`CREATE TABLE IF NOT EXISTS "media" (
+				"id" bigserial,
+				"user_id" bigint,
+				"original_name" text,
+				"file_name" text,
+				"file_path" text,
+				"file_url" text,
+				"media_type" text,
+				"mime_type" text,
+				"file_size" bigint,
+				"width" bigint,
+				"height" bigint,
+				"duration" bigint,
+				"is_converted" boolean,
+				"created_at" timestamptz,
+				"updated_at" timestamptz,
+				PRIMARY KEY ("id")
+			)`,
+			`CREATE TABLE IF NOT EXISTS "users" (
+				"id" bigserial,
+				"full_name" text,
+				"username" text,
+				"email" text,
+				"phone" text,
+				"password" text,
+				"user_type" text,
+				"apple_id" text,
+				"google_id" text,
+				"biography" text,
+				"birth_date" timestamptz,
+				"profile_pic_id" bigint,
+				"cover_pic_id" bigint,
+				"email_verified_at" timestamptz,
+				"phone_verified_at" timestamptz,
+				"followers_count" bigint DEFAULT 0,
+				"following_count" bigint DEFAULT 0,
+				"is_active" boolean,
+				"has_used_trial" boolean DEFAULT false,
+				"created_at" timestamptz,
+				"updated_at" timestamptz,
+				PRIMARY KEY ("id"),
+				CONSTRAINT "fk_users_profile_picture" FOREIGN KEY ("profile_pic_id") REFERENCES "media"("id"),
+				CONSTRAINT "fk_users_cover_picture" FOREIGN KEY ("cover_pic_id") REFERENCES "media"("id")
+			)`,
+			`CREATE TABLE IF NOT EXISTS "industries" (
+				"id" bigserial,
+				"name" varchar(100) NOT NULL,
+				"slug" varchar(100) NOT NULL,
+				"created_at" timestamptz,
+				"updated_at" timestamptz,
+				PRIMARY KEY ("id")
+			)`,
+			`CREATE UNIQUE INDEX IF NOT EXISTS "idx_industries_slug" ON "industries" ("slug")`,
+			`CREATE TABLE IF NOT EXISTS "creators" (
+				"id" bigserial,
+				"user_id" bigint NOT NULL,
+				"weeztix_token" json,
+				"company_name" varchar(200) NOT NULL,
+				"address" varchar(500) NOT NULL,
+				"estimated_tickets" bigint NOT NULL,
+				"estimated_events" bigint NOT NULL,
+				"created_at" timestamptz,
+				"updated_at" timestamptz,
+				PRIMARY KEY ("id"),
+				CONSTRAINT "fk_users_creator" FOREIGN KEY ("user_id") REFERENCES "users"("id")
+			)`,
+			`CREATE UNIQUE INDEX IF NOT EXISTS "idx_creators_user_id" ON "creators" ("user_id")`,
+			`CREATE TABLE IF NOT EXISTS "creator_industries" (
+				"creator_id" bigint,
+				"industry_id" bigint,
+				PRIMARY KEY ("creator_id","industry_id"),
+				CONSTRAINT "fk_creator_industries_creator" FOREIGN KEY ("creator_id") REFERENCES "creators"("id"),
+				CONSTRAINT "fk_creator_industries_industry" FOREIGN KEY ("industry_id") REFERENCES "industries"("id")
+			)`,
+			`CREATE TABLE IF NOT EXISTS "categories" (
+				"id" bigserial,
+				"name" varchar(200) NOT NULL,
+				"icon_id" bigint,
+				"type" varchar(50) NOT NULL,
+				"slug" varchar(250) NOT NULL,
+				"parent_id" bigint,
+				"lft" bigint NOT NULL,
+				"rgt" bigint NOT NULL,
+				"depth" bigint NOT NULL DEFAULT 0,
+				"created_at" timestamptz,
+				"updated_at" timestamptz,
+				PRIMARY KEY ("id"),
+				CONSTRAINT "fk_categories_icon" FOREIGN KEY ("icon_id") REFERENCES "media"("id"),
+				CONSTRAINT "fk_categories_children" FOREIGN KEY ("parent_id") REFERENCES "categories"("id")
+			)`,
+			`CREATE INDEX IF NOT EXISTS "idx_categories_rgt" ON "categories" ("rgt")`,
+			`CREATE INDEX IF NOT EXISTS "idx_categories_lft" ON "categories" ("lft")`,
+			`CREATE INDEX IF NOT EXISTS "idx_categories_parent_id" ON "categories" ("parent_id")`,
+			`CREATE UNIQUE INDEX IF NOT EXISTS "idx_categories_slug" ON "categories" ("slug")`,
+			`CREATE INDEX IF NOT EXISTS "idx_categories_icon_id" ON "categories" ("icon_id")`,
+			`CREATE TABLE IF NOT EXISTS "verification_codes" (
+				"id" bigserial,
+				"identifier" text NOT NULL,
+				"code" text NOT NULL,
+				"type" text NOT NULL,
+				"attempts" bigint DEFAULT 0,
+				"used_at" timestamptz,
+				"expires_at" timestamptz NOT NULL,
+				"created_at" timestamptz,
+				"updated_at" timestamptz,
+				PRIMARY KEY ("id")
+			)`,
+			`CREATE INDEX IF NOT EXISTS "idx_verification_codes_expires_at" ON "verification_codes" ("expires_at")`,
+			`CREATE INDEX IF NOT EXISTS "idx_verification_codes_used_at" ON "verification_codes" ("used_at")`,
+			`CREATE INDEX IF NOT EXISTS "idx_verification_codes_type" ON "verification_codes" ("type")`,
+			`CREATE INDEX IF NOT EXISTS "idx_verification_codes_identifier" ON "verification_codes" ("identifier")`,
+			`CREATE TABLE IF NOT EXISTS "follows" (
+				"id" bigserial,
+				"follower_id" bigint NOT NULL,
+				"following_id" bigint NOT NULL,
+				"created_at" timestamptz,
+				"updated_at" timestamptz,
+				PRIMARY KEY ("id"),
+				CONSTRAINT "fk_follows_follower" FOREIGN KEY ("follower_id") REFERENCES "users"("id"),
+				CONSTRAINT "fk_follows_following" FOREIGN KEY ("following_id") REFERENCES "users"("id")
+			)`,
+			`CREATE INDEX IF NOT EXISTS "idx_follows_following_id" ON "follows" ("following_id")`,
+			`CREATE INDEX IF NOT EXISTS "idx_follows_follower_id" ON "follows" ("follower_id")`,
+			`CREATE TABLE IF NOT EXISTS "addresses" (
+				"id" bigserial,
+				"place_id" varchar(255) NOT NULL,
+				"full_address" text NOT NULL,
+				"country" varchar(100) NOT NULL,
+				"city" varchar(100) NOT NULL,
+				"district" varchar(100),
+				"street" varchar(200),
+				"postal_code" varchar(20),
+				"latitude" decimal(10,8) NOT NULL,
+				"longitude" decimal(11,8) NOT NULL,
+				"door_number" varchar(50),
+				"created_at" timestamptz,
+				"updated_at" timestamptz,
+				PRIMARY KEY ("id")
+			)`,
+			`CREATE UNIQUE INDEX IF NOT EXISTS "idx_addresses_place_id" ON "addresses" ("place_id")`,
+			`CREATE TABLE IF NOT EXISTS "events" (
+				"id" bigserial,
+				"creator_id" bigint NOT NULL,
+				"name" varchar(200) NOT NULL,
+				"description" text,
+				"image_id" bigint,
+				"video_id" bigint,
+				"type" varchar(20) NOT NULL,
+				"location_type" varchar(20) NOT NULL,
+				"status" varchar(20) NOT NULL DEFAULT 'draft',
+				"start_date" date,
+				"start_time" timestamptz,
+				"end_date" date,
+				"end_time" timestamptz,
+				"address_id" bigint,
+				"online_event_url" varchar(500),
+				"online_event_type" varchar(50),
+				"ticket_url" varchar(500),
+				"has_system_tickets" boolean DEFAULT false,
+				"sales_closed_at" timestamptz DEFAULT null,
+				"close_sales_at_start" boolean DEFAULT false,
+				"additional_info" text,
+				"created_at" timestamptz,
+				"updated_at" timestamptz,
+				PRIMARY KEY ("id"),
+				CONSTRAINT "fk_events_image" FOREIGN KEY ("image_id") REFERENCES "media"("id"),
+				CONSTRAINT "fk_events_video" FOREIGN KEY ("video_id") REFERENCES "media"("id"),
+				CONSTRAINT "fk_events_address" FOREIGN KEY ("address_id") REFERENCES "addresses"("id"),
+				CONSTRAINT "fk_events_creator" FOREIGN KEY ("creator_id") REFERENCES "creators"("id")
+			)`,
+			`CREATE INDEX IF NOT EXISTS "idx_events_address_id" ON "events" ("address_id")`,
+			`CREATE INDEX IF NOT EXISTS "idx_events_video_id" ON "events" ("video_id")`,
+			`CREATE INDEX IF NOT EXISTS "idx_events_image_id" ON "events" ("image_id")`,
+			`CREATE INDEX IF NOT EXISTS "idx_events_creator_id" ON "events" ("creator_id")`,
+			`CREATE TABLE IF NOT EXISTS "event_categories" (
+				"event_id" bigint,
+				"category_id" bigint,
+				PRIMARY KEY ("event_id","category_id"),
+				CONSTRAINT "fk_event_categories_category" FOREIGN KEY ("category_id") REFERENCES "categories"("id"),
+				CONSTRAINT "fk_event_categories_event" FOREIGN KEY ("event_id") REFERENCES "events"("id")
+			)`,
+			`CREATE TABLE IF NOT EXISTS "tickets" (
+				"id" bigserial,
+				"event_id" bigint NOT NULL,
+				"title" varchar(200) NOT NULL,
+				"price" decimal(10,2) NOT NULL,
+				"total_quantity" bigint NOT NULL,
+				"sold_quantity" bigint DEFAULT 0,
+				"is_active" boolean DEFAULT true,
+				"created_at" timestamptz,
+				"updated_at" timestamptz,
+				PRIMARY KEY ("id"),
+				CONSTRAINT "fk_events_tickets" FOREIGN KEY ("event_id") REFERENCES "events"("id")
+			)`,
+			`CREATE INDEX IF NOT EXISTS "idx_tickets_event_id" ON "tickets" ("event_id")`,
+			`CREATE TABLE IF NOT EXISTS "invitations" (
+				"id" bigserial,
+				"event_id" bigint NOT NULL,
+				"invited_user_id" bigint,
+				"invited_email" varchar(255) NOT NULL,
+				"status" varchar(20) NOT NULL DEFAULT 'pending',
+				"invited_at" timestamptz,
+				"responded_at" timestamptz,
+				"created_at" timestamptz,
+				"updated_at" timestamptz,
+				PRIMARY KEY ("id"),
+				CONSTRAINT "fk_invitations_invited_user" FOREIGN KEY ("invited_user_id") REFERENCES "users"("id"),
+				CONSTRAINT "fk_events_invitations" FOREIGN KEY ("event_id") REFERENCES "events"("id")
+			)`,
+			`CREATE INDEX IF NOT EXISTS "idx_invitations_invited_email" ON "invitations" ("invited_email")`,
+			`CREATE INDEX IF NOT EXISTS "idx_invitations_invited_user_id" ON "invitations" ("invited_user_id")`,
+			`CREATE INDEX IF NOT EXISTS "idx_invitations_event_id" ON "invitations" ("event_id")`,
+			`CREATE TABLE IF NOT EXISTS "subscription_plans" (
+				"id" bigserial,
+				"type" varchar(20) NOT NULL,
+				"name" varchar(50) NOT NULL,
+				"display_name" varchar(100) NOT NULL,
+				"description" text,
+				"price" decimal(10,2) NOT NULL,
+				"currency" varchar(3) NOT NULL DEFAULT 'EUR',
+				"billing_cycle" varchar(20) DEFAULT null,
+				"weekly_limit" bigint DEFAULT null,
+				"monthly_limit" bigint DEFAULT null,
+				"total_credits" bigint DEFAULT null,
+				"duration_days" bigint DEFAULT null,
+				"trial_days" bigint DEFAULT 0,
+				"is_active" boolean DEFAULT true,
+				"sort_order" bigint DEFAULT 0,
+				"stripe_id" varchar(255) DEFAULT null,
+				"metadata" jsonb DEFAULT '{}',
+				"created_at" timestamptz,
+				"updated_at" timestamptz,
+				PRIMARY KEY ("id")
+			)`,
+			`CREATE INDEX IF NOT EXISTS "idx_subscription_plans_stripe_id" ON "subscription_plans" ("stripe_id")`,
+			`CREATE UNIQUE INDEX IF NOT EXISTS "idx_subscription_plans_name" ON "subscription_plans" ("name")`,
+			`CREATE INDEX IF NOT EXISTS "idx_subscription_plans_type" ON "subscription_plans" ("type")`,
+			`CREATE TABLE IF NOT EXISTS "user_subscriptions" (
+				"id" bigserial,
+				"user_id" bigint NOT NULL,
+				"type" varchar(20) NOT NULL,
+				"name" varchar(50) NOT NULL,
+				"price" decimal(10,2) NOT NULL,
+				"currency" varchar(3) NOT NULL DEFAULT 'EUR',
+				"weekly_limit" bigint DEFAULT null,
+				"monthly_limit" bigint DEFAULT null,
+				"total_credits" bigint DEFAULT null,
+				"used_credits" bigint DEFAULT 0,
+				"weekly_used" bigint DEFAULT 0,
+				"monthly_used" bigint DEFAULT 0,
+				"status" varchar(20) NOT NULL DEFAULT 'pending',
+				"started_at" timestamptz DEFAULT null,
+				"expired_at" timestamptz DEFAULT null,
+				"period_start" timestamptz DEFAULT null,
+				"period_end" timestamptz DEFAULT null,
+				"trial_ends_at" timestamptz DEFAULT null,
+				"cancel_at_period_end" boolean DEFAULT false,
+				"cancellation_reason" text DEFAULT null,
+				"cancelled_at" timestamptz DEFAULT null,
+				"stripe_id" varchar(255) DEFAULT null,
+				"metadata" jsonb DEFAULT '{}',
+				"created_at" timestamptz,
+				"updated_at" timestamptz,
+				PRIMARY KEY ("id"),
+				CONSTRAINT "fk_user_subscriptions_user" FOREIGN KEY ("user_id") REFERENCES "users"("id") ON DELETE CASCADE
+			)`,
+			`CREATE INDEX IF NOT EXISTS "idx_user_subscriptions_stripe_id" ON "user_subscriptions" ("stripe_id")`,
+			`CREATE INDEX IF NOT EXISTS "idx_user_subscriptions_type" ON "user_subscriptions" ("type")`,
+			`CREATE INDEX IF NOT EXISTS "idx_user_subscriptions_user_id" ON "user_subscriptions" ("user_id")`,
+		),
+	},
+	{
+		Version: 2,
+		Name:    "event_history",
+		Up: execSQL(
+			`CREATE TABLE IF NOT EXISTS "event_histories" (
+				"id" bigserial,
+				"event_id" bigint NOT NULL,
+				"actor_user_id" bigint,
+				"action" varchar(30) NOT NULL,
+				"from_status" varchar(20),
+				"to_status" varchar(20),
+				"changed_fields" text,
+				"created_at" timestamptz,
+				PRIMARY KEY ("id"),
+				CONSTRAINT "fk_event_histories_actor" FOREIGN KEY ("actor_user_id") REFERENCES "users"("id")
+			)`,
+			`CREATE INDEX IF NOT EXISTS "idx_event_histories_created_at" ON "event_histories" ("created_at")`,
+			`CREATE INDEX IF NOT EXISTS "idx_event_histories_actor_user_id" ON "event_histories" ("actor_user_id")`,
+			`CREATE INDEX IF NOT EXISTS "idx_event_histories_event_id" ON "event_histories" ("event_id")`,
+		),
+	},
+	{
+		Version: 3,
+		Name:    "ticket_purchases",
+		Up: execSQL(
+			`CREATE TABLE IF NOT EXISTS "ticket_purchases" (
+				"id" bigserial,
+				"ticket_id" bigint NOT NULL,
+				"user_id" bigint NOT NULL,
+				"quantity" bigint NOT NULL,
+				"purchased_at" timestamptz NOT NULL,
+				PRIMARY KEY ("id"),
+				CONSTRAINT "fk_ticket_purchases_ticket" FOREIGN KEY ("ticket_id") REFERENCES "tickets"("id"),
+				CONSTRAINT "fk_ticket_purchases_user" FOREIGN KEY ("user_id") REFERENCES "users"("id")
+			)`,
+			`CREATE INDEX IF NOT EXISTS "idx_ticket_purchases_purchased_at" ON "ticket_purchases" ("purchased_at")`,
+			`CREATE INDEX IF NOT EXISTS "idx_ticket_purchases_user_id" ON "ticket_purchases" ("user_id")`,
+			`CREATE INDEX IF NOT EXISTS "idx_ticket_purchases_ticket_id" ON "ticket_purchases" ("ticket_id")`,
+		),
+	},
+	{
+		Version: 4,
+		Name:    "event_reports",
+		Up: execSQL(
+			`CREATE TABLE IF NOT EXISTS "event_reports" (
+				"id" bigserial,
+				"event_id" bigint NOT NULL,
+				"reporter_user_id" bigint NOT NULL,
+				"reason" varchar(30) NOT NULL,
+				"details" text,
+				"status" varchar(20) NOT NULL DEFAULT 'pending',
+				"resolved_by_user_id" bigint,
+				"resolution_note" text,
+				"resolved_at" timestamptz,
+				"created_at" timestamptz,
+				"updated_at" timestamptz,
+				PRIMARY KEY ("id"),
+				CONSTRAINT "fk_event_reports_event" FOREIGN KEY ("event_id") REFERENCES "events"("id"),
+				CONSTRAINT "fk_event_reports_reporter" FOREIGN KEY ("reporter_user_id") REFERENCES "users"("id")
+			)`,
+			`CREATE INDEX IF NOT EXISTS "idx_event_reports_status" ON "event_reports" ("status")`,
+			`CREATE UNIQUE INDEX IF NOT EXISTS "idx_event_reports_event_reporter" ON "event_reports" ("event_id","reporter_user_id")`,
+		),
+	},
+	{
+		Version: 5,
+		Name:    "event_share_tokens",
+		Up: execSQL(
+			`ALTER TABLE "events" ADD COLUMN IF NOT EXISTS "share_token" varchar(64)`,
+			`ALTER TABLE "events" ADD COLUMN IF NOT EXISTS "share_token_enabled" boolean DEFAULT false`,
+			`ALTER TABLE "events" ADD COLUMN IF NOT EXISTS "share_token_created_at" timestamptz DEFAULT null`,
+			`CREATE UNIQUE INDEX IF NOT EXISTS "idx_events_share_token" ON "events" ("share_token")`,
+			`CREATE TABLE IF NOT EXISTS "share_token_accesses" (
+				"id" bigserial,
+				"event_id" bigint NOT NULL,
+				"user_id" bigint,
+				"ip_address" varchar(45),
+				"accessed_at" timestamptz NOT NULL,
+				PRIMARY KEY ("id"),
+				CONSTRAINT "fk_share_token_accesses_event" FOREIGN KEY ("event_id") REFERENCES "events"("id")
+			)`,
+			`CREATE INDEX IF NOT EXISTS "idx_share_token_accesses_accessed_at" ON "share_token_accesses" ("accessed_at")`,
+			`CREATE INDEX IF NOT EXISTS "idx_share_token_accesses_user_id" ON "share_token_accesses" ("user_id")`,
+			`CREATE INDEX IF NOT EXISTS "idx_share_token_accesses_event_id" ON "share_token_accesses" ("event_id")`,
+		),
+	},
+	{
+		Version: 6,
+		Name:    "event_capacity_warnings",
+		Up: execSQL(
+			`ALTER TABLE "events" ADD COLUMN IF NOT EXISTS "capacity_warning_level" bigint DEFAULT 0`,
+			`ALTER TABLE "event_histories" ADD COLUMN IF NOT EXISTS "threshold" bigint`,
+		),
+	},
+	{
+		Version: 7,
+		Name:    "subscription_invitation_limits",
+		Up: execSQL(
+			`ALTER TABLE "subscription_plans" ADD COLUMN IF NOT EXISTS "max_invitations_per_event" bigint DEFAULT null`,
+			`ALTER TABLE "user_subscriptions" ADD COLUMN IF NOT EXISTS "max_invitations_per_event" bigint DEFAULT null`,
+		),
+	},
+	{
+		Version: 8,
+		Name:    "export_jobs",
+		Up: execSQL(
+			`CREATE TABLE IF NOT EXISTS "export_jobs" (
+				"id" bigserial,
+				"user_id" bigint NOT NULL,
+				"type" varchar(30) NOT NULL,
+				"params" jsonb DEFAULT '{}',
+				"status" varchar(20) NOT NULL DEFAULT 'queued',
+				"result_key" varchar(500),
+				"error" text,
+				"started_at" timestamptz,
+				"completed_at" timestamptz,
+				"created_at" timestamptz,
+				"updated_at" timestamptz,
+				PRIMARY KEY ("id")
+			)`,
+			`CREATE INDEX IF NOT EXISTS "idx_export_jobs_status" ON "export_jobs" ("status")`,
+			`CREATE INDEX IF NOT EXISTS "idx_export_jobs_user_id" ON "export_jobs" ("user_id")`,
+		),
+	},
+	{
+		Version: 9,
+		Name:    "event_join_requests",
+		Up: execSQL(
+			`ALTER TABLE "events" ADD COLUMN IF NOT EXISTS "allow_join_requests" boolean DEFAULT false`,
+			`CREATE TABLE IF NOT EXISTS "event_join_requests" (
+				"id" bigserial,
+				"event_id" bigint NOT NULL,
+				"user_id" bigint NOT NULL,
+				"email" varchar(255) NOT NULL,
+				"message" text,
+				"status" varchar(20) NOT NULL DEFAULT 'pending',
+				"responded_at" timestamptz,
+				"created_at" timestamptz,
+				"updated_at" timestamptz,
+				PRIMARY KEY ("id"),
+				CONSTRAINT "fk_event_join_requests_user" FOREIGN KEY ("user_id") REFERENCES "users"("id")
+			)`,
+			`CREATE INDEX IF NOT EXISTS "idx_event_join_requests_status" ON "event_join_requests" ("status")`,
+			`CREATE UNIQUE INDEX IF NOT EXISTS "idx_event_join_requests_event_user" ON "event_join_requests" ("event_id","user_id")`,
+		),
+	},
+	{
+		Version: 10,
+		Name:    "ticket_reservations",
+		Up: execSQL(
+			`CREATE TABLE IF NOT EXISTS "ticket_reservations" (
+				"id" bigserial,
+				"cart_id" varchar(36) NOT NULL,
+				"ticket_id" bigint NOT NULL,
+				"user_id" bigint NOT NULL,
+				"quantity" bigint NOT NULL,
+				"status" varchar(20) NOT NULL DEFAULT 'active',
+				"expires_at" timestamptz NOT NULL,
+				"created_at" timestamptz,
+				"updated_at" timestamptz,
+				PRIMARY KEY ("id")
+			)`,
+			`CREATE INDEX IF NOT EXISTS "idx_ticket_reservations_user_id" ON "ticket_reservations" ("user_id")`,
+			`CREATE INDEX IF NOT EXISTS "idx_ticket_reservations_ticket_status" ON "ticket_reservations" ("ticket_id","status")`,
+			`CREATE INDEX IF NOT EXISTS "idx_ticket_reservations_cart_id" ON "ticket_reservations" ("cart_id")`,
+		),
+	},
+	{
+		Version: 11,
+		Name:    "invitation_tokens",
+		Up: execSQL(
+			`ALTER TABLE "invitations" ADD COLUMN IF NOT EXISTS "token" varchar(64)`,
+			`CREATE UNIQUE INDEX IF NOT EXISTS "idx_invitations_token" ON "invitations" ("token")`,
+		),
+	},
+	{
+		Version: 12,
+		Name:    "failed_webhooks",
+		Up: execSQL(
+			`CREATE TABLE IF NOT EXISTS "failed_webhooks" (
+				"id" bigserial,
+				"stripe_event_id" varchar(255),
+				"type" varchar(100) NOT NULL,
+				"payload" text NOT NULL,
+				"error" text NOT NULL,
+				"attempts" bigint NOT NULL DEFAULT 1,
+				"resolved_at" timestamptz,
+				"created_at" timestamptz,
+				"updated_at" timestamptz,
+				PRIMARY KEY ("id")
+			)`,
+			`CREATE INDEX IF NOT EXISTS "idx_failed_webhooks_resolved_at" ON "failed_webhooks" ("resolved_at")`,
+			`CREATE INDEX IF NOT EXISTS "idx_failed_webhooks_stripe_event_id" ON "failed_webhooks" ("stripe_event_id")`,
+		),
+	},
+	{
+		Version: 13,
+		Name:    "event_co_hosts",
+		Up: execSQL(
+			`CREATE TABLE IF NOT EXISTS "event_co_hosts" (
+				"id" bigserial,
+				"event_id" bigint NOT NULL,
+				"creator_id" bigint NOT NULL,
+				"role" varchar(20) NOT NULL DEFAULT 'editor',
+				"accepted_at" timestamptz,
+				"created_at" timestamptz,
+				"updated_at" timestamptz,
+				PRIMARY KEY ("id"),
+				CONSTRAINT "fk_event_co_hosts_creator" FOREIGN KEY ("creator_id") REFERENCES "creators"("id")
+			)`,
+			`CREATE INDEX IF NOT EXISTS "idx_event_co_hosts_creator_id" ON "event_co_hosts" ("creator_id")`,
+			`CREATE UNIQUE INDEX IF NOT EXISTS "idx_event_co_hosts_event_creator" ON "event_co_hosts" ("event_id","creator_id")`,
+		),
+	},
+	{
+		Version: 14,
+		Name:    "event_interests",
+		Up: execSQL(
+			`ALTER TABLE "events" ADD COLUMN IF NOT EXISTS "interest_milestone_level" bigint DEFAULT 0`,
+			`CREATE TABLE IF NOT EXISTS "event_interests" (
+				"id" bigserial,
+				"event_id" bigint NOT NULL,
+				"user_id" bigint NOT NULL,
+				"email" varchar(255) NOT NULL,
+				"created_at" timestamptz,
+				PRIMARY KEY ("id")
+			)`,
+			`CREATE INDEX IF NOT EXISTS "idx_event_interests_user_id" ON "event_interests" ("user_id")`,
+			`CREATE UNIQUE INDEX IF NOT EXISTS "idx_event_interests_event_user" ON "event_interests" ("event_id","user_id")`,
+		),
+	},
+	{
+		Version: 15,
+		Name:    "event_ratings",
+		Up: execSQL(
+			`CREATE TABLE IF NOT EXISTS "event_ratings" (
+				"id" bigserial,
+				"event_id" bigint NOT NULL,
+				"user_id" bigint NOT NULL,
+				"stars" bigint NOT NULL,
+				"comment" text,
+				"created_at" timestamptz,
+				"updated_at" timestamptz,
+				PRIMARY KEY ("id")
+			)`,
+			`CREATE INDEX IF NOT EXISTS "idx_event_ratings_user_id" ON "event_ratings" ("user_id")`,
+			`CREATE UNIQUE INDEX IF NOT EXISTS "idx_event_ratings_event_user" ON "event_ratings" ("event_id","user_id")`,
+		),
+	},
+	{
+		Version: 16,
+		Name:    "ticket_versions",
+		Up: execSQL(
+			`ALTER TABLE "tickets" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1`,
+		),
+	},
+	{
+		Version: 17,
+		Name:    "event_soft_delete",
+		Up: execSQL(
+			`ALTER TABLE "events" ADD COLUMN IF NOT EXISTS "deleted_at" timestamptz`,
+			`CREATE INDEX IF NOT EXISTS "idx_events_deleted_at" ON "events" ("deleted_at")`,
+		),
+	},
+	{
+		Version: 18,
+		Name:    "event_timezones",
+		Up: execSQL(
+			`ALTER TABLE "events" ADD COLUMN IF NOT EXISTS "timezone" varchar(64) NOT NULL DEFAULT ''`,
+		),
+	},
+	{
+		Version: 19,
+		Name:    "event_capacity",
+		Up: execSQL(
+			`ALTER TABLE "events" ADD COLUMN IF NOT EXISTS "capacity" bigint DEFAULT null`,
+		),
+	},
+	{
+		Version: 20,
+		Name:    "event_history_changes",
+		Up: execSQL(
+			`ALTER TABLE "event_histories" ADD COLUMN IF NOT EXISTS "changes" jsonb`,
+			`ALTER TABLE "event_histories" ADD COLUMN IF NOT EXISTS "notified_at" timestamptz DEFAULT null`,
+		),
+	},
+	{
+		Version: 21,
+		Name:    "event_featured",
+		Up: execSQL(
+			`ALTER TABLE "events" ADD COLUMN IF NOT EXISTS "featured" boolean DEFAULT false`,
+			`ALTER TABLE "events" ADD COLUMN IF NOT EXISTS "featured_at" timestamptz DEFAULT null`,
+			`CREATE INDEX IF NOT EXISTS "idx_events_featured" ON "events" ("featured")`,
+		),
+	},
+	{
+		Version: 22,
+		Name:    "event_views",
+		Up: execSQL(
+			`CREATE TABLE IF NOT EXISTS "event_views" (
+				"id" bigserial,
+				"event_id" bigint NOT NULL,
+				"user_id" bigint,
+				"viewed_at" timestamptz NOT NULL,
+				PRIMARY KEY ("id")
+			)`,
+			`CREATE INDEX IF NOT EXISTS "idx_event_views_user_id" ON "event_views" ("user_id")`,
+			`CREATE INDEX IF NOT EXISTS "idx_event_views_event_viewed" ON "event_views" ("event_id","viewed_at")`,
+		),
+	},
+	{
+		Version: 23,
+		Name:    "address_timezones",
+		Up: execSQL(
+			`ALTER TABLE "addresses" ADD COLUMN IF NOT EXISTS "timezone" varchar(64) NOT NULL DEFAULT ''`,
+		),
+	},
+	{
+		Version: 24,
+		Name:    "ticket_title_unique",
+		Up:      ticketTitleUnique,
+	},
+	{
+		Version: 25,
+		Name:    "name_collations",
+		Up: func(tx *gorm.DB) error {
+			// Collations for sorting names in the supported languages
+			for _, locale := range []string{"en-US", "tr-TR"} {
+				err := tx.Exec(fmt.Sprintf(`CREATE COLLATION IF NOT EXISTS "%s" (provider = icu, locale = '%s')`, locale, locale)).Error
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+	{
+		Version: 26,
+		Name:    "waitlists",
+		Up: execSQL(
+			`CREATE TABLE IF NOT EXISTS "waitlists" (
+				"id" bigserial,
+				"event_id" bigint NOT NULL,
+				"user_id" bigint NOT NULL,
+				"email" varchar(255) NOT NULL,
+				"status" varchar(20) NOT NULL DEFAULT 'waiting',
+				"position" bigint NOT NULL,
+				"promoted_at" timestamptz DEFAULT null,
+				"created_at" timestamptz,
+				"updated_at" timestamptz,
+				PRIMARY KEY ("id")
+			)`,
+			`CREATE INDEX IF NOT EXISTS "idx_waitlists_user_id" ON "waitlists" ("user_id")`,
+			`CREATE INDEX IF NOT EXISTS "idx_waitlists_event_position" ON "waitlists" ("event_id","position")`,
+			`CREATE UNIQUE INDEX IF NOT EXISTS "idx_waitlists_event_user" ON "waitlists" ("event_id","user_id")`,
+		),
+	},
+	{
+		Version: 27,
+		Name:    "event_categories_unique",
+		Up: func(tx *gorm.DB) error {
+			// Keep the oldest row of each duplicate pair so the index can be built
+			err := tx.Exec(`DELETE FROM event_categories duplicate USING event_categories original
+				WHERE duplicate.event_id = original.event_id
+					AND duplicate.category_id = original.category_id
+					AND duplicate.id > original.id`).Error
+			if err != nil {
+				return err
+			}
+			return tx.Exec("CREATE UNIQUE INDEX IF NOT EXISTS idx_event_categories_event_category ON event_categories (event_id, category_id)").Error
+		},
+	},
+	{
+		Version: 28,
+		Name:    "user_roles",
+		Up: execSQL(
+			`ALTER TABLE "users" ADD COLUMN IF NOT EXISTS "role" varchar(20) NOT NULL DEFAULT 'member'`,
+		),
+	},
+	{
+		Version: 29,
+		Name:    "failed_webhook_retry_claims",
+		Up: execSQL(
+			`ALTER TABLE "failed_webhooks" ADD COLUMN IF NOT EXISTS "retrying_at" timestamptz DEFAULT null`,
+		),
+	},
+	{
+		Version: 30,
+		Name:    "event_history_notes",
+		Up: execSQL(
+			`ALTER TABLE "event_histories" ADD COLUMN IF NOT EXISTS "note" text`,
+		),
+	},
+	{
+		Version: 31,
+		Name:    "event_hide_going_count",
+		Up: execSQL(
+			`ALTER TABLE "events" ADD COLUMN IF NOT EXISTS "hide_going_count" boolean DEFAULT false`,
+		),
+	},
+	{
+		Version: 32,
+		Name:    "event_view_ip_addresses",
+		Up: execSQL(
+			`ALTER TABLE "event_views" ADD COLUMN IF NOT EXISTS "ip_address" varchar(45)`,
+		),
+	},
+	{
+		Version: 33,
+		Name:    "subscription_histories",
+		Up: execSQL(
+			`CREATE TABLE IF NOT EXISTS "subscription_histories" (
+				"id" bigserial,
+				"subscription_id" bigint NOT NULL,
+				"actor_user_id" bigint,
+				"action" varchar(30) NOT NULL,
+				"reason" text,
+				"created_at" timestamptz,
+				PRIMARY KEY ("id")
+			)`,
+			`CREATE INDEX IF NOT EXISTS "idx_subscription_histories_created_at" ON "subscription_histories" ("created_at")`,
+			`CREATE INDEX IF NOT EXISTS "idx_subscription_histories_actor_user_id" ON "subscription_histories" ("actor_user_id")`,
+			`CREATE INDEX IF NOT EXISTS "idx_subscription_histories_subscription_id" ON "subscription_histories" ("subscription_id")`,
+		),
+	},
+	{
+		Version: 34,
+		Name:    "user_languages",
+		Up: execSQL(
+			`ALTER TABLE "users" ADD COLUMN IF NOT EXISTS "language" varchar(10) NOT NULL DEFAULT ''`,
+		),
+	},
+	{
+		Version: 35,
+		Name:    "creator_timezones",
+		Up: execSQL(
+			`ALTER TABLE "creators" ADD COLUMN IF NOT EXISTS "timezone" varchar(64) NOT NULL DEFAULT ''`,
+		),
+	},
+	{
+		Version: 36,
+		Name:    "pending_checkouts",
+		Up: execSQL(
+			`CREATE TABLE IF NOT EXISTS "pending_checkouts" (
+				"id" bigserial,
+				"stripe_session_id" varchar(255) NOT NULL,
+				"cart_reservation_id" varchar(36) NOT NULL,
+				"user_id" bigint NOT NULL,
+				"status" varchar(20) NOT NULL DEFAULT 'pending',
+				"expires_at" timestamptz NOT NULL,
+				"created_at" timestamptz,
+				"updated_at" timestamptz,
+				PRIMARY KEY ("id")
+			)`,
+			`CREATE INDEX IF NOT EXISTS "idx_pending_checkouts_status" ON "pending_checkouts" ("status")`,
+			`CREATE INDEX IF NOT EXISTS "idx_pending_checkouts_user_id" ON "pending_checkouts" ("user_id")`,
+			`CREATE INDEX IF NOT EXISTS "idx_pending_checkouts_cart_reservation_id" ON "pending_checkouts" ("cart_reservation_id")`,
+			`CREATE UNIQUE INDEX IF NOT EXISTS "idx_pending_checkouts_stripe_session_id" ON "pending_checkouts" ("stripe_session_id")`,
+		),
+	},
+	{
+		Version: 37,
+		Name:    "event_co_host_event_fk",
+		Up: execSQL(
+			`DO $$
+			BEGIN
+				IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'fk_events_co_hosts') THEN
+					ALTER TABLE "event_co_hosts" ADD CONSTRAINT "fk_events_co_hosts" FOREIGN KEY ("event_id") REFERENCES "events"("id");
+				END IF;
+			END $$`,
+		),
+	},
+}
+
+// ErrDuplicateTicketTitles stops the ticket_title_unique migration while an event still has
+// tickets whose titles differ only by case
+var ErrDuplicateTicketTitles = errors.New("duplicate ticket titles must be renamed before the unique index can be built")
+
+// ticketTitleUnique builds the per-event unique index on ticket titles. Duplicates are not
+// renamed automatically since only the organizer knows which tier is which; the migration fails
+// listing them instead.
+func ticketTitleUnique(tx *gorm.DB) error {
+	var duplicates []struct {
+		EventID   int
+		Title     string
+		TicketIDs string
+	}
+	err := tx.Raw(`SELECT event_id, LOWER(title) AS title, STRING_AGG(id::text, ', ' ORDER BY id) AS ticket_ids
+		FROM tickets
+		GROUP BY event_id, LOWER(title)
+		HAVING COUNT(*) > 1
+		ORDER BY event_id, LOWER(title)`).Scan(&duplicates).Error
+	if err != nil {
+		return fmt.Errorf("failed to find duplicate ticket titles: %w", err)
+	}
+	if len(duplicates) > 0 {
+		report := make([]string, len(duplicates))
+		for i, duplicate := range duplicates {
+			report[i] = fmt.Sprintf("event %d title %q tickets %s", duplicate.EventID, duplicate.Title, duplicate.TicketIDs)
+		}
+		return fmt.Errorf("%w: %s", ErrDuplicateTicketTitles, strings.Join(report, "; "))
+	}
+	return tx.Exec("CREATE UNIQUE INDEX IF NOT EXISTS idx_tickets_event_title ON tickets (event_id, LOWER(title))").Error
+}
+
+// Migrate applies pending migrations in version order, each in its own transaction
+func (d *Database) Migrate() error {
+	if err := d.DB.AutoMigrate(&SchemaMigration{}); err != nil {
+		return fmt.Errorf("failed to create schema migrations table: %w", err)
+	}
+
+	current, err := d.currentVersion()
+	if err != nil {
+		return err
+	}
+
+	for _, migration := range migrations {
+		if migration.Version <= current {
+			continue
+		}
+
+		err := d.DB.Transaction(func(tx *gorm.DB) error {
+			if err := migration.Up(tx); err != nil {
+				return err
+			}
+			return tx.Create(&SchemaMigration{
+				Version:   migration.Version,
+				Name:      migration.Name,
+				AppliedAt: time.Now(),
+			}).Error
+		})
+		if err != nil {
+			return fmt.Errorf("failed to apply migration %d (%s): %w", migration.Version, migration.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// MigrationStatus returns the applied version and any migrations still pending
+func (d *Database) MigrationStatus() (*MigrationStatus, error) {
+	var applied []SchemaMigration
+	if err := d.DB.Order("version ASC").Find(&applied).Error; err != nil {
+		return nil, fmt.Errorf("failed to get applied migrations: %w", err)
+	}
+
+	status := &MigrationStatus{
+		LatestVersion: LatestMigrationVersion(),
+		Pending:       make([]string, 0),
+		Applied:       applied,
+	}
+	if len(applied) > 0 {
+		status.CurrentVersion = applied[len(applied)-1].Version
+	}
+	for _, migration := range migrations {
+		if migration.Version > status.CurrentVersion {
+			status.Pending = append(status.Pending, fmt.Sprintf("%d_%s", migration.Version, migration.Name))
+		}
+	}
+	status.UpToDate = len(status.Pending) == 0
+
+	return status, nil
+}
+
+// LatestMigrationVersion returns the version the code expects the schema to be at
+func LatestMigrationVersion() int {
+	if len(migrations) == 0 {
+		return 0
+	}
+	return migrations[len(migrations)-1].Version
+}
+
+func (d *Database) currentVersion() (int, error) {
+	var version int
+	if err := d.DB.Model(&SchemaMigration{}).Select("COALESCE(MAX(version), 0)").Scan(&version).Error; err != nil {
+		return 0, fmt.Errorf("failed to get schema version: %w", err)
+	}
+	return version, nil
+}
@@ -0,0 +1,90 @@
+package database_test
+
+import (
+	"testing"
+
+	"github.com/louco-event/internal/domain"
+	"github.com/louco-event/internal/testutil"
+	"github.com/louco-event/pkg/database"
+	"gorm.io/gorm"
+)
+
+func TestMigrateRecordsAppliedVersion(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	migrator := &database.Database{DB: db}
+
+	// A second run finds nothing left to apply
+	if err := migrator.Migrate(); err != nil {
+		t.Fatalf("Migrate: %v", err)
+	}
+
+	status, err := migrator.MigrationStatus()
+	if err != nil {
+		t.Fatalf("MigrationStatus: %v", err)
+	}
+	latest := database.LatestMigrationVersion()
+	if status.CurrentVersion != latest || status.LatestVersion != latest {
+		t.Errorf("current version %d, latest %d; want both at %d", status.CurrentVersion, status.LatestVersion, latest)
+	}
+	if !status.UpToDate || len(status.Pending) != 0 {
+		t.Errorf("status = %+v, want up to date with nothing pending", status)
+	}
+	if len(status.Applied) != latest {
+		t.Fatalf("%d migrations recorded, want %d", len(status.Applied), latest)
+	}
+	for i, applied := range status.Applied {
+		if applied.Version != i+1 || applied.Name == "" || applied.AppliedAt.IsZero() {
+			t.Errorf("applied migration %d = %+v, want version %d with a name and time", i, applied, i+1)
+		}
+	}
+
+	// Rolling the record back reports the newest migration as pending again
+	if err := db.Where("version = ?", latest).Delete(&database.SchemaMigration{}).Error; err != nil {
+		t.Fatalf("failed to remove the latest migration record: %v", err)
+	}
+	status, err = migrator.MigrationStatus()
+	if err != nil {
+		t.Fatalf("MigrationStatus: %v", err)
+	}
+	if status.CurrentVersion != latest-1 || status.UpToDate || len(status.Pending) != 1 {
+		t.Errorf("status = %+v, want version %d with one migration pending", status, latest-1)
+	}
+}
+
+// The migrations are frozen DDL, so nothing else keeps them in step with the models
+func TestMigrationsCoverModels(t *testing.T) {
+	db := testutil.NewPostgres(t)
+	models := []interface{}{
+		&domain.Address{}, &domain.Category{}, &domain.Creator{}, &domain.CreatorIndustry{},
+		&domain.Event{}, &domain.EventCategory{}, &domain.EventCoHost{}, &domain.EventHistory{},
+		&domain.EventInterest{}, &domain.EventJoinRequest{}, &domain.EventRating{}, &domain.EventReport{},
+		&domain.EventView{}, &domain.ExportJob{}, &domain.FailedWebhook{}, &domain.Follow{},
+		&domain.Industry{}, &domain.Invitation{}, &domain.Media{}, &domain.PendingCheckout{},
+		&domain.ShareTokenAccess{}, &domain.SubscriptionHistory{}, &domain.SubscriptionPlan{},
+		&domain.Ticket{}, &domain.TicketPurchase{}, &domain.TicketReservation{}, &domain.User{},
+		&domain.UserSubscription{}, &domain.VerificationCode{}, &domain.Waitlist{},
+	}
+	for _, model := range models {
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(model); err != nil {
+			t.Fatalf("failed to parse %T: %v", model, err)
+		}
+		if !db.Migrator().HasTable(model) {
+			t.Errorf("table %s is missing", stmt.Schema.Table)
+			continue
+		}
+		for _, field := range stmt.Schema.Fields {
+			if field.DBName == "" || field.IgnoreMigration {
+				continue
+			}
+			if !db.Migrator().HasColumn(model, field.DBName) {
+				t.Errorf("column %s.%s is missing", stmt.Schema.Table, field.DBName)
+			}
+		}
+		for _, index := range stmt.Schema.ParseIndexes() {
+			if !db.Migrator().HasIndex(model, index.Name) {
+				t.Errorf("index %s is missing", index.Name)
+			}
+		}
+	}
+}
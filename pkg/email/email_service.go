@@ -10,6 +10,7 @@ import (
 
 type EmailService interface {
 	SendVerificationCode(ctx context.Context, email, code, language string) error
+	SendNotification(ctx context.Context, email, subject, message string) error
 }
 
 type emailService struct {
@@ -73,6 +74,20 @@ func (e *emailService) SendVerificationCode(ctx context.Context, email, code, la
 	return nil
 }
 
+func (e *emailService) SendNotification(ctx context.Context, email, subject, message string) error {
+	htmlContent := "<p>" + template.HTMLEscapeString(message) + "</p>"
+	emailMessage := e.createEmailMessage(email, subject, htmlContent, message)
+
+	auth := smtp.PlainAuth("", e.smtpUsername, e.smtpPassword, e.smtpHost)
+	addr := fmt.Sprintf("%s:%d", e.smtpHost, e.smtpPort)
+
+	if err := smtp.SendMail(addr, auth, e.fromEmail, []string{email}, []byte(emailMessage)); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+
+	return nil
+}
+
 func (e *emailService) generateVerificationHTML(code, language string) (string, error) {
 	data := VerificationEmailData{
 		Code:     code,
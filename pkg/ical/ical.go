@@ -0,0 +1,100 @@
+package ical
+
+import (
+	"strings"
+	"time"
+)
+
+// productID identifies the generator in the PRODID property
+const productID = "-//Louco Event//Events//EN"
+
+// maxLineOctets is the RFC 5545 content line limit before folding
+const maxLineOctets = 75
+
+// Event is one VEVENT. All-day events use only the dates of Start and End, with End being the
+// last day of the event; timed events are written in UTC.
+type Event struct {
+	UID         string
+	Start       time.Time
+	End         time.Time
+	AllDay      bool
+	Summary     string
+	Description string
+	Location    string
+	URL         string
+	Updated     time.Time
+}
+
+// Calendar renders the events as an RFC 5545 VCALENDAR
+func Calendar(events ...Event) []byte {
+	var b strings.Builder
+	writeLine(&b, "BEGIN:VCALENDAR")
+	writeLine(&b, "VERSION:2.0")
+	writeLine(&b, "PRODID:"+productID)
+	writeLine(&b, "CALSCALE:GREGORIAN")
+	writeLine(&b, "METHOD:PUBLISH")
+	for _, event := range events {
+		writeEvent(&b, event)
+	}
+	writeLine(&b, "END:VCALENDAR")
+	return []byte(b.String())
+}
+
+func writeEvent(b *strings.Builder, event Event) {
+	writeLine(b, "BEGIN:VEVENT")
+	writeLine(b, "UID:"+escapeText(event.UID))
+	writeLine(b, "DTSTAMP:"+formatUTC(event.Updated))
+	if event.AllDay {
+		// DTEND of an all-day event is exclusive, so it is the day after the last day
+		writeLine(b, "DTSTART;VALUE=DATE:"+event.Start.Format("20060102"))
+		writeLine(b, "DTEND;VALUE=DATE:"+event.End.AddDate(0, 0, 1).Format("20060102"))
+	} else {
+		writeLine(b, "DTSTART:"+formatUTC(event.Start))
+		writeLine(b, "DTEND:"+formatUTC(event.End))
+	}
+	writeLine(b, "SUMMARY:"+escapeText(event.Summary))
+	if event.Description != "" {
+		writeLine(b, "DESCRIPTION:"+escapeText(event.Description))
+	}
+	if event.Location != "" {
+		writeLine(b, "LOCATION:"+escapeText(event.Location))
+	}
+	if event.URL != "" {
+		writeLine(b, "URL:"+event.URL)
+	}
+	writeLine(b, "END:VEVENT")
+}
+
+func formatUTC(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// escapeText escapes a TEXT value as RFC 5545 section 3.3.11 requires
+func escapeText(text string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	replacer := strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`, "\r", `\n`)
+	return replacer.Replace(text)
+}
+
+// writeLine writes a CRLF terminated content line, folding it so no line exceeds 75 octets
+// without splitting a UTF-8 character
+func writeLine(b *strings.Builder, line string) {
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !isRuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// Continuation lines start with a space, which counts towards the limit
+		limit = maxLineOctets - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+func isRuneStart(c byte) bool {
+	return c&0xC0 != 0x80
+}
@@ -0,0 +1,54 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestCalendarFoldsAndEscapes(t *testing.T) {
+	start := time.Date(2030, time.June, 1, 17, 0, 0, 0, time.UTC)
+	description := "Line one\r\nLine two " + strings.Repeat("ü", 60)
+	data := string(Calendar(Event{
+		UID:         "event-1@louco.test",
+		Start:       start,
+		End:         start.Add(3 * time.Hour),
+		Summary:     "Jazz, wine; and more",
+		Description: description,
+		Updated:     start,
+	}))
+
+	// Folding keeps lines within the limit and never splits a character
+	for _, line := range strings.Split(strings.TrimSuffix(data, "\r\n"), "\r\n") {
+		if len(line) > maxLineOctets {
+			t.Errorf("line of %d octets: %q", len(line), line)
+		}
+		if !utf8.ValidString(line) {
+			t.Errorf("folding split a character: %q", line)
+		}
+	}
+
+	unfolded := strings.ReplaceAll(data, "\r\n ", "")
+	for _, want := range []string{
+		`SUMMARY:Jazz\, wine\; and more` + "\r\n",
+		`DESCRIPTION:Line one\nLine two ` + strings.Repeat("ü", 60) + "\r\n",
+		"DTSTART:20300601T170000Z\r\n",
+		"DTEND:20300601T200000Z\r\n",
+	} {
+		if !strings.Contains(unfolded, want) {
+			t.Errorf("calendar lacks %q:\n%s", want, unfolded)
+		}
+	}
+}
+
+func TestCalendarAllDayEndIsExclusive(t *testing.T) {
+	day := time.Date(2030, time.July, 10, 0, 0, 0, 0, time.UTC)
+	data := string(Calendar(Event{UID: "event-2@louco.test", Start: day, End: day.AddDate(0, 0, 2), AllDay: true, Summary: "Launch"}))
+
+	for _, want := range []string{"DTSTART;VALUE=DATE:20300710\r\n", "DTEND;VALUE=DATE:20300713\r\n"} {
+		if !strings.Contains(data, want) {
+			t.Errorf("calendar lacks %q:\n%s", want, data)
+		}
+	}
+}
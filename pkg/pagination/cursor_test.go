@@ -0,0 +1,60 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCursorCodecRoundTrip(t *testing.T) {
+	codec := NewCursorCodec("cursor-secret")
+	cursor := Cursor{CreatedAt: time.Date(2026, 3, 14, 9, 26, 53, 589000000, time.UTC), ID: 42}
+
+	token, err := codec.Encode(cursor)
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	decoded, err := codec.Decode(token)
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if !decoded.CreatedAt.Equal(cursor.CreatedAt) || decoded.ID != cursor.ID {
+		t.Errorf("Decode() = %+v, want %+v", *decoded, cursor)
+	}
+}
+
+func TestCursorCodecRejectsTamperedCursors(t *testing.T) {
+	codec := NewCursorCodec("cursor-secret")
+	token, err := codec.Encode(Cursor{CreatedAt: time.Now().UTC(), ID: 42})
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	payload, signature, _ := strings.Cut(token, ".")
+	forged := base64.RawURLEncoding.EncodeToString([]byte(`{"c":"2026-01-01T00:00:00Z","i":1}`))
+	otherKey, err := NewCursorCodec("other-secret").Encode(Cursor{CreatedAt: time.Now().UTC(), ID: 42})
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"forged payload", forged + "." + signature},
+		{"altered signature", payload + "." + strings.Repeat("A", len(signature))},
+		{"signed with another key", otherKey},
+		{"missing signature", payload},
+		{"not base64", "%%%." + signature},
+		{"empty", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := codec.Decode(tt.token); !errors.Is(err, ErrInvalidCursor) {
+				t.Errorf("Decode() error = %v, want ErrInvalidCursor", err)
+			}
+		})
+	}
+}
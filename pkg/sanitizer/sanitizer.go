@@ -0,0 +1,64 @@
+package sanitizer
+
+import (
+	"html"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+)
+
+// Mode selects how much markup user text may keep
+type Mode string
+
+const (
+	// ModePlainText strips every tag and keeps the text HTML-escaped, so it is safe wherever
+	// it ends up rendered as markup
+	ModePlainText Mode = "plain"
+	// ModeLimitedHTML keeps basic formatting and links and drops everything else
+	ModeLimitedHTML Mode = "html"
+)
+
+// Sanitizer cleans user supplied text before it is stored and shown to other users
+type Sanitizer struct {
+	policy *bluemonday.Policy
+}
+
+// New creates a sanitizer for the given mode; unknown modes fall back to plain text
+func New(mode Mode) *Sanitizer {
+	if mode == ModeLimitedHTML {
+		return &Sanitizer{policy: limitedHTMLPolicy()}
+	}
+	return &Sanitizer{policy: bluemonday.StrictPolicy()}
+}
+
+// Sanitize removes disallowed markup, including scripts, styles and event handler attributes.
+// The result stays HTML-escaped: unescaping it would turn entity-encoded input such as
+// &lt;script&gt; back into live markup.
+func (s *Sanitizer) Sanitize(text string) string {
+	return strings.TrimSpace(s.policy.Sanitize(text))
+}
+
+// SanitizePtr sanitizes an optional text, leaving nil untouched
+func (s *Sanitizer) SanitizePtr(text *string) *string {
+	if text == nil {
+		return nil
+	}
+	cleaned := s.Sanitize(*text)
+	return &cleaned
+}
+
+// PlainText reduces sanitized text of either mode to unescaped plain text. Only use it for
+// outputs that are never parsed as HTML, such as calendar files.
+func PlainText(text string) string {
+	return strings.TrimSpace(html.UnescapeString(bluemonday.StrictPolicy().Sanitize(text)))
+}
+
+func limitedHTMLPolicy() *bluemonday.Policy {
+	policy := bluemonday.NewPolicy()
+	policy.AllowElements("p", "br", "b", "strong", "i", "em", "u", "s", "ul", "ol", "li", "blockquote", "h3", "h4")
+	policy.AllowStandardURLs()
+	policy.AllowAttrs("href").OnElements("a")
+	policy.RequireNoFollowOnLinks(true)
+	policy.AddTargetBlankToFullyQualifiedLinks(true)
+	return policy
+}
@@ -0,0 +1,66 @@
+package sanitizer
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSanitizePlainText(t *testing.T) {
+	plain := New(ModePlainText)
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"script tag", `<script>alert(1)</script>Hello`, "Hello"},
+		{"event handler", `<img src="x" onerror="alert(1)">Hi`, "Hi"},
+		{"entity-encoded script", "&lt;script&gt;alert(1)&lt;/script&gt;", "&lt;script&gt;alert(1)&lt;/script&gt;"},
+		{"formatting", "<b>Bold</b> and <i>italic</i>", "Bold and italic"},
+		{"ampersand", "Tom & Jerry", "Tom &amp; Jerry"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := plain.Sanitize(tt.input)
+			if got != tt.want {
+				t.Errorf("Sanitize(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+			if strings.Contains(got, "<") {
+				t.Errorf("Sanitize(%q) = %q keeps markup", tt.input, got)
+			}
+			// Stored text is sanitized again on edits, so escaping must not pile up
+			if again := plain.Sanitize(got); again != got {
+				t.Errorf("Sanitize is not idempotent: %q then %q", got, again)
+			}
+		})
+	}
+}
+
+func TestSanitizeLimitedHTML(t *testing.T) {
+	limited := New(ModeLimitedHTML)
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"script tag", `<p>Hi</p><script>alert(1)</script>`, "<p>Hi</p>"},
+		{"entity-encoded script", "<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>", "<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>"},
+		{"formatting", "<p><b>Bold</b>, <em>em</em> and <u>u</u></p><ul><li>one</li></ul>", "<p><b>Bold</b>, <em>em</em> and <u>u</u></p><ul><li>one</li></ul>"},
+		{"event handler", `<p onclick="alert(1)">Hi</p>`, "<p>Hi</p>"},
+		{"javascript link", `<a href="javascript:alert(1)">x</a>`, "x"},
+		{"link", `<a href="https://example.com">site</a>`, `<a href="https://example.com" rel="nofollow noopener" target="_blank">site</a>`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := limited.Sanitize(tt.input); got != tt.want {
+				t.Errorf("Sanitize(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlainText(t *testing.T) {
+	stored := New(ModeLimitedHTML).Sanitize("<p>Tom &amp; <b>Jerry</b></p>")
+	if got := PlainText(stored); got != "Tom & Jerry" {
+		t.Errorf("PlainText(%q) = %q, want %q", stored, got, "Tom & Jerry")
+	}
+}
@@ -0,0 +1,70 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// S3Config holds the connection settings of an S3 compatible object store
+type S3Config struct {
+	Endpoint             string
+	AccessKeyID          string
+	SecretAccessKey      string
+	Region               string
+	Bucket               string
+	UsePathStyleEndpoint bool
+}
+
+// ObjectStorage is the part of the object store used to keep generated files private
+type ObjectStorage interface {
+	Put(ctx context.Context, key, contentType string, body io.ReadSeeker) error
+	PresignGet(key string, ttl time.Duration) (string, error)
+}
+
+var _ ObjectStorage = (*S3Storage)(nil)
+
+// S3Storage stores private objects and hands out time-limited download links
+type S3Storage struct {
+	client *s3.S3
+	bucket string
+}
+
+func NewS3Storage(cfg S3Config) *S3Storage {
+	sess := session.Must(session.NewSession(&aws.Config{
+		Endpoint:         aws.String(cfg.Endpoint),
+		Region:           aws.String(cfg.Region),
+		Credentials:      credentials.NewStaticCredentials(cfg.AccessKeyID, cfg.SecretAccessKey, ""),
+		S3ForcePathStyle: aws.Bool(cfg.UsePathStyleEndpoint),
+	}))
+
+	return &S3Storage{
+		client: s3.New(sess),
+		bucket: cfg.Bucket,
+	}
+}
+
+// Put uploads a private object under key
+func (s *S3Storage) Put(ctx context.Context, key, contentType string, body io.ReadSeeker) error {
+	_, err := s.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(key),
+		Body:        body,
+		ContentType: aws.String(contentType),
+	})
+	return err
+}
+
+// PresignGet returns a download URL for key that stays valid for ttl
+func (s *S3Storage) PresignGet(key string, ttl time.Duration) (string, error) {
+	req, _ := s.client.GetObjectRequest(&s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	return req.Presign(ttl)
+}
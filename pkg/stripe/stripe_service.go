@@ -2,6 +2,7 @@ package stripe
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/stripe/stripe-go/v76"
 	checkoutsession "github.com/stripe/stripe-go/v76/checkout/session"
 	"github.com/stripe/stripe-go/v76/customer"
+	"github.com/stripe/stripe-go/v76/invoice"
 	"github.com/stripe/stripe-go/v76/paymentintent"
 	"github.com/stripe/stripe-go/v76/price"
 	"github.com/stripe/stripe-go/v76/product"
@@ -17,6 +19,9 @@ import (
 	"github.com/stripe/stripe-go/v76/webhook"
 )
 
+// ErrSubscriptionNotFound is returned when Stripe no longer knows a subscription ID
+var ErrSubscriptionNotFound = errors.New("stripe subscription not found")
+
 type StripeConfig struct {
 	SecretKey      string
 	PublishableKey string
@@ -33,6 +38,24 @@ type StripeService struct {
 	logger *logger.Logger
 }
 
+// SubscriptionClient is the part of the Stripe API used to manage existing subscriptions
+type SubscriptionClient interface {
+	CancelSubscription(ctx context.Context, subscriptionID string, atPeriodEnd bool) error
+	ReactivateSubscription(ctx context.Context, subscriptionID string) error
+	GetSubscription(ctx context.Context, subscriptionID string) (*StripeSubscription, error)
+	PreviewSubscriptionChange(ctx context.Context, subscriptionID string, plan *domain.SubscriptionPlan) (*StripeInvoicePreview, error)
+	ConvertCentsToDollars(cents int64) float64
+}
+
+var _ SubscriptionClient = (*StripeService)(nil)
+
+// CartCheckoutClient is the part of the Stripe API used to take payment for ticket carts
+type CartCheckoutClient interface {
+	CreateCheckoutSessionForCart(ctx context.Context, req CartCheckoutRequest) (*StripeCheckoutSession, error)
+}
+
+var _ CartCheckoutClient = (*StripeService)(nil)
+
 type CreateCustomerRequest struct {
 	Email string
 	Name  string
@@ -63,6 +86,7 @@ type StripeSubscription struct {
 	Status             string
 	CurrentPeriodStart time.Time
 	CurrentPeriodEnd   time.Time
+	CancelAtPeriodEnd  bool
 	PriceID            string
 	ClientSecret       string // For payment confirmation
 }
@@ -75,11 +99,39 @@ type StripePaymentIntent struct {
 	ClientSecret string
 }
 
+type StripeInvoicePreview struct {
+	AmountDue       int64 // in cents
+	ProrationAmount int64 // in cents, negative when the change results in a credit
+	Total           int64 // in cents
+	Currency        string
+	NextBillingDate *time.Time
+}
+
 type StripeCheckoutSession struct {
 	ID  string
 	URL string
 }
 
+// CartCheckoutRequest describes a ticket cart to be paid through a checkout session
+type CartCheckoutRequest struct {
+	CartReservationID string
+	UserID            int
+	// CustomerEmail prefills the payment form when set
+	CustomerEmail string
+	Items         []CartCheckoutItem
+	// ExpiresAt is when the cart hold runs out; Stripe keeps sessions open for at least 30 minutes
+	ExpiresAt time.Time
+}
+
+type CartCheckoutItem struct {
+	Name      string
+	UnitPrice float64
+	Quantity  int
+}
+
+// minCheckoutSessionLifetime is the shortest expiry Stripe accepts for a checkout session
+const minCheckoutSessionLifetime = 31 * time.Minute
+
 func NewStripeService(config StripeConfig, logger *logger.Logger) *StripeService {
 	stripe.Key = config.SecretKey
 	return &StripeService{
@@ -252,24 +304,48 @@ func (s *StripeService) CreateSubscription(ctx context.Context, req CreateSubscr
 	}, nil
 }
 
-func (s *StripeService) CancelSubscription(ctx context.Context, subscriptionID string) error {
+func (s *StripeService) CancelSubscription(ctx context.Context, subscriptionID string, atPeriodEnd bool) error {
+	var err error
+	if atPeriodEnd {
+		// Keep the subscription running until the end of the paid period
+		params := &stripe.SubscriptionParams{
+			CancelAtPeriodEnd: stripe.Bool(true),
+		}
+		_, err = subscription.Update(subscriptionID, params)
+	} else {
+		_, err = subscription.Cancel(subscriptionID, nil)
+	}
+	if err != nil {
+		s.logger.Error().Err(err).Str("subscription_id", subscriptionID).Bool("at_period_end", atPeriodEnd).Msg("Failed to cancel Stripe subscription")
+		return fmt.Errorf("failed to cancel subscription: %w", err)
+	}
+
+	s.logger.Info().Str("subscription_id", subscriptionID).Bool("at_period_end", atPeriodEnd).Msg("Stripe subscription cancelled")
+	return nil
+}
+
+func (s *StripeService) ReactivateSubscription(ctx context.Context, subscriptionID string) error {
 	params := &stripe.SubscriptionParams{
-		CancelAtPeriodEnd: stripe.Bool(true),
+		CancelAtPeriodEnd: stripe.Bool(false),
 	}
 
 	_, err := subscription.Update(subscriptionID, params)
 	if err != nil {
-		s.logger.Error().Err(err).Str("subscription_id", subscriptionID).Msg("Failed to cancel Stripe subscription")
-		return fmt.Errorf("failed to cancel subscription: %w", err)
+		s.logger.Error().Err(err).Str("subscription_id", subscriptionID).Msg("Failed to reactivate Stripe subscription")
+		return fmt.Errorf("failed to reactivate subscription: %w", err)
 	}
 
-	s.logger.Info().Str("subscription_id", subscriptionID).Msg("Stripe subscription cancelled")
+	s.logger.Info().Str("subscription_id", subscriptionID).Msg("Stripe subscription reactivated")
 	return nil
 }
 
 func (s *StripeService) GetSubscription(ctx context.Context, subscriptionID string) (*StripeSubscription, error) {
 	sub, err := subscription.Get(subscriptionID, nil)
 	if err != nil {
+		var stripeErr *stripe.Error
+		if errors.As(err, &stripeErr) && stripeErr.Code == stripe.ErrorCodeResourceMissing {
+			return nil, ErrSubscriptionNotFound
+		}
 		s.logger.Error().Err(err).Str("subscription_id", subscriptionID).Msg("Failed to get Stripe subscription")
 		return nil, fmt.Errorf("failed to get subscription: %w", err)
 	}
@@ -280,9 +356,86 @@ func (s *StripeService) GetSubscription(ctx context.Context, subscriptionID stri
 		Status:             string(sub.Status),
 		CurrentPeriodStart: time.Unix(sub.CurrentPeriodStart, 0),
 		CurrentPeriodEnd:   time.Unix(sub.CurrentPeriodEnd, 0),
+		CancelAtPeriodEnd:  sub.CancelAtPeriodEnd,
 	}, nil
 }
 
+// PreviewSubscriptionChange previews the upcoming invoice for switching an existing
+// subscription to the given plan. No changes are made to the subscription.
+func (s *StripeService) PreviewSubscriptionChange(ctx context.Context, subscriptionID string, plan *domain.SubscriptionPlan) (*StripeInvoicePreview, error) {
+	sub, err := subscription.Get(subscriptionID, nil)
+	if err != nil {
+		s.logger.Error().Err(err).Str("subscription_id", subscriptionID).Msg("Failed to get Stripe subscription")
+		return nil, fmt.Errorf("failed to get subscription: %w", err)
+	}
+
+	if sub.Items == nil || len(sub.Items.Data) == 0 {
+		return nil, fmt.Errorf("subscription has no items")
+	}
+	item := sub.Items.Data[0]
+	if item.Price == nil || item.Price.Product == nil {
+		return nil, fmt.Errorf("subscription item has no product")
+	}
+
+	interval := "month"
+	if plan.BillingCycle != nil && *plan.BillingCycle == domain.BillingCycleWeekly {
+		interval = "week"
+	}
+
+	prorationDate := time.Now().Unix()
+	params := &stripe.InvoiceUpcomingParams{
+		Customer:     stripe.String(sub.Customer.ID),
+		Subscription: stripe.String(sub.ID),
+		SubscriptionItems: []*stripe.SubscriptionItemsParams{
+			{
+				ID: stripe.String(item.ID),
+				PriceData: &stripe.SubscriptionItemPriceDataParams{
+					Product:    stripe.String(item.Price.Product.ID),
+					Currency:   stripe.String(s.config.Currency),
+					UnitAmount: stripe.Int64(s.ConvertDollarsToCents(plan.Price)),
+					Recurring: &stripe.SubscriptionItemPriceDataRecurringParams{
+						Interval: stripe.String(interval),
+					},
+				},
+			},
+		},
+		SubscriptionProrationBehavior: stripe.String("create_prorations"),
+		SubscriptionProrationDate:     stripe.Int64(prorationDate),
+	}
+
+	inv, err := invoice.Upcoming(params)
+	if err != nil {
+		s.logger.Error().Err(err).
+			Str("subscription_id", subscriptionID).
+			Int("plan_id", plan.ID).
+			Msg("Failed to preview Stripe upcoming invoice")
+		return nil, fmt.Errorf("failed to preview upcoming invoice: %w", err)
+	}
+
+	// Only the proration lines are charged (or credited) immediately
+	var prorationAmount int64
+	if inv.Lines != nil {
+		for _, line := range inv.Lines.Data {
+			if line.Proration {
+				prorationAmount += line.Amount
+			}
+		}
+	}
+
+	preview := &StripeInvoicePreview{
+		AmountDue:       inv.AmountDue,
+		ProrationAmount: prorationAmount,
+		Total:           inv.Total,
+		Currency:        string(inv.Currency),
+	}
+	if inv.NextPaymentAttempt > 0 {
+		nextBillingDate := time.Unix(inv.NextPaymentAttempt, 0)
+		preview.NextBillingDate = &nextBillingDate
+	}
+
+	return preview, nil
+}
+
 // Payment Intent Management (for one-time payments/packages)
 func (s *StripeService) CreatePaymentIntent(ctx context.Context, req CreatePaymentIntentRequest) (*StripePaymentIntent, error) {
 	params := &stripe.PaymentIntentParams{
@@ -355,7 +508,7 @@ func (s *StripeService) VerifyWebhookSignature(payload []byte, signature string)
 }
 
 // Checkout Session Management
-func (s *StripeService) CreateCheckoutSessionForSubscription(ctx context.Context, plan *domain.SubscriptionPlan, customerEmail, customerName string, userID uint) (*StripeCheckoutSession, error) {
+func (s *StripeService) CreateCheckoutSessionForSubscription(ctx context.Context, plan *domain.SubscriptionPlan, customerEmail, customerName string, userID uint, trialDays int) (*StripeCheckoutSession, error) {
 	// Create product and price first
 	_, priceID, err := s.CreateProductAndPrice(ctx, plan)
 	if err != nil {
@@ -381,6 +534,14 @@ func (s *StripeService) CreateCheckoutSessionForSubscription(ctx context.Context
 		},
 	}
 
+	// Start the subscription with a free trial when the user is eligible
+	if trialDays > 0 {
+		params.SubscriptionData = &stripe.CheckoutSessionSubscriptionDataParams{
+			TrialPeriodDays: stripe.Int64(int64(trialDays)),
+		}
+		params.Metadata["trial_days"] = fmt.Sprintf("%d", trialDays)
+	}
+
 	sess, err := checkoutsession.New(params)
 	if err != nil {
 		s.logger.Error().Err(err).Int("plan_id", plan.ID).Msg("Failed to create Stripe checkout session for subscription")
@@ -443,6 +604,62 @@ func (s *StripeService) CreateCheckoutSessionForPackage(ctx context.Context, pla
 	}, nil
 }
 
+// CreateCheckoutSessionForCart opens a payment session for the paid tickets of a cart. The
+// session metadata names the cart so the checkout webhooks can confirm or release it.
+func (s *StripeService) CreateCheckoutSessionForCart(ctx context.Context, req CartCheckoutRequest) (*StripeCheckoutSession, error) {
+	lineItems := make([]*stripe.CheckoutSessionLineItemParams, 0, len(req.Items))
+	for _, item := range req.Items {
+		lineItems = append(lineItems, &stripe.CheckoutSessionLineItemParams{
+			PriceData: &stripe.CheckoutSessionLineItemPriceDataParams{
+				Currency:   stripe.String(s.config.Currency),
+				UnitAmount: stripe.Int64(s.ConvertDollarsToCents(item.UnitPrice)),
+				ProductData: &stripe.CheckoutSessionLineItemPriceDataProductDataParams{
+					Name: stripe.String(item.Name),
+				},
+			},
+			Quantity: stripe.Int64(int64(item.Quantity)),
+		})
+	}
+
+	expiresAt := req.ExpiresAt
+	if earliest := time.Now().Add(minCheckoutSessionLifetime); expiresAt.Before(earliest) {
+		expiresAt = earliest
+	}
+
+	params := &stripe.CheckoutSessionParams{
+		PaymentMethodTypes: stripe.StringSlice([]string{"card"}),
+		LineItems:          lineItems,
+		Mode:               stripe.String(string(stripe.CheckoutSessionModePayment)),
+		SuccessURL:         stripe.String(s.config.SuccessURL),
+		CancelURL:          stripe.String(s.config.CancelURL),
+		ExpiresAt:          stripe.Int64(expiresAt.Unix()),
+		Metadata: map[string]string{
+			"user_id":             fmt.Sprintf("%d", req.UserID),
+			"cart_reservation_id": req.CartReservationID,
+			"type":                "tickets",
+		},
+	}
+	if req.CustomerEmail != "" {
+		params.CustomerEmail = stripe.String(req.CustomerEmail)
+	}
+
+	sess, err := checkoutsession.New(params)
+	if err != nil {
+		s.logger.Error().Err(err).Str("cart_id", req.CartReservationID).Msg("Failed to create Stripe checkout session for cart")
+		return nil, fmt.Errorf("failed to create checkout session: %w", err)
+	}
+
+	s.logger.Info().
+		Str("session_id", sess.ID).
+		Str("cart_id", req.CartReservationID).
+		Msg("Stripe checkout session created for cart")
+
+	return &StripeCheckoutSession{
+		ID:  sess.ID,
+		URL: sess.URL,
+	}, nil
+}
+
 // Helper methods
 func (s *StripeService) ConvertDollarsToCents(dollars float64) int64 {
 	return int64(dollars * 100)
@@ -0,0 +1,97 @@
+package timezone
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const googleTimezoneURL = "https://maps.googleapis.com/maps/api/timezone/json"
+
+// Resolver finds the IANA timezone of a point on the map
+type Resolver interface {
+	// Lookup returns the timezone name at the coordinates, or "" when lookups are disabled
+	Lookup(ctx context.Context, latitude, longitude float64) (string, error)
+}
+
+type ResolverConfig struct {
+	GoogleAPIKey string
+	Timeout      time.Duration
+}
+
+// NewResolver returns a Google Time Zone API resolver; without an API key every lookup
+// returns an empty name
+func NewResolver(config ResolverConfig) Resolver {
+	if config.GoogleAPIKey == "" {
+		return disabledResolver{}
+	}
+	return &googleResolver{
+		endpoint: googleTimezoneURL,
+		apiKey:   config.GoogleAPIKey,
+		client:   &http.Client{Timeout: config.Timeout},
+	}
+}
+
+type disabledResolver struct{}
+
+func (disabledResolver) Lookup(ctx context.Context, latitude, longitude float64) (string, error) {
+	return "", nil
+}
+
+type googleResolver struct {
+	endpoint string
+	apiKey   string
+	client   *http.Client
+}
+
+type googleTimezoneResponse struct {
+	Status       string `json:"status"`
+	TimeZoneID   string `json:"timeZoneId"`
+	ErrorMessage string `json:"errorMessage"`
+}
+
+func (r *googleResolver) Lookup(ctx context.Context, latitude, longitude float64) (string, error) {
+	query := url.Values{}
+	query.Set("location", strconv.FormatFloat(latitude, 'f', -1, 64)+","+strconv.FormatFloat(longitude, 'f', -1, 64))
+	query.Set("timestamp", strconv.FormatInt(time.Now().Unix(), 10))
+	query.Set("key", r.apiKey)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.endpoint+"?"+query.Encode(), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to build timezone request: %w", err)
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		// A *url.Error carries the request URL, API key included, into whatever logs the error
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return "", fmt.Errorf("failed to look up timezone: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("timezone lookup returned HTTP %d", resp.StatusCode)
+	}
+	var body googleTimezoneResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("failed to decode timezone response: %w", err)
+	}
+	// ZERO_RESULTS means open sea; the caller falls back to UTC
+	if body.Status == "ZERO_RESULTS" {
+		return "", nil
+	}
+	if body.Status != "OK" {
+		return "", fmt.Errorf("timezone lookup failed: %s %s", body.Status, body.ErrorMessage)
+	}
+	if _, err := time.LoadLocation(body.TimeZoneID); err != nil {
+		return "", fmt.Errorf("timezone lookup returned unknown zone %q", body.TimeZoneID)
+	}
+	return body.TimeZoneID, nil
+}
@@ -0,0 +1,78 @@
+package timezone
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testAPIKey = "test-secret-key"
+
+func newTestResolver(endpoint string) *googleResolver {
+	return &googleResolver{endpoint: endpoint, apiKey: testAPIKey, client: &http.Client{Timeout: time.Second}}
+}
+
+func TestGoogleResolverLookup(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		want    string
+		wantErr bool
+	}{
+		{"found", `{"status":"OK","timeZoneId":"Europe/Istanbul"}`, "Europe/Istanbul", false},
+		{"open sea", `{"status":"ZERO_RESULTS"}`, "", false},
+		{"denied", `{"status":"REQUEST_DENIED","errorMessage":"The provided API key is invalid."}`, "", true},
+		{"unknown zone", `{"status":"OK","timeZoneId":"Mars/Olympus_Mons"}`, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if got := r.URL.Query().Get("location"); got != "41.0082,28.9784" {
+					t.Errorf("location = %q, want 41.0082,28.9784", got)
+				}
+				if got := r.URL.Query().Get("key"); got != testAPIKey {
+					t.Errorf("key = %q, want the configured key", got)
+				}
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			got, err := newTestResolver(server.URL).Lookup(context.Background(), 41.0082, 28.9784)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Lookup() err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Lookup() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGoogleResolverErrorsHideAPIKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	if _, err := newTestResolver(server.URL).Lookup(context.Background(), 0, 0); err == nil || strings.Contains(err.Error(), testAPIKey) {
+		t.Errorf("HTTP error: err = %v, want an error without the API key", err)
+	}
+
+	// Once the server is gone the request itself fails, which is where the URL used to leak
+	server.Close()
+	_, err := newTestResolver(server.URL).Lookup(context.Background(), 0, 0)
+	if err == nil {
+		t.Fatal("Lookup() against a closed server succeeded")
+	}
+	if strings.Contains(err.Error(), testAPIKey) {
+		t.Errorf("Lookup() error leaks the API key: %v", err)
+	}
+}
+
+func TestDisabledResolver(t *testing.T) {
+	got, err := NewResolver(ResolverConfig{}).Lookup(context.Background(), 41.0082, 28.9784)
+	if err != nil || got != "" {
+		t.Errorf("Lookup() = %q, %v; want an empty name without an API key", got, err)
+	}
+}